@@ -7,6 +7,7 @@ require (
 	github.com/33cn/plugin v1.67.5-0.20221102075320-eb0191e2e8d7
 	github.com/btcsuite/btcd v0.22.1
 	github.com/golang/protobuf v1.5.2
+	github.com/hashicorp/golang-lru v0.5.5-0.20210104140557-80c98217689d
 	github.com/libp2p/go-libp2p v0.15.0
 	github.com/libp2p/go-libp2p-autonat v0.4.2
 	github.com/libp2p/go-libp2p-circuit v0.4.0
@@ -18,6 +19,8 @@ require (
 	github.com/panjf2000/gnet v1.4.3
 	github.com/phoreproject/bls v0.0.0-20200525203911-a88a5ae26844
 	github.com/pkg/errors v0.9.1
+	github.com/prometheus/client_golang v1.11.0
+	github.com/rcrowley/go-metrics v0.0.0-20190826022208-cac0b30c2563
 	github.com/spf13/cobra v1.1.1
 	github.com/stretchr/testify v1.8.0
 	golang.org/x/net v0.0.0-20220728211354-c7608f3a8462
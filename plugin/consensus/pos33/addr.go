@@ -0,0 +1,86 @@
+package pos33
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/common/address"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// addrDeriver 从抽签的公钥推导矿工地址, 用这个地址查询票数.
+//
+// 链上的票数和委托记录是按地址保存的, 推导出的地址格式必须和链上记录的一致,
+// 否则查询到的票数为0, 抽签不会中, 别人的抽签也验证不过.
+// 所以改变地址格式会改变所有人的抽签结果, 是共识分叉:
+// 达到ForkAddressFormat高度以后才使用配置的格式, 之前总是eth地址.
+// 格式在链的配置mver.consensus.pos33的addressFormat中设置, 所有节点相同
+type addrDeriver interface {
+	Addr(pubkey []byte) string
+}
+
+// idDeriver 使用chain33注册的地址驱动
+type idDeriver int32
+
+func (id idDeriver) Addr(pubkey []byte) string {
+	return address.PubKeyToAddr(int32(id), pubkey)
+}
+
+// newAddrDeriver 根据地址驱动的名字(eth, btc ...)创建, 为空使用eth
+func newAddrDeriver(format string) (addrDeriver, error) {
+	if format == "" {
+		return idDeriver(ethID), nil
+	}
+	id, err := address.GetDriverType(format)
+	if err != nil {
+		return nil, fmt.Errorf("address format %s error: %v", format, err)
+	}
+	return idDeriver(id), nil
+}
+
+// sortOwner 返回height高度抽签时, 公钥对应的矿工地址.
+// ForkKeyRotate以后是公钥绑定的抵押地址, 查询失败返回错误, 验证时不能当作票数为0
+func (n *node) sortOwner(height int64, pubkey []byte) (string, error) {
+	return n.keyOwner(height, pubkey, n.pubkeyAddr(height, pubkey))
+}
+
+// sortAddr 和sortOwner一样, 查询失败时返回"", 只用于日志和事件
+func (n *node) sortAddr(height int64, pubkey []byte) string {
+	addr, err := n.sortOwner(height, pubkey)
+	if err != nil {
+		plog.Error("sortAddr error", "err", err, "height", height)
+		return ""
+	}
+	return addr
+}
+
+// pubkeyAddr 返回height高度公钥推导出的地址.
+// 链的配置里格式写错时所有节点一样使用eth地址
+func (n *node) pubkeyAddr(height int64, pubkey []byte) string {
+	format := pt.GetPos33AddressFormat(n.GetAPI().GetConfig(), height)
+	d, err := newAddrDeriver(format)
+	if err != nil {
+		plog.Error("pubkeyAddr error", "err", err, "height", height)
+		return address.PubKeyToAddr(ethID, pubkey)
+	}
+	return d.Addr(pubkey)
+}
+
+// isMyPubkey pubkey是不是这个节点的挖矿公钥, 按height高度的地址格式推导地址比较
+func (n *node) isMyPubkey(height int64, pubkey []byte) bool {
+	addr := n.pubkeyAddr(height, pubkey)
+	for _, k := range n.minerKeys() {
+		if n.pubkeyAddr(height, k.priv.PubKey().Bytes()) == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// mySortAddr 返回height高度抽签时自己的矿工地址, 没有私钥时返回myAddr
+func (n *node) mySortAddr(height int64) string {
+	priv := n.priv
+	if priv == nil {
+		return n.myAddr
+	}
+	return n.sortAddr(height, priv.PubKey().Bytes())
+}
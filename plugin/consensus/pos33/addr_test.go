@@ -0,0 +1,101 @@
+package pos33
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// newAddrTestNode 创建使用format地址格式的节点, 链上记录的是recordFormat格式地址的票数
+func newAddrTestNode(t *testing.T, format, recordFormat string, height int64) *node {
+	n, api := newTestNodeCfg(t, testCfgString()+fmt.Sprintf("addressFormat=%q\n", format), nil)
+	n.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "ForkAddressFormat", height)
+	n.setTestMiner(newTestPriv(t))
+
+	rd, err := newAddrDeriver(recordFormat)
+	require.Nil(t, err)
+	record := rd.Addr(n.priv.PubKey().Bytes())
+	api.On("Query", pt.Pos33TicketX, "Pos33TicketCount", &types.ReqAddr{Addr: record}).Return(&types.Int64{Data: 10}, nil)
+	api.On("Query", pt.Pos33TicketX, "Pos33TicketCount", mock.Anything).Return(&types.Int64{Data: 0}, nil)
+	n.setTestCount("", height-pt.Pos33SortBlocks, 0, pt.Pos33CommitteeSize)
+	return n
+}
+
+func TestAddrDeriver(t *testing.T) {
+	_, err := newAddrDeriver("unknown")
+	require.NotNil(t, err)
+
+	priv := newTestPriv(t)
+	pub := priv.PubKey().Bytes()
+	for _, format := range []string{"", "eth", "btc"} {
+		d, err := newAddrDeriver(format)
+		require.Nil(t, err)
+		id := int32(ethID)
+		if format == "btc" {
+			id = 0
+		}
+		require.Equal(t, address.PubKeyToAddr(id, pub), d.Addr(pub), format)
+	}
+}
+
+func TestSortAddrFormat(t *testing.T) {
+	height := int64(100)
+	seed := []byte("address format seed")
+
+	// 地址格式和链上记录一致, 抽签能中, 也能验证
+	for _, format := range []string{"eth", "btc"} {
+		n := newAddrTestNode(t, format, format, height)
+		ss := n.committeeSort(context.Background(), seed, height, 0, Committee)
+		require.Equal(t, 10, len(ss), format)
+		require.Nil(t, n.verifySort(height, Committee, seed, ss[0]), format)
+	}
+
+	// 配置了btc, 但是链上记录的是eth地址, 票数为0
+	n := newAddrTestNode(t, "btc", "eth", height)
+	require.Empty(t, n.committeeSort(context.Background(), seed, height, 0, Committee))
+	require.Zero(t, n.queryTicketCount(n.sortAddr(height, n.priv.PubKey().Bytes()), height-pt.Pos33SortBlocks))
+
+	// eth节点的抽签在btc节点上验证不过
+	en := newAddrTestNode(t, "eth", "eth", height)
+	ss := en.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.NotEmpty(t, ss)
+	require.NotNil(t, n.verifySort(height, Committee, seed, ss[0]))
+
+	// 分叉高度之前总是使用eth地址
+	n.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "ForkAddressFormat", height+1)
+	require.Equal(t, 10, len(n.committeeSort(context.Background(), seed, height, 0, Committee)))
+}
+
+func TestNoSeatsAddrFormat(t *testing.T) {
+	height := int64(100)
+	seed := []byte("no seats address format seed")
+	n := newAddrTestNode(t, "btc", "btc", height)
+	// 全网票数很多, 10张票几乎不可能中签
+	n.setTestCount("", height-pt.Pos33SortBlocks, 0, pt.Pos33CommitteeSize*1000000000)
+
+	// 没有中签的证明使用链上记录的btc地址的票数
+	m := n.makeNoSeats(seed, height, 0, Committee)
+	require.NotNil(t, m)
+	require.Equal(t, int64(10), m.Count)
+	require.Nil(t, n.verifyNoSeats(seed, m))
+	require.Equal(t, address.PubKeyToAddr(0, n.priv.PubKey().Bytes()), n.mySortAddr(height))
+	require.NotEqual(t, n.myAddr, n.mySortAddr(height))
+}
+
+func TestIsMyPubkeyFormat(t *testing.T) {
+	height := int64(100)
+	n := newAddrTestNode(t, "btc", "btc", height)
+	pub := n.priv.PubKey().Bytes()
+
+	// btc地址和myAddr不同, 仍然是自己的公钥
+	require.NotEqual(t, n.myAddr, n.pubkeyAddr(height, pub))
+	require.True(t, n.isMyPubkey(height, pub))
+	require.True(t, n.isMyPubkey(height-1, pub))
+	require.False(t, n.isMyPubkey(height, newTestPriv(t).PubKey().Bytes()))
+}
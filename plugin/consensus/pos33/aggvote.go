@@ -0,0 +1,81 @@
+package pos33
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// blsPubkeys 按编号查询执行器里登记的bls公钥
+func (c *Client) blsPubkeys(is []int64) ([][]byte, error) {
+	resp, err := c.GetAPI().Query(pt.Pos33TicketX, "Pos33BlsPubkeys", &pt.ReqPos33BlsPubkeys{Indices: is})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pt.Pos33BlsPubkeys).Pks, nil
+}
+
+// blsPubkeysAt 在height高度的状态上按编号查询bls公钥, 和节点当前的高度无关
+func (c *Client) blsPubkeysAt(height int64) pt.Pos33BlsPubkeysFunc {
+	return func(is []int64) ([][]byte, error) {
+		resp, err := c.stateQuery(height, "Pos33BlsPubkeys", &pt.ReqPos33BlsPubkeys{Indices: is})
+		if err != nil {
+			return nil, err
+		}
+		return resp.(*pt.Pos33BlsPubkeys).Pks, nil
+	}
+}
+
+// blockMiner 返回区块的miner, ForkAggVote以后把聚合投票展开成BlsPkList和BlsSig,
+// 之前的区块不能有聚合投票. 编号和执行器一样用父区块的状态展开, 以后登记的公钥不影响结果
+func (c *Client) blockMiner(b *types.Block) (*pt.Pos33MinerMsg, error) {
+	m, err := getMiner(b)
+	if err != nil {
+		return nil, err
+	}
+	// 展开和验证之前检查大小
+	err = checkMinerLimits(m)
+	if err != nil {
+		return nil, err
+	}
+	if m.GetAgg() == nil {
+		return m, nil
+	}
+	if !c.GetAPI().GetConfig().IsDappFork(b.Height, pt.Pos33TicketX, "ForkAggVote") {
+		return nil, fmt.Errorf("%w: agg vote before fork, height %d", pt.ErrAggVote, b.Height)
+	}
+	err = m.Expand(c.blsPubkeysAt(b.Height - 1))
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// aggVote 把投票公钥换成链上的编号, 没有编号的公钥放在Pks里, 执行以后编号
+func (c *Client) aggVote(pks [][]byte, sig []byte) (*pt.Pos33AggVote, error) {
+	addrs := make([]string, 0, len(pks))
+	for _, pk := range pks {
+		addrs = append(addrs, address.PubKeyToAddr(ethID, pk))
+	}
+	resp, err := c.GetAPI().Query(pt.Pos33TicketX, "Pos33BlsIndices", &types.ReqAddrs{Addrs: addrs})
+	if err != nil {
+		return nil, err
+	}
+	is := resp.(*pt.Pos33BlsIndices).Indices
+	if len(is) != len(pks) {
+		return nil, errors.New("bls indices count NOT match")
+	}
+	var indices []int64
+	var rest [][]byte
+	for i, pk := range pks {
+		if is[i] < 0 {
+			rest = append(rest, pk)
+		} else {
+			indices = append(indices, is[i])
+		}
+	}
+	return pt.NewPos33AggVote(indices, rest, sig), nil
+}
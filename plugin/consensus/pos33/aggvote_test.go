@@ -0,0 +1,114 @@
+package pos33
+
+import (
+	"context"
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func TestAggVote(t *testing.T) {
+	n, api := newTestNode(t, nil)
+	n.setTestMiner(newTestPriv(t))
+	cfg := n.GetAPI().GetConfig()
+
+	height := int64(100)
+	seed := []byte("agg vote seed")
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+	ss := n.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.NotEmpty(t, ss)
+	s := ss[0]
+	pb := newTestBlock(height-1, nil)
+
+	// 一半投票公钥已经编号
+	quorum := pt.Pos33VoterSize/2 + 1
+	var vs []*pt.Pos33VoteMsg
+	var reg [][]byte
+	idx := make(map[string]int64)
+	for i := 0; i < quorum; i++ {
+		v := &pt.Pos33VoteMsg{Hash: s.SortHash.Hash, Sort: s}
+		v.Sign(newTestPriv(t))
+		vs = append(vs, v)
+		if i%2 == 0 {
+			idx[address.PubKeyToAddr(ethID, v.Sig.Pubkey)] = int64(len(reg))
+			reg = append(reg, v.Sig.Pubkey)
+		}
+	}
+	api.On("Query", pt.Pos33TicketX, "Pos33BlsIndices", mock.Anything).Return(func(_, _ string, req types.Message) types.Message {
+		r := &pt.Pos33BlsIndices{}
+		for _, addr := range req.(*types.ReqAddrs).Addrs {
+			i, ok := idx[addr]
+			if !ok {
+				i = -1
+			}
+			r.Indices = append(r.Indices, i)
+		}
+		return r
+	}, nil)
+	// 父区块状态里的编号, 以后的状态编号对应别的公钥, 展开时不能使用
+	mockStateHeaders(api)
+	later := make([][]byte, len(reg))
+	for i := range later {
+		later[i] = newTestPriv(t).PubKey().Bytes()
+	}
+	states := map[byte][][]byte{byte(height - 1): reg, byte(height): later}
+	api.On("QueryChain", mock.MatchedBy(func(p *types.ChainExecutor) bool {
+		return p.FuncName == "Pos33BlsPubkeys"
+	})).Return(func(p *types.ChainExecutor) types.Message {
+		req := new(pt.ReqPos33BlsPubkeys)
+		require.Nil(t, types.Decode(p.Param, req))
+		r := &pt.Pos33BlsPubkeys{}
+		for _, i := range req.Indices {
+			r.Pks = append(r.Pks, states[p.StateHash[0]][i])
+		}
+		return r
+	}, nil)
+	newBlock := func() *types.Block {
+		tx, err := n.minerTx(height, 0, s, vs, n.priv)
+		require.Nil(t, err)
+		return &types.Block{Height: height, Txs: []*types.Transaction{tx}}
+	}
+
+	// 分叉之前使用公钥列表
+	cfg.SetDappFork(pt.Pos33TicketX, "ForkAggVote", height+1)
+	lb := newBlock()
+	lm, err := getMiner(lb)
+	require.Nil(t, err)
+	require.Nil(t, lm.Agg)
+	require.Equal(t, quorum, len(lm.BlsPkList))
+
+	cfg.SetDappFork(pt.Pos33TicketX, "ForkAggVote", height)
+	ab := newBlock()
+	am, err := getMiner(ab)
+	require.Nil(t, err)
+	require.NotNil(t, am.Agg)
+	require.Empty(t, am.BlsPkList)
+	require.Equal(t, quorum/2, len(am.Agg.Pks))
+	require.Equal(t, quorum, am.VoterCount())
+	require.Less(t, types.Size(ab.Txs[0]), types.Size(lb.Txs[0]))
+
+	// 展开以后和公钥列表的投票相同, 两种区块都能通过验证
+	em, err := n.blockMiner(ab)
+	require.Nil(t, err)
+	require.ElementsMatch(t, lm.BlsPkList, em.BlsPkList)
+	require.Equal(t, lm.BlsSig, em.BlsSig)
+	r := n.verifyBlockConsensus(ab, pb, seed)
+	require.Nil(t, r.Err(), r.String())
+	r = n.verifyBlockConsensus(lb, pb, seed)
+	require.Nil(t, r.Err(), r.String())
+
+	// NoAggVote的节点分叉以后仍然使用公钥列表
+	n.conf.NoAggVote = true
+	m, err := getMiner(newBlock())
+	require.Nil(t, err)
+	require.Nil(t, m.Agg)
+	n.conf.NoAggVote = false
+
+	// 分叉之前不接受聚合投票
+	cfg.SetDappFork(pt.Pos33TicketX, "ForkAggVote", height+1)
+	requireOnlyFailed(t, n.verifyBlockConsensus(ab, pb, seed), CheckMiner)
+}
@@ -0,0 +1,251 @@
+package pos33
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// auditFileBlocks 每个审计日志文件保存多少个高度
+const auditFileBlocks = 1000
+
+// auditMaxPending 最多缓存多少个还没有写入的高度, 超过的丢弃最低的
+const auditMaxPending = pt.Pos33SortBlocks * 4
+
+var errAuditNotFound = errors.New("audit record NOT found")
+
+// auditLog 共识审计日志. 每个高度的seed, 难度, 自己的抽签, 收到的抽签和验证时的输入,
+// 最终的委员会先记在内存里, 区块加入链以后作为一条Pos33AuditRecord追加到文件.
+// 文件按高度分段, 每条记录是4字节长度加protobuf, 回滚以后同一个高度可能有多条, 最后一条有效.
+// 没有配置AuditDir时为nil, 所有方法什么都不做
+type auditLog struct {
+	dir string
+
+	mu   sync.Mutex
+	recs map[int64]*pt.Pos33AuditRecord
+}
+
+func newAuditLog(dir string) *auditLog {
+	if dir == "" {
+		return nil
+	}
+	return &auditLog{dir: dir, recs: make(map[int64]*pt.Pos33AuditRecord)}
+}
+
+func (a *auditLog) record(height int64) *pt.Pos33AuditRecord {
+	r, ok := a.recs[height]
+	if !ok {
+		if len(a.recs) >= auditMaxPending {
+			min := height
+			for h := range a.recs {
+				if h < min {
+					min = h
+				}
+			}
+			if min == height {
+				return &pt.Pos33AuditRecord{}
+			}
+			delete(a.recs, min)
+		}
+		r = &pt.Pos33AuditRecord{Height: height}
+		a.recs[height] = r
+	}
+	return r
+}
+
+// addSorts 记录verifySortCount的输入和结果
+func (a *auditLog) addSorts(height int64, ss []*pt.Pos33AuditSort) {
+	if a == nil || len(ss) == 0 {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	r := a.record(height)
+	r.Sorts = append(r.Sorts, ss...)
+}
+
+// addMySorts 记录自己的抽签结果
+func (a *auditLog) addMySorts(height int64, ms []*pt.Pos33SortMsg) {
+	if a == nil || len(ms) == 0 {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	r := a.record(height)
+	r.MySorts = append(r.MySorts, ms...)
+}
+
+// setCommittee 记录height高度round轮确定的委员会
+func (a *auditLog) setCommittee(height int64, round int, comm []*pt.Pos33SortMsg) {
+	if a == nil {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	r := a.record(height)
+	r.Round = int32(round)
+	r.Committee = nil
+	for _, s := range comm {
+		r.Committee = append(r.Committee, s.SortHash.Hash)
+	}
+}
+
+// flush 区块height加入链以后写入这个高度的记录, 删除这个高度和以前的缓存
+func (a *auditLog) flush(height int64, round int, seed []byte, diff float64) error {
+	if a == nil {
+		return nil
+	}
+	a.mu.Lock()
+	r := a.record(height)
+	for h := range a.recs {
+		if h <= height {
+			delete(a.recs, h)
+		}
+	}
+	a.mu.Unlock()
+	r.Round = int32(round)
+	r.Seed = seed
+	r.Diff = diff
+	return appendAuditRecord(a.dir, r)
+}
+
+func auditFile(dir string, height int64) string {
+	return filepath.Join(dir, fmt.Sprintf("%d.audit", height/auditFileBlocks))
+}
+
+func appendAuditRecord(dir string, r *pt.Pos33AuditRecord) error {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(auditFile(dir, r.Height), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	data := types.Encode(r)
+	buf := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(buf, uint32(len(data)))
+	copy(buf[4:], data)
+	_, err = f.Write(buf)
+	if err1 := f.Close(); err == nil {
+		err = err1
+	}
+	return err
+}
+
+// readAuditRecord 返回文件里height高度的最后一条记录
+func readAuditRecord(dir string, height int64) (*pt.Pos33AuditRecord, error) {
+	f, err := os.Open(auditFile(dir, height))
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("%w: height %d", errAuditNotFound, height)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var found *pt.Pos33AuditRecord
+	var lb [4]byte
+	for {
+		_, err = io.ReadFull(f, lb[:])
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		data := make([]byte, binary.BigEndian.Uint32(lb[:]))
+		_, err = io.ReadFull(f, data)
+		if err != nil {
+			// 最后一条没有写完
+			break
+		}
+		r := new(pt.Pos33AuditRecord)
+		err = types.Decode(data, r)
+		if err != nil {
+			return nil, err
+		}
+		if r.Height == height {
+			found = r
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("%w: height %d", errAuditNotFound, height)
+	}
+	return found, nil
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// replayAudit 用记录的seed, 票数和难度重新做verifySortCount, 返回和记录的结果不同的抽签
+func (n *node) replayAudit(r *pt.Pos33AuditRecord) *pt.Pos33AuditReplay {
+	rp := &pt.Pos33AuditReplay{
+		Height:    r.Height,
+		Round:     r.Round,
+		MySorts:   int32(len(r.MySorts)),
+		Sorts:     int32(len(r.Sorts)),
+		Committee: int32(len(r.Committee)),
+	}
+	for _, s := range r.Sorts {
+		m := s.Sort
+		if m == nil || m.Proof == nil || m.Proof.Input == nil || m.SortHash == nil {
+			continue
+		}
+		err := n.verifySortCount(r.Height, int(s.Ty), s.Seed, m, s.Count, s.Diff)
+		if errString(err) == s.Err {
+			continue
+		}
+		rp.Divergences = append(rp.Divergences, &pt.Pos33AuditDivergence{
+			SortHash: m.SortHash.Hash,
+			Ty:       s.Ty,
+			Round:    m.Proof.Input.Round,
+			Addr:     s.Addr,
+			Recorded: s.Err,
+			Replayed: errString(err),
+		})
+	}
+	return rp
+}
+
+// Query_ReplayAudit 读取审计日志里req.Height高度的记录, 重新验证收到的抽签, 返回不一致的结果
+func (client *Client) Query_ReplayAudit(req *types.ReqInt) (types.Message, error) {
+	if client.conf.AuditDir == "" {
+		return nil, errors.New("audit log NOT enabled, set auditDir")
+	}
+	r, err := readAuditRecord(client.conf.AuditDir, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	return client.n.replayAudit(r), nil
+}
+
+// flushAudit 区块b加入链以后写入b.Height高度的审计记录
+func (n *node) flushAudit(b *types.Block) {
+	if n.audit == nil {
+		return
+	}
+	seed, err := n.calcSeed(b.Height)
+	if err != nil {
+		plog.Error("audit seed error", "err", err, "height", b.Height)
+	}
+	diff, err := n.getDiff(b.Height, 0)
+	if err != nil {
+		plog.Error("audit diff error", "err", err, "height", b.Height)
+		return
+	}
+	err = n.audit.flush(b.Height, blockRound(b), seed, diff)
+	if err != nil {
+		plog.Error("write audit log error", "err", err, "height", b.Height)
+	}
+}
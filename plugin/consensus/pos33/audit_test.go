@@ -0,0 +1,67 @@
+package pos33
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func TestAuditReplay(t *testing.T) {
+	dir := t.TempDir()
+	n, _ := newTestNode(t, &subConfig{AuditDir: dir})
+	n.setTestMiner(newTestPriv(t))
+	height := int64(100)
+	seed := []byte("audit seed")
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+
+	ss := n.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.Equal(t, 10, len(ss))
+	bad := proto.Clone(ss[1]).(*pt.Pos33SortMsg)
+	bad.Proof.VrfHash = hash2([]byte("other"))
+	errs := n.verifySorts(height, Committee, seed, []*pt.Pos33SortMsg{ss[0], bad})
+	require.Nil(t, errs[0])
+	require.NotNil(t, errs[1])
+	n.audit.setCommittee(height, 0, ss[:1])
+	require.Nil(t, n.audit.flush(height, 0, seed, 1))
+
+	_, err := readAuditRecord(dir, height+1)
+	require.True(t, errors.Is(err, errAuditNotFound))
+	r, err := readAuditRecord(dir, height)
+	require.Nil(t, err)
+	require.Equal(t, 10, len(r.MySorts))
+	require.Equal(t, 2, len(r.Sorts))
+	require.Equal(t, "", r.Sorts[0].Err)
+	require.Equal(t, errs[1].Error(), r.Sorts[1].Err)
+	require.Equal(t, int64(10), r.Sorts[0].Count)
+	require.Equal(t, [][]byte{ss[0].SortHash.Hash}, r.Committee)
+
+	// 按记录的输入重新验证, 结果相同
+	resp, err := n.Query_ReplayAudit(&types.ReqInt{Height: height})
+	require.Nil(t, err)
+	rp := resp.(*pt.Pos33AuditReplay)
+	require.Equal(t, int32(2), rp.Sorts)
+	require.Empty(t, rp.Divergences)
+
+	// 回滚以后同一个高度再写一次, 读最后一条. 记录的结果和现在的验证不同
+	r.Sorts[1].Err = ""
+	require.Nil(t, appendAuditRecord(dir, r))
+	r, err = readAuditRecord(dir, height)
+	require.Nil(t, err)
+	rp = n.replayAudit(r)
+	require.Equal(t, 1, len(rp.Divergences))
+	require.Equal(t, "", rp.Divergences[0].Recorded)
+	require.Equal(t, errs[1].Error(), rp.Divergences[0].Replayed)
+
+	// 没有配置时不记录
+	n2, _ := newTestNode(t, nil)
+	require.Nil(t, n2.audit)
+	n2.audit.addMySorts(height, ss)
+	require.Nil(t, n2.audit.flush(height, 0, seed, 1))
+	_, err = n2.Query_ReplayAudit(&types.ReqInt{Height: height})
+	require.NotNil(t, err)
+}
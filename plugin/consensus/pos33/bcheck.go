@@ -0,0 +1,332 @@
+package pos33
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// 区块共识检查项的名字
+const (
+	CheckMiner  = "miner"
+	CheckRound  = "round"
+	CheckSeed   = "seed"
+	CheckDiff   = "diff"
+	CheckSort   = "sort"
+	CheckSeats  = "seats"
+	CheckDigest = "digest"
+	CheckCommit = "commit"
+	CheckSig    = "blocksig"
+	CheckQuorum = "quorum"
+	CheckReward = "reward"
+)
+
+var errCheckSkipped = errors.New("check skipped")
+
+// blockCheckNames miner之后的检查项, 按检查的顺序
+var blockCheckNames = []string{CheckRound, CheckSeed, CheckDiff, CheckSort, CheckSeats, CheckDigest, CheckQuorum, CheckReward, CheckCommit, CheckSig}
+
+var errRoundEvidence = errors.New("block time too early for the round")
+
+var errBlockTooLarge = errors.New("block consensus data too large")
+
+// roundEvidenceTime 每超时一轮, 区块时间至少比父区块晚这么多(毫秒).
+// 实际每轮至少5秒(blockTimeout + resortTimeout + voteCommitteeTmo), 留出时钟误差
+const roundEvidenceTime = 2000
+
+// CheckResult 一项检查的结果, Err为nil表示通过
+type CheckResult struct {
+	Name string
+	Err  error
+}
+
+// BlockCheckResult 一个区块所有共识检查项的结果, 按检查的顺序
+type BlockCheckResult struct {
+	Height int64
+	Round  int
+	Checks []*CheckResult
+}
+
+func (r *BlockCheckResult) add(name string, err error) bool {
+	r.Checks = append(r.Checks, &CheckResult{Name: name, Err: err})
+	return err == nil
+}
+
+// Get 返回name检查项的结果
+func (r *BlockCheckResult) Get(name string) *CheckResult {
+	for _, c := range r.Checks {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// Err 返回第一个没有通过的检查项的错误, 全部通过返回nil
+func (r *BlockCheckResult) Err() error {
+	for _, c := range r.Checks {
+		if c.Err != nil {
+			return fmt.Errorf("block %d check %s error: %v", r.Height, c.Name, c.Err)
+		}
+	}
+	return nil
+}
+
+func (r *BlockCheckResult) String() string {
+	var ss []string
+	for _, c := range r.Checks {
+		s := "ok"
+		if c.Err != nil {
+			s = c.Err.Error()
+		}
+		ss = append(ss, c.Name+": "+s)
+	}
+	return fmt.Sprintf("height %d, round %d, %s", r.Height, r.Round, strings.Join(ss, ", "))
+}
+
+// VerifyBlockConsensus 一次检查区块所有的共识数据:
+// 轮次, seed, diff, miner抽签, 票数上限, 投票的摘要, 法定投票数, 奖励, 票数承诺, 出块人对区块的签名.
+// 每一项都有结果, 前面的检查失败后, 依赖它的检查是errCheckSkipped
+func (client *Client) VerifyBlockConsensus(b *types.Block) *BlockCheckResult {
+	r := &BlockCheckResult{Height: b.Height}
+	pb, err := client.RequestBlock(b.Height - 1)
+	if err != nil {
+		r.add(CheckRound, err)
+		return r
+	}
+	seed, err := client.n.calcSeed(b.Height)
+	if err != nil {
+		r.add(CheckSeed, err)
+		return r
+	}
+	tb := time.Now()
+	r = client.n.verifyBlockConsensus(b, pb, seed)
+	blockVerifyTimer.UpdateSince(tb)
+	if r.Err() != nil {
+		blockVerifyFailed.Inc(1)
+	}
+	return r
+}
+
+// checkMinerLimits 验证之前检查miner交易的大小, 防止构造验证很慢的区块攻击节点.
+// 出块时最多打包Pos33VoterSize个投票和maxBlockEvidences个证据, 超过的区块不用验证.
+// 只看区块里的数据, 所有节点的结果相同
+func checkMinerLimits(m *pt.Pos33MinerMsg) error {
+	if n := m.VoterCount(); n > pt.Pos33VoterSize {
+		return fmt.Errorf("%w: %d votes > %d", errBlockTooLarge, n, pt.Pos33VoterSize)
+	}
+	if n := len(m.Evidences); n > maxBlockEvidences {
+		return fmt.Errorf("%w: %d evidences > %d", errBlockTooLarge, n, maxBlockEvidences)
+	}
+	return nil
+}
+
+func (n *node) verifyBlockConsensus(b, pb *types.Block, seed []byte) *BlockCheckResult {
+	r := &BlockCheckResult{Height: b.Height}
+	skip := func(from int) *BlockCheckResult {
+		for _, name := range blockCheckNames[from:] {
+			r.add(name, errCheckSkipped)
+		}
+		return r
+	}
+	m, err := n.blockMiner(b)
+	if err == nil && (m.Sort == nil || m.Sort.Proof == nil || m.Sort.Proof.Input == nil || m.Sort.SortHash == nil) {
+		err = fmt.Errorf("miner tx error")
+	}
+	if !r.add(CheckMiner, err) {
+		return skip(0)
+	}
+	r.Round = int(m.Sort.Proof.Input.Round)
+
+	r.add(CheckRound, n.checkRoundEvidence(b, pb, m))
+	if !r.add(CheckSeed, checkBlockSeed(b, seed)) {
+		return skip(2)
+	}
+	// seed以后的检查互相独立, 慢的vrf和bls验证放在前面, 先拿到空闲的协程
+	names := []string{CheckSort, CheckQuorum, CheckSeats, CheckDiff, CheckDigest, CheckReward, CheckCommit, CheckSig}
+	errs := n.runChecks([]func() error{
+		func() error { return n.verifySort(b.Height, Committee, seed, m.Sort) },
+		func() error { return n.checkQuorum(b.Height, m) },
+		func() error { return n.checkSeats(b.Height, m.Sort) },
+		func() error { return n.checkDiffSchedule(b.Height) },
+		func() error { return n.checkMinerDigest(b.Height, m) },
+		func() error { return n.checkMinerReward(b.Height, m) },
+		func() error { return n.checkTicketCommits(b.Height, m) },
+		func() error { return n.checkBlockSig(b, m) },
+	})
+	mp := make(map[string]error)
+	for i, name := range names {
+		mp[name] = errs[i]
+	}
+	// 结果还是按blockCheckNames的顺序
+	for _, name := range blockCheckNames[2:] {
+		r.add(name, mp[name])
+	}
+	if r.Err() == nil {
+		n.shadowBlock(b, pb, m)
+	}
+	return r
+}
+
+// runChecks 并行做互相独立的检查, 返回的错误和fs一一对应.
+// 没有空闲的验证协程时在当前协程做, 所有区块的验证一共最多多用verifyWorkers个协程
+func (n *node) runChecks(fs []func() error) []error {
+	errs := make([]error, len(fs))
+	run := func(i int) {
+		errs[i] = fs[i]()
+	}
+	var wg sync.WaitGroup
+	for i := range fs {
+		// 最后一个总是在当前协程做
+		if i < len(fs)-1 {
+			select {
+			case n.vworkers <- struct{}{}:
+				wg.Add(1)
+				go func(i int) {
+					defer func() {
+						<-n.vworkers
+						wg.Done()
+					}()
+					run(i)
+				}(i)
+				continue
+			default:
+			}
+		}
+		run(i)
+	}
+	wg.Wait()
+	return errs
+}
+
+// checkRoundEvidence 检查区块声明的轮次确实发生过.
+// 第3轮以后不检查投票签名, 矿工不能直接跳到高的轮次. 前面每一轮超时都要花时间,
+// 区块时间和父区块时间的差就是轮次推进的证据. 达到ForkRoundEvidence高度后生效
+func (n *node) checkRoundEvidence(b, pb *types.Block, m *pt.Pos33MinerMsg) error {
+	if !n.GetAPI().GetConfig().IsDappFork(b.Height, pt.Pos33TicketX, "ForkRoundEvidence") {
+		return nil
+	}
+	return roundEvidence(b, pb, m)
+}
+
+// roundEvidence 不考虑ForkRoundEvidence的轮次证据检查
+func roundEvidence(b, pb *types.Block, m *pt.Pos33MinerMsg) error {
+	round := int64(m.Sort.Proof.Input.Round)
+	if round == 0 {
+		return nil
+	}
+	if pb == nil || pb.Height != b.Height-1 {
+		return fmt.Errorf("parent block NOT match, height %d", b.Height)
+	}
+	if pb.Height == 0 {
+		return nil
+	}
+	pm, err := getMiner(pb)
+	if err != nil {
+		return err
+	}
+	if m.BlockTime-pm.BlockTime < round*roundEvidenceTime {
+		return errRoundEvidence
+	}
+	return nil
+}
+
+// checkDiffSchedule ForkBlockCheck以后检查抽签快照高度的状态里有全网票数, 抽签的diff按它计算
+func (n *node) checkDiffSchedule(height int64) error {
+	if height <= pt.Pos33SortBlocks || !n.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkBlockCheck") {
+		return nil
+	}
+	all, err := n.sortTotal(height)
+	if err != nil {
+		return err
+	}
+	if all <= 0 {
+		return fmt.Errorf("all count is %d, height %d", all, height)
+	}
+	return nil
+}
+
+// checkSeats ForkBlockCheck以后检查中签的票号没有超过矿工的票数, 矿工的票数没有超过全网票数.
+// 票数都来自抽签快照高度的状态
+func (n *node) checkSeats(height int64, s *pt.Pos33SortMsg) error {
+	if height <= pt.Pos33SortBlocks || !n.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkBlockCheck") {
+		return nil
+	}
+	addr, err := n.sortOwner(height, s.Proof.Pubkey)
+	if err != nil {
+		return err
+	}
+	count, err := n.sortCount(addr, height)
+	if err != nil {
+		return err
+	}
+	if s.SortHash.Index < 0 || s.SortHash.Index >= count {
+		return fmt.Errorf("sort index %d out of count %d", s.SortHash.Index, count)
+	}
+	all, err := n.sortTotal(height)
+	if err != nil {
+		return err
+	}
+	if count > all {
+		return fmt.Errorf("miner count %d > all count %d", count, all)
+	}
+	return nil
+}
+
+// checkMinerDigest ForkBlockCheck以后检查投票签名的摘要就是miner的抽签hash
+func (n *node) checkMinerDigest(height int64, m *pt.Pos33MinerMsg) error {
+	if !n.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkBlockCheck") {
+		return nil
+	}
+	if string(m.Hash) != string(m.Sort.SortHash.Hash) {
+		return fmt.Errorf("miner hash NOT match sort hash")
+	}
+	return nil
+}
+
+// checkMinerReward ForkRewardV2以后检查miner tx声明的奖励和按投票数, 轮次计算的一致, 以前不能有奖励
+func (n *node) checkMinerReward(height int64, m *pt.Pos33MinerMsg) error {
+	cfg := n.GetAPI().GetConfig()
+	if !cfg.IsDappFork(height, pt.Pos33TicketX, "ForkRewardV2") {
+		if m.Reward != nil {
+			return fmt.Errorf("%w: miner reward before fork, height %d", pt.ErrMinerReward, height)
+		}
+		return nil
+	}
+	expect, err := pt.GetPos33MineParam(cfg, height).MinerRewardV2(len(m.BlsPkList), int(m.Sort.Proof.Input.Round))
+	if err != nil {
+		return err
+	}
+	return m.Reward.Check(expect)
+}
+
+// checkQuorum 检查投票满足height高度的QuorumRule, 聚合签名正确, ForkBlockCheck以后投票人不能重复.
+// 和以前的blockCheck一样, 第3轮以后不检查签名
+func (n *node) checkQuorum(height int64, m *pt.Pos33MinerMsg) error {
+	if n.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkBlockCheck") {
+		mp := make(map[string]bool)
+		for _, pk := range m.BlsPkList {
+			if mp[string(pk)] {
+				return fmt.Errorf("duplicate voter")
+			}
+			mp[string(pk)] = true
+		}
+	}
+	rule, err := n.quorumRule(height)
+	if err != nil {
+		return err
+	}
+	err = n.quorumSufficient(rule, height, m)
+	if err != nil {
+		return err
+	}
+	if m.Sort.Proof.Input.Round >= 3 {
+		return nil
+	}
+	return m.Verify()
+}
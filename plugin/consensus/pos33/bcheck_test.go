@@ -0,0 +1,279 @@
+package pos33
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// newTestMinerBlock 用nv个不同投票人对hash的投票, 生成一个包含miner交易的区块
+func newTestMinerBlock(t *testing.T, n *node, s *pt.Pos33SortMsg, hash []byte, nv int) *types.Block {
+	var vs []*pt.Pos33VoteMsg
+	for i := 0; i < nv; i++ {
+		v := &pt.Pos33VoteMsg{Hash: hash, Sort: s}
+		v.Sign(newTestPriv(t))
+		vs = append(vs, v)
+	}
+	height := s.Proof.Input.Height
+	tx, err := n.minerTx(height, int(s.Proof.Input.Round), s, vs, n.priv)
+	require.Nil(t, err)
+	m, err := getMiner(&types.Block{Txs: []*types.Transaction{tx}})
+	require.Nil(t, err)
+	m.Hash = hash
+	act := &pt.Pos33TicketAction{Value: &pt.Pos33TicketAction_Miner{Miner: m}, Ty: pt.Pos33TicketActionMiner}
+	tx.Payload = types.Encode(act)
+	return &types.Block{Height: height, Txs: []*types.Transaction{tx}}
+}
+
+// requireOnlyFailed 检查只有name检查项失败, 其他的通过或者跳过
+func requireOnlyFailed(t *testing.T, r *BlockCheckResult, name string) {
+	require.NotNil(t, r.Err(), r.String())
+	for _, c := range r.Checks {
+		if c.Name == name {
+			require.NotNil(t, c.Err, r.String())
+			require.NotEqual(t, errCheckSkipped, c.Err, r.String())
+		} else if c.Err != errCheckSkipped {
+			require.Nil(t, c.Err, "%s: %s", name, r.String())
+		}
+	}
+}
+
+func TestVerifyBlockConsensus(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	n.setTestMiner(newTestPriv(t))
+	cfg := n.GetAPI().GetConfig()
+	cfg.SetDappFork(pt.Pos33TicketX, "ForkBlockCheck", 0)
+
+	height := int64(100)
+	sh := height - pt.Pos33SortBlocks
+	seed := []byte("block check seed")
+	n.setTestCount(n.myAddr, sh, 10, pt.Pos33CommitteeSize)
+	ss := n.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.NotEmpty(t, ss)
+	s := ss[0]
+	quorum := pt.Pos33VoterSize/2 + 1
+	pb := newTestBlock(height-1, nil)
+
+	b := newTestMinerBlock(t, n, s, s.SortHash.Hash, quorum)
+	r := n.verifyBlockConsensus(b, pb, seed)
+	require.Nil(t, r.Err(), r.String())
+	require.Equal(t, 11, len(r.Checks))
+	require.Nil(t, r.Get(CheckQuorum).Err)
+
+	t.Run(CheckMiner, func(t *testing.T) {
+		r := n.verifyBlockConsensus(&types.Block{Height: height}, pb, seed)
+		requireOnlyFailed(t, r, CheckMiner)
+		require.Equal(t, errCheckSkipped, r.Get(CheckQuorum).Err)
+	})
+	t.Run(CheckSeed, func(t *testing.T) {
+		requireOnlyFailed(t, n.verifyBlockConsensus(b, pb, []byte("other seed")), CheckSeed)
+	})
+	t.Run(CheckDiff, func(t *testing.T) {
+		// 快照高度没有全网票数, 抽签和座位也跟着失败
+		n.setTestCount(n.myAddr, sh, 10, 0)
+		defer n.setTestCount(n.myAddr, sh, 10, pt.Pos33CommitteeSize)
+		r := n.verifyBlockConsensus(b, pb, seed)
+		require.NotNil(t, r.Get(CheckDiff).Err, r.String())
+		require.NotNil(t, r.Get(CheckSort).Err, r.String())
+	})
+	t.Run(CheckSort, func(t *testing.T) {
+		fs := types.Clone(s).(*pt.Pos33SortMsg)
+		fs.Proof.VrfHash = hash2([]byte("fake vrf hash"))
+		fb := newTestMinerBlock(t, n, fs, fs.SortHash.Hash, quorum)
+		requireOnlyFailed(t, n.verifyBlockConsensus(fb, pb, seed), CheckSort)
+	})
+	t.Run(CheckSeats, func(t *testing.T) {
+		// 矿工的票数超过了全网票数
+		n.setTestCount(n.myAddr, sh, pt.Pos33CommitteeSize+1, pt.Pos33CommitteeSize)
+		defer n.setTestCount(n.myAddr, sh, 10, pt.Pos33CommitteeSize)
+		requireOnlyFailed(t, n.verifyBlockConsensus(b, pb, seed), CheckSeats)
+	})
+	t.Run(CheckDigest, func(t *testing.T) {
+		fb := newTestMinerBlock(t, n, s, hash2([]byte("other digest")), quorum)
+		requireOnlyFailed(t, n.verifyBlockConsensus(fb, pb, seed), CheckDigest)
+	})
+	t.Run(CheckQuorum, func(t *testing.T) {
+		fb := newTestMinerBlock(t, n, s, s.SortHash.Hash, quorum-1)
+		requireOnlyFailed(t, n.verifyBlockConsensus(fb, pb, seed), CheckQuorum)
+	})
+	t.Run("before fork", func(t *testing.T) {
+		// ForkBlockCheck以前的区块不检查摘要和座位, 重复的投票人也计数
+		cfg.SetDappFork(pt.Pos33TicketX, "ForkBlockCheck", types.MaxHeight)
+		defer cfg.SetDappFork(pt.Pos33TicketX, "ForkBlockCheck", 0)
+		fb := newTestMinerBlock(t, n, s, hash2([]byte("other digest")), quorum)
+		require.Nil(t, n.verifyBlockConsensus(fb, pb, seed).Err())
+		n.setTestCount(n.myAddr, sh, pt.Pos33CommitteeSize+1, pt.Pos33CommitteeSize)
+		defer n.setTestCount(n.myAddr, sh, 10, pt.Pos33CommitteeSize)
+		require.Nil(t, n.checkSeats(height, s))
+	})
+}
+
+// testRewardV2Cfg ForkRewardV2的奖励参数
+const testRewardV2Cfg = `blockReward=15
+perVoteReward=36
+makerBonus=100
+maxCarryRounds=3
+`
+
+func TestCheckMinerReward(t *testing.T) {
+	n, _ := newTestNodeCfg(t, testCfgString()+testRewardV2Cfg, nil)
+	n.setTestMiner(newTestPriv(t))
+	cfg := n.GetAPI().GetConfig()
+
+	height := int64(100)
+	seed := []byte("block reward seed")
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+	s := n.committeeSort(context.Background(), seed, height, 0, Committee)[0]
+	pb := newTestBlock(height-1, nil)
+	quorum := pt.Pos33VoterSize/2 + 1
+
+	// 分叉以前没有奖励
+	b := newTestMinerBlock(t, n, s, s.SortHash.Hash, quorum)
+	m, err := getMiner(b)
+	require.Nil(t, err)
+	require.Nil(t, m.Reward)
+	require.Nil(t, n.verifyBlockConsensus(b, pb, seed).Err())
+
+	cfg.SetDappFork(pt.Pos33TicketX, "ForkRewardV2", 0)
+	requireOnlyFailed(t, n.verifyBlockConsensus(b, pb, seed), CheckReward)
+
+	b = newTestMinerBlock(t, n, s, s.SortHash.Hash, quorum)
+	r := n.verifyBlockConsensus(b, pb, seed)
+	require.Nil(t, r.Err(), r.String())
+	m, err = getMiner(b)
+	require.Nil(t, err)
+	expect, err := pt.GetPos33MineParam(cfg, height).MinerRewardV2(quorum, 0)
+	require.Nil(t, err)
+	require.Equal(t, expect.String(), m.Reward.String())
+	require.Equal(t, int64(1e8), m.Reward.MakerReward)
+
+	// 出块人多给自己的奖励
+	m.Reward.MakerReward *= 2
+	m.Reward.FundReward -= m.Reward.MakerReward / 2
+	act := &pt.Pos33TicketAction{Value: &pt.Pos33TicketAction_Miner{Miner: m}, Ty: pt.Pos33TicketActionMiner}
+	b.Txs[0].Payload = types.Encode(act)
+	r = n.verifyBlockConsensus(b, pb, seed)
+	requireOnlyFailed(t, r, CheckReward)
+	require.True(t, errors.Is(r.Get(CheckReward).Err, pt.ErrMinerReward))
+
+	cfg.SetDappFork(pt.Pos33TicketX, "ForkRewardV2", types.MaxHeight)
+	requireOnlyFailed(t, n.verifyBlockConsensus(b, pb, seed), CheckReward)
+}
+
+func TestRunChecks(t *testing.T) {
+	n, _ := newTestNode(t, &subConfig{VerifyWorkers: 2})
+	require.Equal(t, 2, cap(n.vworkers))
+	errA := errors.New("a")
+
+	var running, most int32
+	f := func(err error) func() error {
+		return func() error {
+			c := atomic.AddInt32(&running, 1)
+			for {
+				m := atomic.LoadInt32(&most)
+				if c <= m || atomic.CompareAndSwapInt32(&most, m, c) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond * 20)
+			atomic.AddInt32(&running, -1)
+			return err
+		}
+	}
+	errs := n.runChecks([]func() error{f(nil), f(errA), f(nil), f(nil), f(errA)})
+	require.Equal(t, []error{nil, errA, nil, nil, errA}, errs)
+	// 2个验证协程加上当前协程
+	require.True(t, most > 1 && most <= 3, most)
+	require.Zero(t, len(n.vworkers))
+
+	require.Equal(t, 0, verifyWorkers(-1))
+	require.Equal(t, verifyThreads(), verifyWorkers(0))
+}
+
+func TestVerifyBlockLimits(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	n.setTestMiner(newTestPriv(t))
+
+	height := int64(100)
+	seed := []byte("block limits seed")
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+	s := n.committeeSort(context.Background(), seed, height, 0, Committee)[0]
+	pb := newTestBlock(height-1, nil)
+	b := newTestMinerBlock(t, n, s, s.SortHash.Hash, pt.Pos33VoterSize)
+	require.Nil(t, n.verifyBlockConsensus(b, pb, seed).Err())
+
+	// 投票数超过Pos33VoterSize的区块, 不做其他验证
+	m, err := getMiner(b)
+	require.Nil(t, err)
+	m.BlsPkList = append(m.BlsPkList, pt.Hash2BlsSk(hash2([]byte("extra voter"))).PubKey().Bytes())
+	setTestMinerMsg(b, m)
+	r := n.verifyBlockConsensus(b, pb, seed)
+	require.True(t, errors.Is(r.Get(CheckMiner).Err, errBlockTooLarge))
+	for _, name := range blockCheckNames {
+		require.Equal(t, errCheckSkipped, r.Get(name).Err, name)
+	}
+
+	// 证据太多
+	m.BlsPkList = m.BlsPkList[:pt.Pos33VoterSize]
+	m.Evidences = make([]*pt.Pos33Evidence, maxBlockEvidences+1)
+	setTestMinerMsg(b, m)
+	require.True(t, errors.Is(n.verifyBlockConsensus(b, pb, seed).Get(CheckMiner).Err, errBlockTooLarge))
+
+	// 聚合投票在展开之前检查
+	require.True(t, errors.Is(checkMinerLimits(&pt.Pos33MinerMsg{Agg: &pt.Pos33AggVote{Bitmap: []byte{0xff, 0xff, 0xff, 0xff}}}), errBlockTooLarge))
+	require.Nil(t, checkMinerLimits(&pt.Pos33MinerMsg{Agg: &pt.Pos33AggVote{Bitmap: []byte{0xff, 0xff, 0xff}, Pks: [][]byte{nil}}}))
+}
+
+// setTestMinerMsg 把区块的miner交易换成m
+func setTestMinerMsg(b *types.Block, m *pt.Pos33MinerMsg) {
+	act := &pt.Pos33TicketAction{Value: &pt.Pos33TicketAction_Miner{Miner: m}, Ty: pt.Pos33TicketActionMiner}
+	b.Txs[0].Payload = types.Encode(act)
+}
+
+// setTestMinerTime 修改区块miner交易里的时间
+func setTestMinerTime(t *testing.T, b *types.Block, ms int64) {
+	m, err := getMiner(b)
+	require.Nil(t, err)
+	m.BlockTime = ms
+	act := &pt.Pos33TicketAction{Value: &pt.Pos33TicketAction_Miner{Miner: m}, Ty: pt.Pos33TicketActionMiner}
+	b.Txs[0].Payload = types.Encode(act)
+}
+
+func TestVerifyRoundEvidence(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	n.setTestMiner(newTestPriv(t))
+
+	height := int64(100)
+	seed := []byte("round evidence seed")
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+	round := 3
+	ss := n.committeeSort(context.Background(), seed, height, round, Committee)
+	require.NotEmpty(t, ss)
+	b := newTestMinerBlock(t, n, ss[0], ss[0].SortHash.Hash, pt.Pos33VoterSize/2+1)
+	pb := newTestBlock(height-1, nil)
+	m, err := getMiner(b)
+	require.Nil(t, err)
+
+	// 父区块1秒前产生, 不可能已经超时了3轮
+	setTestMinerTime(t, pb, m.BlockTime-1000)
+
+	// 没有到分叉高度, 不检查
+	r := n.verifyBlockConsensus(b, pb, seed)
+	require.Nil(t, r.Err(), r.String())
+
+	n.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "ForkRoundEvidence", height)
+	r = n.verifyBlockConsensus(b, pb, seed)
+	requireOnlyFailed(t, r, CheckRound)
+	require.Equal(t, errRoundEvidence, r.Get(CheckRound).Err)
+	require.Equal(t, round, r.Round)
+
+	setTestMinerTime(t, pb, m.BlockTime-int64(round)*roundEvidenceTime)
+	r = n.verifyBlockConsensus(b, pb, seed)
+	require.Nil(t, r.Err(), r.String())
+}
@@ -0,0 +1,123 @@
+package pos33
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/33cn/chain33/common/difficulty"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// binomialSort 用二项分布一次算出count张票中签的数量, 和doSort逐票抽签的中签数量同分布.
+// 把vrfHash/max作为[0, 1)上均匀分布的随机数u, 求最小的j使得 Binomial(count, diff)的CDF(j) > u.
+// 计算量只和中签数量有关, 和票数无关, 只用于和doSort做对比
+func binomialSort(vrfHash []byte, count int, diff float64) int {
+	if count <= 0 || diff <= 0 {
+		return 0
+	}
+	if diff >= 1 {
+		return count
+	}
+
+	tmpHash := make([]byte, len(vrfHash))
+	copy(tmpHash, vrfHash)
+	y := difficulty.HashToBig(tmpHash)
+	u, _ := new(big.Float).Quo(new(big.Float).SetInt(y), fmax).Float64()
+
+	// 在log空间计算概率, 防止票数很多时(1-diff)^count下溢
+	lp := float64(count) * math.Log1p(-diff)
+	logRatio := math.Log(diff) - math.Log1p(-diff)
+	cdf := 0.
+	for j := 0; j < count; j++ {
+		cdf += math.Exp(lp)
+		if u < cdf {
+			return j
+		}
+		lp += math.Log(float64(count-j)) - math.Log(float64(j+1)) + logRatio
+	}
+	return count
+}
+
+var benchCounts = []int{100, 1000, 10000, 100000, 1000000}
+
+// 固定的vrf hash, 保证每次运行结果一致
+func testVrfHash(i int) []byte {
+	return hash2([]byte(fmt.Sprintf("pos33 binomial %d", i)))
+}
+
+func meanVar(xs []int) (float64, float64) {
+	var sum, sq float64
+	for _, x := range xs {
+		sum += float64(x)
+	}
+	mean := sum / float64(len(xs))
+	for _, x := range xs {
+		sq += (float64(x) - mean) * (float64(x) - mean)
+	}
+	return mean, sq / float64(len(xs)-1)
+}
+
+func TestBinomialSortDistribution(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+
+	trials := 500
+	count := 200
+	diff := 0.1
+	proof := &pt.HashProof{}
+
+	var perTicket, binomial []int
+	for i := 0; i < trials; i++ {
+		vrfHash := testVrfHash(i)
+		perTicket = append(perTicket, len(n.doSort(context.Background(), vrfHash, count, 0, diff, proof)))
+		binomial = append(binomial, binomialSort(vrfHash, count, diff))
+	}
+
+	// Binomial(200, 0.1): mean 20, var 18
+	m1, v1 := meanVar(perTicket)
+	m2, v2 := meanVar(binomial)
+	t.Log("per ticket", m1, v1, "binomial", m2, v2)
+	require.InDelta(t, 20, m1, 1)
+	require.InDelta(t, 20, m2, 1)
+	require.InDelta(t, 18, v1, 18*0.3)
+	require.InDelta(t, 18, v2, 18*0.3)
+}
+
+func TestBinomialSortEdge(t *testing.T) {
+	h := testVrfHash(0)
+	require.Equal(t, 0, binomialSort(h, 0, 0.5))
+	require.Equal(t, 0, binomialSort(h, 100, 0))
+	require.Equal(t, 100, binomialSort(h, 100, 1))
+	// 票数很多时不会下溢
+	w := binomialSort(h, 1000000, 0.01)
+	require.InDelta(t, 10000, w, 500)
+}
+
+func BenchmarkDoSort(b *testing.B) {
+	n, _ := newTestNode(b, nil)
+	proof := &pt.HashProof{}
+	for _, count := range benchCounts {
+		diff := float64(pt.Pos33CommitteeSize) / float64(count*10)
+		b.Run(fmt.Sprintf("count-%d", count), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				n.doSort(context.Background(), testVrfHash(i), count, 0, diff, proof)
+			}
+		})
+	}
+}
+
+func BenchmarkBinomialSort(b *testing.B) {
+	for _, count := range benchCounts {
+		diff := float64(pt.Pos33CommitteeSize) / float64(count*10)
+		b.Run(fmt.Sprintf("count-%d", count), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				binomialSort(testVrfHash(i), count, diff)
+			}
+		})
+	}
+}
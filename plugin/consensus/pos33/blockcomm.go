@@ -0,0 +1,200 @@
+package pos33
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// blsOwnerFunc 返回bls公钥绑定的矿工地址
+type blsOwnerFunc func(pk []byte) (string, error)
+
+// newBlsOwner 从执行器查询bls公钥绑定的地址, 查过的缓存
+func (c *Client) newBlsOwner() blsOwnerFunc {
+	mp := make(map[string]string)
+	return func(pk []byte) (string, error) {
+		if addr, ok := mp[string(pk)]; ok {
+			return addr, nil
+		}
+		resp, err := c.GetAPI().Query(pt.Pos33TicketX, "Pos33BlsAddr", &types.ReqAddr{Addr: address.PubKeyToAddr(ethID, pk)})
+		if err != nil {
+			return "", err
+		}
+		addr := resp.(*types.ReplyString).Data
+		mp[string(pk)] = addr
+		return addr, nil
+	}
+}
+
+// stateBlsOwner 在height高度区块执行以后的状态上查询bls公钥绑定的地址, 查过的缓存.
+// 结果和节点什么时候查询无关
+func (c *Client) stateBlsOwner(height int64) blsOwnerFunc {
+	mp := make(map[string]string)
+	return func(pk []byte) (string, error) {
+		if addr, ok := mp[string(pk)]; ok {
+			return addr, nil
+		}
+		resp, err := c.stateQuery(height, "Pos33BlsAddr", &types.ReqAddr{Addr: address.PubKeyToAddr(ethID, pk)})
+		if err != nil {
+			return "", err
+		}
+		rs, ok := resp.(*types.ReplyString)
+		if !ok {
+			return "", fmt.Errorf("%w: bls owner reply %T", errStateQuery, resp)
+		}
+		mp[string(pk)] = rs.Data
+		return rs.Data, nil
+	}
+}
+
+// committeeOf 按区块的投票列表统计委员会, 和执行器计算奖励一致:
+// 每个bls公钥是一个座位, 重复的只算一次
+func (client *Client) committeeOf(b *types.Block, owner blsOwnerFunc) (*pt.Pos33BlockCommittee, error) {
+	m, err := client.blockMiner(b)
+	if err != nil {
+		return nil, err
+	}
+	c := &pt.Pos33BlockCommittee{Height: b.Height, Maker: b.Txs[0].From(), MakerSort: m.Sort}
+	if m.Sort.GetProof().GetInput() != nil {
+		c.Round = m.Sort.Proof.Input.Round
+	}
+	mp := make(map[string]*pt.Pos33CommitteeMember)
+	voted := make(map[string]bool)
+	for _, pk := range m.BlsPkList {
+		if voted[string(pk)] {
+			continue
+		}
+		voted[string(pk)] = true
+		addr, err := owner(pk)
+		if err != nil {
+			return nil, fmt.Errorf("bls owner NOT found, height %d: %v", b.Height, err)
+		}
+		mb, ok := mp[addr]
+		if !ok {
+			mb = &pt.Pos33CommitteeMember{Addr: addr}
+			mp[addr] = mb
+			c.Members = append(c.Members, mb)
+		}
+		mb.Seats++
+		mb.BlsPks = append(mb.BlsPks, pk)
+		c.Seats++
+	}
+	sort.Slice(c.Members, func(i, j int) bool { return c.Members[i].Addr < c.Members[j].Addr })
+	return c, nil
+}
+
+// reconstructCommittee 先做VerifyBlockConsensus的所有检查, 通过后返回区块的委员会
+func (n *node) reconstructCommittee(b, pb *types.Block, seed []byte, owner blsOwnerFunc) (*pt.Pos33BlockCommittee, error) {
+	err := n.verifyBlockConsensus(b, pb, seed).Err()
+	if err != nil {
+		return nil, err
+	}
+	return n.committeeOf(b, owner)
+}
+
+// ReconstructCommittee 返回共识接受区块b时的委员会: 出块的矿工和抽签, 每个投票地址的座位和bls公钥.
+// 区块验证不通过时返回错误. 浏览器和奖励统计都应该使用这个结果, 和共识一致
+func (client *Client) ReconstructCommittee(b *types.Block) (*pt.Pos33BlockCommittee, error) {
+	pb, err := client.RequestBlock(b.Height - 1)
+	if err != nil {
+		return nil, err
+	}
+	seed, err := client.n.calcSeed(b.Height)
+	if err != nil {
+		return nil, err
+	}
+	return client.n.reconstructCommittee(b, pb, seed, client.newBlsOwner())
+}
+
+// Query_GetBlockCommittee 查询height高度区块的委员会
+func (client *Client) Query_GetBlockCommittee(req *types.ReqInt) (types.Message, error) {
+	b, err := client.RequestBlock(req.Height)
+	if err != nil {
+		return nil, err
+	}
+	return client.ReconstructCommittee(b)
+}
+
+// committeeAt 返回height高度委员会的seed, diff, 成员和投票情况, 使用节点记录的委员会
+func (client *Client) committeeAt(height int64, owner blsOwnerFunc) (*pt.Pos33CommitteeAt, error) {
+	rs := client.n.cstore.rounds(height)
+	if len(rs) == 0 {
+		return nil, fmt.Errorf("committee NOT found, height %d", height)
+	}
+	var b *types.Block
+	if height <= client.GetCurrentHeight() {
+		var err error
+		b, err = client.RequestBlock(height)
+		if err != nil {
+			return nil, err
+		}
+	}
+	seed, err := client.n.calcSeed(height)
+	if err != nil {
+		return nil, err
+	}
+	return client.roundsCommittee(height, rs, b, seed, owner)
+}
+
+// roundsCommittee 区块b已经在链上时使用区块那一轮的委员会, 投票来自miner交易的bls公钥,
+// 一个地址投票时它的抽签都算投票. b为nil表示还没有出块, 使用最后一轮的委员会, voted都是false
+func (client *Client) roundsCommittee(height int64, rs []*pt.Pos33CommitteeRecord, b *types.Block, seed []byte, owner blsOwnerFunc) (*pt.Pos33CommitteeAt, error) {
+	r := rs[len(rs)-1]
+	ca := &pt.Pos33CommitteeAt{Height: height, Seed: seed}
+	voted := make(map[string]bool)
+	var makerSort []byte
+	if b != nil {
+		m, err := client.blockMiner(b)
+		if err != nil {
+			return nil, err
+		}
+		round := int32(blockRound(b))
+		for _, x := range rs {
+			if x.Round == round {
+				r = x
+			}
+		}
+		for _, pk := range m.BlsPkList {
+			addr, err := owner(pk)
+			if err != nil {
+				return nil, fmt.Errorf("bls owner NOT found, height %d: %v", height, err)
+			}
+			voted[addr] = true
+		}
+		d, err := client.blockDiffOf(b)
+		if err != nil {
+			return nil, err
+		}
+		ca.Final = true
+		ca.BlockHash = b.Hash(client.GetAPI().GetConfig())
+		ca.Maker = b.Txs[0].From()
+		ca.Diff = d.Diff
+		makerSort = m.Sort.SortHash.Hash
+	} else {
+		d, err := client.n.getDiff(height, int(r.Round))
+		if err != nil {
+			return nil, err
+		}
+		ca.Diff = d
+	}
+	ca.Round = r.Round
+	for _, s := range r.Comm {
+		addr := client.n.pubkeyAddr(height, s.Proof.Pubkey)
+		ca.Members = append(ca.Members, &pt.Pos33CommitteeAtMember{
+			Addr:     addr,
+			Index:    s.SortHash.Index,
+			SortHash: s.SortHash.Hash,
+			Voted:    voted[addr],
+			Maker:    makerSort != nil && string(s.SortHash.Hash) == string(makerSort),
+		})
+	}
+	return ca, nil
+}
+
+// Query_CommitteeAt 查询height高度的委员会成员和投票情况, 可以查询正在进行的高度
+func (client *Client) Query_CommitteeAt(req *types.ReqInt) (types.Message, error) {
+	return client.committeeAt(req.Height, client.newBlsOwner())
+}
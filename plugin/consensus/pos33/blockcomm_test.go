@@ -0,0 +1,154 @@
+package pos33
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func TestReconstructCommittee(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	n.setTestMiner(newTestPriv(t))
+
+	height := int64(100)
+	seed := []byte("reconstruct seed")
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+	s := n.committeeSort(context.Background(), seed, height, 0, Committee)[0]
+	pb := newTestBlock(height-1, nil)
+
+	// 出块的矿工打算使用的委员会: 每个投票人一个bls公钥, 前3个属于同一个地址
+	quorum := pt.Pos33VoterSize/2 + 1
+	owners := make(map[string]string)
+	want := make(map[string]int64)
+	var vs []*pt.Pos33VoteMsg
+	for i := 0; i < quorum; i++ {
+		priv := newTestPriv(t)
+		v := &pt.Pos33VoteMsg{Hash: s.SortHash.Hash, Sort: s}
+		v.Sign(priv)
+		vs = append(vs, v)
+		addr := address.PubKeyToAddr(ethID, priv.PubKey().Bytes())
+		if i < 3 {
+			addr = "shared"
+		}
+		owners[string(v.Sig.Pubkey)] = addr
+		want[addr]++
+	}
+	owner := func(pk []byte) (string, error) {
+		addr, ok := owners[string(pk)]
+		if !ok {
+			return "", errors.New("not found")
+		}
+		return addr, nil
+	}
+
+	tx, err := n.minerTx(height, 0, s, vs, n.priv)
+	require.Nil(t, err)
+	b := &types.Block{Height: height, Txs: []*types.Transaction{tx}}
+	c, err := n.reconstructCommittee(b, pb, seed, owner)
+	require.Nil(t, err)
+	require.Equal(t, height, c.Height)
+	require.Equal(t, n.myAddr, c.Maker)
+	require.Equal(t, types.Encode(s), types.Encode(c.MakerSort))
+	require.Equal(t, int64(quorum), c.Seats)
+	require.Equal(t, len(want), len(c.Members))
+	for i, mb := range c.Members {
+		require.Equal(t, want[mb.Addr], mb.Seats, mb.Addr)
+		require.Equal(t, int(mb.Seats), len(mb.BlsPks))
+		if i > 0 {
+			require.Less(t, c.Members[i-1].Addr, mb.Addr)
+		}
+	}
+
+	// 重建的委员会和奖励统计使用的一致
+	tally := &rewardTally{c: n.Client, r: &pt.Pos33Rewards{Addr: "shared"}, owner: owner}
+	require.Nil(t, tally.add(b))
+	require.Equal(t, want["shared"], tally.r.Seats)
+
+	// 没有通过共识验证的区块不能重建
+	tx, err = n.minerTx(height, 0, s, vs[:quorum-1], n.priv)
+	require.Nil(t, err)
+	_, err = n.reconstructCommittee(&types.Block{Height: height, Txs: []*types.Transaction{tx}}, pb, seed, owner)
+	require.NotNil(t, err)
+
+	// 投票人的地址查不到
+	delete(owners, string(vs[0].Sig.Pubkey))
+	_, err = n.reconstructCommittee(b, pb, seed, owner)
+	require.NotNil(t, err)
+}
+
+func TestCommitteeAt(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	n.setTestMiner(newTestPriv(t))
+	mks, err := loadMinerKeys([]string{common.ToHex(newTestPriv(t).Bytes())})
+	require.Nil(t, err)
+	n.extraKeys = mks
+	addr2 := mks[0].addr
+
+	height := int64(100)
+	seed := []byte("committee at seed")
+	sh := n.sortHeight(height)
+	n.setTestCount(n.myAddr, sh, 10, pt.Pos33CommitteeSize)
+	n.setTestCount(addr2, sh, 5, pt.Pos33CommitteeSize)
+	n.mlock.Lock()
+	n.dsMap[sh] = pt.Pos33CommitteeSize
+	n.mlock.Unlock()
+	ss := n.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.Equal(t, 15, len(ss))
+	n.cstore.add(height, 0, ss)
+	n.cstore.add(height, 1, ss[:2])
+	rs := n.cstore.rounds(height)
+
+	// 还没有出块, 使用最后一轮, 投票没有确定
+	ca, err := n.roundsCommittee(height, rs, nil, seed, nil)
+	require.Nil(t, err)
+	require.False(t, ca.Final)
+	require.Equal(t, int32(1), ca.Round)
+	require.Equal(t, seed, ca.Seed)
+	require.Equal(t, 1.0, ca.Diff)
+	require.Equal(t, 2, len(ca.Members))
+
+	// 只有第一个地址投票
+	mys := getMySorts(n.myAddr, ss)
+	var vs []*pt.Pos33VoteMsg
+	for _, s := range mys {
+		v := &pt.Pos33VoteMsg{Hash: mys[0].SortHash.Hash, Sort: s}
+		v.Sign(n.priv)
+		vs = append(vs, v)
+	}
+	owner := func(pk []byte) (string, error) {
+		if string(pk) != string(vs[0].Sig.Pubkey) {
+			return "", errors.New("not found")
+		}
+		return n.myAddr, nil
+	}
+	tx, err := n.minerTx(height, 0, mys[0], vs, n.priv)
+	require.Nil(t, err)
+	b := &types.Block{Height: height, Txs: []*types.Transaction{tx}}
+	ca, err = n.roundsCommittee(height, rs, b, seed, owner)
+	require.Nil(t, err)
+	require.True(t, ca.Final)
+	require.Equal(t, int32(0), ca.Round)
+	require.Equal(t, n.myAddr, ca.Maker)
+	require.Equal(t, b.Hash(n.GetAPI().GetConfig()), ca.BlockHash)
+	require.Equal(t, 15, len(ca.Members))
+	makers := 0
+	for i, mb := range ca.Members {
+		require.Equal(t, ss[i].SortHash.Hash, mb.SortHash)
+		require.Equal(t, ss[i].SortHash.Index, mb.Index)
+		require.Equal(t, mb.Addr == n.myAddr, mb.Voted, mb.Addr)
+		if mb.Maker {
+			makers++
+			require.Equal(t, mys[0].SortHash.Hash, mb.SortHash)
+		}
+	}
+	require.Equal(t, 1, makers)
+
+	_, err = n.committeeAt(height+1, owner)
+	require.NotNil(t, err)
+}
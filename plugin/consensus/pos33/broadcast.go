@@ -0,0 +1,56 @@
+package pos33
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+// maxSortBroadcastDelay 广播延迟的上限.
+// 超时重新抽签后, 1秒(resortTimeout)后投票委员会, 延迟不超过一半保证消息能及时到达
+const maxSortBroadcastDelay = time.Millisecond * 500
+
+var sortDelayGauge = metrics.GetOrRegisterGauge("pos33/sort/broadcastdelay", nil)
+
+// sortDelay 中签消息广播前的随机延迟, 分散很多矿工同时中签时的网络负载.
+// 只延迟发送, 不影响验证, 自己的抽签在本地立即处理
+type sortDelay struct {
+	max time.Duration
+
+	mu sync.Mutex
+	r  *rand.Rand
+}
+
+func newSortDelay(ms int64) *sortDelay {
+	d := time.Duration(ms) * time.Millisecond
+	if d > maxSortBroadcastDelay {
+		d = maxSortBroadcastDelay
+	}
+	if d < 0 {
+		d = 0
+	}
+	return &sortDelay{max: d, r: rand.New(rand.NewSource(time.Now().UnixNano()))}
+}
+
+func (s *sortDelay) next() time.Duration {
+	if s.max == 0 {
+		return 0
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return time.Duration(s.r.Int63n(int64(s.max) + 1))
+}
+
+// send 延迟后调用f, 没有配置延迟时直接调用, 返回实际的延迟
+func (s *sortDelay) send(f func()) time.Duration {
+	d := s.next()
+	sortDelayGauge.Update(d.Milliseconds())
+	if d == 0 {
+		f()
+		return 0
+	}
+	time.AfterFunc(d, f)
+	return d
+}
@@ -0,0 +1,43 @@
+package pos33
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortDelayDeadline(t *testing.T) {
+	// 配置超过上限时使用上限
+	d := newSortDelay(10000)
+	require.Equal(t, maxSortBroadcastDelay, d.max)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var late []time.Duration
+	delays := make(map[time.Duration]bool)
+	start := time.Now()
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		delay := d.send(func() {
+			defer wg.Done()
+			mu.Lock()
+			defer mu.Unlock()
+			if e := time.Since(start); e > maxSortBroadcastDelay+time.Millisecond*200 {
+				late = append(late, e)
+			}
+		})
+		require.True(t, delay <= maxSortBroadcastDelay)
+		delays[delay] = true
+	}
+	wg.Wait()
+	require.Empty(t, late)
+	require.True(t, len(delays) > 1)
+	require.True(t, sortDelayGauge.Value() <= maxSortBroadcastDelay.Milliseconds())
+
+	// 没有配置时立即发送
+	sent := false
+	require.Equal(t, time.Duration(0), newSortDelay(0).send(func() { sent = true }))
+	require.True(t, sent)
+}
@@ -0,0 +1,51 @@
+package pos33
+
+import (
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// defaultChainParam ForkChainParam之前和没有治理交易时的参数, 只读
+var defaultChainParam = pt.DefaultPos33ChainParam()
+
+// chainParam 返回height高度生效的共识参数. 参数在executor里按生效高度保存,
+// 第一次使用和有新的参数交易时查询, 保存在cparams里
+func (c *Client) chainParam(height int64) *pt.Pos33ChainParam {
+	if !c.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkChainParam") {
+		return defaultChainParam
+	}
+	ps, ok := c.cparams.Load().(*pt.Pos33ChainParams)
+	if !ok {
+		ps = c.loadChainParams()
+	}
+	return ps.At(height)
+}
+
+// loadChainParams 查询executor里的参数, 出错时不保存, 下次再查
+func (c *Client) loadChainParams() *pt.Pos33ChainParams {
+	msg, err := c.GetAPI().Query(pt.Pos33TicketX, "Pos33ChainParams", &types.ReqNil{})
+	if err != nil {
+		plog.Error("query chain params error", "err", err)
+		return &pt.Pos33ChainParams{}
+	}
+	ps := msg.(*pt.Pos33ChainParams)
+	c.cparams.Store(ps)
+	return ps
+}
+
+// sortHeight 返回height高度抽签使用的seed和票数的快照高度
+func (c *Client) sortHeight(height int64) int64 {
+	return height - int64(c.chainParam(height).SortBlocks)
+}
+
+// sortHeights 返回区块height以后要抽签的高度, 也就是快照高度是height的高度.
+// sortBlocks变小时有的区块以后不用抽签, 变大时有的区块以后要抽两个高度
+func (c *Client) sortHeights(height int64) []int64 {
+	var hs []int64
+	for h := height + pt.Pos33MinSortBlocks; h <= height+pt.Pos33SortBlocks; h++ {
+		if c.sortHeight(h) == height {
+			hs = append(hs, h)
+		}
+	}
+	return hs
+}
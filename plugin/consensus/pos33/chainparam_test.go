@@ -0,0 +1,49 @@
+package pos33
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func TestChainParam(t *testing.T) {
+	n, api := newTestNode(t, nil)
+	cfg := n.GetAPI().GetConfig()
+	cfg.SetDappFork(pt.Pos33TicketX, "UseEntrust", 1000)
+	cfg.SetDappFork(pt.Pos33TicketX, "ForkChainParam", 50)
+	fork := int64(100)
+	ps := &pt.Pos33ChainParams{}
+	_, err := ps.Add(&pt.Pos33ChainParam{Height: fork, CommitteeSize: 150, DiffPersent: 50, SortBlocks: pt.Pos33MinSortBlocks})
+	require.Nil(t, err)
+	api.On("Query", pt.Pos33TicketX, "Pos33ChainParams", mock.Anything).Return(ps, nil)
+
+	// 分叉之前不查询
+	require.Equal(t, defaultChainParam, n.chainParam(10))
+	api.AssertNotCalled(t, "Query", pt.Pos33TicketX, "Pos33ChainParams", mock.Anything)
+
+	require.Equal(t, fork-1-pt.Pos33SortBlocks, n.sortHeight(fork-1))
+	require.Equal(t, fork-pt.Pos33MinSortBlocks, n.sortHeight(fork))
+	require.Equal(t, float64(150)/300/2, n.calcDiff(fork, 300))
+	require.Equal(t, float64(pt.Pos33CommitteeSize)/300, n.calcDiff(fork-1, 300))
+	api.AssertNumberOfCalls(t, "Query", 1)
+
+	// sortBlocks变小以后, 中间的区块以后不用抽签
+	require.Equal(t, []int64{fork - 1}, n.sortHeights(fork-1-pt.Pos33SortBlocks))
+	for h := fork - pt.Pos33SortBlocks; h < fork-pt.Pos33MinSortBlocks; h++ {
+		require.Nil(t, n.sortHeights(h))
+	}
+	require.Equal(t, []int64{fork}, n.sortHeights(fork-pt.Pos33MinSortBlocks))
+
+	// 有新的参数交易的区块重新查询
+	tx, err := types.CreateFormatTx(cfg, pt.Pos33TicketX, types.Encode(&pt.Pos33TicketAction{
+		Value: &pt.Pos33TicketAction_ChainParam{ChainParam: &pt.Pos33ChainParam{Height: fork + 100, DiffPersent: 80}},
+		Ty:    pt.Pos33ActionChainParam,
+	}))
+	require.Nil(t, err)
+	api.On("Query", pt.Pos33TicketX, "AllPos33TicketCount", mock.Anything).Return(&types.Int64{Data: 300}, nil)
+	n.updateTicketCount(&types.Block{Height: 60, Txs: []*types.Transaction{{}, tx}})
+	api.AssertNumberOfCalls(t, "Query", 3)
+}
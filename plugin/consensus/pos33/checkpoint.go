@@ -0,0 +1,298 @@
+package pos33
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/yccproject/ycc/plugin/consensus/pos33/signer"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+var (
+	errCheckpointDigest = errors.New("committee digest NOT match the trusted checkpoint")
+	errCheckpointHash   = errors.New("block hash NOT match the trusted checkpoint")
+	errCheckpointBundle = errors.New("checkpoint bundle hash error")
+	errCheckpointQuorum = errors.New("checkpoint bundle NOT signed by enough validators")
+	errCheckpointChain  = errors.New("checkpoint block NOT on the local chain")
+	errCheckpointSigner = errors.New("checkpoint signers NOT configured, can NOT import checkpoint")
+)
+
+var checkpointSkipped = metrics.GetOrRegisterCounter("pos33/checkpoint/skipped", nil)
+
+// checkpoint 信任的检查点: 高度和这个高度区块的hash, 委员会摘要, 至少配置一个.
+//
+// 信任假设: 检查点高度以下区块的抽签, vrf, 投票签名都不验证, 认为是有效的,
+// 只检查miner交易的格式和父区块hash. 节点相信配置检查点的人(运维, 治理公布的值,
+// 或者checkpointSigners里足够多的验证者签名的检查点)给出的是主链上的区块.
+// 同步到检查点高度时, 区块的hash和委员会摘要必须和检查点相同, 父区块hash把下面的区块连起来,
+// 所以假的链不能越过检查点, 节点会停在检查点, 需要回滚后重新同步.
+// 检查点和检查点以上的区块完整验证
+type checkpoint struct {
+	height int64
+	hash   []byte
+	digest []byte
+	// 展开检查点区块的聚合投票
+	pubkeys pt.Pos33BlsPubkeysFunc
+}
+
+func parseHash32(name, s string) ([]byte, error) {
+	if s == "" {
+		return nil, nil
+	}
+	d, err := common.FromHex(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(d) != 32 {
+		return nil, fmt.Errorf("checkpoint %s size error: %d", name, len(d))
+	}
+	return d, nil
+}
+
+// newCheckpoint height小于等于0时不使用检查点
+func newCheckpoint(height int64, hash, digest string) (*checkpoint, error) {
+	if height <= 0 {
+		return nil, nil
+	}
+	h, err := parseHash32("hash", hash)
+	if err != nil {
+		return nil, err
+	}
+	d, err := parseHash32("digest", digest)
+	if err != nil {
+		return nil, err
+	}
+	if h == nil && d == nil {
+		return nil, errors.New("checkpoint needs block hash or committee digest")
+	}
+	return &checkpoint{height: height, hash: h, digest: d}, nil
+}
+
+// committeeDigest 区块的委员会摘要: sha256(miner的抽签hash + 投票的bls公钥)
+func committeeDigest(m *pt.Pos33MinerMsg) []byte {
+	var buf bytes.Buffer
+	buf.Write(m.GetSort().GetSortHash().GetHash())
+	for _, pk := range m.BlsPkList {
+		buf.Write(pk)
+	}
+	return crypto.Sha256(buf.Bytes())
+}
+
+// trusted 返回true表示区块在检查点以下, 跳过完整的共识验证.
+// 检查点高度的区块检查委员会摘要, 然后完整验证
+func (cp *checkpoint) trusted(b *types.Block, cfg *types.Chain33Config) (bool, error) {
+	if cp == nil || b.Height > cp.height {
+		return false, nil
+	}
+	m, err := getMiner(b)
+	if err != nil {
+		return false, err
+	}
+	if m.Sort == nil || m.Sort.SortHash == nil {
+		return false, fmt.Errorf("miner tx error")
+	}
+	if b.Height < cp.height {
+		checkpointSkipped.Inc(1)
+		return true, nil
+	}
+	if cp.hash != nil && !bytes.Equal(b.Hash(cfg), cp.hash) {
+		return false, errCheckpointHash
+	}
+	if cp.digest == nil {
+		return false, nil
+	}
+	err = m.Expand(cp.pubkeys)
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(committeeDigest(m), cp.digest) {
+		return false, errCheckpointDigest
+	}
+	return false, nil
+}
+
+func checkpointHash(c *pt.Pos33Checkpoint) []byte {
+	return crypto.Sha256(types.Encode(&pt.Pos33Checkpoint{Height: c.Height, BlockHash: c.BlockHash, Digest: c.Digest}))
+}
+
+// checkpointSigners 可以签名检查点的验证者地址, 导入的检查点需要其中quorum个不同的签名
+type checkpointSigners struct {
+	addrs  map[string]bool
+	quorum int
+}
+
+// newCheckpointSigners addrs为空时不能导入检查点. quorum为0时默认超过2/3, 至少要超过一半
+func newCheckpointSigners(addrs []string, quorum int) (*checkpointSigners, error) {
+	if len(addrs) == 0 {
+		return nil, nil
+	}
+	cs := &checkpointSigners{addrs: make(map[string]bool), quorum: quorum}
+	for _, a := range addrs {
+		cs.addrs[a] = true
+	}
+	if cs.quorum <= 0 {
+		cs.quorum = len(cs.addrs)*2/3 + 1
+	}
+	if cs.quorum > len(cs.addrs) {
+		return nil, fmt.Errorf("checkpoint quorum %d larger than signers %d", cs.quorum, len(cs.addrs))
+	}
+	if cs.quorum <= len(cs.addrs)/2 {
+		return nil, fmt.Errorf("checkpoint quorum %d NOT more than half of signers %d", cs.quorum, len(cs.addrs))
+	}
+	return cs, nil
+}
+
+// check 检查检查点的hash和签名数量, 没有配置签名的验证者时不能导入
+func (cs *checkpointSigners) check(c *pt.Pos33Checkpoint) error {
+	if c.Height <= 0 {
+		return fmt.Errorf("checkpoint height error: %d", c.Height)
+	}
+	if !bytes.Equal(checkpointHash(c), c.Hash) {
+		return errCheckpointBundle
+	}
+	if cs == nil || cs.quorum <= 0 {
+		return errCheckpointSigner
+	}
+	signed := make(map[string]bool)
+	for _, sig := range c.Sigs {
+		addr := address.PubKeyToAddr(ethID, sig.GetPubkey())
+		if !cs.addrs[addr] || signed[addr] {
+			continue
+		}
+		if !checkMsgSign(signer.KindMsg, c.Hash, sig, c.Height) {
+			plog.Error("checkpoint signature error", "addr", addr, "height", c.Height)
+			continue
+		}
+		signed[addr] = true
+	}
+	if len(signed) < cs.quorum {
+		return fmt.Errorf("%w: %d < %d", errCheckpointQuorum, len(signed), cs.quorum)
+	}
+	return nil
+}
+
+// getCheckpoint 当前使用的检查点, 可以被ImportCheckpoint替换
+func (n *node) getCheckpoint() *checkpoint {
+	n.cpLock.Lock()
+	defer n.cpLock.Unlock()
+	return n.cp
+}
+
+func (n *node) setCheckpoint(c *pt.Pos33Checkpoint) {
+	n.cpLock.Lock()
+	defer n.cpLock.Unlock()
+	n.cp = &checkpoint{height: c.Height, hash: c.BlockHash, digest: c.Digest, pubkeys: n.blsPubkeys}
+	plog.Info("checkpoint set", "height", c.Height, "hash", common.HashHex(c.BlockHash))
+}
+
+// exportCheckpoint 用本地height高度的区块生成检查点, sign为true时用挖矿私钥签名.
+// 多个验证者导出的相同检查点可以合并签名
+func (n *node) exportCheckpoint(height int64, sign bool) (*pt.Pos33Checkpoint, error) {
+	b, err := n.RequestBlock(height)
+	if err != nil {
+		return nil, err
+	}
+	m, err := n.blockMiner(b)
+	if err != nil {
+		return nil, err
+	}
+	c := &pt.Pos33Checkpoint{
+		Height:    height,
+		BlockHash: b.Hash(n.GetAPI().GetConfig()),
+		Digest:    committeeDigest(m),
+	}
+	c.Hash = checkpointHash(c)
+	if sign {
+		if n.getPriv() == nil {
+			return nil, errors.New("wallet locked or mining account NOT set")
+		}
+		priv := n.signKey(signer.KindMsg, height, 0)
+		c.Sigs = append(c.Sigs, &types.Signature{
+			Ty:        types.SECP256K1,
+			Pubkey:    priv.PubKey().Bytes(),
+			Signature: signMsg(priv, signer.KindMsg, c.Hash).Bytes(),
+		})
+	}
+	return c, nil
+}
+
+// importCheckpoint 检查签名后使用检查点c, 需要checkpointSigners里quorum个签名.
+// 本地已经有检查点高度的区块时, hash必须相同
+func (n *node) importCheckpoint(c *pt.Pos33Checkpoint) error {
+	err := n.cpSigners.check(c)
+	if err != nil {
+		return err
+	}
+	if n.lastBlock().Height >= c.Height {
+		b, err := n.RequestBlock(c.Height)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(b.Hash(n.GetAPI().GetConfig()), c.BlockHash) {
+			return errCheckpointChain
+		}
+	}
+	n.setCheckpoint(c)
+	return nil
+}
+
+// loadCheckpoint 启动时从文件导入检查点, 文件是GetCheckpoint返回的json.
+// 这时还不能读取区块, 只检查签名
+func (n *node) loadCheckpoint(file string) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	var c pt.Pos33Checkpoint
+	err = types.JSONToPB(data, &c)
+	if err != nil {
+		return err
+	}
+	err = n.cpSigners.check(&c)
+	if err != nil {
+		return err
+	}
+	n.setCheckpoint(&c)
+	return nil
+}
+
+// Query_GetCheckpoint 导出height高度的检查点, 为0时使用当前高度
+func (client *Client) Query_GetCheckpoint(req *pt.ReqPos33Checkpoint) (types.Message, error) {
+	height := req.Height
+	if height <= 0 {
+		height = client.GetCurrentHeight()
+	}
+	if req.Sign {
+		client.getMiner()
+	}
+	return client.n.exportCheckpoint(height, req.Sign)
+}
+
+// Query_ImportCheckpoint 导入检查点, 替换配置的检查点. 没有配置checkpointSigners时拒绝
+func (client *Client) Query_ImportCheckpoint(req *pt.Pos33Checkpoint) (types.Message, error) {
+	err := client.n.importCheckpoint(req)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Reply{IsOk: true}, nil
+}
+
+// Query_GetCommitteeDigest 查询height高度区块的委员会摘要, 用于配置检查点
+func (client *Client) Query_GetCommitteeDigest(req *types.ReqInt) (types.Message, error) {
+	b, err := client.RequestBlock(req.Height)
+	if err != nil {
+		return nil, err
+	}
+	m, err := client.blockMiner(b)
+	if err != nil {
+		return nil, err
+	}
+	return &types.ReplyString{Data: common.ToHex(committeeDigest(m))}, nil
+}
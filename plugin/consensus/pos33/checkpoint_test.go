@@ -0,0 +1,174 @@
+package pos33
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+	"github.com/yccproject/ycc/plugin/consensus/pos33/signer"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func TestCheckpoint(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	n.setTestMiner(newTestPriv(t))
+
+	height := int64(100)
+	seed := []byte("checkpoint seed")
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+	ss := n.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.NotEmpty(t, ss)
+	s := ss[0]
+	quorum := pt.Pos33VoterSize/2 + 1
+	pb := newTestBlock(height-1, nil)
+
+	b := newTestMinerBlock(t, n, s, s.SortHash.Hash, quorum)
+	m, err := getMiner(b)
+	require.Nil(t, err)
+	cp, err := newCheckpoint(height, "", common.ToHex(committeeDigest(m)))
+	require.Nil(t, err)
+	n.cp = cp
+	cfg := n.GetAPI().GetConfig()
+
+	// 检查点以下的区块抽签和投票都不对, 也跳过完整验证
+	bad := newTestMinerBlock(t, n, s, s.SortHash.Hash, 1)
+	bad.Height = height - 1
+	require.NotNil(t, n.verifyBlockConsensus(bad, newTestBlock(height-2, nil), seed).Err())
+	skipped := checkpointSkipped.Count()
+	trusted, err := n.cp.trusted(bad, cfg)
+	require.Nil(t, err)
+	require.True(t, trusted)
+	require.Equal(t, skipped+1, checkpointSkipped.Count())
+
+	// 检查点以下也要有miner交易
+	_, err = n.cp.trusted(&types.Block{Height: height - 1}, cfg)
+	require.NotNil(t, err)
+
+	// 检查点的区块检查摘要后完整验证
+	trusted, err = n.cp.trusted(b, cfg)
+	require.Nil(t, err)
+	require.False(t, trusted)
+	require.Nil(t, n.verifyBlockConsensus(b, pb, seed).Err())
+
+	fake := newTestMinerBlock(t, n, s, s.SortHash.Hash, quorum)
+	_, err = n.cp.trusted(fake, cfg)
+	require.Equal(t, errCheckpointDigest, err)
+
+	// 检查点以上的区块完整验证
+	bad.Height = height + 1
+	trusted, err = n.cp.trusted(bad, cfg)
+	require.Nil(t, err)
+	require.False(t, trusted)
+	require.Equal(t, skipped+1, checkpointSkipped.Count())
+
+	// 没有配置检查点时全部完整验证
+	n.cp = nil
+	bad.Height = height - 1
+	trusted, err = n.cp.trusted(bad, cfg)
+	require.Nil(t, err)
+	require.False(t, trusted)
+}
+
+func TestNewCheckpoint(t *testing.T) {
+	cp, err := newCheckpoint(0, "", "")
+	require.Nil(t, err)
+	require.Nil(t, cp)
+
+	_, err = newCheckpoint(10, "", "0xzz")
+	require.NotNil(t, err)
+	_, err = newCheckpoint(10, "", "0x0102")
+	require.NotNil(t, err)
+	_, err = newCheckpoint(10, "0x0102", "")
+	require.NotNil(t, err)
+	_, err = newCheckpoint(10, "", "")
+	require.NotNil(t, err)
+
+	cp, err = newCheckpoint(10, "", common.ToHex(hash2([]byte("a"))))
+	require.Nil(t, err)
+	require.Equal(t, int64(10), cp.height)
+	cp, err = newCheckpoint(10, common.ToHex(hash2([]byte("b"))), "")
+	require.Nil(t, err)
+	require.Nil(t, cp.digest)
+}
+
+func TestCheckpointHash(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	n.setTestMiner(newTestPriv(t))
+	cfg := n.GetAPI().GetConfig()
+
+	height := int64(100)
+	seed := []byte("checkpoint seed")
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+	s := n.committeeSort(context.Background(), seed, height, 0, Committee)[0]
+	b := newTestMinerBlock(t, n, s, s.SortHash.Hash, pt.Pos33VoterSize/2+1)
+
+	// 只配置区块hash时不检查摘要
+	cp, err := newCheckpoint(height, common.ToHex(b.Hash(cfg)), "")
+	require.Nil(t, err)
+	trusted, err := cp.trusted(b, cfg)
+	require.Nil(t, err)
+	require.False(t, trusted)
+
+	other := newTestMinerBlock(t, n, s, s.SortHash.Hash, pt.Pos33VoterSize/2+1)
+	other.BlockTime++
+	_, err = cp.trusted(other, cfg)
+	require.Equal(t, errCheckpointHash, err)
+}
+
+func TestCheckpointBundle(t *testing.T) {
+	p1, p2, p3 := newTestPriv(t), newTestPriv(t), newTestPriv(t)
+	addr := func(p crypto.PrivKey) string { return address.PubKeyToAddr(ethID, p.PubKey().Bytes()) }
+	c := &pt.Pos33Checkpoint{Height: 100, BlockHash: hash2([]byte("block")), Digest: hash2([]byte("digest"))}
+	c.Hash = checkpointHash(c)
+	sign := func(p crypto.PrivKey) {
+		c.Sigs = append(c.Sigs, &types.Signature{Ty: types.SECP256K1, Pubkey: p.PubKey().Bytes(), Signature: signMsg(p, signer.KindMsg, c.Hash).Bytes()})
+	}
+
+	// 没有配置签名的验证者时不能导入
+	var none *checkpointSigners
+	require.Equal(t, errCheckpointSigner, none.check(c))
+	bad := &pt.Pos33Checkpoint{Height: c.Height + 1, BlockHash: c.BlockHash, Digest: c.Digest, Hash: c.Hash}
+	require.Equal(t, errCheckpointBundle, none.check(bad))
+
+	_, err := newCheckpointSigners([]string{addr(p1)}, 2)
+	require.NotNil(t, err)
+	// quorum不能只有一半
+	_, err = newCheckpointSigners([]string{addr(p1), addr(p2)}, 1)
+	require.NotNil(t, err)
+	cs, err := newCheckpointSigners([]string{addr(p1), addr(p2), addr(p3)}, 0)
+	require.Nil(t, err)
+	require.Equal(t, 3, cs.quorum)
+	cs.quorum = 2
+
+	sign(p1)
+	// 重复的签名和不认识的签名不计数
+	sign(p1)
+	sign(newTestPriv(t))
+	require.True(t, errors.Is(cs.check(c), errCheckpointQuorum))
+	sign(p2)
+	require.Nil(t, cs.check(c))
+
+	// 从文件导入, 替换配置的检查点
+	n, _ := newTestNode(t, nil)
+	n.cpSigners = cs
+	file := filepath.Join(t.TempDir(), "checkpoint.json")
+	data, err := types.PBToJSON(c)
+	require.Nil(t, err)
+	require.Nil(t, ioutil.WriteFile(file, data, 0644))
+	require.Nil(t, n.loadCheckpoint(file))
+	cp := n.getCheckpoint()
+	require.Equal(t, c.Height, cp.height)
+	require.Equal(t, c.BlockHash, cp.hash)
+	require.Equal(t, c.Digest, cp.digest)
+
+	// 签名错误的不计数
+	c.Sigs[len(c.Sigs)-1].Signature = c.Sigs[0].Signature
+	require.True(t, errors.Is(cs.check(c), errCheckpointQuorum))
+}
@@ -0,0 +1,128 @@
+package pos33
+
+import (
+	"sync"
+	"sync/atomic"
+
+	lru "github.com/hashicorp/golang-lru"
+	metrics "github.com/rcrowley/go-metrics"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// defaultTicketCountCache 没有配置TicketCountCache时缓存多少个(地址, 高度)的票数
+const defaultTicketCountCache = 8192
+
+var (
+	countCacheHitCounter  = metrics.GetOrRegisterCounter("pos33/countcache/hit", nil)
+	countCacheMissCounter = metrics.GetOrRegisterCounter("pos33/countcache/miss", nil)
+)
+
+// countSnapshotBlocks 保留最近多少个高度的票数快照
+const countSnapshotBlocks = pt.Pos33SortBlocks
+
+// ticketCountCache 缓存(addr, height)的票数. 只缓存落后tip至少Pos33SortBlocks的高度,
+// 这些高度的票数不会再变, 更近的高度仍然使用tcMap. 为nil时不缓存.
+// 每个区块预取下一个高度抽签使用的快照高度的全部票数, 这个高度的验证都从snaps得到
+type ticketCountCache struct {
+	cache *lru.Cache
+	tip   int64
+
+	mu    sync.RWMutex
+	snaps map[int64]map[string]int64
+}
+
+// newTicketCountCache size为0时使用默认大小, 小于0不缓存
+func newTicketCountCache(size int) *ticketCountCache {
+	if size < 0 {
+		return nil
+	}
+	if size == 0 {
+		size = defaultTicketCountCache
+	}
+	cache, err := lru.New(size)
+	if err != nil {
+		panic(err)
+	}
+	return &ticketCountCache{cache: cache, snaps: make(map[int64]map[string]int64)}
+}
+
+// setTip 区块增加到height
+func (c *ticketCountCache) setTip(height int64) {
+	if c == nil {
+		return
+	}
+	atomic.StoreInt64(&c.tip, height)
+}
+
+// cacheable height的票数已经不会改变
+func (c *ticketCountCache) cacheable(height int64) bool {
+	return height <= atomic.LoadInt64(&c.tip)-pt.Pos33SortBlocks
+}
+
+func (c *ticketCountCache) get(addr string, height int64) (int64, bool) {
+	if c == nil {
+		return 0, false
+	}
+	c.mu.RLock()
+	count, ok := c.snaps[height][addr]
+	c.mu.RUnlock()
+	if ok {
+		countCacheHitCounter.Inc(1)
+		return count, true
+	}
+	if !c.cacheable(height) {
+		return 0, false
+	}
+	v, ok := c.cache.Get(countKey{height, addr})
+	if !ok {
+		countCacheMissCounter.Inc(1)
+		return 0, false
+	}
+	countCacheHitCounter.Inc(1)
+	return v.(int64), true
+}
+
+func (c *ticketCountCache) add(addr string, height int64, count int64) {
+	if c == nil || !c.cacheable(height) {
+		return
+	}
+	c.cache.Add(countKey{height, addr}, count)
+}
+
+// snapshot 记录height高度的全部票数mp(复制), 删除太旧的快照
+func (c *ticketCountCache) snapshot(height int64, mp map[string]int64) {
+	if c == nil || mp == nil {
+		return
+	}
+	snap := make(map[string]int64, len(mp))
+	for addr, count := range mp {
+		snap[addr] = count
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.snaps[height] = snap
+	for h := range c.snaps {
+		if h <= height-countSnapshotBlocks {
+			delete(c.snaps, h)
+		}
+	}
+}
+
+// reorg 区块回滚以后重新增加height高度的区块, height及以后高度缓存的票数都不再可信
+func (c *ticketCountCache) reorg(height int64) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	for h := range c.snaps {
+		if h >= height {
+			delete(c.snaps, h)
+		}
+	}
+	c.mu.Unlock()
+	for _, k := range c.cache.Keys() {
+		if k.(countKey).height >= height {
+			c.cache.Remove(k)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package pos33
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// expireTestCount 模拟tcMap过期, 下次只能从缓存或者api得到票数
+func (n *node) expireTestCount(height int64) {
+	n.mlock.Lock()
+	defer n.mlock.Unlock()
+	delete(n.tcMap, height)
+}
+
+func TestTicketCountCache(t *testing.T) {
+	n, api := newTestNode(t, nil)
+	n.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "UseEntrust", 1000)
+	addr := "1CountCacheTestAddr"
+	api.On("Query", pt.Pos33TicketX, "Pos33TicketCount", &types.ReqAddr{Addr: addr}).Return(&types.Int64{Data: 5}, nil)
+	n.tcCache.setTip(100)
+
+	// 落后tip Pos33SortBlocks的高度, 第二次从缓存得到
+	h := int64(100 - pt.Pos33SortBlocks)
+	require.Equal(t, int64(5), n.queryTicketCount(addr, h))
+	n.expireTestCount(h)
+	require.Equal(t, int64(5), n.queryTicketCount(addr, h))
+	api.AssertNumberOfCalls(t, "Query", 1)
+
+	// 更近的高度票数还可能改变, 不缓存
+	h++
+	require.Equal(t, int64(5), n.queryTicketCount(addr, h))
+	n.expireTestCount(h)
+	require.Equal(t, int64(5), n.queryTicketCount(addr, h))
+	api.AssertNumberOfCalls(t, "Query", 3)
+
+	// 查询出错的不缓存
+	bad := "1CountCacheBadAddr"
+	api.On("Query", pt.Pos33TicketX, "Pos33TicketCount", &types.ReqAddr{Addr: bad}).Return(nil, types.ErrNotFound)
+	require.Equal(t, int64(0), n.queryTicketCount(bad, 50))
+	n.expireTestCount(50)
+	require.Equal(t, int64(0), n.queryTicketCount(bad, 50))
+	api.AssertNumberOfCalls(t, "Query", 5)
+}
+
+func TestTicketCountCacheDisabled(t *testing.T) {
+	n, api := newTestNode(t, &subConfig{TicketCountCache: -1})
+	require.Nil(t, n.tcCache)
+	n.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "UseEntrust", 1000)
+	addr := "1CountCacheTestAddr"
+	api.On("Query", pt.Pos33TicketX, "Pos33TicketCount", &types.ReqAddr{Addr: addr}).Return(&types.Int64{Data: 5}, nil)
+	n.tcCache.setTip(100)
+
+	require.Equal(t, int64(5), n.queryTicketCount(addr, 50))
+	n.expireTestCount(50)
+	require.Equal(t, int64(5), n.queryTicketCount(addr, 50))
+	api.AssertNumberOfCalls(t, "Query", 2)
+}
+
+func TestTicketCountSnapshot(t *testing.T) {
+	n, api := newTestNode(t, nil)
+	n.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "UseEntrust", 1000)
+	api.On("Query", pt.Pos33TicketX, "AllPos33TicketCount", mock.Anything).Return(&types.Int64{Data: 100}, nil)
+	addr := "1CountCacheTestAddr"
+	n.setTestCount(addr, 0, 7, 100)
+
+	// 高度Pos33SortBlocks-1的区块预取高度0的快照, 验证高度Pos33SortBlocks的抽签不用查询
+	for h := int64(0); h < pt.Pos33SortBlocks; h++ {
+		n.updateTicketCount(&types.Block{Height: h})
+	}
+	n.expireTestCount(0)
+	require.Equal(t, int64(7), n.queryTicketCount(addr, 0))
+	api.AssertNotCalled(t, "Query", pt.Pos33TicketX, "Pos33TicketCount", mock.Anything)
+
+	// 回滚到高度1以后快照作废
+	n.updateTicketCount(&types.Block{Height: 1})
+	n.mlock.Lock()
+	_, ok := n.tcMap[pt.Pos33SortBlocks-1]
+	n.mlock.Unlock()
+	require.False(t, ok)
+	api.On("Query", pt.Pos33TicketX, "Pos33TicketCount", &types.ReqAddr{Addr: addr}).Return(&types.Int64{Data: 5}, nil)
+	require.Equal(t, int64(5), n.queryTicketCount(addr, 1))
+}
+
+func TestTicketCountCacheReorg(t *testing.T) {
+	c := newTicketCountCache(0)
+	c.setTip(100)
+	c.add("a", 20, 1)
+	c.add("a", 50, 2)
+	c.snapshot(60, map[string]int64{"a": 3})
+	count, ok := c.get("a", 60)
+	require.True(t, ok)
+	require.Equal(t, int64(3), count)
+
+	c.reorg(40)
+	count, ok = c.get("a", 20)
+	require.True(t, ok)
+	require.Equal(t, int64(1), count)
+	_, ok = c.get("a", 50)
+	require.False(t, ok)
+	_, ok = c.get("a", 60)
+	require.False(t, ok)
+
+	// 快照只保留最近countSnapshotBlocks个高度
+	c.snapshot(70, map[string]int64{"a": 3})
+	c.snapshot(70+countSnapshotBlocks, map[string]int64{"a": 4})
+	_, ok = c.get("a", 70)
+	require.False(t, ok)
+}
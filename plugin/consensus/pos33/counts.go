@@ -0,0 +1,129 @@
+package pos33
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/33cn/chain33/types"
+	lru "github.com/hashicorp/golang-lru"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// sortCountCacheSize 缓存多少个(快照高度, 地址)的抽签票数
+const sortCountCacheSize = 8192
+
+var errStateQuery = errors.New("pos33 state query error")
+
+type countKey struct {
+	height int64 // 抽签快照的高度
+	addr   string
+}
+
+// sortCountCache 从快照高度的状态查询到的抽签票数, 有自己的锁.
+// 同一个快照高度的状态不会变, 只有这个区块回滚时清空
+type sortCountCache struct {
+	cache *lru.Cache
+}
+
+func newSortCountCache(size int) *sortCountCache {
+	if size <= 0 {
+		size = sortCountCacheSize
+	}
+	cache, err := lru.New(size)
+	if err != nil {
+		panic(err)
+	}
+	return &sortCountCache{cache: cache}
+}
+
+func (sc *sortCountCache) get(height int64, addr string) (int64, bool) {
+	v, ok := sc.cache.Get(countKey{height, addr})
+	if !ok {
+		return 0, false
+	}
+	return v.(int64), true
+}
+
+func (sc *sortCountCache) add(height int64, addr string, count int64) {
+	sc.cache.Add(countKey{height, addr}, count)
+}
+
+// reset 区块回滚, 快照高度的状态可能变了
+func (sc *sortCountCache) reset() {
+	sc.cache.Purge()
+}
+
+// stateQuery 在height高度区块执行以后的状态上查询pos33执行器, 结果和节点什么时候查询无关
+func (c *Client) stateQuery(height int64, fn string, param types.Message) (types.Message, error) {
+	hs, err := c.GetAPI().GetHeaders(&types.ReqBlocks{Start: height, End: height})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s at %d: %v", errStateQuery, fn, height, err)
+	}
+	if len(hs.GetItems()) != 1 || hs.Items[0].Height != height {
+		return nil, fmt.Errorf("%w: %s at %d: header NOT found", errStateQuery, fn, height)
+	}
+	msg, err := c.GetAPI().QueryChain(&types.ChainExecutor{
+		Driver:    pt.Pos33TicketX,
+		FuncName:  fn,
+		StateHash: hs.Items[0].StateHash,
+		Param:     types.Encode(param),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s at %d: %v", errStateQuery, fn, height, err)
+	}
+	return msg, nil
+}
+
+// sortState 验证height高度的抽签是否使用快照高度sh的状态里的票数.
+// ForkBlockCheck以前和以前一样使用tcMap, 重新同步历史区块的结果不变, 也不需要历史状态
+func (n *node) sortState(height, sh int64) bool {
+	cfg := n.GetAPI().GetConfig()
+	return cfg.IsDappFork(height, pt.Pos33TicketX, "ForkBlockCheck") && cfg.IsDappFork(sh, pt.Pos33TicketX, "UseEntrust")
+}
+
+// sortCount 返回验证height高度的抽签时addr的票数. ForkBlockCheck以后是sortHeight(height)区块执行以后的状态里的票数,
+// 不使用按本地查询时间填充的tcMap, 所有节点对同一个抽签得到相同的票数
+func (n *node) sortCount(addr string, height int64) (int64, error) {
+	sh := n.sortHeight(height)
+	if !n.sortState(height, sh) {
+		return n.queryTicketCount(addr, sh), nil
+	}
+	if count, ok := n.scCache.get(sh, addr); ok {
+		return count, nil
+	}
+	msg, err := n.stateQuery(sh, "Pos33SortCount", &pt.ReqPos33SortCount{Addr: addr, Height: sh})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := msg.(*types.Int64)
+	if !ok {
+		return 0, fmt.Errorf("%w: sort count reply %T", errStateQuery, msg)
+	}
+	n.scCache.add(sh, addr, count.Data)
+	return count.Data, nil
+}
+
+// sortTotal 返回验证height高度的抽签时的全网票数, ForkBlockCheck以后从sortHeight(height)的状态查询.
+// 和地址的票数使用同一个缓存, 地址不会是空的
+func (n *node) sortTotal(height int64) (int64, error) {
+	sh := n.sortHeight(height)
+	if sh < 0 {
+		sh = 0
+	}
+	if !n.sortState(height, sh) {
+		return n.totalStake(sh)
+	}
+	if count, ok := n.scCache.get(sh, ""); ok {
+		return count, nil
+	}
+	msg, err := n.stateQuery(sh, "Pos33SortTotal", &types.ReqInt{Height: sh})
+	if err != nil {
+		return 0, err
+	}
+	count, ok := msg.(*types.Int64)
+	if !ok || count.Data <= 0 {
+		return 0, fmt.Errorf("%w: sort total reply %v at %d", errStateQuery, msg, sh)
+	}
+	n.scCache.add(sh, "", count.Data)
+	return count.Data, nil
+}
@@ -0,0 +1,103 @@
+package pos33
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// mockStateHeaders 每个高度的区块头, 状态hash是高度
+func mockStateHeaders(api *mocks.QueueProtocolAPI) {
+	api.On("GetHeaders", mock.Anything).Return(func(req *types.ReqBlocks) *types.Headers {
+		return &types.Headers{Items: []*types.Header{{Height: req.Start, StateHash: []byte{byte(req.Start)}}}}
+	}, nil)
+}
+
+// onSortCount mock height高度状态上addr的抽签票数查询
+func onSortCount(api *mocks.QueueProtocolAPI, addr string, height int64) *mock.Call {
+	return api.On("QueryChain", mock.MatchedBy(func(p *types.ChainExecutor) bool {
+		req := new(pt.ReqPos33SortCount)
+		return p.FuncName == "Pos33SortCount" && types.Decode(p.Param, req) == nil &&
+			req.Addr == addr && req.Height == height && string(p.StateHash) == string([]byte{byte(height)})
+	}))
+}
+
+// onSortTotal mock height高度状态上的全网抽签票数查询
+func onSortTotal(api *mocks.QueueProtocolAPI, height int64) *mock.Call {
+	return api.On("QueryChain", mock.MatchedBy(func(p *types.ChainExecutor) bool {
+		return p.FuncName == "Pos33SortTotal" && string(p.StateHash) == string([]byte{byte(height)})
+	}))
+}
+
+// onBlsAddr mock height高度状态上bls公钥pk绑定的地址
+func onBlsAddr(api *mocks.QueueProtocolAPI, pk []byte, height int64, owner string) {
+	api.On("QueryChain", mock.MatchedBy(func(p *types.ChainExecutor) bool {
+		req := new(types.ReqAddr)
+		return p.FuncName == "Pos33BlsAddr" && types.Decode(p.Param, req) == nil &&
+			req.Addr == address.PubKeyToAddr(ethID, pk) && string(p.StateHash) == string([]byte{byte(height)})
+	})).Return(&types.ReplyString{Data: owner}, nil)
+}
+
+func TestSortCountState(t *testing.T) {
+	n, api := newTestNode(t, nil)
+	n.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "UseEntrust", 0)
+	n.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "ForkBlockCheck", 0)
+	priv := newTestPriv(t)
+	n.setTestMiner(priv)
+	mockStateHeaders(api)
+	height := int64(100)
+	sh := height - pt.Pos33SortBlocks
+	s := &pt.Pos33SortMsg{SortHash: &pt.SortHash{Index: 7}, Proof: &pt.HashProof{Pubkey: priv.PubKey().Bytes()}}
+
+	// 本地缓存的票数不影响验证, 使用快照高度状态里的票数
+	n.setTestCount(n.myAddr, sh, 5, 100)
+	state := int64(10)
+	onSortCount(api, n.myAddr, sh).Return(func(*types.ChainExecutor) types.Message { return &types.Int64{Data: state} }, nil)
+	onSortTotal(api, sh).Return(&types.Int64{Data: 100}, nil)
+	count, err := n.sortCount(n.myAddr, height)
+	require.Nil(t, err)
+	require.Equal(t, int64(10), count)
+	require.Nil(t, n.checkSeats(height, s))
+	// 票数已经缓存, checkSeats只查询全网票数
+	api.AssertNumberOfCalls(t, "QueryChain", 2)
+
+	// 回滚以后重新查询
+	n.mlock.Lock()
+	n.rollbackTicketCount(sh)
+	n.mlock.Unlock()
+	state = 5
+	require.NotNil(t, n.checkSeats(height, s))
+	api.AssertNumberOfCalls(t, "QueryChain", 3)
+
+	// 查询出错时验证失败, 不缓存
+	other := "other"
+	onSortCount(api, other, sh).Return(nil, errors.New("query error")).Once()
+	_, err = n.sortCount(other, height)
+	require.True(t, errors.Is(err, errStateQuery))
+	onSortCount(api, other, sh).Return(&types.Int64{Data: 3}, nil)
+	count, err = n.sortCount(other, height)
+	require.Nil(t, err)
+	require.Equal(t, int64(3), count)
+
+	// ForkBlockCheck之前仍然使用tcMap, 不查询历史状态
+	n.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "ForkBlockCheck", height+1)
+	n.setTestCount(n.myAddr, sh, 6, 100)
+	count, err = n.sortCount(n.myAddr, height)
+	require.Nil(t, err)
+	require.Equal(t, int64(6), count)
+	api.AssertNumberOfCalls(t, "QueryChain", 5)
+
+	// UseEntrust之前仍然使用tcMap
+	n.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "ForkBlockCheck", 0)
+	n.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "UseEntrust", sh+1)
+	n.setTestCount(n.myAddr, sh, 5, 100)
+	count, err = n.sortCount(n.myAddr, height)
+	require.Nil(t, err)
+	require.Equal(t, int64(5), count)
+}
@@ -0,0 +1,357 @@
+package pos33
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+const defaultCommitteeStoreBlocks = 1000
+
+// maxCommitteeRounds 查询每轮委员会大小时, 最多返回的轮数
+const maxCommitteeRounds = 16
+
+// committeeStore 保存最近确定的每一轮的委员会, 用于查询.
+// 如果配置了file, 异步写入磁盘, 重启后加载.
+// 配置了maxBytes时在后台按字节数清理最老的高度, 但是至少保留minBlocks个高度
+type committeeStore struct {
+	mu     sync.Mutex
+	mp     map[int64]map[int32]*pt.Pos33CommitteeRecord // height -> round -> record
+	blocks int64
+
+	minBlocks int64
+	maxBytes  int64
+	bytes     int64
+	last      int64
+
+	prunedHeights int64
+	prunedBytes   int64
+
+	file   string
+	saveCh chan struct{}
+}
+
+// newNodeCommitteeStore 按配置创建委员会记录, 保留的高度不少于惩罚窗口
+func newNodeCommitteeStore(conf *subConfig) *committeeStore {
+	min := int64(slashWindow + 1)
+	blocks := conf.CommitteeStoreBlocks
+	if blocks > 0 && blocks < min {
+		plog.Warn("committeeStoreBlocks less than slash window, use it", "blocks", blocks, "min", min)
+		blocks = min
+	}
+	s := newCommitteeStore(conf.CommitteeStoreFile, blocks)
+	s.setRetention(min, conf.CommitteeStoreBytes)
+	return s
+}
+
+func newCommitteeStore(file string, blocks int64) *committeeStore {
+	if blocks <= 0 {
+		blocks = defaultCommitteeStoreBlocks
+	}
+	s := &committeeStore{
+		mp:     make(map[int64]map[int32]*pt.Pos33CommitteeRecord),
+		blocks: blocks,
+		file:   file,
+		saveCh: make(chan struct{}, 1),
+	}
+	if file != "" {
+		err := s.load()
+		if err != nil {
+			plog.Error("committee store load error, discard it", "err", err, "file", file)
+			s.mp = make(map[int64]map[int32]*pt.Pos33CommitteeRecord)
+			os.Remove(file)
+		}
+	}
+	return s
+}
+
+// setRetention 设置最少保留的高度数和最多占用的字节数, maxBytes为0不限制
+func (s *committeeStore) setRetention(minBlocks, maxBytes int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.minBlocks = minBlocks
+	if s.blocks < minBlocks {
+		s.blocks = minBlocks
+	}
+	s.maxBytes = maxBytes
+}
+
+// add 记录height高度round轮的委员会
+func (s *committeeStore) add(height int64, round int, comm []*pt.Pos33SortMsg) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	s.set(&pt.Pos33CommitteeRecord{Height: height, Round: int32(round), Comm: comm})
+	for h := range s.mp {
+		if h <= height-s.blocks {
+			s.del(h)
+		}
+	}
+	background := s.file != "" || s.maxBytes > 0
+	s.mu.Unlock()
+
+	if !background {
+		return
+	}
+	// 不阻塞共识, 正在清理或者写的时候合并
+	select {
+	case s.saveCh <- struct{}{}:
+	default:
+	}
+}
+
+func (s *committeeStore) set(r *pt.Pos33CommitteeRecord) {
+	rmp, ok := s.mp[r.Height]
+	if !ok {
+		rmp = make(map[int32]*pt.Pos33CommitteeRecord)
+		s.mp[r.Height] = rmp
+	}
+	if old, ok := rmp[r.Round]; ok {
+		s.bytes -= int64(types.Size(old))
+	}
+	rmp[r.Round] = r
+	s.bytes += int64(types.Size(r))
+	if r.Height > s.last {
+		s.last = r.Height
+	}
+}
+
+// del 删除height高度的所有记录
+func (s *committeeStore) del(height int64) {
+	rmp, ok := s.mp[height]
+	if !ok {
+		return
+	}
+	for _, r := range rmp {
+		size := int64(types.Size(r))
+		s.bytes -= size
+		s.prunedBytes += size
+	}
+	s.prunedHeights++
+	delete(s.mp, height)
+}
+
+// prune 超过maxBytes时从最老的高度开始删除, 最近minBlocks个高度不删除, 返回释放的字节数
+func (s *committeeStore) prune() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.maxBytes <= 0 || s.bytes <= s.maxBytes {
+		return 0
+	}
+	var hs []int64
+	for h := range s.mp {
+		if h <= s.last-s.minBlocks {
+			hs = append(hs, h)
+		}
+	}
+	sort.Slice(hs, func(i, j int) bool { return hs[i] < hs[j] })
+	before := s.bytes
+	for _, h := range hs {
+		if s.bytes <= s.maxBytes {
+			break
+		}
+		s.del(h)
+	}
+	if s.bytes > s.maxBytes {
+		plog.Debug("committee store over maxBytes, keep min blocks", "bytes", s.bytes, "maxBytes", s.maxBytes, "minBlocks", s.minBlocks)
+	}
+	return before - s.bytes
+}
+
+// retention 返回保留策略和清理统计
+func (s *committeeStore) retention() *pt.Pos33RetentionStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r := &pt.Pos33RetentionStats{
+		Blocks:        s.blocks,
+		MinBlocks:     s.minBlocks,
+		MaxBytes:      s.maxBytes,
+		Heights:       int64(len(s.mp)),
+		Bytes:         s.bytes,
+		PrunedHeights: s.prunedHeights,
+		PrunedBytes:   s.prunedBytes,
+	}
+	for h, rmp := range s.mp {
+		r.Records += int64(len(rmp))
+		if r.Lowest == 0 || h < r.Lowest {
+			r.Lowest = h
+		}
+		if h > r.Highest {
+			r.Highest = h
+		}
+	}
+	return r
+}
+
+// rounds 返回height高度所有轮的委员会, 按轮次排序
+func (s *committeeStore) rounds(height int64) []*pt.Pos33CommitteeRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var rs []*pt.Pos33CommitteeRecord
+	for _, r := range s.mp[height] {
+		rs = append(rs, r)
+	}
+	sort.Slice(rs, func(i, j int) bool { return rs[i].Round < rs[j].Round })
+	return rs
+}
+
+// get 返回height高度最后一轮的委员会
+func (s *committeeStore) get(height int64) (*pt.Pos33CommitteeRecord, error) {
+	rs := s.rounds(height)
+	if len(rs) == 0 {
+		return nil, fmt.Errorf("committee NOT found, height %d", height)
+	}
+	return rs[len(rs)-1], nil
+}
+
+// sizes 返回height高度每一轮的委员会大小, 最多maxCommitteeRounds轮
+func (s *committeeStore) sizes(height int64) (*pt.Pos33CommitteeSizes, error) {
+	rs := s.rounds(height)
+	if len(rs) == 0 {
+		return nil, fmt.Errorf("committee NOT found, height %d", height)
+	}
+	cs := &pt.Pos33CommitteeSizes{Height: height, Rounds: int32(len(rs))}
+	if len(rs) > maxCommitteeRounds {
+		rs = rs[:maxCommitteeRounds]
+	}
+	for _, r := range rs {
+		cs.Sizes = append(cs.Sizes, &pt.Pos33RoundSize{Round: r.Round, Size: int32(len(r.Comm))})
+	}
+	return cs, nil
+}
+
+func (s *committeeStore) records() *pt.Pos33CommitteeStore {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cs := &pt.Pos33CommitteeStore{}
+	for _, rmp := range s.mp {
+		for _, r := range rmp {
+			cs.Records = append(cs.Records, r)
+		}
+	}
+	sort.Slice(cs.Records, func(i, j int) bool {
+		ri, rj := cs.Records[i], cs.Records[j]
+		if ri.Height == rj.Height {
+			return ri.Round < rj.Round
+		}
+		return ri.Height < rj.Height
+	})
+	return cs
+}
+
+func (s *committeeStore) run() {
+	for range s.saveCh {
+		s.prune()
+		if s.file == "" {
+			continue
+		}
+		err := s.save()
+		if err != nil {
+			plog.Error("committee store save error", "err", err, "file", s.file)
+		}
+	}
+}
+
+// save 先写临时文件再rename, 写到一半退出时不会留下不完整的文件.
+// 文件内容是 sha256(data) + data, 加载时校验
+func (s *committeeStore) save() error {
+	data := types.Encode(s.records())
+	data = append(crypto.Sha256(data), data...)
+	tmp := s.file + ".tmp"
+	err := os.WriteFile(tmp, data, 0666)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.file)
+}
+
+func (s *committeeStore) load() error {
+	data, err := os.ReadFile(s.file)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(data) < sha256.Size || !bytes.Equal(data[:sha256.Size], crypto.Sha256(data[sha256.Size:])) {
+		return fmt.Errorf("committee store checksum error")
+	}
+	var cs pt.Pos33CommitteeStore
+	err = types.Decode(data[sha256.Size:], &cs)
+	if err != nil {
+		return err
+	}
+	err = checkCommitteeRecords(cs.Records)
+	if err != nil {
+		return err
+	}
+	s.restore(cs.Records)
+	plog.Info("committee store loaded", "file", s.file, "heights", len(s.mp))
+	return nil
+}
+
+// restore 恢复检查过的记录, 只保留最后blocks个高度
+func (s *committeeStore) restore(rs []*pt.Pos33CommitteeRecord) {
+	if len(rs) == 0 {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	last := rs[len(rs)-1].Height
+	for _, r := range rs {
+		if r.Height > last-s.blocks {
+			s.set(r)
+		}
+	}
+}
+
+// checkCommitteeRecords 检查记录按高度和轮次递增, 并且委员会的抽签和记录的高度和轮次一致
+func checkCommitteeRecords(rs []*pt.Pos33CommitteeRecord) error {
+	for i, r := range rs {
+		if r == nil {
+			return fmt.Errorf("committee record %d is nil", i)
+		}
+		if i > 0 {
+			p := rs[i-1]
+			if r.Height < p.Height || (r.Height == p.Height && r.Round <= p.Round) {
+				return fmt.Errorf("committee record NOT increase, height %d, round %d", r.Height, r.Round)
+			}
+		}
+		for _, s := range r.Comm {
+			if s.GetSortHash() == nil || s.GetProof().GetInput() == nil {
+				return fmt.Errorf("committee record sort is nil, height %d", r.Height)
+			}
+			input := s.Proof.Input
+			if input.Height != r.Height || input.Round != r.Round {
+				return fmt.Errorf("committee record sort NOT match, height %d, round %d", r.Height, r.Round)
+			}
+		}
+		if err := checkDistinctIndices(r.Comm); err != nil {
+			return fmt.Errorf("committee record error, height %d, round %d: %v", r.Height, r.Round, err)
+		}
+	}
+	return nil
+}
+
+// Query_GetCommittee 查询height高度最后一轮确定的委员会
+func (client *Client) Query_GetCommittee(req *types.ReqInt) (types.Message, error) {
+	return client.n.cstore.get(req.Height)
+}
+
+// Query_GetRetentionStats 查询委员会记录的保留策略和清理统计
+func (client *Client) Query_GetRetentionStats(req *types.ReqNil) (types.Message, error) {
+	return client.n.cstore.retention(), nil
+}
+
+// Query_GetCommitteeSizes 查询height高度每一轮的委员会大小
+func (client *Client) Query_GetCommitteeSizes(req *types.ReqInt) (types.Message, error) {
+	return client.n.cstore.sizes(req.Height)
+}
@@ -0,0 +1,183 @@
+package pos33
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func newTestComm(height int64, round int) []*pt.Pos33SortMsg {
+	input := &pt.VrfInput{Height: height, Round: int32(round)}
+	return []*pt.Pos33SortMsg{
+		{SortHash: &pt.SortHash{Hash: hash2([]byte("a")), Index: 1}, Proof: &pt.HashProof{Input: input}},
+		{SortHash: &pt.SortHash{Hash: hash2([]byte("b")), Index: 2}, Proof: &pt.HashProof{Input: input}},
+	}
+}
+
+func storeData(cs *pt.Pos33CommitteeStore) []byte {
+	data := types.Encode(cs)
+	return append(crypto.Sha256(data), data...)
+}
+
+func waitSaved(t *testing.T, file string, s *committeeStore) {
+	want := storeData(s.records())
+	require.Eventually(t, func() bool {
+		data, err := os.ReadFile(file)
+		return err == nil && string(data) == string(want)
+	}, time.Second*5, time.Millisecond*10)
+}
+
+func TestCommitteeStoreRoundTrip(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "committee.db")
+	s := newCommitteeStore(file, 5)
+	go s.run()
+	for h := int64(1); h <= 10; h++ {
+		s.add(h, 0, newTestComm(h, 0))
+	}
+	s.add(10, 1, newTestComm(10, 1))
+	waitSaved(t, file, s)
+
+	// 只保留最近5个高度
+	_, err := s.get(5)
+	require.NotNil(t, err)
+
+	s2 := newCommitteeStore(file, 5)
+	require.Equal(t, types.Encode(s.records()), types.Encode(s2.records()))
+	r, err := s2.get(10)
+	require.Nil(t, err)
+	require.Equal(t, int32(1), r.Round)
+	require.Len(t, r.Comm, 2)
+
+	// 重启时保留的高度更少, 高度10有两轮
+	s3 := newCommitteeStore(file, 2)
+	require.Len(t, s3.records().Records, 3)
+	require.Len(t, s3.rounds(10), 2)
+}
+
+func TestCommitteeStoreSizes(t *testing.T) {
+	s := newCommitteeStore("", 10)
+
+	// 第0轮就完成
+	s.add(1, 0, newTestComm(1, 0))
+	cs, err := s.sizes(1)
+	require.Nil(t, err)
+	require.Equal(t, int32(1), cs.Rounds)
+	require.Len(t, cs.Sizes, 1)
+	require.Equal(t, int32(0), cs.Sizes[0].Round)
+	require.Equal(t, int32(2), cs.Sizes[0].Size)
+
+	// 第0轮委员会不够, 第2轮成功, 第1轮没有确定委员会
+	s.add(2, 2, append(newTestComm(2, 2), newTestComm(2, 2)...))
+	s.add(2, 0, newTestComm(2, 0)[:1])
+	cs, err = s.sizes(2)
+	require.Nil(t, err)
+	require.Equal(t, int32(2), cs.Rounds)
+	require.Equal(t, []*pt.Pos33RoundSize{{Round: 0, Size: 1}, {Round: 2, Size: 4}}, cs.Sizes)
+	r, err := s.get(2)
+	require.Nil(t, err)
+	require.Equal(t, int32(2), r.Round)
+
+	// 轮数太多时截断
+	for round := 0; round < maxCommitteeRounds+5; round++ {
+		s.add(3, round, newTestComm(3, round))
+	}
+	cs, err = s.sizes(3)
+	require.Nil(t, err)
+	require.Equal(t, int32(maxCommitteeRounds+5), cs.Rounds)
+	require.Len(t, cs.Sizes, maxCommitteeRounds)
+	require.Equal(t, int32(maxCommitteeRounds-1), cs.Sizes[maxCommitteeRounds-1].Round)
+
+	_, err = s.sizes(4)
+	require.NotNil(t, err)
+}
+
+func TestCommitteeStoreCorrupt(t *testing.T) {
+	dir := t.TempDir()
+	good := storeData(&pt.Pos33CommitteeStore{Records: []*pt.Pos33CommitteeRecord{
+		{Height: 1, Comm: newTestComm(1, 0)},
+		{Height: 2, Comm: newTestComm(2, 0)},
+	}})
+	cases := map[string][]byte{
+		"partial":  good[:len(good)-10],
+		"short":    good[:10],
+		"garbage":  []byte("not a committee store"),
+		"mismatch": storeData(&pt.Pos33CommitteeStore{Records: []*pt.Pos33CommitteeRecord{{Height: 3, Comm: newTestComm(4, 0)}}}),
+		"order": storeData(&pt.Pos33CommitteeStore{Records: []*pt.Pos33CommitteeRecord{
+			{Height: 2, Comm: newTestComm(2, 0)},
+			{Height: 1, Comm: newTestComm(1, 0)},
+		}}),
+		"round": storeData(&pt.Pos33CommitteeStore{Records: []*pt.Pos33CommitteeRecord{
+			{Height: 2, Round: 1, Comm: newTestComm(2, 1)},
+			{Height: 2, Round: 1, Comm: newTestComm(2, 1)},
+		}}),
+	}
+	for name, data := range cases {
+		file := filepath.Join(dir, name)
+		require.Nil(t, os.WriteFile(file, data, 0666))
+		s := newCommitteeStore(file, 10)
+		require.Empty(t, s.records().Records, name)
+		_, err := os.Stat(file)
+		require.True(t, os.IsNotExist(err), name)
+
+		// 丢弃后重新开始记录
+		go s.run()
+		s.add(7, 0, newTestComm(7, 0))
+		waitSaved(t, file, s)
+		require.Len(t, newCommitteeStore(file, 10).records().Records, 1, name)
+	}
+
+	file := filepath.Join(dir, "good")
+	require.Nil(t, os.WriteFile(file, good, 0666))
+	require.Len(t, newCommitteeStore(file, 10).records().Records, 2)
+}
+
+func TestCommitteeStoreRetention(t *testing.T) {
+	s := newCommitteeStore("", 100)
+	s.setRetention(10, 1)
+	require.Equal(t, int64(100), s.blocks)
+	size := int64(types.Size(&pt.Pos33CommitteeRecord{Height: 1, Comm: newTestComm(1, 0)}))
+	for h := int64(1); h <= 30; h++ {
+		s.add(h, 0, newTestComm(h, 0))
+	}
+	r := s.retention()
+	require.Equal(t, int64(30), r.Heights)
+	require.Equal(t, 30*size, r.Bytes)
+
+	// 超过maxBytes, 但是最近10个高度不清理
+	freed := s.prune()
+	require.Equal(t, 20*size, freed)
+	r = s.retention()
+	require.Equal(t, int64(10), r.Heights)
+	require.Equal(t, int64(21), r.Lowest)
+	require.Equal(t, int64(30), r.Highest)
+	require.Equal(t, int64(20), r.PrunedHeights)
+	require.Equal(t, 20*size, r.PrunedBytes)
+	require.Equal(t, 10*size, r.Bytes)
+	_, err := s.get(20)
+	require.NotNil(t, err)
+	_, err = s.get(21)
+	require.Nil(t, err)
+	require.Equal(t, int64(0), s.prune())
+
+	// 放宽到15个记录, 后台清理到不超过maxBytes
+	s.setRetention(10, 15*size)
+	go s.run()
+	for h := int64(31); h <= 50; h++ {
+		s.add(h, 0, newTestComm(h, 0))
+	}
+	require.Eventually(t, func() bool { return s.retention().Bytes <= 15*size }, time.Second*5, time.Millisecond*10)
+	r = s.retention()
+	require.Equal(t, int64(15), r.Heights)
+	require.Equal(t, int64(36), r.Lowest)
+
+	// 配置的高度数小于惩罚窗口时使用惩罚窗口
+	s = newNodeCommitteeStore(&subConfig{CommitteeStoreBlocks: 5})
+	require.Equal(t, int64(slashWindow+1), s.retention().Blocks)
+	require.Equal(t, int64(slashWindow+1), s.retention().MinBlocks)
+}
@@ -0,0 +1,89 @@
+package pos33
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// diffScheduleBlocks 按高度保留全网票数的区块数, 用于查询历史区块的diff
+const diffScheduleBlocks = 10000
+
+// calcDiff height高度全网票数为allCount时的抽签难度
+func (c *Client) calcDiff(height int64, allCount int) float64 {
+	return c.chainParam(height).Diff(allCount)
+}
+
+// setDiffSchedule 记录height高度的全网票数, 需要持有mlock.
+// acMap只保留最近的几个高度, 这里保留得更久, 而且记录的是当时的值,
+// 跨过UseEntrust等改变票数计算方式的分叉也不会变
+func (c *Client) setDiffSchedule(height int64, allCount int) {
+	c.dsMap[height] = allCount
+	delete(c.dsMap, height-diffScheduleBlocks)
+}
+
+// blockDiffOf 返回区块b确定时那一轮使用的diff
+func (c *Client) blockDiffOf(b *types.Block) (*pt.Pos33BlockDiff, error) {
+	m, err := getMiner(b)
+	if err != nil {
+		return nil, err
+	}
+	if m.Sort.GetProof().GetInput() == nil {
+		return nil, fmt.Errorf("block miner sort is nil, height %d", b.Height)
+	}
+	round := m.Sort.Proof.Input.Round
+
+	sh := c.sortHeight(b.Height)
+	if sh < 0 {
+		sh = 0
+	}
+	c.mlock.Lock()
+	count, ok := c.dsMap[sh]
+	c.mlock.Unlock()
+	if !ok || count == 0 {
+		return nil, fmt.Errorf("diff schedule NOT found, height %d, snapshot height %d", b.Height, sh)
+	}
+	diff, err := c.diffV2(b.Height, int(round), c.calcDiff(b.Height, count))
+	if err != nil {
+		return nil, err
+	}
+	return &pt.Pos33BlockDiff{Height: b.Height, Round: round, AllCount: int64(count), Diff: diff}, nil
+}
+
+// Query_GetBlockDiff 查询height高度的区块确定时那一轮使用的diff
+func (client *Client) Query_GetBlockDiff(req *types.ReqInt) (types.Message, error) {
+	b, err := client.RequestBlock(req.Height)
+	if err != nil {
+		return nil, err
+	}
+	return client.blockDiffOf(b)
+}
+
+// seatsStats count张票在diff下每个区块中签数的均值和标准差.
+// 每张票独立抽签, 中签数是二项分布: 均值count*diff, 方差count*diff*(1-diff)
+func seatsStats(count int64, diff float64) (*pt.Pos33SeatsStats, error) {
+	if count < 0 {
+		return nil, fmt.Errorf("seats stats count error: %d", count)
+	}
+	if math.IsNaN(diff) || diff < 0 {
+		return nil, fmt.Errorf("seats stats diff error: %v", diff)
+	}
+	// diff大于1时每张票都中签
+	p := math.Min(diff, 1)
+	mean := float64(count) * p
+	variance := mean * (1 - p)
+	return &pt.Pos33SeatsStats{
+		Count:    count,
+		Diff:     diff,
+		Mean:     mean,
+		Variance: variance,
+		Stddev:   math.Sqrt(variance),
+	}, nil
+}
+
+// Query_GetSeatsStats 查询count张票在diff下每个区块中签数的均值和标准差
+func (client *Client) Query_GetSeatsStats(req *pt.ReqPos33Seats) (types.Message, error) {
+	return seatsStats(req.Count, req.Diff)
+}
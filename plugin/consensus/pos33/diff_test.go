@@ -0,0 +1,87 @@
+package pos33
+
+import (
+	"math"
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func TestBlockDiffAcrossFork(t *testing.T) {
+	n, api := newTestNode(t, nil)
+	cfg := n.GetAPI().GetConfig()
+	fork := int64(50)
+	cfg.SetDappFork(pt.Pos33TicketX, "UseEntrust", fork)
+	price := pt.GetPos33MineParam(cfg, fork).GetTicketPrice()
+
+	// 分叉前按票数, 分叉后按金额/票价计算全网票数
+	api.On("Query", pt.Pos33TicketX, "AllPos33TicketCount", mock.Anything).Return(&types.Int64{Data: 300}, nil)
+	api.On("Query", pt.Pos33TicketX, "AllPos33TicketAmount", mock.Anything).Return(&types.Int64{Data: 150 * price}, nil)
+
+	last := fork + pt.Pos33SortBlocks*5
+	for h := int64(0); h <= last; h++ {
+		n.updateTicketCount(&types.Block{Height: h})
+	}
+	// acMap已经不再保留分叉附近的高度
+	n.mlock.Lock()
+	_, ok := n.acMap[fork]
+	n.mlock.Unlock()
+	require.False(t, ok)
+
+	sort := func(h int64, round int32) *pt.Pos33SortMsg {
+		return &pt.Pos33SortMsg{Proof: &pt.HashProof{Input: &pt.VrfInput{Height: h, Round: round}}}
+	}
+
+	h := fork + pt.Pos33SortBlocks - 1
+	d, err := n.Client.blockDiffOf(newTestBlock(h, sort(h, 2)))
+	require.Nil(t, err)
+	require.Equal(t, int32(2), d.Round)
+	require.Equal(t, int64(300), d.AllCount)
+	require.Equal(t, float64(pt.Pos33CommitteeSize)/300, d.Diff)
+
+	h = fork + pt.Pos33SortBlocks
+	d, err = n.Client.blockDiffOf(newTestBlock(h, sort(h, 0)))
+	require.Nil(t, err)
+	require.Equal(t, int32(0), d.Round)
+	require.Equal(t, int64(150), d.AllCount)
+	require.Equal(t, float64(pt.Pos33CommitteeSize)/150, d.Diff)
+
+	_, err = n.Client.blockDiffOf(newTestBlock(last+pt.Pos33SortBlocks+1, sort(last+pt.Pos33SortBlocks+1, 0)))
+	require.NotNil(t, err)
+}
+
+func TestSeatsStats(t *testing.T) {
+	cases := []struct {
+		count          int64
+		diff           float64
+		mean, variance float64
+	}{
+		{100, 0.5, 50, 25},
+		{10, 0.1, 1, 0.9},
+		{1000, 0.075, 75, 69.375},
+		{0, 0.5, 0, 0},
+		{100, 0, 0, 0},
+		{100, 1, 100, 0},
+		{100, 2, 100, 0},
+	}
+	for i, c := range cases {
+		s, err := seatsStats(c.count, c.diff)
+		require.Nil(t, err, "case %d", i)
+		require.InDelta(t, c.mean, s.Mean, 1e-9, "case %d", i)
+		require.InDelta(t, c.variance, s.Variance, 1e-9, "case %d", i)
+		require.InDelta(t, math.Sqrt(c.variance), s.Stddev, 1e-9, "case %d", i)
+	}
+	s, err := seatsStats(100, 0.5)
+	require.Nil(t, err)
+	require.Equal(t, float64(5), s.Stddev)
+
+	_, err = seatsStats(-1, 0.5)
+	require.NotNil(t, err)
+	_, err = seatsStats(10, -0.1)
+	require.NotNil(t, err)
+	_, err = seatsStats(10, math.NaN())
+	require.NotNil(t, err)
+}
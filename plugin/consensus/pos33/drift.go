@@ -0,0 +1,59 @@
+package pos33
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+// defaultClockDriftWarn 默认本地时钟和区块时间相差多少毫秒时告警
+const defaultClockDriftWarn = 3000
+
+// clockDriftSamples 使用最近多少个区块时间的中位数, 减少网络延迟的影响
+const clockDriftSamples = 16
+
+var (
+	clockDriftGauge   = metrics.GetOrRegisterGauge("pos33/clock/drift", nil)
+	clockDriftWarning = metrics.GetOrRegisterCounter("pos33/clock/driftwarning", nil)
+)
+
+// clockDrift 比较收到区块时的本地时间和区块里miner的时间(毫秒).
+// 本地时钟不准时, 会在网络已经过去的高度抽签, 错过中签, 而且没有其他的错误提示
+type clockDrift struct {
+	mu      sync.Mutex
+	samples []int64
+	warn    int64
+	warned  bool
+}
+
+func newClockDrift(warn int64) *clockDrift {
+	if warn <= 0 {
+		warn = defaultClockDriftWarn
+	}
+	return &clockDrift{warn: warn}
+}
+
+// observe 记录一个区块, 返回当前的偏差(本地时间-区块时间的中位数)和是否超过阈值.
+// 超过阈值时告警一次, 恢复以后再超过再告警
+func (d *clockDrift) observe(blockTime int64, now time.Time) (int64, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.samples = append(d.samples, now.UnixNano()/1000000-blockTime)
+	if len(d.samples) > clockDriftSamples {
+		d.samples = d.samples[len(d.samples)-clockDriftSamples:]
+	}
+	ss := append([]int64{}, d.samples...)
+	sort.Slice(ss, func(i, j int) bool { return ss[i] < ss[j] })
+	drift := ss[len(ss)/2]
+	clockDriftGauge.Update(drift)
+
+	over := drift >= d.warn || drift <= -d.warn
+	if over && !d.warned {
+		clockDriftWarning.Inc(1)
+		plog.Warn("local clock drifts from block time, check NTP", "drift(ms)", drift, "threshold(ms)", d.warn)
+	}
+	d.warned = over
+	return drift, over
+}
@@ -0,0 +1,59 @@
+package pos33
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClockDrift(t *testing.T) {
+	d := newClockDrift(1000)
+	now := time.Now()
+	ms := now.UnixNano() / 1000000
+	warned := clockDriftWarning.Count()
+
+	// 正常的网络延迟
+	for i := 0; i < clockDriftSamples; i++ {
+		drift, over := d.observe(ms-200, now)
+		require.Equal(t, int64(200), drift)
+		require.False(t, over)
+	}
+	require.Equal(t, int64(200), clockDriftGauge.Value())
+
+	// 个别区块时间不准不告警
+	_, over := d.observe(ms-5000, now)
+	require.False(t, over)
+
+	// 本地时钟快了, 区块时间看起来晚了999ms, 还没有到阈值
+	late := func(skew int64) (int64, bool) {
+		var drift int64
+		var over bool
+		for i := 0; i < clockDriftSamples; i++ {
+			drift, over = d.observe(ms-skew, now)
+		}
+		return drift, over
+	}
+	drift, over := late(999)
+	require.Equal(t, int64(999), drift)
+	require.False(t, over)
+	require.Equal(t, warned, clockDriftWarning.Count())
+
+	// 到阈值时告警一次
+	drift, over = late(1000)
+	require.Equal(t, int64(1000), drift)
+	require.True(t, over)
+	require.Equal(t, warned+1, clockDriftWarning.Count())
+	late(3000)
+	require.Equal(t, warned+1, clockDriftWarning.Count())
+
+	// 恢复以后, 本地时钟慢了再告警
+	late(0)
+	drift, over = late(-1500)
+	require.Equal(t, int64(-1500), drift)
+	require.True(t, over)
+	require.Equal(t, warned+2, clockDriftWarning.Count())
+	require.Equal(t, int64(-1500), clockDriftGauge.Value())
+
+	require.Equal(t, int64(defaultClockDriftWarn), newClockDrift(0).warn)
+}
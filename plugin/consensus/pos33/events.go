@@ -0,0 +1,117 @@
+package pos33
+
+import (
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+const defaultEventBufferSize = 1024
+
+// maxEventsPerQuery 一次查询最多返回的事件数
+const maxEventsPerQuery = 256
+
+// eventHub 保存最近的抽签和委员会事件, 供rpc推送给外部.
+// 固定大小的环形缓冲, 满了覆盖最旧的, push永远不阻塞共识
+type eventHub struct {
+	mu   sync.Mutex
+	buf  []*pt.Pos33SortitionEvent
+	next int64 // 下一个事件的seq
+}
+
+func newEventHub(size int) *eventHub {
+	if size <= 0 {
+		size = defaultEventBufferSize
+	}
+	return &eventHub{buf: make([]*pt.Pos33SortitionEvent, size)}
+}
+
+func (h *eventHub) push(e *pt.Pos33SortitionEvent) {
+	if h == nil {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	e.Seq = h.next
+	e.Time = time.Now().UnixNano() / 1000000
+	h.buf[h.next%int64(len(h.buf))] = e
+	h.next++
+}
+
+// since 返回seq从from开始的最多max个类型在tys里的事件, tys为空时返回全部.
+// from之后已经被覆盖的记在Dropped
+func (h *eventHub) since(from int64, max int, tys ...int32) *pt.Pos33SortitionEvents {
+	if max <= 0 || max > maxEventsPerQuery {
+		max = maxEventsPerQuery
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	r := &pt.Pos33SortitionEvents{}
+	oldest := h.next - int64(len(h.buf))
+	if oldest < 0 {
+		oldest = 0
+	}
+	if from < oldest {
+		r.Dropped = oldest - from
+		from = oldest
+	}
+	if from > h.next {
+		from = h.next
+	}
+	seq := from
+	for ; seq < h.next && len(r.Events) < max; seq++ {
+		e := h.buf[seq%int64(len(h.buf))]
+		if matchEvent(e.Ty, tys) {
+			r.Events = append(r.Events, e)
+		}
+	}
+	r.NextSeq = seq
+	return r
+}
+
+func matchEvent(ty int32, tys []int32) bool {
+	if len(tys) == 0 {
+		return true
+	}
+	for _, t := range tys {
+		if t == ty {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *node) pushEvent(ty int32, height int64, round int, addr string, count int, err error) {
+	e := &pt.Pos33SortitionEvent{Ty: ty, Height: height, Round: int32(round), Addr: addr, Count: int32(count)}
+	if err != nil {
+		e.Err = err.Error()
+	}
+	n.events.push(e)
+}
+
+// pushBlockEvent 区块b上链, 下一个高度的难度和上次推送的不同时推送难度变化
+func (n *node) pushBlockEvent(b *types.Block) {
+	if b.Height == 0 {
+		return
+	}
+	m, err := getMiner(b)
+	if err != nil {
+		return
+	}
+	n.pushEvent(pt.Pos33EventBlock, b.Height, int(m.Sort.Proof.Input.Round), b.Txs[0].From(), m.VoterCount(), nil)
+
+	diff, err := n.getDiff(b.Height+1, 0)
+	if err != nil || diff == n.lastDiff {
+		return
+	}
+	n.lastDiff = diff
+	n.events.push(&pt.Pos33SortitionEvent{Ty: pt.Pos33EventDiff, Height: b.Height + 1, Diff: diff})
+}
+
+// Query_GetSortitionEvents 查询seq从FromSeq开始的共识事件, Types不为空时只返回这些类型
+func (client *Client) Query_GetSortitionEvents(req *pt.ReqPos33SortitionEvents) (types.Message, error) {
+	return client.n.events.since(req.FromSeq, int(req.Count), req.Types...), nil
+}
@@ -0,0 +1,71 @@
+package pos33
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func TestEventHubDropOldest(t *testing.T) {
+	h := newEventHub(4)
+	for i := 0; i < 3; i++ {
+		h.push(&pt.Pos33SortitionEvent{Ty: pt.Pos33EventWonSeats, Height: int64(i)})
+	}
+	r := h.since(0, 0)
+	require.Equal(t, 3, len(r.Events))
+	require.Equal(t, int64(3), r.NextSeq)
+	require.Zero(t, r.Dropped)
+
+	// 消费者停在seq 1, 缓冲满了以后覆盖最旧的
+	for i := 3; i < 10; i++ {
+		h.push(&pt.Pos33SortitionEvent{Ty: pt.Pos33EventCommittee, Height: int64(i)})
+	}
+	r = h.since(1, 2)
+	require.Equal(t, int64(5), r.Dropped)
+	require.Equal(t, 2, len(r.Events))
+	require.Equal(t, int64(6), r.Events[0].Seq)
+	require.Equal(t, int64(6), r.Events[0].Height)
+	require.Equal(t, int64(8), r.NextSeq)
+
+	r = h.since(r.NextSeq, 0)
+	require.Equal(t, 2, len(r.Events))
+	require.Equal(t, int64(10), r.NextSeq)
+
+	r = h.since(r.NextSeq, 0)
+	require.Empty(t, r.Events)
+	require.Equal(t, int64(10), r.NextSeq)
+}
+
+func TestCommitteeEvent(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	height := int64(100)
+	n.getCommittee(height, 1).setCommittee(height, 1)
+
+	es := n.events.since(0, 0).Events
+	require.Equal(t, 1, len(es))
+	require.Equal(t, int32(pt.Pos33EventCommittee), es[0].Ty)
+	require.Equal(t, height, es[0].Height)
+	require.Equal(t, int32(1), es[0].Round)
+}
+
+func TestEventHubFilter(t *testing.T) {
+	h := newEventHub(8)
+	tys := []int32{pt.Pos33EventWonSeats, pt.Pos33EventRound, pt.Pos33EventBlock, pt.Pos33EventRound, pt.Pos33EventDiff}
+	for i, ty := range tys {
+		h.push(&pt.Pos33SortitionEvent{Ty: ty, Height: int64(i)})
+	}
+	r := h.since(0, 0, pt.Pos33EventRound, pt.Pos33EventDiff)
+	require.Equal(t, 3, len(r.Events))
+	require.Equal(t, int64(1), r.Events[0].Seq)
+	require.Equal(t, int64(4), r.Events[2].Seq)
+	require.Equal(t, int64(5), r.NextSeq)
+
+	// 只有匹配的事件算在max里, NextSeq跳过过滤掉的
+	r = h.since(0, 1, pt.Pos33EventRound)
+	require.Equal(t, 1, len(r.Events))
+	require.Equal(t, int64(2), r.NextSeq)
+	r = h.since(r.NextSeq, 1, pt.Pos33EventWonSeats)
+	require.Empty(t, r.Events)
+	require.Equal(t, int64(5), r.NextSeq)
+}
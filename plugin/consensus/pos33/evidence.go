@@ -0,0 +1,116 @@
+package pos33
+
+import (
+	"sort"
+	"sync"
+
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// evidencePoolSize 证据池最多保存的证据数
+const evidencePoolSize = 256
+
+// slashWindow 作恶发生后这么多个区块内可以处理证据, 这段时间的委员会记录不能清理
+const slashWindow = pt.Pos33SlashWindow
+
+// maxBlockEvidences 一个区块最多打包的证据数
+const maxBlockEvidences = 8
+
+// evidencePool 按hash去重的证据池.
+// 满了以后只保留按pt.Evidences排序最前面的, 收到相同证据的节点池里的内容相同
+type evidencePool struct {
+	mu  sync.Mutex
+	mp  map[string]*pt.Pos33Evidence
+	max int
+}
+
+func newEvidencePool(max int) *evidencePool {
+	if max <= 0 {
+		max = evidencePoolSize
+	}
+	return &evidencePool{mp: make(map[string]*pt.Pos33Evidence), max: max}
+}
+
+func (p *evidencePool) sorted() []*pt.Pos33Evidence {
+	es := make([]*pt.Pos33Evidence, 0, len(p.mp))
+	for _, e := range p.mp {
+		es = append(es, e)
+	}
+	sort.Sort(pt.Evidences(es))
+	return es
+}
+
+// add 加入证据, 重复的或者池满了排在最后的返回false
+func (p *evidencePool) add(e *pt.Pos33Evidence) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.mp[string(e.Hash)]; ok {
+		return false
+	}
+	if len(p.mp) >= p.max {
+		es := p.sorted()
+		last := es[len(es)-1]
+		if !pt.Evidences([]*pt.Pos33Evidence{e, last}).Less(0, 1) {
+			return false
+		}
+		delete(p.mp, string(last.Hash))
+	}
+	p.mp[string(e.Hash)] = e
+	return true
+}
+
+// next 按顺序返回最前面的最多n个证据, 打包到区块.
+// 不删除, 区块上链以后才通过remove删除, 没有上链的区块打包的证据不会丢
+func (p *evidencePool) next(n int) []*pt.Pos33Evidence {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	es := p.sorted()
+	if len(es) > n {
+		es = es[:n]
+	}
+	return es
+}
+
+// remove 删除已经被区块打包的证据
+func (p *evidencePool) remove(es []*pt.Pos33Evidence) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, e := range es {
+		delete(p.mp, string(e.Hash))
+	}
+}
+
+func (p *evidencePool) size() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.mp)
+}
+
+// checkEquivocation 检查投票m的抽签是否已经在同一轮给别的区块投过票, 是就加入证据池
+func (n *node) checkEquivocation(comm *committee, m *pt.Pos33VoteMsg) {
+	for h, vs := range comm.bvmp {
+		if h == string(m.Hash) {
+			continue
+		}
+		for _, v := range vs {
+			if string(v.Sort.SortHash.Hash) != string(m.Sort.SortHash.Hash) || v.Round != m.Round {
+				continue
+			}
+			height := m.Sort.Proof.Input.Height
+			offender, err := n.sortOwner(height, m.Sort.Proof.Pubkey)
+			if err != nil {
+				plog.Error("evidence offender error", "err", err, "height", height)
+				return
+			}
+			e, err := pt.NewPos33Evidence(offender, v, m, comm.sortMap())
+			if err != nil {
+				plog.Error("new evidence error", "err", err, "height", height)
+				return
+			}
+			if n.evpool.add(e) {
+				plog.Info("equivocation evidence", "height", height, "round", m.Round, "offender", e.Offender)
+			}
+			return
+		}
+	}
+}
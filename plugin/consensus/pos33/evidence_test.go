@@ -0,0 +1,166 @@
+package pos33
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+	"github.com/yccproject/ycc/plugin/dapp/pos33/verifier"
+)
+
+// newTestEvidence 高度height的抽签seat给两个区块投票
+func newTestEvidence(t *testing.T, height int64, seat string) *pt.Pos33Evidence {
+	sort := &pt.Pos33SortMsg{
+		SortHash: &pt.SortHash{Hash: hash2([]byte(seat))},
+		Proof:    &pt.HashProof{Input: &pt.VrfInput{Height: height}},
+	}
+	a := &pt.Pos33VoteMsg{Sort: sort, Hash: hash2([]byte("block a"))}
+	b := &pt.Pos33VoteMsg{Sort: sort, Hash: hash2([]byte("block b"))}
+	e, err := pt.NewPos33Evidence("offender-"+seat[:1], b, a, nil)
+	require.Nil(t, err)
+	return e
+}
+
+func TestEvidenceOrder(t *testing.T) {
+	var es []*pt.Pos33Evidence
+	for h := int64(3); h > 0; h-- {
+		for _, seat := range []string{"b1", "a1", "a2", "c1"} {
+			es = append(es, newTestEvidence(t, h, fmt.Sprintf("%s-%d", seat, h)))
+		}
+	}
+	// 投票的顺序不影响证据
+	e := newTestEvidence(t, 1, "a1-1")
+	require.Equal(t, e.Hash, es[len(es)-3].Hash)
+	require.True(t, string(e.A.Hash) < string(e.B.Hash))
+
+	// 两个节点以不同的顺序收到证据, 还有重复的
+	p1 := newEvidencePool(12)
+	p2 := newEvidencePool(12)
+	for _, e := range es {
+		require.True(t, p1.add(e))
+	}
+	r := rand.New(rand.NewSource(1))
+	for _, i := range r.Perm(len(es)) {
+		require.True(t, p2.add(es[i]))
+		require.False(t, p2.add(es[i]))
+	}
+	for _, e := range es[:3] {
+		require.False(t, p1.add(e))
+	}
+
+	e1 := p1.next(maxBlockEvidences)
+	e2 := p2.next(maxBlockEvidences)
+	require.Equal(t, maxBlockEvidences, len(e1))
+	for i := range e1 {
+		require.Equal(t, e1[i].Hash, e2[i].Hash)
+		if i > 0 {
+			require.True(t, pt.Evidences(e1).Less(i-1, i))
+		}
+	}
+	require.Equal(t, int64(1), e1[0].Height)
+	require.Equal(t, "offender-a", e1[0].Offender)
+
+	// 打包后删除
+	p1.remove(e1)
+	require.Equal(t, len(es)-maxBlockEvidences, p1.size())
+	require.Equal(t, int64(3), p1.next(1)[0].Height)
+}
+
+func TestEvidencePoolBounded(t *testing.T) {
+	var es []*pt.Pos33Evidence
+	for h := int64(1); h <= 6; h++ {
+		es = append(es, newTestEvidence(t, h, fmt.Sprintf("s-%d", h)))
+	}
+	// 池满了只保留最前面的3个, 和收到的顺序无关
+	p1 := newEvidencePool(3)
+	p2 := newEvidencePool(3)
+	for i := range es {
+		p1.add(es[i])
+		p2.add(es[len(es)-1-i])
+	}
+	e1 := p1.next(10)
+	e2 := p2.next(10)
+	require.Equal(t, 3, len(e1))
+	for i := range e1 {
+		require.Equal(t, int64(i+1), e1[i].Height)
+		require.Equal(t, e1[i].Hash, e2[i].Hash)
+	}
+}
+
+func TestCheckEquivocation(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	height := int64(100)
+	comm := n.getCommittee(height, 0)
+
+	priv := newTestPriv(t)
+	proof := &pt.HashProof{Input: &pt.VrfInput{Height: height}, Pubkey: priv.PubKey().Bytes()}
+	sort := &pt.Pos33SortMsg{SortHash: &pt.SortHash{Hash: hash2([]byte("seat"))}, Proof: proof}
+	vs := []*pt.Pos33VoteMsg{{Sort: sort, Hash: hash2([]byte("block hash"))}}
+	comm.bvmp[string(vs[0].Hash)] = vs
+
+	// 同一个抽签给另一个区块投票
+	v := &pt.Pos33VoteMsg{Sort: sort, Hash: hash2([]byte("other block"))}
+	n.checkEquivocation(comm, v)
+	require.Equal(t, 1, n.evpool.size())
+	e := n.evpool.next(1)[0]
+	require.Equal(t, n.sortAddr(height, proof.Pubkey), e.Offender)
+	require.Equal(t, height, e.Height)
+
+	// 其他抽签的投票不是证据
+	other := &pt.Pos33VoteMsg{Sort: &pt.Pos33SortMsg{SortHash: &pt.SortHash{Hash: hash2([]byte("new seat"))}, Proof: proof}, Hash: v.Hash}
+	n.checkEquivocation(comm, other)
+	require.Equal(t, 1, n.evpool.size())
+}
+
+// newTestSlashEvidence voter在height高度给两个出块抽签投票, 证据可以上链处罚
+func newTestSlashEvidence(t *testing.T, n *node, height int64, voter crypto.PrivKey) *pt.Pos33Evidence {
+	makers := make(map[string]*pt.Pos33SortMsg)
+	var vs []*pt.Pos33VoteMsg
+	sort := &pt.Pos33SortMsg{SortHash: &pt.SortHash{Hash: hash2([]byte("seat"))}, Proof: &pt.HashProof{Input: &pt.VrfInput{Height: height}}}
+	for i := 0; i < 2; i++ {
+		proof := n.makeProof(&pt.VrfInput{Seed: []byte("seed"), Height: height}, newTestPriv(t))
+		m := &pt.Pos33SortMsg{SortHash: &pt.SortHash{Hash: verifier.SortHash(proof.VrfHash, 0, 0)}, Proof: proof}
+		makers[string(m.SortHash.Hash)] = m
+		v := &pt.Pos33VoteMsg{Sort: sort, Hash: m.SortHash.Hash}
+		v.Sign(voter)
+		vs = append(vs, v)
+	}
+	e, err := pt.NewPos33Evidence("offender", vs[0], vs[1], makers)
+	require.Nil(t, err)
+	require.Nil(t, e.Check(n.GetAPI().GetConfig()))
+	return e
+}
+
+func TestReportSlash(t *testing.T) {
+	n, api := newTestNode(t, &subConfig{SlashReport: true})
+	n.setTestMiner(newTestPriv(t))
+	n.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "ForkSlash", 0)
+	api.On("SendTx", mock.Anything).Return(&types.Reply{IsOk: true}, nil)
+
+	height := int64(100)
+	e := newTestSlashEvidence(t, n, height, newTestPriv(t))
+	n.evpool.add(e)
+	// 没有出块抽签的证据不能处罚
+	n.evpool.add(newTestEvidence(t, height, "other"))
+
+	n.reportSlash(height)
+	api.AssertNumberOfCalls(t, "SendTx", 1)
+	tx := api.Calls[len(api.Calls)-1].Arguments[0].(*types.Transaction)
+	require.Equal(t, n.myAddr, tx.From())
+	var act pt.Pos33TicketAction
+	require.Nil(t, types.Decode(tx.Payload, &act))
+	require.Equal(t, int32(pt.Pos33ActionSlash), act.Ty)
+	require.Equal(t, 1, len(act.GetSlash().Evidences))
+	require.Equal(t, e.Hash, act.GetSlash().Evidences[0].Hash)
+
+	// 发送过的不再发送, 超过处罚窗口的也不发送
+	n.reportSlash(height + 1)
+	n.evpool.add(newTestSlashEvidence(t, n, height-slashWindow, newTestPriv(t)))
+	n.reportSlash(height + 1)
+	api.AssertNumberOfCalls(t, "SendTx", 1)
+}
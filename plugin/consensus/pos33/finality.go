@@ -0,0 +1,248 @@
+package pos33
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/types"
+	"github.com/yccproject/ycc/plugin/consensus/pos33/signer"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+var (
+	errTicketCommits = errors.New("ticket commits NOT match")
+	errBlockSig      = errors.New("block signature error")
+)
+
+// ticketCommitment 返回height高度抽签使用的票数承诺和快照高度的验证者集合.
+// 每一轮的难度记录到不再放宽为止
+func (client *Client) ticketCommitment(height int64, seed []byte) (*pt.Pos33TicketCommitment, *pt.Pos33ValidatorSet, error) {
+	vs, err := client.validatorSet(height)
+	if err != nil {
+		return nil, nil, err
+	}
+	var diffs []float64
+	for r := 0; r < maxCommitteeRounds; r++ {
+		d, err := client.n.getDiff(height, r)
+		if err != nil {
+			return nil, nil, err
+		}
+		if r > 0 && d == diffs[r-1] {
+			break
+		}
+		diffs = append(diffs, d)
+	}
+	return pt.NewPos33TicketCommitment(vs, seed, diffs, int32(client.n.subCommittees(height))), vs, nil
+}
+
+// ticketCommits ForkLightProof以后height高度的miner交易里的票数承诺hash:
+// 快照高度是height-1的那些高度, 这时快照高度的票数, seed和难度都已经确定
+func (client *Client) ticketCommits(height int64) ([][]byte, error) {
+	sh := height - 1
+	hs := client.sortHeights(sh)
+	if len(hs) == 0 {
+		return nil, nil
+	}
+	seed, err := client.n.getSortSeed(sh)
+	if err != nil {
+		return nil, err
+	}
+	var cs [][]byte
+	for _, h := range hs {
+		c, _, err := client.ticketCommitment(h, seed)
+		if err != nil {
+			return nil, err
+		}
+		cs = append(cs, c.Hash)
+	}
+	return cs, nil
+}
+
+// checkTicketCommits 检查miner交易里的票数承诺和本地计算的相同, 分叉以前必须为空
+func (n *node) checkTicketCommits(height int64, m *pt.Pos33MinerMsg) error {
+	if !n.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkLightProof") {
+		if len(m.TicketCommits) > 0 {
+			return fmt.Errorf("%w: ticket commits before fork, height %d", errTicketCommits, height)
+		}
+		return nil
+	}
+	cs, err := n.ticketCommits(height)
+	if err != nil {
+		return err
+	}
+	if len(cs) != len(m.TicketCommits) {
+		return fmt.Errorf("%w: %d != %d, height %d", errTicketCommits, len(m.TicketCommits), len(cs), height)
+	}
+	for i, c := range cs {
+		if !bytes.Equal(c, m.TicketCommits[i]) {
+			return fmt.Errorf("%w: index %d, height %d", errTicketCommits, i, height)
+		}
+	}
+	return nil
+}
+
+// signBlock ForkLightProof以后出块人用出块的私钥签名区块hash. 区块hash包括执行以后的状态hash,
+// 所以先预执行区块. 投票签的是出块人的抽签hash, 这个签名把区块头和出块人绑定.
+// chain33收到区块时用types.VerifySignature检查Block.Signature, 签的必须是区块hash本身, 不能加Domain前缀
+func (n *node) signBlock(b *types.Block, priv crypto.PrivKey) (*types.Block, error) {
+	nb := n.PreExecBlock(b, false)
+	if nb == nil {
+		return nil, fmt.Errorf("pre exec block error, height %d", b.Height)
+	}
+	sig := signMsg(priv, signer.KindBlock, nb.Hash(n.GetAPI().GetConfig()))
+	if sig.IsZero() {
+		return nil, fmt.Errorf("%w: sign failed, height %d", errBlockSig, b.Height)
+	}
+	nb.Signature = &types.Signature{
+		Ty:        types.EncodeSignID(types.SECP256K1, ethID),
+		Pubkey:    priv.PubKey().Bytes(),
+		Signature: sig.Bytes(),
+	}
+	return nb, nil
+}
+
+// checkBlockSig ForkLightProof以后区块必须由miner交易的出块人签名
+func (n *node) checkBlockSig(b *types.Block, m *pt.Pos33MinerMsg) error {
+	cfg := n.GetAPI().GetConfig()
+	if !cfg.IsDappFork(b.Height, pt.Pos33TicketX, "ForkLightProof") {
+		return nil
+	}
+	if b.Signature == nil || !bytes.Equal(b.Signature.Pubkey, m.Sort.Proof.Pubkey) {
+		return fmt.Errorf("%w: NOT signed by the maker, height %d", errBlockSig, b.Height)
+	}
+	if !checkMsgSign(signer.KindBlock, b.Hash(cfg), b.Signature, b.Height) {
+		return fmt.Errorf("%w: signature verify failed, height %d", errBlockSig, b.Height)
+	}
+	return nil
+}
+
+// txBranch 区块第一个交易到交易根的merkle路径, 和merkle.CalcMerkleRoot的计算一致.
+// ForkRootHash以后叶子是FullHash, 有平行链交易时主链交易根还要经过child这一层
+func txBranch(cfg *types.Chain33Config, b *types.Block) (branch, child [][]byte, full bool) {
+	if !cfg.IsFork(b.Height, "ForkRootHash") {
+		hs := make([][]byte, len(b.Txs))
+		for i, tx := range b.Txs {
+			hs[i] = tx.Hash()
+		}
+		return merkle.GetMerkleBranch(hs, 0), nil, false
+	}
+	_, chains := merkle.CalcMultiLayerMerkleInfo(cfg, b.Height, b.Txs)
+	txs := b.Txs
+	if len(chains) > 1 {
+		txs = txs[:chains[0].TxCount]
+		cs := make([][]byte, len(chains))
+		for i, c := range chains {
+			cs[i] = c.ChildHash
+		}
+		child = merkle.GetMerkleBranch(cs, 0)
+	}
+	hs := make([][]byte, len(txs))
+	for i, tx := range txs {
+		hs[i] = tx.FullHash()
+	}
+	return merkle.GetMerkleBranch(hs, 0), child, true
+}
+
+// finalityProof 生成height高度区块的最终性证明. 票数快照和委员会记录只保留最近的高度,
+// 只能生成最近的区块的证明. 快照高度的下一个区块在ForkLightProof以后才有票数承诺
+func (client *Client) finalityProof(height int64, owner blsOwnerFunc) (*pt.Pos33FinalityProof, error) {
+	if height <= pt.Pos33SortBlocks {
+		return nil, fmt.Errorf("block %d is NOT sorted, no finality proof", height)
+	}
+	ah := client.sortHeight(height) + 1
+	if !client.GetAPI().GetConfig().IsDappFork(ah, pt.Pos33TicketX, "ForkLightProof") {
+		return nil, fmt.Errorf("block %d is before ForkLightProof, no finality proof", height)
+	}
+	b, err := client.RequestBlock(height)
+	if err != nil {
+		return nil, err
+	}
+	ab, err := client.RequestBlock(ah)
+	if err != nil {
+		return nil, err
+	}
+	seed, err := client.n.calcSeed(height)
+	if err != nil {
+		return nil, err
+	}
+	return client.blockFinalityProof(b, ab, seed, owner)
+}
+
+// minerTxProof 区块b的区块头和交易根下的miner交易
+func minerTxProof(cfg *types.Chain33Config, b *types.Block) *pt.Pos33MinerTxProof {
+	p := &pt.Pos33MinerTxProof{Header: b.GetHeader(cfg), MinerTx: b.Txs[0]}
+	p.Header.Signature = b.Signature
+	p.TxBranch, p.ChildBranch, p.FullHash = txBranch(cfg, b)
+	return p
+}
+
+// blockFinalityProof 生成区块b的最终性证明, anchor是快照高度的下一个区块, seed是这个高度抽签的seed
+func (client *Client) blockFinalityProof(b, anchor *types.Block, seed []byte, owner blsOwnerFunc) (*pt.Pos33FinalityProof, error) {
+	height := b.Height
+	m, err := client.blockMiner(b)
+	if err != nil {
+		return nil, err
+	}
+	if m.Sort.GetProof().GetInput() == nil || m.Sort.SortHash == nil {
+		return nil, fmt.Errorf("block miner sort is nil, height %d", height)
+	}
+	round := m.Sort.Proof.Input.Round
+	var comm []*pt.Pos33SortMsg
+	for _, r := range client.n.cstore.rounds(height) {
+		if r.Round == round {
+			comm = r.Comm
+		}
+	}
+	if comm == nil {
+		return nil, fmt.Errorf("committee NOT found, height %d, round %d", height, round)
+	}
+	tc, vs, err := client.ticketCommitment(height, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := client.GetAPI().GetConfig()
+	mp := minerTxProof(cfg, b)
+	p := &pt.Pos33FinalityProof{
+		Header:      mp.Header,
+		MinerTx:     mp.MinerTx,
+		TxBranch:    mp.TxBranch,
+		ChildBranch: mp.ChildBranch,
+		FullHash:    mp.FullHash,
+		Tickets:     tc,
+		Committee:   comm,
+		Anchor:      minerTxProof(cfg, anchor),
+	}
+	added := make(map[string]bool)
+	for _, s := range append([]*pt.Pos33SortMsg{m.Sort}, comm...) {
+		addr, err := client.n.sortOwner(height, s.Proof.Pubkey)
+		if err != nil {
+			return nil, err
+		}
+		if added[addr] {
+			continue
+		}
+		added[addr] = true
+		br, err := vs.TicketBranch(addr)
+		if err != nil {
+			return nil, err
+		}
+		p.Counts = append(p.Counts, br)
+	}
+	for _, pk := range m.BlsPkList {
+		addr, err := owner(pk)
+		if err != nil {
+			return nil, fmt.Errorf("bls owner NOT found, height %d: %v", height, err)
+		}
+		p.Voters = append(p.Voters, &pt.Pos33FinalityVoter{BlsPk: pk, Addr: addr})
+	}
+	return p, nil
+}
+
+// Query_GetFinalityProof 查询height高度区块的最终性证明, 用于轻客户端
+func (client *Client) Query_GetFinalityProof(req *types.ReqInt) (types.Message, error) {
+	return client.finalityProof(req.Height, client.newBlsOwner())
+}
@@ -0,0 +1,253 @@
+package pos33
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+	"github.com/yccproject/ycc/plugin/consensus/pos33/signer"
+	"github.com/yccproject/ycc/plugin/dapp/pos33/light"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func TestFinalityProof(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	n.setTestMiner(newTestPriv(t))
+	var keys []string
+	for i := 0; i < pt.Pos33MustVotes; i++ {
+		keys = append(keys, common.ToHex(newTestPriv(t).Bytes()))
+	}
+	mks, err := loadMinerKeys(keys)
+	require.Nil(t, err)
+	n.extraKeys = mks
+
+	height := int64(100)
+	seed := []byte("finality seed")
+	sh := n.sortHeight(height)
+	// 总票数和委员会大小相同, diff为1, 每张票都中签
+	n.setTestCount(n.myAddr, sh, 2, pt.Pos33CommitteeSize)
+	for _, k := range mks {
+		n.setTestCount(k.addr, sh, 1, pt.Pos33CommitteeSize)
+	}
+	n.setTestCount("no sorts", sh, 3, pt.Pos33CommitteeSize)
+	ss := n.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.Equal(t, pt.Pos33MustVotes+2, len(ss))
+	n.cstore.add(height, 0, ss)
+
+	// 每个额外的地址用自己的bls公钥投一票
+	maker := getMySorts(n.myAddr, ss)[0]
+	owners := make(map[string]string)
+	var vs []*pt.Pos33VoteMsg
+	for _, k := range mks {
+		v := &pt.Pos33VoteMsg{Hash: maker.SortHash.Hash, Sort: getMySorts(k.addr, ss)[0]}
+		v.Sign(k.priv)
+		vs = append(vs, v)
+		owners[string(v.Sig.Pubkey)] = k.addr
+	}
+	owner := func(pk []byte) (string, error) {
+		addr, ok := owners[string(pk)]
+		if !ok {
+			return "", errors.New("not found")
+		}
+		return addr, nil
+	}
+	tx, err := n.minerTx(height, 0, maker, vs, n.priv)
+	require.Nil(t, err)
+	cfg := n.GetAPI().GetConfig()
+	other := &types.Transaction{Execer: []byte("coins"), Payload: []byte("other"), Nonce: 1}
+	b := &types.Block{Height: height, BlockTime: 1, Txs: []*types.Transaction{tx, other}}
+	b.TxHash = merkle.CalcMerkleRoot(cfg, height, b.Txs)
+	signTestBlock(t, cfg, b, n.priv)
+
+	// anchor是快照高度的下一个区块, miner交易里有这个高度的票数承诺
+	tc, _, err := n.ticketCommitment(height, seed)
+	require.Nil(t, err)
+	anchor := newTestAnchor(t, n, maker, sh+1, [][]byte{tc.Hash})
+
+	p, err := n.blockFinalityProof(b, anchor, seed, owner)
+	require.Nil(t, err)
+	require.Equal(t, cfg.IsFork(height, "ForkRootHash"), p.FullHash)
+	require.Equal(t, b.TxHash, light.TxRoot(minerTxProof(cfg, b)))
+	require.Equal(t, []float64{1}, p.Tickets.Diffs)
+	require.Equal(t, pt.Pos33MustVotes+1, len(p.Counts))
+
+	v := &light.Verifier{BlockHashFork: cfg.GetFork("ForkBlockHash"), BlsOwner: owner}
+	trusted := anchor.Hash(cfg)
+	hash, err := v.Verify(p, trusted)
+	require.Nil(t, err)
+	require.Equal(t, b.Hash(cfg), hash)
+
+	// 编码以后再验证, 和RPC返回的一样
+	p2 := new(pt.Pos33FinalityProof)
+	require.Nil(t, types.Decode(types.Encode(p), p2))
+	_, err = v.Verify(p2, trusted)
+	require.Nil(t, err)
+
+	// 不信任的anchor, 包括证明里自带的票数承诺hash
+	_, err = v.Verify(p, []byte("untrusted"))
+	require.True(t, errors.Is(err, pt.ErrFinalityProof))
+	_, err = v.Verify(p, p.Tickets.Hash)
+	require.True(t, errors.Is(err, pt.ErrFinalityProof))
+
+	// anchor里没有这个票数承诺
+	other2 := newTestAnchor(t, n, maker, sh+1, [][]byte{[]byte("other commitment")})
+	p2.Anchor = minerTxProof(cfg, other2)
+	_, err = v.Verify(p2, other2.Hash(cfg))
+	require.True(t, errors.Is(err, pt.ErrFinalityProof))
+
+	// anchor不是快照高度的下一个区块
+	other3 := newTestAnchor(t, n, maker, sh+2, [][]byte{tc.Hash})
+	p2.Anchor = minerTxProof(cfg, other3)
+	_, err = v.Verify(p2, other3.Hash(cfg))
+	require.True(t, errors.Is(err, pt.ErrFinalityProof))
+
+	// 投票不够
+	v.Quorum = pt.Pos33MustVotes + 1
+	_, err = v.Verify(p, trusted)
+	require.True(t, errors.Is(err, pt.ErrFinalityProof))
+	v.Quorum = 0
+
+	// 票数被改过, 不在承诺的根下
+	require.Nil(t, types.Decode(types.Encode(p), p2))
+	p2.Counts[0].Leaf.Count += 10
+	_, err = v.Verify(p2, trusted)
+	require.True(t, errors.Is(err, pt.ErrFinalityProof))
+
+	// 区块头被改过
+	require.Nil(t, types.Decode(types.Encode(p), p2))
+	p2.Header.BlockTime++
+	_, err = v.Verify(p2, trusted)
+	require.True(t, errors.Is(err, pt.ErrFinalityProof))
+
+	// 区块头没有签名或者不是出块人签名
+	require.Nil(t, types.Decode(types.Encode(p), p2))
+	p2.Header.Signature = nil
+	_, err = v.Verify(p2, trusted)
+	require.True(t, errors.Is(err, pt.ErrFinalityProof))
+	nb := types.Clone(b).(*types.Block)
+	signTestBlock(t, cfg, nb, newTestPriv(t))
+	p2.Header.Signature = nb.Signature
+	_, err = v.Verify(p2, trusted)
+	require.True(t, errors.Is(err, pt.ErrFinalityProof))
+
+	// bls公钥绑定的地址没有座位, 投票不算
+	v.BlsOwner = func(pk []byte) (string, error) { return "no sorts", nil }
+	_, err = v.Verify(p, trusted)
+	require.True(t, errors.Is(err, pt.ErrFinalityProof))
+
+	// 必须设置bls公钥的绑定
+	v.BlsOwner = nil
+	_, err = v.Verify(p, trusted)
+	require.True(t, errors.Is(err, pt.ErrFinalityProof))
+
+	// 委员会记录没有出块的轮次
+	n.cstore = newCommitteeStore("", 0)
+	_, err = n.blockFinalityProof(b, anchor, seed, owner)
+	require.NotNil(t, err)
+}
+
+// signTestBlock 用priv签名区块hash, 和signBlock相同但不预执行
+func signTestBlock(t *testing.T, cfg *types.Chain33Config, b *types.Block, priv crypto.PrivKey) {
+	sig := signMsg(priv, signer.KindBlock, b.Hash(cfg))
+	require.False(t, sig.IsZero())
+	b.Signature = &types.Signature{
+		Ty:        types.EncodeSignID(types.SECP256K1, ethID),
+		Pubkey:    priv.PubKey().Bytes(),
+		Signature: sig.Bytes(),
+	}
+}
+
+// newTestAnchor height高度由本节点出块的区块, miner交易里有票数承诺commits
+func newTestAnchor(t *testing.T, n *node, s *pt.Pos33SortMsg, height int64, commits [][]byte) *types.Block {
+	m := &pt.Pos33MinerMsg{Sort: s, Hash: s.SortHash.Hash, TicketCommits: commits}
+	act := &pt.Pos33TicketAction{Value: &pt.Pos33TicketAction_Miner{Miner: m}, Ty: pt.Pos33TicketActionMiner}
+	cfg := n.GetAPI().GetConfig()
+	tx, err := types.CreateFormatTx(cfg, pt.Pos33TicketX, types.Encode(act))
+	require.Nil(t, err)
+	tx.Sign(types.EncodeSignID(types.SECP256K1, ethID), n.priv)
+	b := &types.Block{Height: height, BlockTime: 1, Txs: []*types.Transaction{tx}}
+	b.TxHash = merkle.CalcMerkleRoot(cfg, height, b.Txs)
+	signTestBlock(t, cfg, b, n.priv)
+	return b
+}
+
+func TestTxBranch(t *testing.T) {
+	cfg := types.NewChain33Config(testCfgString() + "\n[fork.system]\nForkRootHash=10\n")
+	para := []byte(types.ParaKeyX + "test.coins")
+	var txs []*types.Transaction
+	for i := 0; i < 5; i++ {
+		execer := []byte("coins")
+		if i >= 3 {
+			execer = para
+		}
+		txs = append(txs, &types.Transaction{Execer: execer, Nonce: int64(i)})
+	}
+	for _, c := range []struct {
+		height int64
+		txs    int
+		full   bool
+		child  bool
+	}{
+		{9, 5, false, false},
+		{10, 1, true, false},
+		{10, 3, true, false},
+		{10, 5, true, true},
+	} {
+		b := &types.Block{Height: c.height, Txs: txs[:c.txs]}
+		b.TxHash = merkle.CalcMerkleRoot(cfg, b.Height, b.Txs)
+		p := &pt.Pos33MinerTxProof{MinerTx: b.Txs[0]}
+		p.TxBranch, p.ChildBranch, p.FullHash = txBranch(cfg, b)
+		require.Equal(t, c.full, p.FullHash, c)
+		require.Equal(t, c.child, len(p.ChildBranch) > 0, c)
+		require.Equal(t, b.TxHash, light.TxRoot(p), c)
+	}
+}
+
+func TestCheckLightProof(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	n.setTestMiner(newTestPriv(t))
+	cfg := n.GetAPI().GetConfig()
+
+	// 快照高度height-1小于Pos33SortBlocks, seed是零hash
+	height := int64(5)
+	hs := n.sortHeights(height - 1)
+	require.NotEmpty(t, hs)
+	n.setTestCount(n.myAddr, height-1, 10, pt.Pos33CommitteeSize)
+	ss := n.committeeSort(context.Background(), []byte("light seed"), hs[0], 0, Committee)
+	require.NotEmpty(t, ss)
+	maker := ss[0]
+	cs, err := n.ticketCommits(height)
+	require.Nil(t, err)
+	require.NotEmpty(t, cs)
+	b := newTestAnchor(t, n, maker, height, cs)
+	m, err := getMiner(b)
+	require.Nil(t, err)
+
+	// 分叉之前必须没有票数承诺, 不检查区块签名
+	b.Signature = nil
+	require.True(t, errors.Is(n.checkTicketCommits(height, m), errTicketCommits))
+	require.Nil(t, n.checkTicketCommits(height, &pt.Pos33MinerMsg{Sort: maker}))
+	require.Nil(t, n.checkBlockSig(b, m))
+
+	cfg.SetDappFork(pt.Pos33TicketX, "ForkLightProof", 0)
+	require.Nil(t, n.checkTicketCommits(height, m))
+	require.True(t, errors.Is(n.checkTicketCommits(height, &pt.Pos33MinerMsg{Sort: maker}), errTicketCommits))
+	m2 := &pt.Pos33MinerMsg{Sort: maker, TicketCommits: [][]byte{[]byte("fake")}}
+	require.True(t, errors.Is(n.checkTicketCommits(height, m2), errTicketCommits))
+
+	require.True(t, errors.Is(n.checkBlockSig(b, m), errBlockSig))
+	signTestBlock(t, cfg, b, newTestPriv(t))
+	require.True(t, errors.Is(n.checkBlockSig(b, m), errBlockSig))
+	signTestBlock(t, cfg, b, n.priv)
+	require.Nil(t, n.checkBlockSig(b, m))
+	// chain33收到区块时检查的也是这个签名
+	require.True(t, types.VerifySignature(cfg, b, nil))
+	// 签名以后改过区块头
+	b.BlockTime++
+	require.True(t, errors.Is(n.checkBlockSig(b, m), errBlockSig))
+}
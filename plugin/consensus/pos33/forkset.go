@@ -0,0 +1,136 @@
+package pos33
+
+import (
+	"sync"
+
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// forkSetMaxAge 保留最近多少个高度的分叉验证结果
+const forkSetMaxAge = 16
+
+// forkKey 分叉上的seed由父区块所在的链决定, 验证结果按(父区块hash, 高度)区分,
+// 一个分叉上有效的抽签不会被当成另一个分叉上有效
+type forkKey struct {
+	parent string
+	height int64
+}
+
+// forkBranch 一个分叉在一个高度的seed和已经验证过的抽签
+type forkBranch struct {
+	seed []byte
+	mu   sync.Mutex
+	mp   map[string]error // sort hash -> 验证结果
+}
+
+// forkReq 一个分叉上要验证的抽签
+type forkReq struct {
+	parent []byte // 分叉区块的父区块hash
+	height int64
+	seed   []byte // 这个分叉上height高度的seed
+	ty     int
+	msgs   []*pt.Pos33SortMsg
+}
+
+// forkResult 一个分叉的验证结果, valid的数量可以用来比较分叉的权重
+type forkResult struct {
+	parent []byte
+	valid  []*pt.Pos33SortMsg
+	errs   []error // 和msgs一一对应
+}
+
+// forkSet 同一高度有多个分叉时, 每个分叉用自己的seed并行验证抽签
+type forkSet struct {
+	n  *node
+	mu sync.Mutex
+	mp map[forkKey]*forkBranch
+}
+
+func newForkSet(n *node) *forkSet {
+	return &forkSet{n: n, mp: make(map[forkKey]*forkBranch)}
+}
+
+// branch 返回分叉的缓存, 同一个分叉的seed不同时重新开始
+func (fs *forkSet) branch(parent []byte, height int64, seed []byte) *forkBranch {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	k := forkKey{parent: string(parent), height: height}
+	b, ok := fs.mp[k]
+	if !ok || string(b.seed) != string(seed) {
+		b = &forkBranch{seed: seed, mp: make(map[string]error)}
+		fs.mp[k] = b
+	}
+	return b
+}
+
+func (fs *forkSet) verifyBranch(r *forkReq) *forkResult {
+	b := fs.branch(r.parent, r.height, r.seed)
+	res := &forkResult{parent: r.parent, errs: make([]error, len(r.msgs))}
+	// 没有验证过的一起验证
+	var todo []int
+	var ms []*pt.Pos33SortMsg
+	b.mu.Lock()
+	for i, m := range r.msgs {
+		err, ok := b.mp[string(m.GetSortHash().GetHash())]
+		if ok {
+			res.errs[i] = err
+		} else {
+			todo = append(todo, i)
+			ms = append(ms, m)
+		}
+	}
+	b.mu.Unlock()
+	if len(ms) > 0 {
+		errs := fs.n.verifySorts(r.height, r.ty, b.seed, ms)
+		b.mu.Lock()
+		for j, i := range todo {
+			res.errs[i] = errs[j]
+			// 重复的消息只记录第一个的结果
+			k := string(ms[j].GetSortHash().GetHash())
+			if _, ok := b.mp[k]; !ok {
+				b.mp[k] = errs[j]
+			}
+		}
+		b.mu.Unlock()
+	}
+	// 缓存中验证通过的也可能重复
+	rejectRepeated(r.msgs, res.errs)
+	for i, m := range r.msgs {
+		if res.errs[i] == nil {
+			res.valid = append(res.valid, m)
+		}
+	}
+	return res
+}
+
+// verify 每个分叉一个协程并行验证, 结果和reqs一一对应
+func (fs *forkSet) verify(reqs []*forkReq) []*forkResult {
+	rs := make([]*forkResult, len(reqs))
+	var wg sync.WaitGroup
+	for i, r := range reqs {
+		wg.Add(1)
+		go func(i int, r *forkReq) {
+			defer wg.Done()
+			rs[i] = fs.verifyBranch(r)
+		}(i, r)
+	}
+	wg.Wait()
+	return rs
+}
+
+// expire 区块高度增加到height时, 清除太旧的分叉
+func (fs *forkSet) expire(height int64) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	for k := range fs.mp {
+		if k.height < height-forkSetMaxAge {
+			delete(fs.mp, k)
+		}
+	}
+}
+
+func (fs *forkSet) size() int {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return len(fs.mp)
+}
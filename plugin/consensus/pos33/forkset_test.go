@@ -0,0 +1,59 @@
+package pos33
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func TestForkSetVerify(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	n.setTestMiner(newTestPriv(t))
+
+	height := int64(100)
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+	// 两个分叉的父区块不同, seed不同, 各自有自己的委员会
+	pa, pb := hash2([]byte("parent a")), hash2([]byte("parent b"))
+	seedA, seedB := []byte("fork a seed"), []byte("fork b seed")
+	ssA := n.committeeSort(context.Background(), seedA, height, 0, Committee)
+	ssB := n.committeeSort(context.Background(), seedB, height, 0, Committee)
+	require.NotEmpty(t, ssA)
+	require.NotEmpty(t, ssB)
+
+	rs := n.forks.verify([]*forkReq{
+		{parent: pa, height: height, seed: seedA, ty: Committee, msgs: ssA},
+		{parent: pb, height: height, seed: seedB, ty: Committee, msgs: ssB},
+	})
+	require.Len(t, rs, 2)
+	require.Equal(t, pa, rs[0].parent)
+	require.Len(t, rs[0].valid, len(ssA))
+	require.Len(t, rs[1].valid, len(ssB))
+	require.Equal(t, 2, n.forks.size())
+
+	// 一个分叉的抽签在另一个分叉上无效, 已经验证过的结果不会串到另一个分叉
+	rs = n.forks.verify([]*forkReq{
+		{parent: pa, height: height, seed: seedA, ty: Committee, msgs: ssB},
+		{parent: pb, height: height, seed: seedB, ty: Committee, msgs: ssA},
+	})
+	require.Empty(t, rs[0].valid)
+	require.Empty(t, rs[1].valid)
+	for _, r := range rs {
+		for _, err := range r.errs {
+			require.NotNil(t, err)
+		}
+	}
+
+	// 两个分叉的抽签混在一起时, 按分叉的seed各自过滤
+	rs = n.forks.verify([]*forkReq{
+		{parent: pa, height: height, seed: seedA, ty: Committee, msgs: append(append([]*pt.Pos33SortMsg{}, ssA...), ssB...)},
+	})
+	require.Equal(t, ssA, rs[0].valid)
+	require.Len(t, rs[0].errs, len(ssA)+len(ssB))
+
+	n.forks.expire(height + forkSetMaxAge)
+	require.Equal(t, 2, n.forks.size())
+	n.forks.expire(height + forkSetMaxAge + 1)
+	require.Equal(t, 0, n.forks.size())
+}
@@ -111,7 +111,8 @@ func (g *gossip2) fsLoop(fs []string) error {
 				fc.reConnect()
 				continue
 			}
-			g.C <- data
+			// 转发服务器是配置的, 不计分
+			g.C <- &peerMsg{data: data}
 		}
 	}
 	mp := make(map[string]*frc)
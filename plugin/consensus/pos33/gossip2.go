@@ -7,6 +7,7 @@ import (
 	"io"
 	"io/ioutil"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	ccrypto "github.com/33cn/chain33/common/crypto"
@@ -44,17 +45,21 @@ func (m *mdnsNotifee) HandlePeerFound(pi peer.AddrInfo) {
 }
 
 type gossip2 struct {
-	C         chan []byte
+	C         chan *peerMsg
 	h         host.Host
 	tmap      map[string]*pubsub.Topic
 	bootPeers []string
 
 	mu         sync.Mutex
 	streams    map[peer.ID]stream
-	incoming   chan *pt.Pos33Msg
+	incoming   chan *peerMsg
 	outgoing   chan *smsg
 	raddrPid   string
 	peersTopic string
+	// 是否接收节点的消息, func(from string) bool, 没有设置时都接收
+	allow atomic.Value
+	// 转发前检查gossip消息, func(from string, data []byte) bool, 检查失败的不转发
+	validate atomic.Value
 }
 
 func (g *gossip2) bootstrap(addrs ...string) error {
@@ -118,9 +123,9 @@ func newGossip2(priv ccrypto.PrivKey, port int, ns string, fs []string, forwardP
 		h:          h,
 		tmap:       make(map[string]*pubsub.Topic),
 		streams:    make(map[peer.ID]stream),
-		incoming:   make(chan *pt.Pos33Msg, 16),
+		incoming:   make(chan *peerMsg, 16),
 		outgoing:   make(chan *smsg, 16),
-		C:          make(chan []byte, 1024),
+		C:          make(chan *peerMsg, 1024),
 		raddrPid:   ns + "/" + remoteAddrID,
 		peersTopic: ns + "-" + pos33Peerstore,
 	}
@@ -161,9 +166,58 @@ func (g *gossip2) handlePeers(data []byte) {
 	}
 }
 
+// setAllow 设置是否接收节点的消息, 不接收的gossip消息也不转发
+func (g *gossip2) setAllow(f func(from string) bool) {
+	g.allow.Store(f)
+}
+
+// setValidator 设置转发前检查gossip消息的函数, 这样诚实的节点不会转发无效的消息
+func (g *gossip2) setValidator(f func(from string, data []byte) bool) {
+	g.validate.Store(f)
+}
+
+func (g *gossip2) validMsg(pid peer.ID, data []byte) bool {
+	f, _ := g.validate.Load().(func(string, []byte) bool)
+	return f == nil || pid == g.h.ID() || f(pid.String(), data)
+}
+
+func (g *gossip2) allowPeer(pid peer.ID) bool {
+	f, _ := g.allow.Load().(func(string) bool)
+	return f == nil || pid == g.h.ID() || f(pid.String())
+}
+
+// blockPeer 断开和被禁止的节点的连接, 禁止期间重新连接后的消息由allow丢弃
+func (g *gossip2) blockPeer(from string) {
+	if g == nil {
+		return
+	}
+	pid, err := peer.Decode(from)
+	if err != nil {
+		return
+	}
+	g.mu.Lock()
+	delete(g.streams, pid)
+	g.mu.Unlock()
+	g.h.Network().ClosePeer(pid)
+}
+
 func (g *gossip2) run(ps *pubsub.PubSub, topics, fs []string, forwardPeers bool) {
 	for _, t := range topics {
 		t := t
+		if t != g.peersTopic {
+			err := ps.RegisterTopicValidator(t, func(ctx context.Context, pid peer.ID, m *pubsub.Message) pubsub.ValidationResult {
+				if !g.allowPeer(pid) {
+					return pubsub.ValidationIgnore
+				}
+				if !g.validMsg(pid, m.Data) {
+					return pubsub.ValidationReject
+				}
+				return pubsub.ValidationAccept
+			})
+			if err != nil {
+				panic(err)
+			}
+		}
 		tp, err := ps.Join(t)
 		if err != nil {
 			panic(err)
@@ -185,7 +239,7 @@ func (g *gossip2) run(ps *pubsub.PubSub, topics, fs []string, forwardPeers bool)
 				if t == g.peersTopic {
 					go g.handlePeers(m.Data)
 				} else {
-					g.C <- m.Data
+					g.C <- &peerMsg{from: m.ReceivedFrom.String(), data: m.Data, checked: true}
 				}
 			}
 		}(sb)
@@ -258,6 +312,7 @@ func (g *gossip2) sendMsg(pub []byte, msg types.Message) error {
 }
 
 func (g *gossip2) handleIncoming(s network.Stream) {
+	pid := s.Conn().RemotePeer()
 	r := NewDelimitedReader(s, defaultMaxSize)
 	for {
 		m := new(pt.Pos33Msg)
@@ -271,8 +326,11 @@ func (g *gossip2) handleIncoming(s network.Stream) {
 			s.Close()
 			return
 		}
-		plog.Debug("recv from remote peer", "protocolID", s.Protocol(), "remote peer", s.Conn().RemotePeer())
-		g.incoming <- m
+		plog.Debug("recv from remote peer", "protocolID", s.Protocol(), "remote peer", pid)
+		if !g.allowPeer(pid) {
+			continue
+		}
+		g.incoming <- &peerMsg{from: pid.String(), pm: m}
 	}
 }
 
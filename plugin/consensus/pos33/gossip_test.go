@@ -33,8 +33,8 @@ func TestGossip2(t *testing.T) {
 	for i := 0; i < 10; i++ {
 		msg := []byte(fmt.Sprintf("%d ----------------- %d", i, i))
 		g1.gossip("bar", msg)
-		data := <-g2.C
-		fmt.Println(string(data))
+		m := <-g2.C
+		fmt.Println(string(m.data))
 		time.Sleep(time.Millisecond * 100)
 	}
 
@@ -0,0 +1,155 @@
+package pos33
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// maxGrindingRange 一次最多分析多少个区块. 统计需要整个范围, 不能像rewards一样分段
+const maxGrindingRange = 10000
+
+// grindingMinSamples 出块少于这么多次的地址样本太少, score为0
+const grindingMinSamples = 10
+
+// grindingScores 离线分析抽签结果的自相关, 检查是否有地址通过出块操纵seed.
+//
+// 高度h的抽签seed来自h-lag(Pos33SortBlocks)高度的区块, 出块的矿工可以影响这个seed.
+// 对每个地址A, 统计A出的区块在lag个高度以后的区块里:
+//   - A出块的次数, 和A在整个范围出块的比例比较, 得到makerZ
+//   - A的座位数, 和A在整个范围座位的比例比较, 得到seatZ
+//
+// z是二项分布的标准分数 (k - n*p) / sqrt(n*p*(1-p)), score取两者中大的.
+//
+// 统计假设: 各个高度的抽签相互独立, A的票数在范围内不变, 没有操纵时A在任何高度中签的概率都是整个范围的比例.
+// 误报: 范围内票数变化很大(新抵押, 委托)的地址, 比例和真实概率不同, 可能得到高分;
+// 同一个区块的座位不独立, seatZ比实际偏大; 分析很多地址时总会有一些z超过2到3, 应当只关注远大于3的分数,
+// 并且用别的范围重复分析. score只是怀疑的程度, 不能作为惩罚的证据
+func grindingScores(comms []*pt.Pos33BlockCommittee, lag int64) []*pt.Pos33GrindingScore {
+	byHeight := make(map[int64]*pt.Pos33BlockCommittee)
+	makes := make(map[string]int64)
+	seats := make(map[string]int64)
+	allSeats := int64(0)
+	for _, c := range comms {
+		byHeight[c.Height] = c
+		makes[c.Maker]++
+		for _, m := range c.Members {
+			seats[m.Addr] += m.Seats
+		}
+		allSeats += c.Seats
+	}
+
+	// 每个地址出块以后lag个高度的区块
+	type follow struct {
+		produced, wins, seats, total int64
+	}
+	fs := make(map[string]*follow)
+	for _, c := range comms {
+		t, ok := byHeight[c.Height+lag]
+		if !ok {
+			continue
+		}
+		f, ok := fs[c.Maker]
+		if !ok {
+			f = &follow{}
+			fs[c.Maker] = f
+		}
+		f.produced++
+		if t.Maker == c.Maker {
+			f.wins++
+		}
+		for _, m := range t.Members {
+			if m.Addr == c.Maker {
+				f.seats += m.Seats
+			}
+		}
+		f.total += t.Seats
+	}
+
+	var scores []*pt.Pos33GrindingScore
+	for addr, f := range fs {
+		pm := float64(makes[addr]) / float64(len(comms))
+		s := &pt.Pos33GrindingScore{
+			Addr:         addr,
+			Produced:     f.produced,
+			FollowWins:   f.wins,
+			ExpectedWins: float64(f.produced) * pm,
+			MakerZ:       zScore(f.wins, f.produced, pm),
+			FollowSeats:  f.seats,
+		}
+		if allSeats > 0 {
+			ps := float64(seats[addr]) / float64(allSeats)
+			s.ExpectedSeats = float64(f.total) * ps
+			s.SeatZ = zScore(f.seats, f.total, ps)
+		}
+		if f.produced >= grindingMinSamples {
+			s.Score = math.Max(s.MakerZ, s.SeatZ)
+		}
+		scores = append(scores, s)
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Score != scores[j].Score {
+			return scores[i].Score > scores[j].Score
+		}
+		return scores[i].Addr < scores[j].Addr
+	})
+	return scores
+}
+
+// zScore n次概率是p的试验中成功k次的标准分数
+func zScore(k, n int64, p float64) float64 {
+	if n == 0 || p <= 0 || p >= 1 {
+		return 0
+	}
+	mean := float64(n) * p
+	return (float64(k) - mean) / math.Sqrt(mean*(1-p))
+}
+
+// grinding 分析[start, end]高度的抽签自相关, lag为0时使用Pos33SortBlocks
+func (c *Client) grinding(req *pt.ReqPos33Grinding) (*pt.Pos33GrindingReport, error) {
+	if req.Start <= 0 || req.End < req.Start {
+		return nil, fmt.Errorf("grinding error: range [%d, %d] error", req.Start, req.End)
+	}
+	if req.End-req.Start+1 > maxGrindingRange {
+		return nil, fmt.Errorf("grinding error: range [%d, %d] > %d blocks", req.Start, req.End, maxGrindingRange)
+	}
+	lag := req.Lag
+	if lag == 0 {
+		lag = pt.Pos33SortBlocks
+	}
+	if lag < 0 || lag > req.End-req.Start {
+		return nil, fmt.Errorf("grinding error: lag %d error", lag)
+	}
+
+	owner := c.newBlsOwner()
+	var comms []*pt.Pos33BlockCommittee
+	for start := req.Start; start <= req.End; start += rewardsBatch {
+		end := start + rewardsBatch - 1
+		if end > req.End {
+			end = req.End
+		}
+		bs, err := c.GetAPI().GetBlocks(&types.ReqBlocks{Start: start, End: end})
+		if err != nil {
+			return nil, err
+		}
+		if len(bs.Items) != int(end-start+1) {
+			return nil, fmt.Errorf("grinding error: blocks [%d, %d] NOT found", start, end)
+		}
+		for _, d := range bs.Items {
+			comm, err := c.committeeOf(d.Block, owner)
+			if err != nil {
+				return nil, err
+			}
+			comms = append(comms, comm)
+		}
+	}
+	return &pt.Pos33GrindingReport{Start: req.Start, End: req.End, Lag: lag, Scores: grindingScores(comms, lag)}, nil
+}
+
+// Query_GetGrindingScores 分析一段高度内抽签结果的自相关, 返回每个地址的怀疑分数
+func (client *Client) Query_GetGrindingScores(req *pt.ReqPos33Grinding) (types.Message, error) {
+	return client.grinding(req)
+}
@@ -0,0 +1,93 @@
+package pos33
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// newTestCommittees 20个地址票数相同, 随机出块和分配座位.
+// grinder出块以后lag个高度的区块有一半的机会也由它出, 并且得到一半座位
+func newTestCommittees(blocks int, lag int64, grinder string) []*pt.Pos33BlockCommittee {
+	rd := rand.New(rand.NewSource(33))
+	var addrs []string
+	for i := 0; i < 20; i++ {
+		addrs = append(addrs, fmt.Sprintf("addr%02d", i))
+	}
+	var comms []*pt.Pos33BlockCommittee
+	for h := int64(1); h <= int64(blocks); h++ {
+		c := &pt.Pos33BlockCommittee{Height: h, Maker: addrs[rd.Intn(len(addrs))]}
+		mp := make(map[string]int64)
+		grinding := grinder != "" && h > lag && comms[h-lag-1].Maker == grinder
+		if grinding && rd.Intn(2) == 0 {
+			c.Maker = grinder
+			mp[grinder] = pt.Pos33VoterSize / 2
+		}
+		for i := int64(0); i < pt.Pos33VoterSize-mp[grinder]; i++ {
+			mp[addrs[rd.Intn(len(addrs))]]++
+		}
+		for _, addr := range addrs {
+			if mp[addr] > 0 {
+				c.Members = append(c.Members, &pt.Pos33CommitteeMember{Addr: addr, Seats: mp[addr]})
+			}
+		}
+		c.Seats = pt.Pos33VoterSize
+		comms = append(comms, c)
+	}
+	return comms
+}
+
+func TestGrindingScores(t *testing.T) {
+	lag := int64(pt.Pos33SortBlocks)
+
+	// 没有操纵时所有地址的分数都不高
+	scores := grindingScores(newTestCommittees(4000, lag, ""), lag)
+	require.Equal(t, 20, len(scores))
+	for _, s := range scores {
+		require.True(t, s.Score < 4, "%s %f", s.Addr, s.Score)
+	}
+
+	// addr07出块以后总是赢得lag以后的区块
+	scores = grindingScores(newTestCommittees(4000, lag, "addr07"), lag)
+	g := scores[0]
+	require.Equal(t, "addr07", g.Addr)
+	require.True(t, g.Score > 10, "%f", g.Score)
+	require.True(t, g.MakerZ > 10)
+	require.True(t, g.SeatZ > 10)
+	require.True(t, g.FollowWins > 2*int64(g.ExpectedWins), "%d %f", g.FollowWins, g.ExpectedWins)
+	for _, s := range scores[1:] {
+		require.True(t, s.Score < 4, "%s %f", s.Addr, s.Score)
+	}
+
+	// 使用别的lag时看不出操纵
+	scores = grindingScores(newTestCommittees(4000, lag, "addr07"), lag+3)
+	for _, s := range scores {
+		if s.Addr == "addr07" {
+			require.True(t, s.MakerZ < 10, "%f", s.MakerZ)
+		}
+	}
+
+	// 出块太少的地址score为0
+	scores = grindingScores(newTestCommittees(50, lag, ""), lag)
+	for _, s := range scores {
+		require.True(t, s.Produced < grindingMinSamples)
+		require.Equal(t, float64(0), s.Score)
+	}
+}
+
+func TestGrindingRange(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	for _, req := range []*pt.ReqPos33Grinding{
+		{Start: 0, End: 100},
+		{Start: 100, End: 99},
+		{Start: 1, End: maxGrindingRange + 1},
+		{Start: 1, End: 100, Lag: -1},
+		{Start: 1, End: 100, Lag: 100},
+	} {
+		_, err := n.grinding(req)
+		require.NotNil(t, err, "%v", req)
+	}
+}
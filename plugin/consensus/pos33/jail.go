@@ -0,0 +1,31 @@
+package pos33
+
+import (
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// queryLiveness ForkJail以后查询addr的参与记录, 之前返回nil
+func (c *Client) queryLiveness(addr string, height int64) (*pt.Pos33Liveness, error) {
+	if !c.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkJail") {
+		return nil, nil
+	}
+	msg, err := c.GetAPI().Query(pt.Pos33TicketX, "Pos33Liveness", &types.ReqAddr{Addr: addr})
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*pt.Pos33Liveness), nil
+}
+
+// jailedCount 被关押的矿工在共识中的票数是0, 解除关押以后恢复
+func (c *Client) jailedCount(addr string, height, count int64) (int64, error) {
+	l, err := c.queryLiveness(addr, height)
+	if err != nil {
+		plog.Error("query liveness error", "error", err, "height", height, "miner", addr)
+		return 0, err
+	}
+	if l.GetJailed() {
+		return 0, nil
+	}
+	return count, nil
+}
@@ -0,0 +1,39 @@
+package pos33
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func TestJailedTicketCount(t *testing.T) {
+	n, api := newTestNode(t, nil)
+	n.setTestMiner(newTestPriv(t))
+	cfg := n.GetAPI().GetConfig()
+	cfg.SetDappFork(pt.Pos33TicketX, "UseEntrust", 0)
+	cfg.SetDappFork(pt.Pos33TicketX, "ForkJail", 100)
+	price := pt.GetPos33MineParam(cfg, 0).GetTicketPrice()
+	on, off := "1JailOnlineAddr", n.myAddr
+	api.On("Query", pt.Pos33TicketX, "Pos33ConsigneeEntrust", &types.ReqAddr{Addr: off}).Return(&pt.Pos33Consignee{Address: off, Amount: 5 * price}, nil)
+	api.On("Query", pt.Pos33TicketX, "Pos33Liveness", &types.ReqAddr{Addr: on}).Return(&pt.Pos33Liveness{Address: on, LastActive: 120}, nil)
+	api.On("Query", pt.Pos33TicketX, "Pos33Liveness", &types.ReqAddr{Addr: off}).Return(&pt.Pos33Liveness{Address: off, Jailed: true, JailHeight: 150}, nil)
+
+	// 分叉以前不查询关押状态
+	count, err := n.jailedCount(off, 50, 5)
+	require.Nil(t, err)
+	require.Equal(t, int64(5), count)
+	count, err = n.readyTicketCount(50)
+	require.Nil(t, err)
+	require.Equal(t, int64(5), count)
+
+	count, err = n.jailedCount(on, 200, 5)
+	require.Nil(t, err)
+	require.Equal(t, int64(5), count)
+	count, err = n.jailedCount(off, 200, 5)
+	require.Nil(t, err)
+	require.Equal(t, int64(0), count)
+	_, err = n.readyTicketCount(200)
+	require.NotNil(t, err)
+}
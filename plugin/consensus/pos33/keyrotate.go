@@ -0,0 +1,81 @@
+package pos33
+
+import (
+	"fmt"
+
+	lru "github.com/hashicorp/golang-lru"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// keyOwnerCacheSize 缓存多少个公钥的绑定记录
+const keyOwnerCacheSize = 4096
+
+var errKeyOwner = fmt.Errorf("pos33 key owner error")
+
+type ownerKey struct {
+	height int64 // 抽签快照的高度
+	pubkey string
+	addr   string
+}
+
+// keyOwnerCache (快照高度, 共识公钥, 推导的地址) -> 绑定记录, 有自己的锁, 持有mlock时也可以清空.
+// 同一个快照高度的状态不会变, 只有回滚时清空
+type keyOwnerCache struct {
+	cache *lru.Cache
+}
+
+func newKeyOwnerCache(size int) *keyOwnerCache {
+	if size <= 0 {
+		size = keyOwnerCacheSize
+	}
+	cache, err := lru.New(size)
+	if err != nil {
+		panic(err)
+	}
+	return &keyOwnerCache{cache: cache}
+}
+
+func (kc *keyOwnerCache) get(key ownerKey) (*pt.Pos33KeyOwner, bool) {
+	v, ok := kc.cache.Get(key)
+	if !ok {
+		return nil, false
+	}
+	return v.(*pt.Pos33KeyOwner), true
+}
+
+func (kc *keyOwnerCache) add(key ownerKey, o *pt.Pos33KeyOwner) {
+	kc.cache.Add(key, o)
+}
+
+// reset 回滚以后快照高度的状态可能变了, 重新查询公钥的绑定
+func (kc *keyOwnerCache) reset() {
+	kc.cache.Purge()
+}
+
+// keyOwner ForkKeyRotate以后返回height高度公钥pubkey抽签时使用的抵押地址, addr是公钥推导出的地址.
+// 绑定从sortHeight(height)的状态查询, 和节点当前的高度无关. 换公钥至少在交易以后Pos33KeyRotateDelay个区块生效,
+// 在height生效的绑定都已经在快照高度的状态里了.
+// 公钥已经被换掉时返回"", 这样的抽签票数为0. 查询失败返回错误, 不缓存
+func (c *Client) keyOwner(height int64, pubkey []byte, addr string) (string, error) {
+	if !c.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkKeyRotate") {
+		return addr, nil
+	}
+	sh := c.sortHeight(height)
+	if sh < 0 {
+		sh = 0
+	}
+	key := ownerKey{sh, string(pubkey), addr}
+	o, ok := c.koCache.get(key)
+	if !ok {
+		msg, err := c.stateQuery(sh, "Pos33KeyOwner", &pt.ReqPos33KeyOwner{Pubkey: pubkey, Addr: addr})
+		if err != nil {
+			return "", fmt.Errorf("%w: %v, height %d, addr %s", errKeyOwner, err, height, addr)
+		}
+		o, ok = msg.(*pt.Pos33KeyOwner)
+		if !ok {
+			return "", fmt.Errorf("%w: bad reply type %T, height %d, addr %s", errKeyOwner, msg, height, addr)
+		}
+		c.koCache.add(key, o)
+	}
+	return o.Owner(addr, height), nil
+}
@@ -0,0 +1,115 @@
+package pos33
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// onKeyOwner mock height高度状态上pubkey和addr的绑定查询
+func onKeyOwner(api *mocks.QueueProtocolAPI, pubkey []byte, addr string, height int64) *mock.Call {
+	return api.On("QueryChain", mock.MatchedBy(func(p *types.ChainExecutor) bool {
+		req := new(pt.ReqPos33KeyOwner)
+		return p.FuncName == "Pos33KeyOwner" && types.Decode(p.Param, req) == nil &&
+			string(req.Pubkey) == string(pubkey) && req.Addr == addr && string(p.StateHash) == string([]byte{byte(height)})
+	}))
+}
+
+func TestSortAddrKeyRotate(t *testing.T) {
+	n, api := newTestNode(t, nil)
+	cfg := n.GetAPI().GetConfig()
+	mockStateHeaders(api)
+	oldKey, newKey := newTestPriv(t).PubKey().Bytes(), newTestPriv(t).PubKey().Bytes()
+	staker := n.pubkeyAddr(0, oldKey)
+	newAddr := n.pubkeyAddr(0, newKey)
+	for _, h := range []int64{150, 200} {
+		sh := n.sortHeight(h)
+		onKeyOwner(api, newKey, newAddr, sh).Return(&pt.Pos33KeyOwner{
+			Key: &pt.Pos33ConsensusKey{Staker: staker, Pubkey: newKey, Height: 200},
+		}, nil)
+		onKeyOwner(api, oldKey, staker, sh).Return(&pt.Pos33KeyOwner{
+			Staker: &pt.Pos33StakerKey{Staker: staker, Pubkey: newKey, Height: 200, SelfRevoke: 200},
+		}, nil)
+	}
+	api.On("Query", pt.Pos33TicketX, "AllPos33TicketCount", mock.Anything).Return(&types.Int64{Data: 300}, nil)
+	keyOwnerCalls := func() int {
+		num := 0
+		for _, c := range api.Calls {
+			if c.Method == "QueryChain" && c.Arguments.Get(0).(*types.ChainExecutor).FuncName == "Pos33KeyOwner" {
+				num++
+			}
+		}
+		return num
+	}
+
+	// 分叉以前不查询绑定
+	require.Equal(t, newAddr, n.sortAddr(300, newKey))
+	require.Equal(t, 0, keyOwnerCalls())
+
+	// 绑定从快照高度的状态查询, 每个快照高度查询一次
+	cfg.SetDappFork(pt.Pos33TicketX, "ForkKeyRotate", 100)
+	require.Equal(t, newAddr, n.sortAddr(150, newKey))
+	require.Equal(t, staker, n.sortAddr(150, oldKey))
+	require.Equal(t, staker, n.sortAddr(200, newKey))
+	require.Equal(t, "", n.sortAddr(200, oldKey))
+	require.Equal(t, staker, n.sortAddr(200, newKey))
+	require.Equal(t, 4, keyOwnerCalls())
+
+	// 新的换公钥交易不改变快照高度的状态, 不用重新查询
+	tx, err := types.CreateFormatTx(cfg, pt.Pos33TicketX, types.Encode(&pt.Pos33TicketAction{
+		Value: &pt.Pos33TicketAction_KeyRotate{KeyRotate: &pt.Pos33KeyRotate{Staker: staker, Height: 300}},
+		Ty:    pt.Pos33ActionKeyRotate,
+	}))
+	require.Nil(t, err)
+	n.updateTicketCount(&types.Block{Height: 10, Txs: []*types.Transaction{{}, tx}})
+	require.Equal(t, staker, n.sortAddr(200, newKey))
+	require.Equal(t, 4, keyOwnerCalls())
+
+	// 回滚清空缓存
+	n.updateTicketCount(&types.Block{Height: 11})
+	require.Equal(t, staker, n.sortAddr(200, newKey))
+	require.Equal(t, 4, keyOwnerCalls())
+	n.updateTicketCount(&types.Block{Height: 11})
+	require.Equal(t, staker, n.sortAddr(200, newKey))
+	require.Equal(t, 5, keyOwnerCalls())
+}
+
+func TestSortOwnerQueryError(t *testing.T) {
+	n, api := newTestNode(t, nil)
+	cfg := n.GetAPI().GetConfig()
+	cfg.SetDappFork(pt.Pos33TicketX, "ForkKeyRotate", 100)
+	mockStateHeaders(api)
+	key := newTestPriv(t).PubKey().Bytes()
+	addr := n.pubkeyAddr(200, key)
+	sh := n.sortHeight(200)
+	onKeyOwner(api, key, addr, sh).Return(nil, errors.New("db error")).Once()
+	onKeyOwner(api, key, addr, sh).Return(&pt.Pos33KeyOwner{}, nil)
+
+	// 查询失败返回错误, 不当作公钥已经被换掉, 也不缓存
+	_, err := n.sortOwner(200, key)
+	require.True(t, errors.Is(err, errKeyOwner))
+	owner, err := n.sortOwner(200, key)
+	require.Nil(t, err)
+	require.Equal(t, addr, owner)
+}
+
+func TestKeyOwnerCacheBound(t *testing.T) {
+	kc := newKeyOwnerCache(2)
+	for _, k := range []string{"a", "b", "c"} {
+		kc.add(ownerKey{addr: k}, &pt.Pos33KeyOwner{})
+	}
+	_, ok := kc.get(ownerKey{addr: "a"})
+	require.False(t, ok)
+	_, ok = kc.get(ownerKey{addr: "c"})
+	require.True(t, ok)
+	_, ok = kc.get(ownerKey{height: 1, addr: "c"})
+	require.False(t, ok)
+	kc.reset()
+	_, ok = kc.get(ownerKey{addr: "c"})
+	require.False(t, ok)
+}
@@ -0,0 +1,75 @@
+package pos33
+
+import (
+	"time"
+
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+// 从收到新区块到广播抽签消息的各个阶段
+const (
+	stageSeed   = iota // 从区块得到seed
+	stageCount         // 查询票数和diff
+	stageVrf           // 计算vrf
+	stageSort          // doSort
+	stageEncode        // 编码消息
+	numStages
+)
+
+var stageNames = [numStages]string{"seed", "count", "vrf", "sort", "encode"}
+
+var (
+	stageHistograms [numStages]metrics.Histogram
+	stageGauges     [numStages]metrics.Gauge
+
+	sortLatencyHistogram = metrics.GetOrRegisterHistogram("pos33/sort/latency/total", nil, metrics.NewExpDecaySample(1028, 0.015))
+	sortLatencyGauge     = metrics.GetOrRegisterGauge("pos33/sort/latency/total/last", nil)
+)
+
+func init() {
+	for i, name := range stageNames {
+		stageHistograms[i] = metrics.GetOrRegisterHistogram("pos33/sort/latency/"+name, nil, metrics.NewExpDecaySample(1028, 0.015))
+		stageGauges[i] = metrics.GetOrRegisterGauge("pos33/sort/latency/"+name+"/last", nil)
+	}
+}
+
+// sortTimer 记录一次抽签各个阶段的耗时(微秒记录到metrics).
+// 没有配置SortLatency时为nil, 所有方法直接返回, 不调用time.Now
+type sortTimer struct {
+	start  time.Time
+	last   time.Time
+	stages [numStages]time.Duration
+}
+
+func (n *node) newSortTimer() *sortTimer {
+	if !n.conf.SortLatency {
+		return nil
+	}
+	now := time.Now()
+	return &sortTimer{start: now, last: now}
+}
+
+// stage 上一个阶段结束到现在的时间记到阶段i
+func (t *sortTimer) stage(i int) {
+	if t == nil {
+		return
+	}
+	now := time.Now()
+	t.stages[i] += now.Sub(t.last)
+	t.last = now
+}
+
+// done 抽签消息已经交给广播, 记录各个阶段和总的耗时
+func (t *sortTimer) done() time.Duration {
+	if t == nil {
+		return 0
+	}
+	total := t.last.Sub(t.start)
+	for i, d := range t.stages {
+		stageHistograms[i].Update(d.Microseconds())
+		stageGauges[i].Update(d.Microseconds())
+	}
+	sortLatencyHistogram.Update(total.Microseconds())
+	sortLatencyGauge.Update(total.Microseconds())
+	return total
+}
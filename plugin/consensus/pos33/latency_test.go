@@ -0,0 +1,50 @@
+package pos33
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func TestSortLatency(t *testing.T) {
+	// 没有配置时不记录
+	n, _ := newTestNode(t, nil)
+	tm := n.newSortTimer()
+	require.Nil(t, tm)
+	tm.stage(stageSeed)
+	require.Zero(t, tm.done())
+
+	n, _ = newTestNode(t, &subConfig{SortLatency: true})
+	n.setTestMiner(newTestPriv(t))
+	height := int64(100)
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+	before := sortLatencyHistogram.Count()
+
+	tm = n.newSortTimer()
+	time.Sleep(time.Millisecond)
+	tm.stage(stageSeed)
+	r := n.timedSorts(context.Background(), []byte("latency seed"), height, 0, tm)
+	require.Equal(t, 10, len(r.ss))
+	require.Equal(t, tm, r.tm)
+	types.Encode(&pt.Pos33Sorts{Sorts: r.ss})
+	tm.stage(stageEncode)
+	total := tm.done()
+
+	var sum time.Duration
+	for i, d := range tm.stages {
+		require.True(t, d >= 0, stageNames[i])
+		sum += d
+	}
+	require.True(t, tm.stages[stageSeed] >= time.Millisecond)
+	require.True(t, tm.stages[stageVrf] > 0)
+	// 各个阶段的和就是总的耗时
+	require.InDelta(t, float64(total), float64(sum), float64(time.Microsecond))
+
+	require.Equal(t, before+1, sortLatencyHistogram.Count())
+	require.Equal(t, total.Microseconds(), sortLatencyGauge.Value())
+	require.Equal(t, tm.stages[stageVrf].Microseconds(), stageGauges[stageVrf].Value())
+}
@@ -0,0 +1,179 @@
+package pos33
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/yccproject/ycc/plugin/consensus/pos33/signer"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+var (
+	errManifestHash = errors.New("config manifest hash error")
+	errManifestSig  = errors.New("config manifest signature error")
+)
+
+// manifestForks 影响共识的pos33分叉
+var manifestForks = []string{
+	"Enable",
+	"ForkReward15",
+	"ForkFixReward",
+	"UseEntrust",
+	"ForkSubCommittee",
+	"ForkRoundEvidence",
+	"ForkAddressFormat",
+	"ForkEvidence",
+	"ForkVrfSuite",
+	"ForkParticipants",
+	"ForkQuorumRule",
+	"ForkSlash",
+	"ForkChainParam",
+	"ForkAggVote",
+	"ForkDelegate",
+	"ForkDiffV2",
+	"ForkJail",
+	"ForkKeyRotate",
+	"ForkRewardV2",
+	"ForkTicketPrice",
+	"ForkLightProof",
+	"ForkBlockCheck",
+}
+
+// manifestEntries 返回height高度影响共识的所有参数, 按key排序.
+// 只包含所有节点必须相同的参数, 不包含端口, 文件路径这些本地配置. 查询票价失败返回错误
+func (n *node) manifestEntries(height int64) ([]*pt.Pos33ManifestEntry, error) {
+	cfg := n.GetAPI().GetConfig()
+	mp := make(map[string]string)
+	set := func(k string, v interface{}) { mp[k] = fmt.Sprint(v) }
+
+	cp := n.chainParam(height)
+	set("sortBlocks", cp.SortBlocks)
+	set("makerSize", pt.Pos33MakerSize)
+	set("voterSize", pt.Pos33VoterSize)
+	set("committeeSize", cp.CommitteeSize)
+	set("diffPersent", cp.DiffPersent)
+	set("mustVotes", pt.Pos33MustVotes)
+	set("maxSubCommittees", pt.Pos33MaxSubCommittees)
+	set("subCommittees", n.subCommittees(height))
+	set("maxBlockEvidences", maxBlockEvidences)
+	set("slashWindow", slashWindow)
+	set("roundEvidenceTime", roundEvidenceTime)
+	set("diffEmaBlocks", diffEmaBlocks)
+	set("diffRoundRelax", diffRoundRelax)
+
+	set("hasher", "sha256")
+	set("curve", "secp256k1")
+	set("vrfSuite", n.proofSuite(height))
+	set("addressFormat", pt.GetPos33AddressFormat(cfg, height))
+
+	price, err := n.ticketPrice(height)
+	if err != nil {
+		return nil, err
+	}
+	mp33 := pt.GetPos33MineParam(cfg, height)
+	set("ticketPrice", price)
+	set("blockReward", mp33.BlockReward)
+	set("voteReward", mp33.VoteReward)
+	set("mineReward", mp33.MineReward)
+	set("minerFeePersent", mp33.MinerFeePersent)
+	set("rewardTransfer", mp33.RewardTransfer)
+	set("slashPersent", mp33.SlashPersent)
+	set("slashBountyPersent", mp33.SlashBountyPersent)
+	set("jailBlocks", mp33.JailBlocks)
+	set("perVoteReward", mp33.PerVoteReward)
+	set("makerBonus", mp33.MakerBonus)
+	set("maxCarryRounds", mp33.MaxCarryRounds)
+	set("priceEpoch", mp33.PriceEpoch)
+	set("targetBondRatio", mp33.TargetBondRatio)
+	set("maxPriceStep", mp33.MaxPriceStep)
+	set("minTicketPrice", mp33.MinTicketPrice)
+	set("maxTicketPrice", mp33.MaxTicketPrice)
+	set("forkSupply", mp33.ForkSupply)
+
+	allow, deny := pt.GetPos33Participants(cfg, height).Lists()
+	set("allowList", strings.Join(allow, ","))
+	set("denyList", strings.Join(deny, ","))
+	if rule, err := n.quorumRule(height); err == nil {
+		set("quorumRule", rule.Name())
+	} else {
+		set("quorumRule", err.Error())
+	}
+
+	for _, name := range manifestForks {
+		set("fork."+name, cfg.GetDappFork(pt.Pos33TicketX, name))
+	}
+
+	es := make([]*pt.Pos33ManifestEntry, 0, len(mp))
+	for k, v := range mp {
+		es = append(es, &pt.Pos33ManifestEntry{Key: k, Value: v})
+	}
+	sort.Slice(es, func(i, j int) bool { return es[i].Key < es[j].Key })
+	return es, nil
+}
+
+func manifestHash(m *pt.Pos33ConfigManifest) []byte {
+	return crypto.Sha256(types.Encode(&pt.Pos33ConfigManifest{Height: m.Height, Entries: m.Entries}))
+}
+
+// manifest 导出height高度的共识参数清单, sign为true时用挖矿私钥签名.
+// 配置相同的节点清单的hash相同
+func (n *node) manifest(height int64, sign bool) (*pt.Pos33ConfigManifest, error) {
+	es, err := n.manifestEntries(height)
+	if err != nil {
+		return nil, err
+	}
+	m := &pt.Pos33ConfigManifest{Height: height, Entries: es}
+	m.Hash = manifestHash(m)
+	if sign {
+		if n.getPriv() == nil {
+			return nil, errors.New("wallet locked or mining account NOT set")
+		}
+		priv := n.signKey(signer.KindMsg, height, 0)
+		m.Pubkey = priv.PubKey().Bytes()
+		m.Signature = signMsg(priv, signer.KindMsg, m.Hash).Bytes()
+	}
+	return m, nil
+}
+
+// checkManifest 检查清单的hash, 有签名时检查签名
+func checkManifest(m *pt.Pos33ConfigManifest) error {
+	if !bytes.Equal(manifestHash(m), m.Hash) {
+		return errManifestHash
+	}
+	if len(m.Signature) == 0 {
+		return nil
+	}
+	cr, err := crypto.Load(types.GetSignName("", types.SECP256K1), -1)
+	if err != nil {
+		return err
+	}
+	pub, err := cr.PubKeyFromBytes(m.Pubkey)
+	if err != nil {
+		return err
+	}
+	sig, err := cr.SignatureFromBytes(m.Signature)
+	if err != nil {
+		return err
+	}
+	if !pub.VerifyBytes(signer.Domain(signer.KindMsg, m.Hash), sig) {
+		return errManifestSig
+	}
+	return nil
+}
+
+// Query_GetConfigManifest 导出height高度的共识参数清单, height为0时使用当前高度
+func (client *Client) Query_GetConfigManifest(req *pt.ReqPos33Manifest) (types.Message, error) {
+	height := req.Height
+	if height <= 0 {
+		height = client.GetCurrentHeight()
+	}
+	if req.Sign {
+		client.getMiner()
+	}
+	return client.n.manifest(height, req.Sign)
+}
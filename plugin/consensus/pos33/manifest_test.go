@@ -0,0 +1,53 @@
+package pos33
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func TestConfigManifest(t *testing.T) {
+	a, _ := newSubCommitteeNode(t, 2)
+	b, _ := newSubCommitteeNode(t, 3)
+	a.setTestMiner(newTestPriv(t))
+	b.setTestMiner(newTestPriv(t))
+
+	height := int64(100)
+	ma, err := a.manifest(height, false)
+	require.Nil(t, err)
+	mb, err := b.manifest(height, true)
+	require.Nil(t, err)
+	require.Equal(t, ma.Hash, mb.Hash)
+	require.Equal(t, ma.Entries, mb.Entries)
+	require.Nil(t, checkManifest(ma))
+	require.Nil(t, checkManifest(mb))
+	require.NotEmpty(t, mb.Signature)
+
+	// 修改参数或者签名
+	mb.Entries[0].Value += "1"
+	require.Equal(t, errManifestHash, checkManifest(mb))
+	mb.Entries[0].Value = ma.Entries[0].Value
+	mb.Signature[len(mb.Signature)-1] ^= 1
+	require.NotNil(t, checkManifest(mb))
+
+	// 子委员会分叉以后链的配置不同, hash不同
+	for _, n := range []*node{a, b} {
+		n.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "ForkSubCommittee", height)
+	}
+	ma, err = a.manifest(height, false)
+	require.Nil(t, err)
+	mb, err = b.manifest(height, false)
+	require.Nil(t, err)
+	require.NotEqual(t, ma.Hash, mb.Hash)
+
+	// 不在清单中的本地配置不影响hash
+	a.conf.EventBufferSize = 10
+	mc, err := a.manifest(height, false)
+	require.Nil(t, err)
+	require.Equal(t, ma.Hash, mc.Hash)
+
+	a.priv = nil
+	_, err = a.manifest(height, true)
+	require.NotNil(t, err)
+}
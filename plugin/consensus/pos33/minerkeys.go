@@ -0,0 +1,99 @@
+package pos33
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+// duplicateKeyCounter 从网络收到用自己的挖矿私钥做的抽签, 说明其他节点配置了相同的私钥
+var duplicateKeyCounter = metrics.GetOrRegisterCounter("pos33/miner/duplicatekey", nil)
+
+// minerKey 一个挖矿地址和它的私钥
+type minerKey struct {
+	addr string
+	priv crypto.PrivKey
+}
+
+// loadMinerKeys 解析配置的其他挖矿私钥(hex), 不能重复.
+// 同一个私钥在一个节点里抽签两次会被当作重复的抽签
+func loadMinerKeys(keys []string) ([]*minerKey, error) {
+	seen := make(map[string]bool)
+	var mks []*minerKey
+	for i, k := range keys {
+		b, err := common.FromHex(k)
+		if err != nil {
+			return nil, fmt.Errorf("minerKeys[%d]: %v", i, err)
+		}
+		priv, err := privFromBytes(b)
+		if err != nil {
+			return nil, fmt.Errorf("minerKeys[%d]: %v", i, err)
+		}
+		addr := address.PubKeyToAddr(ethID, priv.PubKey().Bytes())
+		if seen[addr] {
+			return nil, fmt.Errorf("minerKeys[%d]: duplicate miner address %s", i, addr)
+		}
+		seen[addr] = true
+		mks = append(mks, &minerKey{addr: addr, priv: priv})
+	}
+	return mks, nil
+}
+
+// dropPrimaryKey 去掉和钱包的挖矿地址相同的配置私钥
+func dropPrimaryKey(primary string, mks []*minerKey) []*minerKey {
+	var ks []*minerKey
+	for _, k := range mks {
+		if k.addr == primary {
+			plog.Error("minerKeys has the wallet miner address, ignore it", "addr", primary)
+			continue
+		}
+		ks = append(ks, k)
+	}
+	return ks
+}
+
+// minerKeys 返回所有挖矿地址, 钱包或者远程签名的地址在第一个. 没有挖矿私钥时返回nil
+func (client *Client) minerKeys() []*minerKey {
+	priv := client.getPriv()
+	if priv == nil {
+		return nil
+	}
+	return append([]*minerKey{{addr: client.myAddr, priv: priv}}, client.extraKeys...)
+}
+
+// isMyAddr addr是不是这个节点的挖矿地址
+func (client *Client) isMyAddr(addr string) bool {
+	if addr == client.myAddr {
+		return true
+	}
+	for _, k := range client.extraKeys {
+		if k.addr == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// signKeyOf 挖矿地址addr签名kind类型消息使用的私钥, 第一个地址和signKey相同
+func (client *Client) signKeyOf(addr, kind string, height int64, round int) crypto.PrivKey {
+	for _, k := range client.extraKeys {
+		if k.addr == addr {
+			return k.priv
+		}
+	}
+	return client.signKey(kind, height, round)
+}
+
+// checkDuplicateKey 从网络收到的抽签是自己的地址做的时候告警.
+// 自己发出的消息不会从网络收回来, 所以是其他节点用了相同的挖矿私钥
+func (n *node) checkDuplicateKey(pubkey []byte, height int64) {
+	addr := address.PubKeyToAddr(ethID, pubkey)
+	if !n.isMyAddr(addr) {
+		return
+	}
+	duplicateKeyCounter.Inc(1)
+	plog.Error("another node is mining with my key", "addr", addr, "height", height)
+}
@@ -0,0 +1,72 @@
+package pos33
+
+import (
+	"context"
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func TestLoadMinerKeys(t *testing.T) {
+	p1, p2 := newTestPriv(t), newTestPriv(t)
+	k1, k2 := common.ToHex(p1.Bytes()), common.ToHex(p2.Bytes())
+	mks, err := loadMinerKeys([]string{k1, k2})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(mks))
+	require.Equal(t, address.PubKeyToAddr(ethID, p2.PubKey().Bytes()), mks[1].addr)
+
+	_, err = loadMinerKeys([]string{k1, k2, k1})
+	require.NotNil(t, err)
+	_, err = loadMinerKeys([]string{"0xzz"})
+	require.NotNil(t, err)
+
+	// 和钱包的挖矿地址相同的去掉
+	mks = dropPrimaryKey(mks[0].addr, mks)
+	require.Equal(t, 1, len(mks))
+	require.Equal(t, k2, common.ToHex(mks[0].priv.Bytes()))
+}
+
+func TestMultiKeySort(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	n.setTestMiner(newTestPriv(t))
+	mks, err := loadMinerKeys([]string{common.ToHex(newTestPriv(t).Bytes())})
+	require.Nil(t, err)
+	n.extraKeys = mks
+	addr2 := mks[0].addr
+
+	height := int64(100)
+	seed := []byte("multi key seed")
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+	n.setTestCount(addr2, height-pt.Pos33SortBlocks, 5, pt.Pos33CommitteeSize)
+
+	ss := n.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.Equal(t, 15, len(ss))
+	require.Equal(t, 10, len(getMySorts(n.myAddr, ss)))
+	require.Equal(t, 5, len(getMySorts(addr2, ss)))
+	require.Equal(t, ss, pt.CanonicalSorts(ss, 0))
+	for _, err := range n.verifySorts(height, Committee, seed, ss) {
+		require.Nil(t, err)
+	}
+
+	require.True(t, n.isMyAddr(addr2))
+	require.False(t, n.isMyAddr("other"))
+	require.Equal(t, mks[0].priv, n.signKeyOf(addr2, "", height, 0))
+
+	// 每个地址分组发送, 自己的抽签都可以做出块人候选
+	pss := groupMySorts(ss)
+	require.Equal(t, 2, len(pss))
+	for _, ps := range pss {
+		for _, s := range ps.Sorts {
+			require.Equal(t, ps.Sorts[0].Proof.Pubkey, s.Proof.Pubkey)
+		}
+	}
+
+	// 网络收到自己地址的抽签, 是其他节点用了相同的私钥
+	c0 := duplicateKeyCounter.Count()
+	n.checkDuplicateKey(ss[0].Proof.Pubkey, height)
+	n.checkDuplicateKey(newTestPriv(t).PubKey().Bytes(), height)
+	require.Equal(t, c0+1, duplicateKeyCounter.Count())
+}
@@ -1,9 +1,11 @@
 package pos33
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
+	"runtime"
 	"sort"
 	"sync"
 	"time"
@@ -16,6 +18,7 @@ import (
 	"github.com/33cn/plugin/plugin/crypto/bls"
 	"github.com/golang/protobuf/proto"
 
+	"github.com/yccproject/ycc/plugin/consensus/pos33/signer"
 	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
 )
 
@@ -25,6 +28,8 @@ var plog = log15.New("module", "pos33")
 type committee struct {
 	myss []*pt.Pos33SortMsg          // 我的抽签
 	css  map[string]*pt.Pos33SortMsg // 我收到committee的抽签
+	// 我收到子委员会的抽签, num -> sort hash -> sort
+	subss map[int32]map[string]*pt.Pos33SortMsg
 	// ssmp map[string]*pt.Pos33SortMsg
 	svmp map[string]int // 验证委员会的投票
 	n    *node
@@ -71,22 +76,16 @@ func readVotes(file string) (map[string][]*pt.Pos33VoteMsg, error) {
 	return mp, nil
 }
 
-func (c *committee) setCommittee(height int64) {
+func (c *committee) setCommittee(height int64, round int) {
 	if c.setted {
 		return
 	}
 	c.setted = true
-	var ss []*pt.Pos33SortMsg
-	for k := range c.svmp {
-		s, ok := c.css[k]
-		if ok {
-			ss = append(ss, s)
-		}
-	}
-	sort.Sort(pt.Sorts(ss))
-	if len(ss) > pt.Pos33CommitteeSize {
-		ss = ss[:pt.Pos33CommitteeSize]
+	voted := func(s *pt.Pos33SortMsg) bool {
+		_, ok := c.svmp[string(s.SortHash.Hash)]
+		return ok
 	}
+	ss := c.shardSorts(height, int(c.n.chainParam(height).CommitteeSize), voted)
 	for _, s := range ss {
 		n := c.svmp[string(s.SortHash.Hash)]
 		if n >= pt.Pos33MustVotes {
@@ -95,7 +94,7 @@ func (c *committee) setCommittee(height int64) {
 	}
 	mp := make(map[string]string)
 	for i, s := range c.comm {
-		addr := address.PubKeyToAddr(ethID, s.Proof.Pubkey)
+		addr := c.n.pubkeyAddr(height, s.Proof.Pubkey)
 		_, ok := mp[addr]
 		if ok {
 			continue
@@ -108,6 +107,71 @@ func (c *committee) setCommittee(height int64) {
 		}
 	}
 	plog.Info("setCommittee", "len", len(c.comm), "height", height)
+	seats := 0
+	for _, s := range c.comm {
+		if c.n.isMyPubkey(height, s.Proof.Pubkey) {
+			seats++
+		}
+	}
+	committeeSizeGauge.Update(int64(len(c.comm)))
+	committeeMySeatsGauge.Update(int64(seats))
+	if seats > 0 {
+		committeeMemberCounter.Inc(1)
+	}
+	c.n.cstore.add(height, round, c.comm)
+	c.n.audit.setCommittee(height, round, c.comm)
+	c.n.pushEvent(pt.Pos33EventCommittee, height, round, "", len(c.comm), nil)
+}
+
+// shard 子委员会num收到的抽签
+func (c *committee) shard(num int) map[string]*pt.Pos33SortMsg {
+	if num == 0 {
+		return c.css
+	}
+	return c.subss[int32(num)]
+}
+
+// shardSorts 每个子委员会分别取抽签hash最小的keep为true的抽签, 合起来排序.
+// size按子委员会平均分配, 余数给第0个子委员会. 只有一个子委员会时就是css里hash最小的size个
+func (c *committee) shardSorts(height int64, size int, keep func(*pt.Pos33SortMsg) bool) []*pt.Pos33SortMsg {
+	subs := c.n.subCommittees(height)
+	var all []*pt.Pos33SortMsg
+	for num := 0; num < subs; num++ {
+		var ss []*pt.Pos33SortMsg
+		for _, s := range c.shard(num) {
+			if keep == nil || keep(s) {
+				ss = append(ss, s)
+			}
+		}
+		sort.Sort(pt.Sorts(ss))
+		share := size / subs
+		if num == 0 {
+			share += size % subs
+		}
+		if len(ss) > share {
+			ss = ss[:share]
+		}
+		all = append(all, ss...)
+	}
+	sort.Sort(pt.Sorts(all))
+	return all
+}
+
+// sortMap 所有子委员会收到的抽签, sort hash -> sort
+func (c *committee) sortMap() map[string]*pt.Pos33SortMsg {
+	if len(c.subss) == 0 {
+		return c.css
+	}
+	mp := make(map[string]*pt.Pos33SortMsg, len(c.css))
+	for k, s := range c.css {
+		mp[k] = s
+	}
+	for _, ss := range c.subss {
+		for k, s := range ss {
+			mp[k] = s
+		}
+	}
+	return mp
 }
 
 func (n *node) getCommittee(height int64, round int) *committee {
@@ -119,7 +183,8 @@ func (n *node) getCommittee(height int64, round int) *committee {
 	m, ok := rmp[round]
 	if !ok {
 		m = &committee{
-			css: make(map[string]*pt.Pos33SortMsg),
+			css:   make(map[string]*pt.Pos33SortMsg),
+			subss: make(map[int32]map[string]*pt.Pos33SortMsg),
 			// ssmp: make(map[string]*pt.Pos33SortMsg),
 			svmp: make(map[string]int),
 			bvmp: make(map[string][]*pt.Pos33VoteMsg),
@@ -143,18 +208,6 @@ func getMySorts(myaddr string, ss []*pt.Pos33SortMsg) []*pt.Pos33SortMsg {
 	return ms
 }
 
-func getSorts(mp map[string]*pt.Pos33SortMsg, num int) []*pt.Pos33SortMsg {
-	var ss []*pt.Pos33SortMsg
-	for _, s := range mp {
-		ss = append(ss, s)
-	}
-	sort.Sort(pt.Sorts(ss))
-	if len(ss) > num {
-		ss = ss[:num]
-	}
-	return ss
-}
-
 type vArg struct {
 	v  *pt.Pos33VoteMsg
 	ch chan<- bool
@@ -174,6 +227,43 @@ type node struct {
 
 	vCh    chan vArg
 	sortCh chan *sortArg
+	// 共识关闭时close, 抽签协程退出
+	quit     chan struct{}
+	quitOnce sync.Once
+
+	vrfMemo *vrfMemo
+	resort  *resorter
+	cstore  *committeeStore
+	sdelay  *sortDelay
+	events  *eventHub
+	// ForkVrfSuite之后自己的抽签使用的vrf算法
+	vrfSuite string
+	cp       *checkpoint
+	forks    *forkSet
+	drift    *clockDrift
+	rstate   roundState
+	shadows  *shadowRules
+	evpool   *evidencePool
+	audit    *auditLog
+
+	// 共识状态日志
+	wal *roundWal
+	// 转发共识消息的节点的计分
+	peers *peerScores
+
+	// 检查点可以被ImportCheckpoint替换
+	cpLock sync.Mutex
+	// 可以签名检查点的验证者
+	cpSigners *checkpointSigners
+
+	// 已经作为Pos33Slash交易发送的证据, hash -> 发送的高度
+	slashSent map[string]int64
+
+	// 最近推送的下一个高度的难度
+	lastDiff float64
+
+	// 区块的独立检查项并行验证时额外使用的协程
+	vworkers chan struct{}
 
 	vbch chan hr
 
@@ -186,14 +276,60 @@ type node struct {
 }
 
 func newNode(conf *subConfig) *node {
-	return &node{
-		mmp:    make(map[int64]map[int]*committee),
-		bch:    make(chan *types.Block, 16),
-		blsMp:  make(map[string]string),
-		vCh:    make(chan vArg, 8),
-		sortCh: make(chan *sortArg, 8),
-		vbch:   make(chan hr, 1),
+	n := &node{
+		mmp:     make(map[int64]map[int]*committee),
+		bch:     make(chan *types.Block, 16),
+		blsMp:   make(map[string]string),
+		vCh:     make(chan vArg, 8),
+		quit:    make(chan struct{}),
+		vbch:    make(chan hr, 1),
+		vrfMemo: newVrfMemo(vrfMemoSize, conf.VrfMemoMaxAge),
+	}
+	n.sortCh = make(chan *sortArg, sortQueueSize(conf.SortQueueSize, sortitionWorkers(conf.SortitionWorkers)))
+	n.resort = newResorter(n.mySorts)
+	n.forks = newForkSet(n)
+	n.drift = newClockDrift(conf.ClockDriftWarn)
+	n.cstore = newNodeCommitteeStore(conf)
+	n.sdelay = newSortDelay(conf.SortBroadcastDelay)
+	n.events = newEventHub(conf.EventBufferSize)
+	n.vworkers = make(chan struct{}, verifyWorkers(conf.VerifyWorkers))
+	n.evpool = newEvidencePool(evidencePoolSize)
+	n.slashSent = make(map[string]int64)
+	n.audit = newAuditLog(conf.AuditDir)
+	n.wal = newRoundWal(conf.WalDir, conf.DisableWal)
+	n.peers = newPeerScores(conf)
+	var err error
+	n.vrfSuite, err = newVrfSuite(conf.VrfSuite)
+	if err != nil {
+		panic(err)
+	}
+	n.cp, err = newCheckpoint(conf.CheckpointHeight, conf.CheckpointHash, conf.CheckpointDigest)
+	if err != nil {
+		panic(err)
 	}
+	if n.cp != nil {
+		n.cp.pubkeys = n.blsPubkeys
+	}
+	n.cpSigners, err = newCheckpointSigners(conf.CheckpointSigners, conf.CheckpointQuorum)
+	if err != nil {
+		panic(err)
+	}
+	if conf.CheckpointFile != "" {
+		err = n.loadCheckpoint(conf.CheckpointFile)
+		if err != nil {
+			plog.Error("load checkpoint error, discard it", "err", err, "file", conf.CheckpointFile)
+		}
+	}
+	n.shadows, err = newShadowRules(conf)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// stop 通知抽签协程退出, 可以多次调用
+func (n *node) stop() {
+	n.quitOnce.Do(func() { close(n.quit) })
 }
 
 func (n *node) lastBlock() *types.Block {
@@ -234,8 +370,32 @@ func (n *node) minerTx(height int64, round int, sm *pt.Pos33SortMsg, vs []*pt.Po
 		},
 		Ty: pt.Pos33TicketActionMiner,
 	}
-
 	cfg := n.GetAPI().GetConfig()
+	if cfg.IsDappFork(height, pt.Pos33TicketX, "ForkEvidence") {
+		act.GetMiner().Evidences = n.evpool.next(maxBlockEvidences)
+	}
+	if cfg.IsDappFork(height, pt.Pos33TicketX, "ForkAggVote") && !n.conf.NoAggVote {
+		agg, err := n.aggVote(pklist, blsSig.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		m := act.GetMiner()
+		m.Agg, m.BlsPkList, m.BlsSig = agg, nil, nil
+	}
+	if cfg.IsDappFork(height, pt.Pos33TicketX, "ForkRewardV2") {
+		mr, err := pt.GetPos33MineParam(cfg, height).MinerRewardV2(len(pklist), int(sm.Proof.Input.Round))
+		if err != nil {
+			return nil, err
+		}
+		act.GetMiner().Reward = mr
+	}
+	if cfg.IsDappFork(height, pt.Pos33TicketX, "ForkLightProof") {
+		act.GetMiner().TicketCommits, err = n.ticketCommits(height)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	tx, err := types.CreateFormatTx(cfg, "pos33", types.Encode(act))
 	if err != nil {
 		return nil, err
@@ -243,6 +403,9 @@ func (n *node) minerTx(height int64, round int, sm *pt.Pos33SortMsg, vs []*pt.Po
 
 	signID := types.EncodeSignID(types.SECP256K1, ethID)
 	tx.Sign(signID, priv)
+	if len(tx.Signature.Signature) == 0 {
+		return nil, fmt.Errorf("minerTx error: sign failed. height=%d, round=%d", height, round)
+	}
 	plog.Info("make a minerTx", "nvs", len(vs), "height", height, "fee", tx.Fee, "from", tx.From())
 	return tx, nil
 }
@@ -325,7 +488,8 @@ func (n *node) makeBlock0(height int64, round int) (*types.Block, error) {
 		return nil, nil
 	}
 
-	priv := n.getPriv()
+	tb := time.Now()
+	priv := n.signKeyOf(address.PubKeyToAddr(ethID, sort.Proof.Pubkey), signer.KindMinerTx, height, round)
 	if priv == nil {
 		panic("can't go here")
 	}
@@ -341,9 +505,17 @@ func (n *node) makeBlock0(height int64, round int) (*types.Block, error) {
 	}
 
 	nb.Difficulty = n.blockDiff(sort, vs, height)
+	if n.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkLightProof") {
+		nb, err = n.signBlock(nb, n.signKeyOf(address.PubKeyToAddr(ethID, sort.Proof.Pubkey), signer.KindBlock, height, round))
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	plog.Info("block make", "height", height, "round", round, "ntx", len(nb.Txs), "nvs", len(vs), "hash", common.HashHex(nb.Hash(n.GetAPI().GetConfig()))[:16], "diff", nb.Difficulty)
 	comm.maked = true
+	n.wal.add(&pt.Pos33WalRecord{Height: height, Round: int32(round), Ty: walMade, Myself: true}, true)
+	blockProposeTimer.UpdateSince(tb)
 
 	n.setBlock(nb)
 	return nb, nil
@@ -372,6 +544,7 @@ func (n *node) makeBlock0(height int64, round int) (*types.Block, error) {
 
 func (n *node) broadcastBlock(b *types.Block, round int) {
 	m := &pt.Pos33BlockMsg{B: b, Pid: n.pid}
+	n.wal.add(&pt.Pos33WalRecord{Height: b.Height, Round: int32(round), Ty: walBlock, Myself: true, Block: m}, true)
 	pm := &pt.Pos33Msg{Data: types.Encode(m), Ty: pt.Pos33Msg_B}
 	// n.broadcastComm(b.Height, round, pm)
 	data := types.Encode(pm)
@@ -419,7 +592,7 @@ func (n *node) prepareOK(height int64) bool {
 	if height < 10 {
 		return true
 	}
-	return n.IsCaughtUp() && /*n.allCount(height) > 0 &&*/ n.queryTicketCount(n.myAddr, height-10) > 0
+	return n.IsCaughtUp() && /*n.allCount(height) > 0 &&*/ n.queryTicketCount(n.mySortAddr(height), height-10) > 0
 }
 
 func (n *node) checkBlock(b, pb *types.Block) error {
@@ -433,7 +606,7 @@ func (n *node) checkBlock(b, pb *types.Block) error {
 	if len(b.Txs) == 0 {
 		return fmt.Errorf("nil block error")
 	}
-	if b.Txs[0].From() == n.myAddr {
+	if n.isMyAddr(b.Txs[0].From()) {
 		return nil
 	}
 
@@ -446,8 +619,17 @@ func (n *node) checkBlock(b, pb *types.Block) error {
 }
 
 func (n *node) runVerifyVotes() {
-	for i := 0; i < 8; i++ {
+	num := 8
+	if n.conf.VerifyOSThreads {
+		num = verifyThreads()
+	}
+	plog.Debug("runVerifyVotes", "workers", num, "lockOSThread", n.conf.VerifyOSThreads)
+	for i := 0; i < num; i++ {
 		go func() {
+			if n.conf.VerifyOSThreads {
+				runtime.LockOSThread()
+				defer runtime.UnlockOSThread()
+			}
 			for v := range n.vCh {
 				v.ch <- v.v.Verify()
 			}
@@ -455,7 +637,50 @@ func (n *node) runVerifyVotes() {
 	}
 }
 
+// verifyThreads 绑定系统线程时验证协程的数量, 留一个P给网络和其他协程
+func verifyThreads() int {
+	num := runtime.GOMAXPROCS(0) - 1
+	if num < 1 {
+		num = 1
+	}
+	return num
+}
+
+// verifyWorkers 区块的检查项并行验证时最多额外使用的协程数, 0是verifyThreads(), 小于0不并行
+func verifyWorkers(num int) int {
+	if num < 0 {
+		return 0
+	}
+	if num == 0 {
+		return verifyThreads()
+	}
+	return num
+}
+
+// defaultInlineVerify 少于这么多投票时直接在当前协程验证, 不交给验证协程池.
+// 一次bls验证十几毫秒, 协程池的开销只有几微秒, 多核时2个投票就值得并行
+const defaultInlineVerify = 2
+
+func (n *node) inlineVerify() int {
+	if n.conf.InlineVerify < 0 {
+		return 0
+	}
+	if n.conf.InlineVerify == 0 {
+		return defaultInlineVerify
+	}
+	return n.conf.InlineVerify
+}
+
 func (n *node) verifyVotes(vs []*pt.Pos33VoteMsg) bool {
+	if len(vs) < n.inlineVerify() {
+		for _, v := range vs {
+			if !v.Verify() {
+				return false
+			}
+		}
+		return true
+	}
+
 	ch := make(chan bool, len(vs))
 	defer close(ch)
 	k := 0
@@ -505,14 +730,14 @@ func (n *node) checkVotes(vs []*pt.Pos33VoteMsg, ty int, hash []byte, h int64, c
 
 	if !n.verifyVotes(vs) {
 		plog.Error("verifyVotes error", "height", height)
-		return errors.New("verifyVotes error")
+		return fmt.Errorf("%w: verifyVotes error", errInvalidVote)
 	}
 
 	for _, v := range vs {
 		ht := v.Sort.Proof.Input.Height
 		rd := v.Sort.Proof.Input.Round
 		if ht != height || rd != round {
-			return errors.New("checkVotes error: height, round or num NOT same")
+			return fmt.Errorf("%w: checkVotes error: height, round or num NOT same", errInvalidVote)
 		}
 		err := n.checkVote(v, hash, ty)
 		if err != nil {
@@ -535,7 +760,13 @@ func (n *node) getMinerList() []string {
 
 func (n *node) checkVote(v *pt.Pos33VoteMsg, hash []byte, ty int) error {
 	if string(v.Hash) != string(hash) {
-		return errors.New("vote hash NOT right")
+		return fmt.Errorf("%w: vote hash NOT right", errInvalidVote)
+	}
+
+	// 先验证抽签, 通过以后才查询绑定
+	err := n.checkSort(v.Sort, Committee)
+	if err != nil {
+		return err
 	}
 
 	blsAddr := address.PubKeyToAddr(ethID, v.Sig.Pubkey)
@@ -550,12 +781,15 @@ func (n *node) checkVote(v *pt.Pos33VoteMsg, hash []byte, ty int) error {
 		addr = msg.(*types.ReplyString).Data
 		n.blsMp[blsAddr] = addr
 	}
-	sortAddr := address.PubKeyToAddr(ethID, v.Sort.Proof.Pubkey)
+	// 绑定记录的是链上的矿工地址, 和抽签使用相同的地址格式
+	sortAddr, err := n.sortOwner(v.Sort.GetProof().GetInput().GetHeight(), v.Sort.GetProof().GetPubkey())
+	if err != nil {
+		return err
+	}
 	if addr != sortAddr {
 		return errors.New("Pos33BindAddr NOT match")
 	}
-
-	return n.checkSort(v.Sort, Committee)
+	return nil
 }
 
 func (n *node) blockCheck(b *types.Block) error {
@@ -570,31 +804,26 @@ func (n *node) blockCheck(b *types.Block) error {
 		return err
 	}
 
-	act, err := getMiner(b)
-	if act == nil || err != nil {
-		plog.Error("block check getMiner error", "err", err, "height", b.Height)
+	cp := n.getCheckpoint()
+	trusted, err := cp.trusted(b, n.GetAPI().GetConfig())
+	if err != nil {
+		plog.Error("blockCheck error", "err", err, "height", height, "checkpoint", cp.height)
 		return err
 	}
-	if act.Sort == nil || act.Sort.Proof == nil || act.Sort.Proof.Input == nil {
-		return fmt.Errorf("miner tx error")
-	}
-	if len(act.BlsPkList) < pt.Pos33VoterSize/2+1 {
-		return fmt.Errorf("NOT enought votes")
+	if trusted {
+		plog.Debug("block check skipped below checkpoint", "height", b.Height)
+		return nil
 	}
-	round := int(act.Sort.Proof.Input.Round)
 
-	plog.Info("block check", "height", b.Height, "from", b.Txs[0].From()[:16])
-	err = n.checkSort(act.Sort, Committee)
+	r := n.VerifyBlockConsensus(b)
+	err = r.Err()
 	if err != nil {
-		plog.Error("blockCheck error", "err", err, "height", b.Height, "round", round)
+		plog.Error("blockCheck error", "err", err, "result", r.String())
+		n.pushEvent(pt.Pos33EventVerifyFailed, b.Height, r.Round, b.Txs[0].From(), 0, err)
 		return err
 	}
-
-	if round >= 3 {
-		return nil
-	}
-
-	return act.Verify()
+	plog.Info("block check", "height", b.Height, "round", r.Round, "from", b.Txs[0].From()[:16])
+	return nil
 }
 
 func getMinerSeed(b *types.Block) ([]byte, error) {
@@ -612,10 +841,13 @@ func getMinerSeed(b *types.Block) ([]byte, error) {
 
 var zeroHash [32]byte
 
+var errBlockSeed = errors.New("block seed NOT match")
+
 func (n *node) reSortition(height int64, round int) bool {
-	b, err := n.RequestBlock(height - pt.Pos33SortBlocks)
+	sh := n.sortHeight(height)
+	b, err := n.RequestBlock(sh)
 	if err != nil {
-		plog.Error("requestBlock error", "height", height-pt.Pos33SortBlocks, "error", err)
+		plog.Error("requestBlock error", "height", sh, "error", err)
 		return false
 	}
 	seed, err := getMinerSeed(b)
@@ -623,18 +855,24 @@ func (n *node) reSortition(height int64, round int) bool {
 		plog.Error("reSortition error", "height", height, "round", round, "err", err)
 		return false
 	}
-	n.sortCommittee(seed, height, round)
+	// 超时重新抽签可能很频繁, 交给resorter合并后计算
+	n.resort.trigger(seed, height, round)
 	return true
 }
 
 func (n *node) sortition(b *types.Block, round int) {
+	tm := n.newSortTimer()
 	seed, err := getMinerSeed(b)
-	height := b.Height + pt.Pos33SortBlocks
+	tm.stage(stageSeed)
 	if err != nil {
-		plog.Error("reSortition error", "height", height, "round", round, "err", err)
+		plog.Error("reSortition error", "height", b.Height, "round", round, "err", err)
 		return
 	}
-	n.sortCommittee(seed, height, round)
+	// 快照高度是b.Height的所有高度, sortBlocks改变时可能没有或者有两个
+	for _, height := range n.sortHeights(b.Height) {
+		n.handleMySorts(n.timedSorts(context.Background(), seed, height, round, tm))
+		tm = nil
+	}
 }
 
 func (n *node) firstSortition() {
@@ -647,14 +885,52 @@ func (n *node) firstSortition() {
 }
 
 func (n *node) sortCommittee(seed []byte, height int64, round int) {
-	ss := n.committeeSort(seed, height, round, Committee)
+	n.handleMySorts(n.mySorts(context.Background(), seed, height, round))
+}
+
+func (n *node) mySorts(ctx context.Context, seed []byte, height int64, round int) *sortResult {
+	return n.timedSorts(ctx, seed, height, round, nil)
+}
+
+// timedSorts 我的抽签结果, ctx取消时结果不完整
+func (n *node) timedSorts(ctx context.Context, seed []byte, height int64, round int, tm *sortTimer) *sortResult {
+	r := &sortResult{seed: seed, height: height, round: round, tm: tm}
+	r.ss = n.timedCommitteeSort(ctx, seed, height, round, Committee, tm)
+	return r
+}
+
+// groupMySorts 按挖矿地址和子委员会分组发送, 收到的一组抽签必须是同一个公钥的
+func groupMySorts(ss []*pt.Pos33SortMsg) []*pt.Pos33Sorts {
+	var pss []*pt.Pos33Sorts
+	group := make(map[string]*pt.Pos33Sorts)
+	for _, s := range ss {
+		key := fmt.Sprintf("%x-%d", s.Proof.Pubkey, s.SortHash.Num)
+		ps, ok := group[key]
+		if !ok {
+			ps = &pt.Pos33Sorts{}
+			group[key] = ps
+			pss = append(pss, ps)
+		}
+		ps.Sorts = append(ps.Sorts, s)
+	}
+	return pss
+}
+
+func (n *node) handleMySorts(r *sortResult) {
+	height, round, ss := r.height, r.round, r.ss
 	if len(ss) == 0 {
 		return
 	}
+	pss := groupMySorts(ss)
 	c := n.getCommittee(height, round)
+	// 所有子委员会的抽签都可以选进委员会, 都是出块人候选
 	c.myss = ss
 	plog.Info("sortCommittee", "height", height, "round", round, "ss len", len(ss))
-	n.sendCommitteeerSort([]*pt.Pos33Sorts{{Sorts: ss}}, height, round, int(pt.Pos33Msg_VS))
+	n.pushEvent(pt.Pos33EventWonSeats, height, round, n.myAddr, len(ss), nil)
+	for _, ps := range pss {
+		n.wal.add(&pt.Pos33WalRecord{Height: height, Round: int32(round), Ty: walSorts, Myself: true, Sorts: ps.Sorts}, false)
+	}
+	n.sendCommitteeerSort(pss, height, round, int(pt.Pos33Msg_VS), r.tm)
 }
 
 func (n *node) getSortSeed(height int64) ([]byte, error) {
@@ -669,12 +945,44 @@ func (n *node) getSortSeed(height int64) ([]byte, error) {
 	return getMinerSeed(sb)
 }
 
-func (n *node) getDiff(height int64, round int) float64 {
-	height -= pt.Pos33SortBlocks
-	w := n.allCount(height)
-	size := pt.Pos33CommitteeSize
-	diff := float64(size) / float64(w)
-	return diff
+// calcSeed 返回height高度抽签的seed, 由sortHeight(height)高度区块的miner抽签决定
+func (n *node) calcSeed(height int64) ([]byte, error) {
+	return n.getSortSeed(n.sortHeight(height))
+}
+
+// checkBlockSeed 检查区块miner交易里记录的抽签seed和计算出的seed一致
+func checkBlockSeed(b *types.Block, seed []byte) error {
+	m, err := getMiner(b)
+	if err != nil {
+		return err
+	}
+	if m.Sort == nil || m.Sort.Proof == nil || m.Sort.Proof.Input == nil {
+		return fmt.Errorf("miner tx error")
+	}
+	input := m.Sort.Proof.Input
+	if input.Height != b.Height {
+		return fmt.Errorf("block seed error, height NOT match: %d!=%d", input.Height, b.Height)
+	}
+	if string(input.Seed) != string(seed) {
+		return errBlockSeed
+	}
+	return nil
+}
+
+// getDiff height高度第round轮的抽签难度. ForkBlockCheck以后按抽签快照高度的状态里的全网票数计算.
+// 查询失败时返回错误, 不会因为本地缓存或者本地读取出错得到不同的难度
+func (n *node) getDiff(height int64, round int) (float64, error) {
+	if !n.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkBlockCheck") {
+		return n.diffV2(height, round, n.calcDiff(height, n.allCount(n.sortHeight(height))))
+	}
+	all, err := n.sortTotal(height)
+	if err != nil {
+		return 0, err
+	}
+	if all <= 0 {
+		return 0, fmt.Errorf("all count is %d, height %d", all, height)
+	}
+	return n.diffV2(height, round, n.calcDiff(height, int(all)))
 }
 
 func (n *node) handleVoterSorts(ms []*pt.Pos33Sorts, myself bool, ty int) {
@@ -698,87 +1006,122 @@ func (n *node) handleVoterSort(ss []*pt.Pos33SortMsg, myself bool, ty int) bool
 		return false
 	}
 
+	if !myself {
+		n.checkDuplicateKey(s0.Proof.Pubkey, height)
+	}
+
 	round := int(s0.Proof.Input.Round)
 	num := int(s0.SortHash.Num)
-	if num >= 3 {
-		plog.Error("handleVoterSort error: sort num >=3", "height", height, "round", round, "num", num, "addr", address.PubKeyToAddr(ethID, s0.Proof.Pubkey)[:16])
+	if num < 0 || num >= n.subCommittees(height) {
+		plog.Error("handleVoterSort error: sort num NOT exist", "height", height, "round", round, "num", num, "addr", address.PubKeyToAddr(ethID, s0.Proof.Pubkey)[:16])
 		return false
 	}
 
 	comm := n.getCommittee(height, round)
+	css := comm.css
+	if num > 0 {
+		css = comm.subss[int32(num)]
+		if css == nil {
+			css = make(map[string]*pt.Pos33SortMsg)
+			comm.subss[int32(num)] = css
+		}
+	}
 
-	for _, s := range comm.css {
+	for _, s := range css {
 		if string(s.Proof.Pubkey) == string(s0.Proof.Pubkey) {
 			return true
 		}
 	}
 
 	for _, s := range ss {
-		comm.css[string(s.SortHash.Hash)] = s
+		if int(s.SortHash.Num) != num {
+			plog.Error("handleVoterSort error: sort num NOT same", "height", height, "round", round, "num", num)
+			return false
+		}
+	}
+	if err := checkDistinctIndices(ss); err != nil {
+		plog.Error("handleVoterSort error", "err", err, "height", height, "round", round, "addr", address.PubKeyToAddr(ethID, s0.Proof.Pubkey)[:16])
+		return false
+	}
+	for _, s := range ss {
+		css[string(s.SortHash.Hash)] = s
+	}
+	if !myself {
+		n.wal.add(&pt.Pos33WalRecord{Height: height, Round: int32(round), Ty: walSorts, Sorts: ss}, false)
 	}
-	plog.Info("handleVoterSort", "all", len(comm.css), "nvs", len(ss), "height", height, "round", round, "num", num, "ty", ty, "addr", address.PubKeyToAddr(ethID, s0.Proof.Pubkey)[:16])
+	plog.Info("handleVoterSort", "all", len(css), "nvs", len(ss), "height", height, "round", round, "num", num, "ty", ty, "addr", address.PubKeyToAddr(ethID, s0.Proof.Pubkey)[:16])
 	return true
 }
 
-func (n *node) handleVoteMsg(ms []*pt.Pos33VoteMsg, myself bool, ty int) {
+// handleVoteMsg 处理收到的投票
+func (n *node) handleVoteMsg(ms []*pt.Pos33VoteMsg, myself bool, ty int) error {
 	if len(ms) == 0 {
-		return
+		return nil
 	}
 	m0 := ms[0]
-	if m0.Sort == nil || m0.Sort.Proof == nil || m0.Sort.Proof.Input == nil {
-		return
+	if m0.Sort == nil || m0.Sort.Proof == nil || m0.Sort.Proof.Input == nil || m0.Sort.SortHash == nil {
+		return fmt.Errorf("%w: vote sort is nil", errInvalidMsg)
+	}
+	for _, m := range ms {
+		if m.GetSort().GetProof().GetInput() == nil || m.Sort.SortHash == nil || m.Sig == nil {
+			return fmt.Errorf("%w: vote sort is nil", errInvalidMsg)
+		}
 	}
 
 	height := m0.Sort.Proof.Input.Height
 	round := int(m0.Sort.Proof.Input.Round)
 	num := int(m0.Sort.SortHash.Num)
 	if num >= 1 {
-		return
+		return nil
 	}
 
 	if n.lastBlock().Height > height {
-		return
-	}
-
-	if len(ms) == 0 {
-		return
+		return nil
 	}
 
 	err := n.checkVotes(ms, ty, m0.Hash, height, false, true)
 	if err != nil {
 		plog.Error("checkVotes error", "err", err, "height", height)
-		return
+		return err
 	}
 
 	comm := n.getCommittee(height, round)
 	for _, m := range ms {
 		if m.Round != m0.Round {
-			return
+			return fmt.Errorf("%w: vote round NOT same", errInvalidMsg)
 		}
 		if string(m.Hash) != string(m0.Hash) {
-			return
+			return fmt.Errorf("%w: vote hash NOT same", errInvalidMsg)
 		}
 
+		n.checkEquivocation(comm, m)
 		comm.bvmp[string(m.Hash)] = append(comm.bvmp[string(m.Hash)], m)
 	}
+	if !myself {
+		n.wal.add(&pt.Pos33WalRecord{Height: height, Round: int32(round), Ty: walVotes, Votes: ms}, false)
+	}
 
 	plog.Info("handleVoteMsg", "height", height, "round", round, "nvs", len(ms), "hash", common.HashHex(m0.Hash)[:16], "bvmp", len(comm.bvmp[string(m0.Hash)]))
 
 	if height == 0 || n.GetCurrentHeight() >= height {
-		return
+		return nil
 	}
 
 	vs := comm.bvmp[string(m0.Hash)]
+	if len(vs) > pt.Pos33VoterSize/2 && len(vs)-len(ms) <= pt.Pos33VoterSize/2 {
+		n.pushEvent(pt.Pos33EventQuorum, height, round, "", len(vs), nil)
+	}
 	if len(vs) > pt.Pos33VoterSize/2 {
 		myS := comm.myCandidataeSort()
 		if myS == nil {
-			return
+			return nil
 		}
 		if string(m0.Hash) == string(myS.SortHash.Hash) {
 			comm.makerIsMe = true
 			n.makeBlock(height, round)
 		}
 	}
+	return nil
 }
 
 func (co *committee) myCandidataeSort() *pt.Pos33SortMsg {
@@ -853,10 +1196,14 @@ func (n *node) preMakeBlock(height int64, round int) (*types.Block, error) {
 		BlockTime:  int64(round),       // use BlokeTime for round
 	}
 
-	sig := n.priv.Sign(types.Encode(nb))
+	priv := n.signKeyOf(address.PubKeyToAddr(ethID, sort.Proof.Pubkey), signer.KindPreBlock, height, round)
+	sig := priv.Sign(types.Encode(nb))
+	if sig.IsZero() {
+		return nil, fmt.Errorf("preMakeBlock error: sign failed. height=%d, round=%d", height, round)
+	}
 	nb.Signature = &types.Signature{
 		Signature: sig.Bytes(),
-		Pubkey:    n.priv.PubKey().Bytes(),
+		Pubkey:    priv.PubKey().Bytes(),
 		Ty:        types.EncodeSignID(types.SECP256K1, ethID),
 	}
 
@@ -869,7 +1216,7 @@ func (n *node) makePreBlock(height int64, round int) {
 		return
 	}
 	comm.preMaked = true
-	comm.setCommittee(height)
+	comm.setCommittee(height, round)
 
 	myss := comm.myss
 	if len(myss) == 0 {
@@ -893,6 +1240,9 @@ func (n *node) handleBlockMsg(m *pt.Pos33BlockMsg, myself bool) {
 	comm := n.getCommittee(m.B.Height, round)
 	hash := m.B.TxHash
 	comm.bmp[string(hash)] = m.B
+	if !myself {
+		n.wal.add(&pt.Pos33WalRecord{Height: m.B.Height, Round: int32(round), Ty: walBlock, Block: m}, false)
+	}
 	if len(comm.bmp) == 1 {
 		time.AfterFunc(time.Millisecond*700, func() {
 			n.vbch <- hr{m.B.Height, round}
@@ -904,21 +1254,18 @@ func (n *node) handleBlockMsg(m *pt.Pos33BlockMsg, myself bool) {
 	plog.Info("handleBlockMsg", "height", m.B.Height, "hash", common.HashHex(hash)[:16])
 }
 
-func (n *node) handleCommittee(m *pt.Pos33SortsVote, self bool) {
-	if !self && !m.Verify() {
-		plog.Error("handleVotesCount error, signature verify false")
-		return
+// checkCommittee 检查委员会成员消息里不依赖本地状态的部分, self为false时验证签名
+func checkCommittee(m *pt.Pos33SortsVote, self bool) error {
+	if !self && (m.Sig == nil || !m.Verify()) {
+		return fmt.Errorf("%w: committee signature verify false", errInvalidSort)
+	}
+	err := checkDistinctIndices(m.MySorts)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errInvalidSort, err)
 	}
-
-	height := m.Height
 	for _, s := range m.MySorts {
-		if string(m.Sig.Pubkey) != string(s.Proof.Pubkey) {
-			return
-		}
-		err := n.checkSort(s, Committee)
-		if err != nil {
-			plog.Error("checkSort error", "err", err, "height", height)
-			return
+		if s.GetProof().GetInput() == nil || s.SortHash == nil || m.Sig == nil || string(m.Sig.Pubkey) != string(s.Proof.Pubkey) {
+			return fmt.Errorf("%w: committee sort NOT match signer", errInvalidSort)
 		}
 		found := false
 		for _, h := range m.SelectSorts {
@@ -928,51 +1275,76 @@ func (n *node) handleCommittee(m *pt.Pos33SortsVote, self bool) {
 			}
 		}
 		if !found {
-			return
+			return fmt.Errorf("%w: committee sort NOT selected", errInvalidSort)
 		}
 	}
+	return nil
+}
+
+// handleCommittee 处理收到的委员会成员消息, 抽签验证通过以后给选择的抽签计票
+func (n *node) handleCommittee(m *pt.Pos33SortsVote, self bool) error {
+	height := m.Height
+	err := checkCommittee(m, self)
+	if err != nil {
+		plog.Error("handleCommittee error", "err", err, "height", height)
+		return err
+	}
+	err = n.checkSorts(height, m.MySorts, Committee)
+	if err != nil {
+		plog.Error("checkSort error", "err", err, "height", height)
+		return err
+	}
 	round := int(m.Round)
 	comm := n.getCommittee(height, round)
 	for _, h := range m.SelectSorts {
 		comm.svmp[string(h)] += len(m.MySorts)
 	}
+	if !self {
+		n.wal.add(&pt.Pos33WalRecord{Height: height, Round: int32(round), Ty: walCommittee, Committee: m}, false)
+	}
 	plog.Info("handleCommittee", "nsvmp", len(comm.svmp), "nvs", len(m.MySorts), "height", height, "addr", address.PubKeyToAddr(ethID, m.Sig.Pubkey)[:16], "time", time.Now().Format("15:04:05.00000"))
+	return nil
 }
 
 func (n *node) voteCommittee(height int64, round int) {
 	comm := n.getCommittee(height, round)
-	css := getSorts(comm.css, pt.Pos33VoterSize)
+	css := comm.shardSorts(height, pt.Pos33VoterSize, nil)
 
 	var ss [][]byte
 	for _, s := range css {
 		ss = append(ss, s.SortHash.Hash)
 	}
 
-	myss := getMySorts(n.myAddr, css)
-	if len(myss) == 0 {
-		return
-	}
+	// 每个挖矿地址分别用自己的私钥签名投票
+	for _, k := range n.minerKeys() {
+		myss := getMySorts(k.addr, css)
+		if len(myss) == 0 {
+			continue
+		}
 
-	m := &pt.Pos33SortsVote{
-		MySorts:     myss,
-		SelectSorts: ss,
-		Height:      height,
-		Round:       int32(round),
-	}
-	m.Sign(n.getPriv())
+		m := &pt.Pos33SortsVote{
+			MySorts:     myss,
+			SelectSorts: ss,
+			Height:      height,
+			Round:       int32(round),
+		}
+		signSortsVote(m, n.signKeyOf(k.addr, signer.KindCommittee, height, round))
 
-	plog.Info("voteCommittee", "height", height, "nmySelect", len(ss), "nv", len(m.MySorts))
-	n.handleCommittee(m, true)
+		plog.Info("voteCommittee", "height", height, "nmySelect", len(ss), "nv", len(m.MySorts), "addr", k.addr[:16])
+		n.wal.add(&pt.Pos33WalRecord{Height: height, Round: int32(round), Ty: walCommittee, Myself: true, Committee: m}, false)
+		n.handleCommittee(m, true)
 
-	pm := &pt.Pos33Msg{
-		Data: types.Encode(m),
-		Ty:   pt.Pos33Msg_CV,
+		pm := &pt.Pos33Msg{
+			Data: types.Encode(m),
+			Ty:   pt.Pos33Msg_CV,
+		}
+		data := types.Encode(pm)
+		n.gss.gossip(n.topic+"/committee", data)
 	}
-	data := types.Encode(pm)
-	n.gss.gossip(n.topic+"/committee", data)
 }
 
-func signVotes(priv crypto.PrivKey, vs []*pt.Pos33VoteMsg) {
+// signVotes 签名投票, 返回签名成功的投票
+func signVotes(priv crypto.PrivKey, vs []*pt.Pos33VoteMsg) []*pt.Pos33VoteMsg {
 	ch := make(chan *pt.Pos33VoteMsg)
 	wg := new(sync.WaitGroup)
 	for i := 0; i < 8; i++ {
@@ -980,7 +1352,11 @@ func signVotes(priv crypto.PrivKey, vs []*pt.Pos33VoteMsg) {
 		go func() {
 			defer wg.Done()
 			for v := range ch {
-				v.Sign(priv)
+				if bs, ok := priv.(blsSigner); ok {
+					v.Sig = bs.SignBls(v.Hash)
+				} else {
+					v.Sign(priv)
+				}
 			}
 		}()
 	}
@@ -989,6 +1365,14 @@ func signVotes(priv crypto.PrivKey, vs []*pt.Pos33VoteMsg) {
 	}
 	close(ch)
 	wg.Wait()
+
+	var signed []*pt.Pos33VoteMsg
+	for _, v := range vs {
+		if v.Sig != nil {
+			signed = append(signed, v)
+		}
+	}
+	return signed
 }
 
 func (n *node) sendBlockVotes(mvs []*pt.Pos33VoteMsg, ty int) {
@@ -1002,22 +1386,43 @@ func (n *node) sendBlockVotes(mvs []*pt.Pos33VoteMsg, ty int) {
 	n.handleVoteMsg(mvs, true, ty)
 }
 
-func (n *node) checkSort(s *pt.Pos33SortMsg, ty int) error {
-	height := s.Proof.Input.Height
-	seed, err := n.getSortSeed(height - pt.Pos33SortBlocks)
+// checkSorts 验证height高度的多个抽签, 返回第一个错误, 每个失败的都推送事件
+func (n *node) checkSorts(height int64, ss []*pt.Pos33SortMsg, ty int) error {
+	seed, err := n.calcSeed(height)
 	if err != nil {
 		plog.Error("getSeed error", "err", err, "height", height)
 		return err
 	}
-	if s == nil {
-		return fmt.Errorf("sortMsg error")
+	var first error
+	for i, err := range n.verifySorts(height, ty, seed, ss) {
+		if err == nil {
+			continue
+		}
+		s := ss[i]
+		n.pushEvent(pt.Pos33EventVerifyFailed, height, int(s.GetProof().GetInput().GetRound()), n.pubkeyAddr(height, s.GetProof().GetPubkey()), 0, err)
+		if first == nil {
+			first = err
+		}
 	}
-	if s.Proof == nil || s.Proof.Input == nil || s.SortHash == nil {
+	return first
+}
+
+func (n *node) checkSort(s *pt.Pos33SortMsg, ty int) error {
+	if s == nil || s.Proof == nil || s.Proof.Input == nil || s.SortHash == nil {
 		return fmt.Errorf("sortMsg error")
 	}
+	height := s.Proof.Input.Height
+	seed, err := n.calcSeed(height)
+	if err != nil {
+		plog.Error("getSeed error", "err", err, "height", height)
+		return err
+	}
 
 	err = n.verifySort(height, ty, seed, s)
 	if err != nil {
+		// 验证失败的抽签可能是伪造的, 不查询绑定, 用公钥推导的地址
+		addr := n.pubkeyAddr(height, s.Proof.Pubkey)
+		n.pushEvent(pt.Pos33EventVerifyFailed, height, int(s.Proof.Input.Round), addr, 0, err)
 		return err
 	}
 	return nil
@@ -1032,64 +1437,64 @@ func unmarshal(b []byte) (*pt.Pos33Msg, error) {
 	return &pm, nil
 }
 
-func (n *node) handlePos33Msg(pm *pt.Pos33Msg) bool {
+// handlePos33Msg 处理收到的共识消息, 返回处理的错误. 错误可能依赖本地状态, 不用于扣分
+func (n *node) handlePos33Msg(pm *pt.Pos33Msg) error {
 	if pm == nil {
-		return false
+		return nil
 	}
 	switch pm.Ty {
 	case pt.Pos33Msg_BV:
 		var m pt.Pos33Votes
 		err := types.Decode(pm.Data, &m)
 		if err != nil {
-			plog.Error(err.Error())
-			return false
+			return fmt.Errorf("%w: %v", errInvalidMsg, err)
 		}
-		n.handleVoteMsg(m.Vs, false, int(pm.Ty))
+		return n.handleVoteMsg(m.Vs, false, int(pm.Ty))
 	case pt.Pos33Msg_VS:
 		var m pt.Pos33VoteSorts
 		err := types.Decode(pm.Data, &m)
 		if err != nil {
-			plog.Error(err.Error())
-			return false
+			return fmt.Errorf("%w: %v", errInvalidMsg, err)
 		}
 		n.handleVoterSorts(m.VoteSorts, false, int(pm.Ty))
 	case pt.Pos33Msg_B:
 		var m pt.Pos33BlockMsg
 		err := types.Decode(pm.Data, &m)
 		if err != nil {
-			plog.Error(err.Error())
-			return false
+			return fmt.Errorf("%w: %v", errInvalidMsg, err)
+		}
+		if m.B == nil {
+			return fmt.Errorf("%w: block is nil", errInvalidMsg)
 		}
 		n.handleBlockMsg(&m, false)
 	case pt.Pos33Msg_CV:
 		var m pt.Pos33SortsVote
 		err := types.Decode(pm.Data, &m)
 		if err != nil {
-			plog.Error(err.Error())
-			return false
+			return fmt.Errorf("%w: %v", errInvalidMsg, err)
 		}
-		n.handleCommittee(&m, false)
+		return n.handleCommittee(&m, false)
 	default:
-		panic("not support this message type")
+		return fmt.Errorf("%w: not support this message type %d", errInvalidMsg, pm.Ty)
 	}
-
-	return true
+	return nil
 }
 
 // handleGossipMsg multi-goroutine verify pos33 message
-func (n *node) handleGossipMsg() chan *pt.Pos33Msg {
+func (n *node) handleGossipMsg() chan *peerMsg {
 	num := 4
-	ch := make(chan *pt.Pos33Msg, num*128)
+	ch := make(chan *peerMsg, num*128)
 	for i := 0; i < num; i++ {
 		go func() {
 			for {
-				data := <-n.gss.C
-				pm, err := unmarshal(data)
+				m := <-n.gss.C
+				pm, err := unmarshal(m.data)
 				if err != nil {
 					plog.Error(err.Error())
 					continue
 				}
-				ch <- pm
+				m.pm = pm
+				ch <- m
 			}
 		}()
 	}
@@ -1123,7 +1528,7 @@ var pos33Topics = []string{
 
 func (n *node) voteBlock(height int64, round int) {
 	comm := n.getCommittee(height, round)
-	comm.setCommittee(height)
+	comm.setCommittee(height, round)
 
 	var hash []byte
 	for _, c := range comm.candidates {
@@ -1147,21 +1552,26 @@ func (n *node) voteBlock(height int64, round int) {
 		return
 	}
 
-	myss := getMySorts(n.myAddr, comm.comm)
-	if len(myss) == 0 {
-		return
-	}
-
 	var vs []*pt.Pos33VoteMsg
-	for _, mys := range myss {
-		v := &pt.Pos33VoteMsg{
-			Hash: hash,
-			Sort: mys,
+	for _, k := range n.minerKeys() {
+		var kvs []*pt.Pos33VoteMsg
+		for _, mys := range getMySorts(k.addr, comm.comm) {
+			v := &pt.Pos33VoteMsg{
+				Hash: hash,
+				Sort: mys,
+			}
+			kvs = append(kvs, v)
+		}
+		if len(kvs) == 0 {
+			continue
 		}
-		vs = append(vs, v)
+		vs = append(vs, signVotes(n.signKeyOf(k.addr, signer.KindVote, height, round), kvs)...)
+	}
+	if len(vs) == 0 {
+		return
 	}
-	signVotes(n.getPriv(), vs)
 	plog.Info("voteBlock", "height", height, "round", round, "hash", common.HashHex(hash)[:16], "nvs", len(vs))
+	n.wal.add(&pt.Pos33WalRecord{Height: height, Round: int32(round), Ty: walVotes, Myself: true, Votes: vs}, true)
 	n.sendBlockVotes(vs, int(pt.Pos33Msg_BV))
 }
 
@@ -1214,6 +1624,13 @@ func (n *node) runLoop() {
 	if priv == nil {
 		panic("can't go here")
 	}
+	if priv.Bytes() == nil {
+		// 远程签名时本地没有私钥, p2p使用临时的身份
+		priv, err = tempP2PKey()
+		if err != nil {
+			panic(err)
+		}
+	}
 
 	title := n.GetAPI().GetConfig().GetTitle()
 	n.topic = title + pos33Topic
@@ -1225,6 +1642,10 @@ func (n *node) runLoop() {
 	}
 
 	n.gss = newGossip2(priv, n.conf.ListenPort, ns, n.conf.ForwardServers, n.conf.ForwardPeers, topics...)
+	n.gss.setAllow(func(from string) bool {
+		return n.peers.allow(from, n.GetCurrentHeight(), time.Now())
+	})
+	n.gss.setValidator(n.validatePeerMsg)
 	msgch := n.handleGossipMsg()
 	if len(n.conf.BootPeers) > 0 {
 		n.gss.bootstrap(n.conf.BootPeers...)
@@ -1241,6 +1662,17 @@ func (n *node) runLoop() {
 	plog.Info("pos33 running... ", "last block height", lb.Height)
 	go n.runVerifyVotes()
 	go n.runSortition()
+	go n.resort.run()
+	if n.conf.CommitteeStoreFile != "" || n.conf.CommitteeStoreBytes > 0 {
+		go n.cstore.run()
+	}
+	// 重新加入共识以前, 恢复重启前还没有出块的高度的状态
+	rs, err := n.wal.load(lb.Height)
+	if err != nil {
+		plog.Error("wal load error", "err", err)
+	}
+	n.replayWal(rs)
+	n.wal.start()
 
 	isSync := n.IsCaughtUp()
 	syncTm := time.NewTicker(time.Second * 30)
@@ -1295,13 +1727,18 @@ func (n *node) runLoop() {
 			plog.Debug("pos33 consensus run loop stoped")
 			return
 		case msg := <-msgch:
-			n.handlePos33Msg(msg)
+			n.handlePeerMsg(msg)
 		case msg := <-n.gss.incoming:
-			n.handlePos33Msg(msg)
+			n.handlePeerMsg(msg)
 		case height := <-tch:
 			if height == n.lastBlock().Height+1 {
 				round++
+				n.rstate.enter(height, round, time.Now())
+				n.wal.add(&pt.Pos33WalRecord{Height: height, Round: int32(round), Ty: walRound}, false)
+				roundGauge.Update(int64(round))
+				roundTimeoutCounter.Inc(1)
 				plog.Info("block timeout", "height", height, "round", round)
+				n.pushEvent(pt.Pos33EventRound, height, round, "", 0, nil)
 				n.reSortition(height, round)
 				tt := time.Now()
 				time.AfterFunc(resortTimeout, func() {
@@ -1330,20 +1767,27 @@ func (n *node) runLoop() {
 					tch <- height
 				})
 			}
+		case r := <-n.resort.out:
+			n.handleMySorts(r)
 		case hr := <-n.vbch:
 			n.voteBlock(hr.h, hr.r)
 		case b := <-n.bch: // new block add to chain
 			// if b.Height < n.GetCurrentHeight() && b.Height > 10 {
 			// 	break
 			// }
-			round = 0
+			// 重启前下一个高度已经超时过几轮时, 从那一轮继续
+			round = n.wal.round(b.Height + 1)
+			n.rstate.enter(b.Height+1, round, time.Now())
+			roundGauge.Update(int64(round))
 			n.handleNewBlock(b)
+			n.pushBlockEvent(b)
 			d := blockD
 			if b.Height > 0 {
 				m, err := getMiner(b)
 				if err != nil {
 					panic("can't go here")
 				}
+				n.drift.observe(m.BlockTime, time.Now())
 				d = m.BlockTime + blockD - time.Now().UnixNano()/1000000
 				if d < 0 {
 					d = 0
@@ -1375,7 +1819,7 @@ func writeBlockVotes(b *types.Block, n *node) error {
 
 func (n *node) setCommittee(height int64, round int) {
 	comm := n.getCommittee(height, round)
-	comm.setCommittee(height)
+	comm.setCommittee(height, round)
 }
 
 func (n *node) handleNewBlock(b *types.Block) {
@@ -1398,8 +1842,17 @@ func (n *node) handleNewBlock(b *types.Block) {
 	n.setCommittee(b.Height+2, round)
 	n.makePreBlock(b.Height+2, round)
 	n.clear(b.Height)
+	n.vrfMemo.expire(b.Height)
+	n.forks.expire(b.Height)
 	plog.Debug("handleNewBlock cost", "height", b.Height, "cost", time.Since(tb))
 	if b.Height > 0 {
+		// 打包到区块的证据从池里删除以前, 先举报
+		n.reportSlash(b.Height)
+		if m, err := getMiner(b); err == nil {
+			n.evpool.remove(m.Evidences)
+		}
+		n.flushAudit(b)
+		n.wal.prune(b.Height)
 		err := writeBlockVotes(b, n)
 		if err != nil {
 			plog.Error("writeBlockVotes error", "err", err)
@@ -1407,7 +1860,7 @@ func (n *node) handleNewBlock(b *types.Block) {
 	}
 }
 
-func (n *node) sendCommitteeerSort(ss []*pt.Pos33Sorts, height int64, round, ty int) {
+func (n *node) sendCommitteeerSort(ss []*pt.Pos33Sorts, height int64, round, ty int, tm *sortTimer) {
 	m := &pt.Pos33VoteSorts{
 		VoteSorts: ss,
 	}
@@ -1415,6 +1868,13 @@ func (n *node) sendCommitteeerSort(ss []*pt.Pos33Sorts, height int64, round, ty
 		Data: types.Encode(m),
 		Ty:   pt.Pos33Msg_Ty(ty),
 	}
-	n.gss.gossip(n.topic+"/votersorts", types.Encode(pm))
+	data := types.Encode(pm)
+	tm.stage(stageEncode)
+	if tm != nil {
+		plog.Debug("sort latency", "height", height, "round", round, "total", tm.done())
+	}
+	n.sdelay.send(func() {
+		n.gss.gossip(n.topic+"/votersorts", data)
+	})
 	n.handleVoterSorts(ss, true, ty)
 }
@@ -0,0 +1,166 @@
+package pos33
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// newTestBlock 创建一个只包含miner交易的区块
+func newTestBlock(height int64, sort *pt.Pos33SortMsg) *types.Block {
+	act := &pt.Pos33TicketAction{
+		Value: &pt.Pos33TicketAction_Miner{Miner: &pt.Pos33MinerMsg{Sort: sort}},
+		Ty:    pt.Pos33TicketActionMiner,
+	}
+	tx := &types.Transaction{Execer: []byte(pt.Pos33TicketX), Payload: types.Encode(act)}
+	return &types.Block{Height: height, Txs: []*types.Transaction{tx}}
+}
+
+func TestCheckBlockSeed(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	n.setTestMiner(newTestPriv(t))
+
+	height := int64(100)
+	seed := []byte("authoritative seed")
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+
+	ss := n.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.NotEmpty(t, ss)
+	require.Nil(t, checkBlockSeed(newTestBlock(height, ss[0]), seed))
+
+	// 区块里的抽签使用了伪造的seed
+	fakeSeed := []byte("fabricated seed")
+	fs := n.committeeSort(context.Background(), fakeSeed, height, 0, Committee)
+	require.NotEmpty(t, fs)
+	require.Nil(t, n.verifySort(height, Committee, fakeSeed, fs[0]))
+	require.Equal(t, errBlockSeed, checkBlockSeed(newTestBlock(height, fs[0]), seed))
+	require.NotNil(t, n.verifySort(height, Committee, seed, fs[0]))
+
+	// 抽签高度和区块高度不一致
+	require.NotNil(t, checkBlockSeed(newTestBlock(height+1, ss[0]), seed))
+}
+
+func newTestVotes(t testing.TB, num int, height int64) []*pt.Pos33VoteMsg {
+	priv := newTestPriv(t)
+	var vs []*pt.Pos33VoteMsg
+	for i := 0; i < num; i++ {
+		v := &pt.Pos33VoteMsg{
+			Hash: hash2([]byte("block hash")),
+			Sort: &pt.Pos33SortMsg{
+				SortHash: &pt.SortHash{Index: int64(i)},
+				Proof:    &pt.HashProof{Input: &pt.VrfInput{Height: height}},
+			},
+		}
+		vs = append(vs, v)
+	}
+	signVotes(priv, vs)
+	return vs
+}
+
+func TestVerifyVotesOSThreads(t *testing.T) {
+	for _, locked := range []bool{false, true} {
+		n, _ := newTestNode(t, &subConfig{VerifyOSThreads: locked})
+		go n.runVerifyVotes()
+
+		vs := newTestVotes(t, 20, 100)
+		require.True(t, n.verifyVotes(vs), "locked=%v", locked)
+
+		vs[7].Hash = hash2([]byte("other block hash"))
+		require.False(t, n.verifyVotes(vs), "locked=%v", locked)
+	}
+}
+
+// BenchmarkVerifyVotesJitter 测量大量验证时, 其他协程定时唤醒的延迟
+func BenchmarkVerifyVotesJitter(b *testing.B) {
+	for _, locked := range []bool{false, true} {
+		b.Run(fmt.Sprintf("lockOSThread-%v", locked), func(b *testing.B) {
+			n, _ := newTestNode(b, &subConfig{VerifyOSThreads: locked})
+			go n.runVerifyVotes()
+			vs := newTestVotes(b, 200, 100)
+
+			done := make(chan struct{})
+			lats := make(chan time.Duration, 1)
+			go func() {
+				var max time.Duration
+				for {
+					select {
+					case <-done:
+						lats <- max
+						return
+					default:
+					}
+					t := time.Now()
+					time.Sleep(time.Millisecond)
+					if d := time.Since(t) - time.Millisecond; d > max {
+						max = d
+					}
+				}
+			}()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				n.verifyVotes(vs)
+			}
+			b.StopTimer()
+			close(done)
+			b.ReportMetric(float64((<-lats).Microseconds()), "max-jitter-us")
+		})
+	}
+}
+
+func TestVerifyVotesInline(t *testing.T) {
+	// 没有启动验证协程池, 少量投票在当前协程验证
+	n, _ := newTestNode(t, &subConfig{InlineVerify: 5})
+	done := make(chan bool)
+	go func() {
+		vs := newTestVotes(t, 4, 100)
+		ok := n.verifyVotes(vs)
+		vs[2].Hash = hash2([]byte("other block hash"))
+		done <- ok && !n.verifyVotes(vs)
+	}()
+	select {
+	case ok := <-done:
+		require.True(t, ok)
+	case <-time.After(time.Second * 10):
+		t.Fatal("inline verify used the worker pool")
+	}
+
+	// 超过阈值时使用协程池, 结果和直接验证一致
+	go n.runVerifyVotes()
+	for _, num := range []int{1, 4, 5, 20} {
+		vs := newTestVotes(t, num, 100)
+		require.True(t, n.verifyVotes(vs), "num=%d", num)
+		vs[num-1].Hash = hash2([]byte("other block hash"))
+		require.False(t, n.verifyVotes(vs), "num=%d", num)
+	}
+
+	n.conf.InlineVerify = -1
+	require.Equal(t, 0, n.inlineVerify())
+	n.conf.InlineVerify = 0
+	require.Equal(t, defaultInlineVerify, n.inlineVerify())
+}
+
+// BenchmarkVerifyVotesInline 比较直接验证和协程池验证, 找到合适的阈值
+func BenchmarkVerifyVotesInline(b *testing.B) {
+	for _, inline := range []bool{true, false} {
+		conf := &subConfig{InlineVerify: -1}
+		if inline {
+			conf.InlineVerify = 1 << 20
+		}
+		n, _ := newTestNode(b, conf)
+		go n.runVerifyVotes()
+		for _, num := range []int{1, 2, 4, 8, 16} {
+			vs := newTestVotes(b, num, 100)
+			b.Run(fmt.Sprintf("inline-%v/votes-%d", inline, num), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					n.verifyVotes(vs)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package pos33
+
+import (
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+// 节点运行状态的指标, 和其他的指标一起通过MetricsPort导出
+var (
+	ticketMyCountGauge  = metrics.GetOrRegisterGauge("pos33/ticket/mycount", nil)
+	ticketAllCountGauge = metrics.GetOrRegisterGauge("pos33/ticket/allcount", nil)
+
+	committeeSizeGauge     = metrics.GetOrRegisterGauge("pos33/committee/size", nil)
+	committeeMySeatsGauge  = metrics.GetOrRegisterGauge("pos33/committee/myseats", nil)
+	committeeMemberCounter = metrics.GetOrRegisterCounter("pos33/committee/member", nil)
+
+	roundGauge          = metrics.GetOrRegisterGauge("pos33/round", nil)
+	roundTimeoutCounter = metrics.GetOrRegisterCounter("pos33/round/timeout", nil)
+
+	blockProposeTimer = metrics.GetOrRegisterTimer("pos33/block/propose", nil)
+	blockVerifyTimer  = metrics.GetOrRegisterTimer("pos33/block/verify", nil)
+	blockVerifyFailed = metrics.GetOrRegisterCounter("pos33/block/verify/failed", nil)
+)
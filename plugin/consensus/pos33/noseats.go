@@ -0,0 +1,81 @@
+package pos33
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+var errHasSeats = errors.New("no seats error: sort has seats")
+
+// countSeats 重新计算proof和count对应的中签数量
+func (n *node) countSeats(proof *pt.HashProof, count int64) (int, error) {
+	height := proof.Input.Height
+	diff, err := n.getDiff(height, int(proof.Input.Round))
+	if err != nil {
+		return 0, err
+	}
+	seats := 0
+	for num := 0; num < n.subCommittees(height); num++ {
+		seats += len(n.doSort(context.Background(), proof.VrfHash, int(count), num, diff, proof))
+	}
+	return seats, nil
+}
+
+// VerifyNoSeats 验证没有中签的证明
+func (n *node) VerifyNoSeats(m *pt.Pos33NoSeats) error {
+	if m == nil || m.Proof == nil || m.Proof.Input == nil {
+		return fmt.Errorf("no seats error: msg is nil")
+	}
+	seed, err := n.calcSeed(m.Proof.Input.Height)
+	if err != nil {
+		return err
+	}
+	return n.verifyNoSeats(seed, m)
+}
+
+func (n *node) verifyNoSeats(seed []byte, m *pt.Pos33NoSeats) error {
+	if m == nil || m.Proof == nil || m.Proof.Input == nil {
+		return fmt.Errorf("no seats error: msg is nil")
+	}
+	if !m.Verify() {
+		return fmt.Errorf("no seats error: signature verify failed")
+	}
+
+	input := m.Proof.Input
+	height := input.Height
+	if string(input.Seed) != string(seed) {
+		return fmt.Errorf("no seats error, seed NOT match")
+	}
+	err := n.checkProofSuite(height, m.Proof.VrfSuite)
+	if err != nil {
+		return err
+	}
+	err = vrfVerify(m.Proof.VrfSuite, m.Proof.Pubkey, types.Encode(input), m.Proof.VrfProof, m.Proof.VrfHash)
+	if err != nil {
+		return err
+	}
+
+	addr, err := n.sortOwner(height, m.Proof.Pubkey)
+	if err != nil {
+		return err
+	}
+	count, err := n.sortCount(addr, height)
+	if err != nil {
+		return err
+	}
+	if count != m.Count {
+		return fmt.Errorf("no seats error, count NOT match: %d!=%d, height %d", m.Count, count, height)
+	}
+	seats, err := n.countSeats(m.Proof, count)
+	if err != nil {
+		return err
+	}
+	if seats > 0 {
+		return errHasSeats
+	}
+	return nil
+}
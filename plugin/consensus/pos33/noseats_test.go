@@ -0,0 +1,93 @@
+package pos33
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yccproject/ycc/plugin/consensus/pos33/signer"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func TestNoSeats(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	priv := newTestPriv(t)
+	n.setTestMiner(priv)
+
+	height := int64(100)
+	seed := []byte("no seats seed")
+	// 全网票数很多, 10张票几乎不可能中签
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize*1000000000)
+
+	m := n.makeNoSeats(seed, height, 0, Committee)
+	require.NotNil(t, m)
+	require.Nil(t, n.verifyNoSeats(seed, m))
+
+	// 伪造票数
+	m.Count = 0
+	require.NotNil(t, n.verifyNoSeats(seed, m))
+	m.Count = 10
+	m.Sign(priv)
+	require.Nil(t, n.verifyNoSeats(seed, m))
+
+	// seed 不对
+	require.NotNil(t, n.verifyNoSeats([]byte("other seed"), m))
+}
+
+func TestNoSeatsFraud(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	priv := newTestPriv(t)
+	n.setTestMiner(priv)
+
+	height := int64(100)
+	seed := []byte("no seats seed")
+	// diff为1, 每张票都中签
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+	require.Nil(t, n.makeNoSeats(seed, height, 0, Committee))
+
+	ss := n.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.NotEmpty(t, ss)
+
+	// 抽中了却声称没有抽中
+	m := &pt.Pos33NoSeats{Proof: ss[0].Proof, Count: 10}
+	m.Sign(priv)
+	require.Equal(t, errHasSeats, n.verifyNoSeats(seed, m))
+
+	// 别人签名
+	m.Sign(newTestPriv(t))
+	require.NotNil(t, n.verifyNoSeats(seed, m))
+}
+
+// makeNoSeats 没有中签时, 生成一个签名的证明, 说明自己参与了抽签但是没有抽中.
+// 如果抽中了, 返回nil. 节点抽签时不生成, 只用来测试验证
+func (n *node) makeNoSeats(seed []byte, height int64, round, ty int) *pt.Pos33NoSeats {
+	priv := n.getPriv()
+	if priv == nil {
+		return nil
+	}
+	addr := n.sortAddr(height, priv.PubKey().Bytes())
+	count, err := n.sortCount(addr, height)
+	if err != nil {
+		plog.Error("no seats: count error", "err", err, "height", height, "addr", addr)
+		return nil
+	}
+	input := &pt.VrfInput{Seed: seed, Height: height, Round: int32(round), Ty: int32(ty)}
+	proof := n.makeProof(input, priv)
+	if proof == nil {
+		return nil
+	}
+	seats, err := n.countSeats(proof, count)
+	if err != nil {
+		plog.Error("no seats: diff error", "err", err, "height", height)
+		return nil
+	}
+	if seats > 0 {
+		return nil
+	}
+	m := &pt.Pos33NoSeats{Proof: proof, Count: count}
+	signNoSeats(m, n.signKey(signer.KindNoSeats, height, round))
+	if len(m.Sig.Signature) == 0 {
+		return nil
+	}
+	return m
+}
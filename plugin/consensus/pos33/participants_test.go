@@ -0,0 +1,61 @@
+package pos33
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func TestParticipants(t *testing.T) {
+	height := int64(100)
+	seed := []byte("participants seed")
+	sh := height - pt.Pos33SortBlocks
+
+	allowed := newTestPriv(t)
+	other := newTestPriv(t)
+	denied := newTestPriv(t)
+	addr := func(priv crypto.PrivKey) string { return address.PubKeyToAddr(ethID, priv.PubKey().Bytes()) }
+	aAddr, oAddr, dAddr := addr(allowed), addr(other), addr(denied)
+	cfg := testCfgString() + fmt.Sprintf("allowList=[\"%s\", \"%s\"]\ndenyList=[\"%s\"]\n", aAddr, dAddr, dAddr)
+
+	newNode := func() *node {
+		n, _ := newTestNodeCfg(t, cfg, nil)
+		n.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "ForkParticipants", height)
+		for _, a := range []string{aAddr, oAddr, dAddr} {
+			n.setTestCount(a, sh, 10, pt.Pos33CommitteeSize)
+		}
+		return n
+	}
+
+	a := newNode()
+	a.setTestMiner(allowed)
+	o := newNode()
+	o.setTestMiner(other)
+	d := newNode()
+	d.setTestMiner(denied)
+
+	// 不在allowList和在denyList中的节点不抽签
+	as := a.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.Equal(t, 10, len(as))
+	require.Empty(t, o.committeeSort(context.Background(), seed, height, 0, Committee))
+	require.Empty(t, d.committeeSort(context.Background(), seed, height, 0, Committee))
+
+	// 分叉之前不限制, 抽签有效, 但是在分叉之后的节点上验证不过
+	o.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "ForkParticipants", height+1)
+	oss := o.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.Equal(t, 10, len(oss))
+	require.Nil(t, o.verifySort(height, Committee, seed, oss[0]))
+	require.Nil(t, o.verifySort(height, Committee, seed, as[0]))
+	require.Equal(t, errParticipant, a.verifySort(height, Committee, seed, oss[0]))
+	require.Nil(t, a.verifySort(height, Committee, seed, as[0]))
+
+	d.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "ForkParticipants", height+1)
+	ds := d.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.Equal(t, 10, len(ds))
+	require.Equal(t, errParticipant, a.verifySort(height, Committee, seed, ds[0]))
+}
@@ -0,0 +1,366 @@
+package pos33
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	metrics "github.com/rcrowley/go-metrics"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// 节点计分的默认值
+const (
+	// 每个节点每个高度最多处理的共识消息数
+	defaultPeerMsgsPerHeight = 2000
+	// 扣分超过这么多时暂时禁止
+	defaultPeerBanScore = 100
+	// 禁止的时间(秒)
+	defaultPeerBanTime = 600
+	// 扣分的半衰期(秒)
+	defaultPeerScoreHalfLife = 300
+)
+
+// 每种无效消息的扣分, 签名验证失败最贵
+const (
+	invalidSortPenalty = 10
+	invalidVotePenalty = 10
+	invalidMsgPenalty  = 5
+)
+
+// 消息验证失败的类型, 用于给转发的节点扣分. 只用于不依赖本地状态的检查,
+// 依赖seed和票数的错误(比如vrf抽签验证失败, 本地还没有seed)各个节点可能不同, 不扣分
+var (
+	// 委员会成员消息的签名或者抽签和签名人不对
+	errInvalidSort = errors.New("invalid sort")
+	// 投票的签名或者字段不对
+	errInvalidVote = errors.New("invalid vote")
+	// 不能解码或者字段不完整
+	errInvalidMsg = errors.New("invalid message")
+)
+
+var (
+	peerBannedCounter  = metrics.GetOrRegisterCounter("pos33/peer/banned", nil)
+	peerDroppedCounter = metrics.GetOrRegisterCounter("pos33/peer/dropped", nil)
+	peerInvalidCounter = metrics.GetOrRegisterCounter("pos33/peer/invalid", nil)
+)
+
+// peerMsg 收到的共识消息和直接发给我们的节点, 从gossip收到时是转发的节点.
+// from为空是自己或者配置的转发服务器, 不计分
+type peerMsg struct {
+	from string
+	data []byte
+	pm   *pt.Pos33Msg
+	// gossip消息转发前已经由validateMsg检查过
+	checked bool
+}
+
+type peerStat struct {
+	invalidSorts int64
+	invalidVotes int64
+	invalidMsgs  int64
+	dropped      int64
+	bans         int64
+	score        float64
+	updated      time.Time
+	bannedUntil  time.Time
+	// 当前高度收到的消息数
+	height int64
+	msgs   int
+}
+
+// peerScores 给转发无效抽签, 投票的节点扣分, 每个高度限制每个节点的消息数.
+// 扣分随时间衰减, 超过banScore时banTime内不处理这个节点的消息
+type peerScores struct {
+	mu       sync.Mutex
+	peers    map[string]*peerStat
+	height   int64
+	maxMsgs  int
+	banScore float64
+	banTime  time.Duration
+	halfLife time.Duration
+}
+
+func newPeerScores(conf *subConfig) *peerScores {
+	ps := &peerScores{
+		peers:    make(map[string]*peerStat),
+		maxMsgs:  conf.PeerMsgsPerHeight,
+		banScore: float64(conf.PeerBanScore),
+		banTime:  time.Duration(conf.PeerBanTime) * time.Second,
+		halfLife: defaultPeerScoreHalfLife * time.Second,
+	}
+	if ps.maxMsgs == 0 {
+		ps.maxMsgs = defaultPeerMsgsPerHeight
+	}
+	if ps.banScore == 0 {
+		ps.banScore = defaultPeerBanScore
+	}
+	if ps.banTime <= 0 {
+		ps.banTime = defaultPeerBanTime * time.Second
+	}
+	return ps
+}
+
+// decay 把扣分衰减到now
+func (ps *peerScores) decay(st *peerStat, now time.Time) {
+	if dt := now.Sub(st.updated); dt > 0 && st.score > 0 {
+		st.score *= math.Pow(0.5, float64(dt)/float64(ps.halfLife))
+		if st.score < 0.01 {
+			st.score = 0
+		}
+	}
+	st.updated = now
+}
+
+func (ps *peerScores) get(from string, now time.Time) *peerStat {
+	st, ok := ps.peers[from]
+	if !ok {
+		st = &peerStat{updated: now}
+		ps.peers[from] = st
+	}
+	return st
+}
+
+// prune 高度变化时删除没有扣分, 也没有被禁止的节点
+func (ps *peerScores) prune(now time.Time) {
+	for k, st := range ps.peers {
+		ps.decay(st, now)
+		if st.score == 0 && !now.Before(st.bannedUntil) && st.invalidSorts+st.invalidVotes+st.invalidMsgs+st.dropped == 0 {
+			delete(ps.peers, k)
+		}
+	}
+}
+
+// allow 是否处理from在当前高度height时发来的消息, 被禁止或者超过这个高度的消息数时丢弃
+func (ps *peerScores) allow(from string, height int64, now time.Time) bool {
+	if from == "" {
+		return true
+	}
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if height > ps.height {
+		ps.height = height
+		ps.prune(now)
+	}
+	st := ps.get(from, now)
+	if now.Before(st.bannedUntil) {
+		return false
+	}
+	if st.height != height {
+		st.height = height
+		st.msgs = 0
+	}
+	st.msgs++
+	if ps.maxMsgs > 0 && st.msgs > ps.maxMsgs {
+		if st.msgs == ps.maxMsgs+1 {
+			plog.Warn("peer exceeds message limit", "peer", from, "height", height, "limit", ps.maxMsgs)
+		}
+		st.dropped++
+		peerDroppedCounter.Inc(1)
+		return false
+	}
+	return true
+}
+
+// banned from是否被禁止
+func (ps *peerScores) banned(from string, now time.Time) bool {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	st, ok := ps.peers[from]
+	return ok && now.Before(st.bannedUntil)
+}
+
+// penalty err对应的扣分, 不是无效消息的错误返回0
+func penalty(err error) float64 {
+	switch {
+	case errors.Is(err, errInvalidSort):
+		return invalidSortPenalty
+	case errors.Is(err, errInvalidVote):
+		return invalidVotePenalty
+	case errors.Is(err, errInvalidMsg):
+		return invalidMsgPenalty
+	}
+	return 0
+}
+
+// report 记录from发来的消息处理失败, 返回from是否因此被禁止
+func (ps *peerScores) report(from string, err error, now time.Time) bool {
+	p := penalty(err)
+	if from == "" || p == 0 {
+		return false
+	}
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	st := ps.get(from, now)
+	ps.decay(st, now)
+	switch {
+	case errors.Is(err, errInvalidSort):
+		st.invalidSorts++
+	case errors.Is(err, errInvalidVote):
+		st.invalidVotes++
+	default:
+		st.invalidMsgs++
+	}
+	peerInvalidCounter.Inc(1)
+	st.score += p
+	if ps.banScore < 0 || st.score < ps.banScore || now.Before(st.bannedUntil) {
+		return false
+	}
+	st.bannedUntil = now.Add(ps.banTime)
+	st.bans++
+	peerBannedCounter.Inc(1)
+	plog.Warn("ban peer", "peer", from, "score", st.score, "until", st.bannedUntil, "err", err)
+	return true
+}
+
+// scores 所有节点的计分, 按扣分从高到低排序
+func (ps *peerScores) scores(now time.Time) *pt.Pos33PeerScores {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	r := &pt.Pos33PeerScores{Height: ps.height, BanScore: int64(ps.banScore), MsgsPerHeight: int64(ps.maxMsgs)}
+	for k, st := range ps.peers {
+		ps.decay(st, now)
+		s := &pt.Pos33PeerScore{
+			Peer:         k,
+			InvalidSorts: st.invalidSorts,
+			InvalidVotes: st.invalidVotes,
+			InvalidMsgs:  st.invalidMsgs,
+			Dropped:      st.dropped,
+			Score:        st.score,
+			Banned:       now.Before(st.bannedUntil),
+			Bans:         st.bans,
+		}
+		if st.bans > 0 {
+			s.BannedUntil = st.bannedUntil.Unix()
+		}
+		r.Peers = append(r.Peers, s)
+	}
+	sort.Slice(r.Peers, func(i, j int) bool {
+		if r.Peers[i].Score != r.Peers[j].Score {
+			return r.Peers[i].Score > r.Peers[j].Score
+		}
+		return r.Peers[i].Peer < r.Peers[j].Peer
+	})
+	return r
+}
+
+// validateMsg 检查消息里不依赖本地状态的部分: 能否解码, 字段是否完整, 签名是否正确.
+// 所有诚实的节点检查的结果一样, 失败的消息不转发, 给发来的节点扣分
+func (n *node) validateMsg(pm *pt.Pos33Msg) error {
+	if pm == nil {
+		return fmt.Errorf("%w: message is nil", errInvalidMsg)
+	}
+	switch pm.Ty {
+	case pt.Pos33Msg_BV:
+		var m pt.Pos33Votes
+		err := types.Decode(pm.Data, &m)
+		if err != nil {
+			return fmt.Errorf("%w: %v", errInvalidMsg, err)
+		}
+		return n.validateVotes(m.Vs)
+	case pt.Pos33Msg_VS:
+		var m pt.Pos33VoteSorts
+		err := types.Decode(pm.Data, &m)
+		if err != nil {
+			return fmt.Errorf("%w: %v", errInvalidMsg, err)
+		}
+		for _, ss := range m.VoteSorts {
+			for _, s := range ss.GetSorts() {
+				if s.GetProof().GetInput() == nil || s.SortHash == nil {
+					return fmt.Errorf("%w: sort is nil", errInvalidMsg)
+				}
+			}
+		}
+	case pt.Pos33Msg_B:
+		var m pt.Pos33BlockMsg
+		err := types.Decode(pm.Data, &m)
+		if err != nil {
+			return fmt.Errorf("%w: %v", errInvalidMsg, err)
+		}
+		if m.B == nil {
+			return fmt.Errorf("%w: block is nil", errInvalidMsg)
+		}
+	case pt.Pos33Msg_CV:
+		var m pt.Pos33SortsVote
+		err := types.Decode(pm.Data, &m)
+		if err != nil {
+			return fmt.Errorf("%w: %v", errInvalidMsg, err)
+		}
+		return checkCommittee(&m, false)
+	default:
+		return fmt.Errorf("%w: not support this message type %d", errInvalidMsg, pm.Ty)
+	}
+	return nil
+}
+
+// validateVotes 检查一组投票的字段和签名
+func (n *node) validateVotes(vs []*pt.Pos33VoteMsg) error {
+	if len(vs) == 0 {
+		return nil
+	}
+	for _, v := range vs {
+		if v.GetSort().GetProof().GetInput() == nil || v.Sort.SortHash == nil || v.Sig == nil {
+			return fmt.Errorf("%w: vote sort is nil", errInvalidMsg)
+		}
+		in := v.Sort.Proof.Input
+		in0 := vs[0].Sort.Proof.Input
+		if in.Height != in0.Height || in.Round != in0.Round || v.Round != vs[0].Round || string(v.Hash) != string(vs[0].Hash) {
+			return fmt.Errorf("%w: votes height, round or hash NOT same", errInvalidVote)
+		}
+	}
+	if !n.verifyVotes(vs) {
+		return fmt.Errorf("%w: verifyVotes error", errInvalidVote)
+	}
+	return nil
+}
+
+// validatePeerMsg gossip转发前检查from发来的消息, 检查失败时给from扣分
+func (n *node) validatePeerMsg(from string, data []byte) bool {
+	pm, err := unmarshal(data)
+	if err != nil {
+		err = fmt.Errorf("%w: %v", errInvalidMsg, err)
+	} else {
+		err = n.validateMsg(pm)
+	}
+	if err != nil {
+		n.reportPeer(from, err)
+		return false
+	}
+	return true
+}
+
+// reportPeer 记录from发来的无效消息, 扣分超过banScore时断开连接
+func (n *node) reportPeer(from string, err error) {
+	plog.Error("invalid peer message", "err", err, "peer", from)
+	if n.peers.report(from, err, time.Now()) {
+		n.gss.blockPeer(from)
+	}
+}
+
+// handlePeerMsg 处理m.from发来的消息. 直接发来的消息没有经过gossip的检查, 先检查,
+// 无效的给m.from扣分. 处理时依赖本地状态的错误不扣分
+func (n *node) handlePeerMsg(m *peerMsg) {
+	if n.peers.banned(m.from, time.Now()) {
+		return
+	}
+	if !m.checked && m.from != "" {
+		err := n.validateMsg(m.pm)
+		if err != nil {
+			n.reportPeer(m.from, err)
+			return
+		}
+	}
+	err := n.handlePos33Msg(m.pm)
+	if err != nil {
+		plog.Error("handlePos33Msg error", "err", err, "peer", m.from)
+	}
+}
+
+// Query_GetPeerScores 查询转发共识消息的节点的计分和禁止情况
+func (client *Client) Query_GetPeerScores(req *types.ReqNil) (types.Message, error) {
+	return client.n.peers.scores(time.Now()), nil
+}
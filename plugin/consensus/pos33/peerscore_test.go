@@ -0,0 +1,147 @@
+package pos33
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func TestPeerScores(t *testing.T) {
+	ps := newPeerScores(&subConfig{PeerMsgsPerHeight: 3, PeerBanScore: 30, PeerBanTime: 60})
+	now := time.Now()
+
+	// 每个高度最多3个消息, 新的高度重新计数
+	for i := 0; i < 3; i++ {
+		require.True(t, ps.allow("a", 10, now))
+	}
+	require.False(t, ps.allow("a", 10, now))
+	require.True(t, ps.allow("b", 10, now))
+	// 没有扣分的节点在高度变化时删除
+	require.True(t, ps.allow("a", 11, now))
+	// 自己的消息不限制
+	for i := 0; i < 5; i++ {
+		require.True(t, ps.allow("", 11, now))
+	}
+
+	// 不是无效消息的错误不扣分
+	require.False(t, ps.report("a", errors.New("seed not found"), now))
+	require.False(t, ps.report("", errInvalidSort, now))
+	require.False(t, ps.report("a", errInvalidSort, now))
+	require.False(t, ps.report("a", errInvalidVote, now))
+	require.True(t, ps.report("a", errInvalidSort, now))
+	require.True(t, ps.banned("a", now))
+	require.False(t, ps.allow("a", 11, now))
+	// 禁止期间不重复禁止
+	require.False(t, ps.report("a", errInvalidMsg, now))
+
+	r := ps.scores(now)
+	require.Equal(t, int64(11), r.Height)
+	require.Equal(t, 1, len(r.Peers))
+	a := r.Peers[0]
+	require.Equal(t, "a", a.Peer)
+	require.Equal(t, int64(2), a.InvalidSorts)
+	require.Equal(t, int64(1), a.InvalidVotes)
+	require.Equal(t, int64(1), a.InvalidMsgs)
+	require.Equal(t, int64(1), a.Dropped)
+	require.Equal(t, int64(1), a.Bans)
+	require.True(t, a.Banned)
+	require.Equal(t, float64(35), a.Score)
+	require.Equal(t, now.Add(time.Minute).Unix(), a.BannedUntil)
+
+	// 禁止时间过了以后恢复, 扣分按半衰期衰减
+	later := now.Add(defaultPeerScoreHalfLife * time.Second)
+	require.False(t, ps.banned("a", later))
+	require.True(t, ps.allow("a", 12, later))
+	r = ps.scores(later)
+	require.False(t, r.Peers[0].Banned)
+	require.InDelta(t, 17.5, r.Peers[0].Score, 0.01)
+
+	// banScore小于0不禁止
+	ps = newPeerScores(&subConfig{PeerBanScore: -1})
+	for i := 0; i < 100; i++ {
+		require.False(t, ps.report("a", errInvalidSort, now))
+	}
+	require.False(t, ps.banned("a", now))
+}
+
+func TestHandlePeerMsg(t *testing.T) {
+	n, _ := newTestNode(t, &subConfig{PeerBanScore: 24})
+	n.setTestMiner(newTestPriv(t))
+
+	// 高度小于Pos33SortBlocks的seed是zeroHash
+	height := int64(15)
+	n.setTestCount(n.myAddr, n.sortHeight(height), 30, pt.Pos33CommitteeSize)
+	ss := n.committeeSort(context.Background(), zeroHash[:], height, 0, Committee)
+	require.True(t, len(ss) >= pt.Pos33MustVotes, len(ss))
+
+	newCommittee := func(ss []*pt.Pos33SortMsg, priv crypto.PrivKey) *pt.Pos33Msg {
+		m := &pt.Pos33SortsVote{Height: height, MySorts: ss}
+		for _, s := range ss {
+			m.SelectSorts = append(m.SelectSorts, s.SortHash.Hash)
+		}
+		m.Sign(priv)
+		return &pt.Pos33Msg{Data: types.Encode(m), Ty: pt.Pos33Msg_CV}
+	}
+
+	// 收到抽签和委员会投票以后可以选出委员会和出块候选人
+	comm := n.getCommittee(height, 0)
+	for _, s := range ss {
+		comm.css[string(s.SortHash.Hash)] = s
+	}
+	require.Nil(t, n.handlePos33Msg(newCommittee(ss, n.priv)))
+	comm.setCommittee(height, 0)
+	require.NotEmpty(t, comm.comm)
+	require.Equal(t, 1, len(comm.candidates))
+
+	// vrf验证失败依赖本地的seed和票数, 处理出错但是不扣分, 也不阻止转发
+	bad := types.Clone(ss[0]).(*pt.Pos33SortMsg)
+	bad.Proof.Input.Round = 1
+	vrfBad := newCommittee([]*pt.Pos33SortMsg{bad}, n.priv)
+	require.NotNil(t, n.handlePos33Msg(vrfBad))
+	require.Nil(t, n.validateMsg(vrfBad))
+	require.True(t, n.validatePeerMsg("relay peer", types.Encode(vrfBad)))
+	for i := 0; i < 5; i++ {
+		n.handlePeerMsg(&peerMsg{from: "relay peer", pm: vrfBad})
+	}
+	require.False(t, n.peers.banned("relay peer", time.Now()))
+
+	// 签名人和抽签不一致不依赖本地状态, 转发前拒绝并且扣分
+	forged := newCommittee(ss, newTestPriv(t))
+	err := n.validateMsg(forged)
+	require.True(t, errors.Is(err, errInvalidSort), err)
+	require.False(t, n.validatePeerMsg("bad peer", types.Encode(forged)))
+	require.False(t, n.validatePeerMsg("bad peer", []byte("bad data")))
+
+	pm := newCommittee(ss, n.priv)
+	var m pt.Pos33SortsVote
+	require.Nil(t, types.Decode(pm.Data, &m))
+	m.Height++
+	pm.Data = types.Encode(&m)
+	err = n.validateMsg(pm)
+	require.True(t, errors.Is(err, errInvalidSort), err)
+
+	// 不认识的消息类型不会panic
+	err = n.handlePos33Msg(&pt.Pos33Msg{Ty: 100})
+	require.True(t, errors.Is(err, errInvalidMsg), err)
+	err = n.validateMsg(&pt.Pos33Msg{Ty: pt.Pos33Msg_BV, Data: []byte("bad votes")})
+	require.True(t, errors.Is(err, errInvalidMsg), err)
+
+	// 直接发来的消息也检查, 扣分超过banScore以后禁止, 禁止以后的消息不再处理
+	n.handlePeerMsg(&peerMsg{from: "bad peer", pm: forged})
+	n.handlePeerMsg(&peerMsg{from: "good peer", pm: newCommittee(ss, n.priv)})
+	require.True(t, n.peers.banned("bad peer", time.Now()))
+	r, err := n.Query_GetPeerScores(&types.ReqNil{})
+	require.Nil(t, err)
+	scores := r.(*pt.Pos33PeerScores)
+	require.Equal(t, 1, len(scores.Peers))
+	require.Equal(t, "bad peer", scores.Peers[0].Peer)
+	require.Equal(t, int64(2), scores.Peers[0].InvalidSorts)
+	require.Equal(t, int64(1), scores.Peers[0].InvalidMsgs)
+	require.True(t, scores.Peers[0].Banned)
+}
@@ -3,7 +3,9 @@ package pos33
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/33cn/chain33/common"
@@ -31,10 +33,35 @@ type Client struct {
 	// clock  sync.Mutex
 	priv   crypto.PrivKey
 	myAddr string
+	// minerKeys配置的其他挖矿地址
+	extraKeys []*minerKey
 
 	mlock sync.Mutex
 	acMap map[int64]int
 	tcMap map[int64]map[string]int64
+	// 历史高度的票数缓存, 不用mlock
+	tcCache *ticketCountCache
+	// 最后更新票数的区块高度, 用于发现回滚
+	tcHeight int64
+	// 链上治理的共识参数, *pt.Pos33ChainParams
+	cparams atomic.Value
+	dsMap   map[int64]int // 保留更久的全网票数, 用于查询历史diff
+
+	// 区块的投票数, ForkDiffV2以后用于调整难度
+	csMap map[int64]int
+	// 快照高度 -> 难度调整的倍数
+	rtMap map[int64]float64
+	// 共识公钥+推导的地址 -> 绑定记录, ForkKeyRotate以后使用, 不用mlock
+	koCache *keyOwnerCache
+	// UseEntrust以后验证抽签使用的快照高度状态里的票数
+	scCache *sortCountCache
+	// ForkTicketPrice之后每个周期调整的票价, 不用mlock
+	tpCache *ticketPriceCache
+
+	sstats stakingStatsCache
+	probe  readyProbe
+	// MetricsPort的prometheus服务, 没有配置时为nil
+	msrv *http.Server
 
 	done chan struct{}
 }
@@ -59,6 +86,87 @@ type subConfig struct {
 	IssueTotal       int64            `json:"issueTotal,omitempty"`
 	// if true, you can't make block and only vote
 	OnlyVoter bool `json:"onlyVoter,omitempty"`
+	// if true, 验证协程绑定到独立的系统线程, 减少对网络协程的影响
+	VerifyOSThreads bool `json:"verifyOSThreads,omitempty"`
+	// 广播中签消息前的最大随机延迟(毫秒), 不超过500
+	SortBroadcastDelay int64 `json:"sortBroadcastDelay,omitempty"`
+	// vrf缓存保留最近多少个高度的结果, 默认100
+	VrfMemoMaxAge int64 `json:"vrfMemoMaxAge,omitempty"`
+	// 抽签协程的数量, 默认GOMAXPROCS, 不超过NumCPU的4倍
+	SortitionWorkers int `json:"sortitionWorkers,omitempty"`
+	// 等待抽签协程计算的队列长度(每个256张票), 默认是抽签协程数的2倍, 队列满了以后抽签等待
+	SortQueueSize int `json:"sortQueueSize,omitempty"`
+	// 少于这么多投票时不使用验证协程池, 默认2, 小于0总是使用协程池
+	InlineVerify int `json:"inlineVerify,omitempty"`
+	// 缓存多少个(地址, 高度)的票数, 只缓存落后当前高度至少Pos33SortBlocks的高度, 默认8192, 小于0不缓存
+	TicketCountCache int `json:"ticketCountCache,omitempty"`
+	// 影子检查的候选投票数量规则, 格式和quorumRule相同, 只记录会拒绝的区块到pos33/shadow/quorum, 不影响验证结果
+	ShadowQuorumRule string `json:"shadowQuorumRule,omitempty"`
+	// if true, ForkRoundEvidence之前也做轮次证据的影子检查, 记录到pos33/shadow/roundevidence
+	ShadowRoundEvidence bool `json:"shadowRoundEvidence,omitempty"`
+	// 本地时钟和区块时间相差超过多少毫秒时告警, 默认3000
+	ClockDriftWarn int64 `json:"clockDriftWarn,omitempty"`
+	// 区块的抽签, 投票, 票数这些互相独立的检查并行验证时最多额外使用多少个协程, 所有区块共用.
+	// 默认GOMAXPROCS-1, 小于0按顺序验证
+	VerifyWorkers int `json:"verifyWorkers,omitempty"`
+	// 委员会记录保存到这个文件, 重启后加载, 为空不保存
+	CommitteeStoreFile string `json:"committeeStoreFile,omitempty"`
+	// 保留最近多少个高度的委员会, 默认1000
+	CommitteeStoreBlocks int64 `json:"committeeStoreBlocks,omitempty"`
+	// 委员会记录最多占用多少字节, 超过时在后台清理最老的高度, 0不限制.
+	// 不会清理惩罚窗口内的高度
+	CommitteeStoreBytes int64 `json:"committeeStoreBytes,omitempty"`
+	// 达到ForkVrfSuite高度以后抽签使用的vrf算法, 必须是RegisterVrfBackend注册过的, 为空是secp256k1-SHA256.
+	// 验证时按proof里的算法选择, 不同节点可以使用不同的算法. ed25519-SHA512-TAI需要ed25519的共识私钥
+	VrfSuite string `json:"vrfSuite,omitempty"`
+	// 远程签名服务的地址(host:port或者unix:///path), 不为空时共识私钥不从钱包读取, vrf和签名都请求签名服务
+	RemoteSigner string `json:"remoteSigner,omitempty"`
+	// 每个签名请求的超时(毫秒), 默认1000
+	RemoteSignerTimeout int64 `json:"remoteSignerTimeout,omitempty"`
+	// 签名请求失败时的重试次数, 默认3, 小于0不重试
+	RemoteSignerRetries int `json:"remoteSignerRetries,omitempty"`
+	// 签名服务的token, 配置了RemoteSigner时必须和签名服务的相同
+	RemoteSignerToken string `json:"remoteSignerToken,omitempty"`
+	// 启动时导入共识缓存的快照文件(GetConsensusSnapshot导出的json), 为空不导入
+	SnapshotFile string `json:"snapshotFile,omitempty"`
+	// 信任的快照hash(hex), 不为空时快照的hash必须相同
+	SnapshotHash string `json:"snapshotHash,omitempty"`
+	// 信任的检查点高度和这个高度区块的hash, 委员会摘要(hex, GetCommitteeDigest查询), 至少配置一个.
+	// 检查点以下的区块不验证抽签和投票, 认为配置检查点的人给出的是主链, 为0不使用
+	CheckpointHeight int64  `json:"checkpointHeight,omitempty"`
+	CheckpointHash   string `json:"checkpointHash,omitempty"`
+	CheckpointDigest string `json:"checkpointDigest,omitempty"`
+	// 启动时导入的检查点文件(GetCheckpoint导出的json), 替换上面配置的检查点
+	CheckpointFile string `json:"checkpointFile,omitempty"`
+	// 可以签名检查点的验证者地址, 导入的检查点需要checkpointQuorum个签名, 默认超过2/3, 至少超过一半.
+	// 为空时不能用checkpointFile和ImportCheckpoint导入检查点
+	CheckpointSigners []string `json:"checkpointSigners,omitempty"`
+	CheckpointQuorum  int      `json:"checkpointQuorum,omitempty"`
+	// 保留最近多少个抽签和委员会事件, 供rpc推送, 默认1024
+	EventBufferSize int `json:"eventBufferSize,omitempty"`
+	// if true, ForkSlash之后把收集到的双重投票证据作为Pos33Slash交易发送, 得到举报奖励
+	SlashReport bool `json:"slashReport,omitempty"`
+	// if true, 记录从收到新区块到广播抽签消息各个阶段的耗时
+	SortLatency bool `json:"sortLatency,omitempty"`
+	// prometheus抓取指标的http端口, 地址是http://host:port/metrics, 为0不启动
+	MetricsPort int `json:"metricsPort,omitempty"`
+	// 同一个节点里挖矿的其他地址的私钥(hex), 每个地址分别抽签和投票, 奖励按地址分别计算.
+	// 不能重复, 也不能和其他节点的相同
+	MinerKeys []string `json:"minerKeys,omitempty"`
+	// 共识审计日志的目录, 每个高度的抽签和验证记录追加到这里, 用pos33 replay重新验证. 为空不记录
+	AuditDir string `json:"auditDir,omitempty"`
+	// 共识状态日志的目录, 默认./datadir/pos33wal. 重启后重放还没有出块的高度收到和发出的消息
+	WalDir string `json:"walDir,omitempty"`
+	// if true, 不记录共识状态日志
+	DisableWal bool `json:"disableWal,omitempty"`
+	// 每个节点每个高度最多处理多少个gossip共识消息, 超过的丢弃也不转发, 默认2000, 小于0不限制
+	PeerMsgsPerHeight int `json:"peerMsgsPerHeight,omitempty"`
+	// 转发签名错误或者不能解码的消息的节点扣分超过多少时暂时禁止, 默认100, 小于0不禁止. 扣分的半衰期是5分钟
+	PeerBanScore int64 `json:"peerBanScore,omitempty"`
+	// 禁止节点的时间(秒), 默认600
+	PeerBanTime int64 `json:"peerBanTime,omitempty"`
+	// if true, ForkAggVote之后出块仍然使用投票公钥列表, 不使用聚合投票. 验证时两种都接受
+	NoAggVote bool `json:"noAggVote,omitempty"`
 	// only for test!!! if true, delay 5 second make block
 	TrubleMaker bool `json:"trubleMaker,omitempty"`
 	// only for test
@@ -81,15 +189,43 @@ func New(cfg *types.Consensus, sub []byte) queue.Module {
 		conf:       &subcfg,
 		acMap:      make(map[int64]int),
 		tcMap:      make(map[int64]map[string]int64),
+		tcCache:    newTicketCountCache(subcfg.TicketCountCache),
+		dsMap:      make(map[int64]int),
+		csMap:      make(map[int64]int),
+		rtMap:      make(map[int64]float64),
+		koCache:    newKeyOwnerCache(keyOwnerCacheSize),
+		scCache:    newSortCountCache(sortCountCacheSize),
+		tpCache:    newTicketPriceCache(ticketPriceCacheSize),
 		done:       make(chan struct{}),
 	}
 	client.n.Client = client
+	var err error
+	client.extraKeys, err = loadMinerKeys(subcfg.MinerKeys)
+	if err != nil {
+		panic(err)
+	}
+	client.probe = client.defaultReadyProbe()
+	if subcfg.SnapshotFile != "" {
+		err := client.loadSnapshot(subcfg.SnapshotFile, subcfg.SnapshotHash)
+		if err != nil {
+			plog.Error("load consensus snapshot error, discard it", "err", err, "file", subcfg.SnapshotFile)
+		}
+	}
+	client.msrv = startMetricsServer(subcfg.MetricsPort)
 	c.SetChild(client)
 	return client
 }
 
 // Close is close the client
 func (client *Client) Close() {
+	client.n.stop()
+	client.n.wal.close()
+	if client.msrv != nil {
+		client.msrv.Close()
+	}
+	if rk, ok := client.priv.(*remoteKey); ok {
+		rk.c.Close()
+	}
 	client.done <- struct{}{}
 	client.BaseClient.Close()
 	plog.Debug("pos33 consensus closed")
@@ -161,6 +297,14 @@ func (c *Client) updateTicketCount(b *types.Block) {
 	if b.Height == 0 {
 		height = 0
 	}
+	if height > 0 && height <= c.tcHeight {
+		c.rollbackTicketCount(height)
+	}
+	c.tcHeight = height
+	c.tcCache.setTip(height)
+	mp33 := pt.GetPos33MineParam(c.GetAPI().GetConfig(), height)
+	priceChanged := mp33.PriceEpochStart(height)
+	c.setBlockVotes(b)
 	for i, tx := range b.Txs {
 		if i != 0 && string(tx.Execer) == "pos33" {
 			pa := new(pt.Pos33TicketAction)
@@ -179,6 +323,25 @@ func (c *Client) updateTicketCount(b *types.Block) {
 			case pt.Pos33ActionEntrust:
 				entrust := pa.GetEntrust()
 				miner = entrust.Consignee
+			case pt.Pos33ActionDelegate:
+				miner = pa.GetDelegate().GetOperator()
+			case pt.Pos33ActionUndelegate:
+				miner = pa.GetUndelegate().GetOperator()
+			case pt.Pos33ActionJail:
+				// 被关押的地址票数变成0
+				for _, addr := range pa.GetJail().GetAddrs() {
+					c.queryMinerTicketCount(addr, height)
+				}
+			case pt.Pos33ActionUnjail:
+				miner = tx.From()
+			case pt.Pos33ActionSlash:
+				// 被处罚的地址票数减少
+				for _, e := range pa.GetSlash().GetEvidences() {
+					c.queryMinerTicketCount(e.Offender, height)
+				}
+				c.queryAllPos33Count(height)
+			case pt.Pos33ActionChainParam:
+				c.loadChainParams()
 			}
 			if miner != "" {
 				plog.Debug("set entrust", "height", height, "miner", miner)
@@ -187,8 +350,7 @@ func (c *Client) updateTicketCount(b *types.Block) {
 			}
 		}
 	}
-	chain33Cfg := c.GetAPI().GetConfig()
-	if pt.GetPos33MineParam(chain33Cfg, height).ChangeTicketPrice() {
+	if mp33.ChangeTicketPrice() || priceChanged {
 		plog.Debug("update ticket count because price changed", "height", height)
 		c.queryAllPos33Count(height)
 		for k := range c.tcMap[height-1] {
@@ -216,15 +378,59 @@ func (c *Client) updateTicketCount(b *types.Block) {
 		// 	}
 	}
 	plog.Info("update ticket count", "height", b.Height, "all count", c.acMap[b.Height])
+	ticketAllCountGauge.Update(int64(c.acMap[height]))
+	c.setDiffSchedule(height, c.acMap[height])
+	// 预取下一个高度抽签使用的快照, 这个高度的验证不再查询executor
+	if sh := c.sortHeight(height + 1); sh >= 0 {
+		c.tcCache.snapshot(sh, c.tcMap[sh])
+	}
 	delete(c.acMap, height-pt.Pos33SortBlocks*2-1)
 	delete(c.tcMap, height-pt.Pos33SortBlocks*2-1)
 }
 
+// rollbackTicketCount 区块回滚以后重新增加height高度, 删除height及以后高度记录的票数
+func (c *Client) rollbackTicketCount(height int64) {
+	plog.Info("rollback ticket count", "height", height, "last", c.tcHeight)
+	for h := range c.tcMap {
+		if h >= height {
+			delete(c.tcMap, h)
+		}
+	}
+	for h := range c.acMap {
+		if h >= height {
+			delete(c.acMap, h)
+		}
+	}
+	c.tcCache.reorg(height)
+	c.scCache.reset()
+	// 回滚的区块里可能有换公钥交易
+	c.koCache.reset()
+	// 回滚的区块里可能有参数交易
+	if c.cparams.Load() != nil {
+		c.loadChainParams()
+	}
+	// 回滚的区块里可能调整了票价
+	c.tpCache.reset()
+}
+
 func (c *Client) getMiner() {
 	if c.myAddr != "" {
 		return
 	}
 
+	if c.conf.RemoteSigner != "" {
+		rk, err := newRemoteKey(c.conf)
+		if err != nil {
+			plog.Error("connect remote signer", "err", err, "addr", c.conf.RemoteSigner)
+			return
+		}
+		c.priv = rk
+		c.myAddr = address.PubKeyToAddr(ethID, rk.PubKey().Bytes())
+		c.extraKeys = dropPrimaryKey(c.myAddr, c.extraKeys)
+		plog.Debug("getMiner from remote signer", "addr", c.myAddr)
+		return
+	}
+
 	resp, err := c.GetAPI().ExecWalletFunc("pos33", "WalletGetMiner", &types.ReqNil{})
 	if err != nil {
 		plog.Debug("WalletGetMinerAddr", "err", err)
@@ -237,30 +443,37 @@ func (c *Client) getMiner() {
 		return
 	}
 	c.myAddr = address.PubKeyToAddr(ethID, c.priv.PubKey().Bytes())
-	plog.Debug("getMiner", "addr", c.myAddr)
+	c.extraKeys = dropPrimaryKey(c.myAddr, c.extraKeys)
+	plog.Debug("getMiner", "addr", c.myAddr, "extra", len(c.extraKeys))
 }
 
-func (c *Client) queryEntrustCount(miner string, height int64) int64 {
+func (c *Client) queryEntrustCount(miner string, height int64) (int64, error) {
 	msg, err := c.GetAPI().Query(pt.Pos33TicketX, "Pos33ConsigneeEntrust", &types.ReqAddr{Addr: miner})
 	if err != nil {
 		plog.Error("query Pos33Consignee error", "error", err, "height", height, "miner", miner)
-		return 0
+		return 0, err
 	}
 	consignee := msg.(*pt.Pos33Consignee)
-	price := pt.GetPos33MineParam(c.GetAPI().GetConfig(), c.GetCurrentHeight()).GetTicketPrice()
-	return consignee.Amount / price
+	price, err := c.ticketPrice(c.GetCurrentHeight())
+	if err != nil {
+		return 0, err
+	}
+	return c.jailedCount(miner, height, consignee.Amount/price)
 }
 
 func (c *Client) queryTicketCount(addr string, height int64) int64 {
-	c.mlock.Lock()
-	defer c.mlock.Unlock()
-
 	if height < 0 {
 		height = 0
 	}
 	if addr == "" {
 		return 0
 	}
+	if count, ok := c.tcCache.get(addr, height); ok {
+		return count
+	}
+
+	c.mlock.Lock()
+	defer c.mlock.Unlock()
 
 	count := int64(0)
 	mp, ok := c.tcMap[height]
@@ -268,13 +481,19 @@ func (c *Client) queryTicketCount(addr string, height int64) int64 {
 		count, ok = mp[addr]
 	}
 	if !ok {
-		count = c.queryMinerTicketCount(addr, height)
+		var err error
+		count, err = c.queryMinerTicketCount(addr, height)
+		// 查询出错的票数是0, 不缓存
+		if err != nil {
+			return count
+		}
 	}
+	c.tcCache.add(addr, height, count)
 	// plog.Debug("query ticket count", "height", height, "addr", addr, "count", count)
 	return count
 }
 
-func (c *Client) queryMinerTicketCount(addr string, height int64) int64 {
+func (c *Client) queryMinerTicketCount(addr string, height int64) (int64, error) {
 	mp, ok := c.tcMap[height]
 	if !ok || mp == nil {
 		mp = make(map[string]int64)
@@ -284,11 +503,13 @@ func (c *Client) queryMinerTicketCount(addr string, height int64) int64 {
 	}
 
 	count := int64(0)
+	var err error
 	cfg := c.GetAPI().GetConfig()
 	if cfg.IsDappFork(height, pt.Pos33TicketX, "UseEntrust") {
-		count = c.queryEntrustCount(addr, height)
+		count, err = c.queryEntrustCount(addr, height)
 	} else {
-		msg, err := c.GetAPI().Query(pt.Pos33TicketX, "Pos33TicketCount", &types.ReqAddr{Addr: addr})
+		var msg types.Message
+		msg, err = c.GetAPI().Query(pt.Pos33TicketX, "Pos33TicketCount", &types.ReqAddr{Addr: addr})
 		if err != nil {
 			plog.Error("query count error", "error", err)
 			count = 0
@@ -299,7 +520,7 @@ func (c *Client) queryMinerTicketCount(addr string, height int64) int64 {
 	// plog.Debug("query miner ticket count", "height", height, "miner", addr, "count", count)
 	mp[addr] = count
 	c.tcMap[height] = mp
-	return count
+	return count, err
 }
 
 func (c *Client) queryAllPos33Count(height int64) int {
@@ -319,7 +540,11 @@ func (c *Client) queryAllPos33Count(height int64) int {
 	}
 	count := msg.(*types.Int64).Data
 	if useAmount {
-		count = count / pt.GetPos33MineParam(cfg, height).GetTicketPrice()
+		price, err := c.ticketPrice(height)
+		if err != nil {
+			return 0
+		}
+		count = count / price
 	}
 	c.acMap[height] = int(count)
 	return int(count)
@@ -530,7 +755,7 @@ func (client *Client) CmpBestBlock(newBlock *types.Block, cmpBlock *types.Block)
 		return false
 	}
 
-	plog.Debug("block cmp", "nv1", len(m1.BlsPkList), "nv2", len(m2.BlsPkList))
+	plog.Debug("block cmp", "nv1", m1.VoterCount(), "nv2", m2.VoterCount())
 	return true
 
 	// vw1 := voteWeight(m1.Votes)
@@ -0,0 +1,72 @@
+package pos33
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/types"
+	lru "github.com/hashicorp/golang-lru"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// ticketPriceCacheSize 缓存多少个周期的票价
+const ticketPriceCacheSize = 64
+
+// ticketPriceCache 调整票价的高度 -> 这个高度的状态里的票价, 有自己的锁.
+// 同一个高度的状态不会变, 只有回滚时清空
+type ticketPriceCache struct {
+	cache *lru.Cache
+}
+
+func newTicketPriceCache(size int) *ticketPriceCache {
+	if size <= 0 {
+		size = ticketPriceCacheSize
+	}
+	cache, err := lru.New(size)
+	if err != nil {
+		panic(err)
+	}
+	return &ticketPriceCache{cache: cache}
+}
+
+func (pc *ticketPriceCache) get(height int64) (int64, bool) {
+	v, ok := pc.cache.Get(height)
+	if !ok {
+		return 0, false
+	}
+	return v.(int64), true
+}
+
+func (pc *ticketPriceCache) add(height, price int64) {
+	pc.cache.Add(height, price)
+}
+
+// reset 回滚的区块里可能调整了票价
+func (pc *ticketPriceCache) reset() {
+	pc.cache.Purge()
+}
+
+// ticketPrice 返回height高度计算票数使用的票价. ForkTicketPrice之后票价在executor里每个周期调整,
+// 从这个周期开始高度的状态查询, 和节点当前的高度无关. 查询失败返回错误, 不缓存
+func (c *Client) ticketPrice(height int64) (int64, error) {
+	cfg := c.GetAPI().GetConfig()
+	mp := pt.GetPos33MineParam(cfg, height)
+	if !cfg.IsDappFork(height, pt.Pos33TicketX, "ForkTicketPrice") {
+		return mp.GetTicketPrice(), nil
+	}
+	eh := mp.PriceEpochHeight(height)
+	if price, ok := c.tpCache.get(eh); ok {
+		return price, nil
+	}
+	msg, err := c.stateQuery(eh, "Pos33TicketPrice", &types.ReqNil{})
+	if err != nil {
+		plog.Error("query ticket price error", "err", err, "height", height, "epochHeight", eh)
+		return 0, err
+	}
+	p := msg.(*pt.Pos33TicketPriceInfo).Current
+	if p.GetPrice() <= 0 {
+		return 0, fmt.Errorf("%w: price %d at %d", pt.ErrTicketPrice, p.GetPrice(), eh)
+	}
+	c.tpCache.add(eh, p.Price)
+	plog.Info("ticket price", "height", height, "epochHeight", eh, "price", p.Price, "epoch", p.Epoch)
+	return p.Price, nil
+}
@@ -0,0 +1,86 @@
+package pos33
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func TestTicketPrice(t *testing.T) {
+	n, api := newTestNodeCfg(t, testCfgString()+"priceEpoch=100\n", nil)
+	cfg := n.GetAPI().GetConfig()
+	cfg.SetDappFork(pt.Pos33TicketX, "UseEntrust", 0)
+	fork := int64(50)
+	cfg.SetDappFork(pt.Pos33TicketX, "ForkTicketPrice", fork)
+	price := pt.GetPos33MineParam(cfg, fork).GetTicketPrice()
+	mockStateHeaders(api)
+
+	// 每个高度状态里的票价, 模拟调整和回滚
+	boundary := fork + 100
+	prices := map[int64]int64{fork: price, boundary: 2 * price}
+	queries := 0
+	stateHeight := func(p *types.ChainExecutor) int64 { return int64(p.StateHash[0]) }
+	api.On("QueryChain", mock.MatchedBy(func(p *types.ChainExecutor) bool {
+		return p.FuncName == "Pos33TicketPrice"
+	})).Return(func(p *types.ChainExecutor) types.Message {
+		queries++
+		if price, ok := prices[stateHeight(p)]; ok {
+			return &pt.Pos33TicketPriceInfo{Current: &pt.Pos33TicketPrice{Price: price}}
+		}
+		return nil
+	}, func(p *types.ChainExecutor) error {
+		if _, ok := prices[stateHeight(p)]; ok {
+			return nil
+		}
+		return errors.New("state NOT found")
+	})
+	api.On("Query", pt.Pos33TicketX, "AllPos33TicketAmount", mock.Anything).Return(&types.Int64{Data: 300 * price}, nil)
+
+	ticketPrice := func(height int64) int64 {
+		p, err := n.ticketPrice(height)
+		require.Nil(t, err)
+		return p
+	}
+
+	// 分叉之前使用配置的票价, 不查询
+	require.Equal(t, price, ticketPrice(fork-1))
+	require.Equal(t, 0, queries)
+
+	// 同一个周期只查询一次
+	for h := int64(0); h < boundary; h++ {
+		n.updateTicketCount(&types.Block{Height: h})
+	}
+	require.Equal(t, price, ticketPrice(fork))
+	require.Equal(t, price, ticketPrice(boundary-1))
+	require.Equal(t, 1, queries)
+
+	// 调整票价的区块查询新周期的票价, 上一个周期的票价不变
+	n.updateTicketCount(&types.Block{Height: boundary})
+	require.Equal(t, 2, queries)
+	require.Equal(t, 2*price, ticketPrice(boundary))
+	require.Equal(t, price, ticketPrice(boundary-1))
+	require.Equal(t, 2, queries)
+	n.mlock.Lock()
+	require.Equal(t, 150, n.acMap[boundary])
+	n.mlock.Unlock()
+
+	// 回滚以后重新查询
+	prices[boundary] = price
+	n.updateTicketCount(&types.Block{Height: boundary})
+	require.Equal(t, price, ticketPrice(boundary))
+	n.mlock.Lock()
+	require.Equal(t, 300, n.acMap[boundary])
+	n.mlock.Unlock()
+
+	// 查询失败返回错误, 不使用配置的票价, 也不缓存
+	queries = 0
+	_, err := n.ticketPrice(boundary + 100)
+	require.NotNil(t, err)
+	_, err = n.ticketPrice(boundary + 150)
+	require.NotNil(t, err)
+	require.Equal(t, 2, queries)
+}
@@ -0,0 +1,105 @@
+package pos33
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+// promPrefix 只导出pos33的指标, 其他模块注册的名字可能转换以后重复
+const promPrefix = "pos33/"
+
+// promQuantiles histogram和timer导出的分位数
+var promQuantiles = []float64{0.5, 0.9, 0.99}
+
+// promCollector 把go-metrics注册表里pos33的指标转成prometheus格式, 名字里的/换成_.
+// counter和meter是counter, gauge是gauge, histogram和timer是summary(timer的单位是纳秒)
+type promCollector struct {
+	r metrics.Registry
+}
+
+// Describe 不提前描述指标, 指标在第一次用到时才注册
+func (p promCollector) Describe(ch chan<- *prometheus.Desc) {}
+
+// Collect 每次抓取时读取所有指标的当前值
+func (p promCollector) Collect(ch chan<- prometheus.Metric) {
+	p.r.Each(func(name string, i interface{}) {
+		if !strings.HasPrefix(name, promPrefix) {
+			return
+		}
+		desc := prometheus.NewDesc(promName(name), name, nil, nil)
+		var m prometheus.Metric
+		var err error
+		switch v := i.(type) {
+		case metrics.Counter:
+			m, err = prometheus.NewConstMetric(desc, prometheus.CounterValue, float64(v.Count()))
+		case metrics.Gauge:
+			m, err = prometheus.NewConstMetric(desc, prometheus.GaugeValue, float64(v.Value()))
+		case metrics.GaugeFloat64:
+			m, err = prometheus.NewConstMetric(desc, prometheus.GaugeValue, v.Value())
+		case metrics.Meter:
+			m, err = prometheus.NewConstMetric(desc, prometheus.CounterValue, float64(v.Count()))
+		case metrics.Histogram:
+			s := v.Snapshot()
+			m, err = prometheus.NewConstSummary(desc, uint64(s.Count()), float64(s.Sum()), promSummary(s.Percentiles(promQuantiles)))
+		case metrics.Timer:
+			s := v.Snapshot()
+			m, err = prometheus.NewConstSummary(desc, uint64(s.Count()), float64(s.Sum()), promSummary(s.Percentiles(promQuantiles)))
+		default:
+			return
+		}
+		if err != nil {
+			plog.Error("prometheus metric error", "err", err, "name", name)
+			return
+		}
+		ch <- m
+	})
+}
+
+func promSummary(ps []float64) map[float64]float64 {
+	mp := make(map[float64]float64, len(ps))
+	for i, q := range promQuantiles {
+		mp[q] = ps[i]
+	}
+	return mp
+}
+
+// promName pos33/sort/verify/ok -> pos33_sort_verify_ok
+func promName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// newMetricsHandler 返回/metrics的handler, 包括pos33的指标和go运行时的指标
+func newMetricsHandler(r metrics.Registry) http.Handler {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(promCollector{r: r})
+	reg.MustRegister(prometheus.NewGoCollector())
+	return promhttp.HandlerFor(reg, promhttp.HandlerOpts{})
+}
+
+// startMetricsServer 配置了MetricsPort时在这个端口的/metrics提供prometheus抓取, 为0不启动
+func startMetricsServer(port int) *http.Server {
+	if port <= 0 {
+		return nil
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", newMetricsHandler(metrics.DefaultRegistry))
+	srv := &http.Server{Addr: fmt.Sprintf(":%d", port), Handler: mux}
+	go func() {
+		err := srv.ListenAndServe()
+		if err != nil && err != http.ErrServerClosed {
+			plog.Error("metrics server error", "err", err, "port", port)
+		}
+	}()
+	plog.Info("metrics server started", "port", port)
+	return srv
+}
@@ -0,0 +1,40 @@
+package pos33
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromName(t *testing.T) {
+	require.Equal(t, "pos33_sort_verify_ok", promName("pos33/sort/verify/ok"))
+	require.Equal(t, "pos33_a_b_c", promName("pos33/a.b-c"))
+}
+
+func TestMetricsHandler(t *testing.T) {
+	r := metrics.NewRegistry()
+	metrics.GetOrRegisterCounter("pos33/test/counter", r).Inc(3)
+	metrics.GetOrRegisterGauge("pos33/test/gauge", r).Update(7)
+	metrics.GetOrRegisterGaugeFloat64("pos33/test/ratio", r).Update(0.5)
+	metrics.GetOrRegisterTimer("pos33/test/timer", r).Update(time.Millisecond)
+	// 不是pos33的指标不导出
+	metrics.GetOrRegisterCounter("other/counter", r).Inc(1)
+
+	w := httptest.NewRecorder()
+	newMetricsHandler(r).ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	require.Equal(t, 200, w.Code)
+	body, err := ioutil.ReadAll(w.Body)
+	require.Nil(t, err)
+	s := string(body)
+	require.Contains(t, s, "pos33_test_counter 3\n")
+	require.Contains(t, s, "pos33_test_gauge 7\n")
+	require.Contains(t, s, "pos33_test_ratio 0.5\n")
+	require.Contains(t, s, "pos33_test_timer_count 1\n")
+	require.Contains(t, s, `pos33_test_timer{quantile="0.5"} 1e+06`)
+	require.Contains(t, s, "go_goroutines")
+	require.NotContains(t, s, "other_counter")
+}
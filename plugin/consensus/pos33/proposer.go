@@ -0,0 +1,21 @@
+package pos33
+
+import (
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// SelectProposer 从委员会中确定出块的抽签: 按pt.CompareSort的规范顺序最小的一个,
+// 即HashToBig(SortHash.Hash)最小, 相同时依次比较Pubkey, Num, Index.
+// 委员会为空时返回nil
+func SelectProposer(msgs []*pt.Pos33SortMsg) *pt.Pos33SortMsg {
+	var p *pt.Pos33SortMsg
+	for _, m := range msgs {
+		if m == nil || m.SortHash == nil {
+			continue
+		}
+		if p == nil || pt.CompareSort(m, p) < 0 {
+			p = m
+		}
+	}
+	return p
+}
@@ -0,0 +1,65 @@
+package pos33
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func newTestProposerSort(hash []byte, pub string, num int32, index int64) *pt.Pos33SortMsg {
+	return &pt.Pos33SortMsg{
+		SortHash: &pt.SortHash{Hash: hash, Num: num, Index: index},
+		Proof:    &pt.HashProof{Input: &pt.VrfInput{Height: 100}, Pubkey: []byte(pub)},
+	}
+}
+
+func TestSelectProposer(t *testing.T) {
+	require.Nil(t, SelectProposer(nil))
+	require.Nil(t, SelectProposer([]*pt.Pos33SortMsg{nil, {}}))
+
+	var ss []*pt.Pos33SortMsg
+	for i := 0; i < 20; i++ {
+		ss = append(ss, newTestProposerSort(hash2([]byte{byte(i)}), "pub", 0, int64(i)))
+	}
+	p := SelectProposer(ss)
+	for _, s := range ss {
+		require.True(t, pt.CompareSort(p, s) <= 0)
+	}
+
+	// hash相同时依次比较Pubkey, Num, Index
+	min := make([]byte, 32)
+	ties := []*pt.Pos33SortMsg{
+		newTestProposerSort(min, "pub2", 0, 0),
+		newTestProposerSort(min, "pub1", 1, 0),
+		newTestProposerSort(min, "pub1", 0, 2),
+		newTestProposerSort(min, "pub1", 0, 1),
+	}
+	all := append(ss, ties...)
+	want := ties[3]
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10; i++ {
+		r.Shuffle(len(all), func(i, j int) { all[i], all[j] = all[j], all[i] })
+		require.Equal(t, want, SelectProposer(all))
+	}
+	require.Equal(t, ties[2], SelectProposer([]*pt.Pos33SortMsg{ties[0], ties[1], ties[2]}))
+	require.Equal(t, ties[1], SelectProposer([]*pt.Pos33SortMsg{ties[0], ties[1]}))
+}
+
+func TestSelectProposerCommittee(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	height := int64(100)
+	comm := n.getCommittee(height, 0)
+	for i := 0; i < 10; i++ {
+		s := newTestProposerSort(hash2([]byte{byte(i)}), string([]byte{byte(i)}), 0, 0)
+		comm.css[string(s.SortHash.Hash)] = s
+		comm.svmp[string(s.SortHash.Hash)] = pt.Pos33MustVotes
+	}
+	comm.setCommittee(height, 0)
+	require.Equal(t, 10, len(comm.comm))
+
+	// 第一个候选人就是出块的抽签
+	p := SelectProposer(comm.comm)
+	require.Equal(t, string(p.SortHash.Hash), comm.candidates[0])
+}
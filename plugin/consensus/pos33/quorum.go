@@ -0,0 +1,141 @@
+package pos33
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// 投票数量规则的名字, 在mver.consensus.pos33的quorumRule中配置
+const (
+	// QuorumSeats 投票数过半, 默认
+	QuorumSeats = "seats"
+	// QuorumTwoThirds 投票数超过委员会的2/3
+	QuorumTwoThirds = "twothirds"
+	// QuorumStake 投票人的抵押超过全网的百分比, 写成stake:<percent>
+	QuorumStake = "stake"
+)
+
+// QuorumVotes 一个区块的投票, QuorumRule用它判断投票是否足够
+type QuorumVotes struct {
+	Height int64
+	// 不重复的投票数量
+	Voters int
+	// 投票人的抵押占全网的比例, 需要查询, 用到时才计算
+	Stake func() (float64, error)
+}
+
+// QuorumRule 判断区块的投票是否足够
+type QuorumRule interface {
+	Name() string
+	Sufficient(q *QuorumVotes) error
+}
+
+type seatsRule struct{}
+
+func (seatsRule) Name() string { return QuorumSeats }
+
+func (seatsRule) Sufficient(q *QuorumVotes) error {
+	if q.Voters < pt.Pos33VoterSize/2+1 {
+		return fmt.Errorf("NOT enought votes")
+	}
+	return nil
+}
+
+type twoThirdsRule struct{}
+
+func (twoThirdsRule) Name() string { return QuorumTwoThirds }
+
+func (twoThirdsRule) Sufficient(q *QuorumVotes) error {
+	if 3*q.Voters <= 2*pt.Pos33VoterSize {
+		return fmt.Errorf("NOT enought votes: %d <= 2/3 of %d", q.Voters, pt.Pos33VoterSize)
+	}
+	return nil
+}
+
+type stakeRule struct {
+	percent float64
+}
+
+func (r stakeRule) Name() string { return fmt.Sprintf("%s:%g", QuorumStake, r.percent) }
+
+func (r stakeRule) Sufficient(q *QuorumVotes) error {
+	f, err := q.Stake()
+	if err != nil {
+		return err
+	}
+	if f*100 < r.percent {
+		return fmt.Errorf("NOT enought stake: %.2f%% < %g%%", f*100, r.percent)
+	}
+	return nil
+}
+
+// NewQuorumRule 解析quorumRule的配置, 为空是QuorumSeats
+func NewQuorumRule(s string) (QuorumRule, error) {
+	switch s {
+	case "", QuorumSeats:
+		return seatsRule{}, nil
+	case QuorumTwoThirds:
+		return twoThirdsRule{}, nil
+	}
+	if strings.HasPrefix(s, QuorumStake+":") {
+		p, err := strconv.ParseFloat(strings.TrimPrefix(s, QuorumStake+":"), 64)
+		if err != nil || p <= 0 || p > 100 {
+			return nil, fmt.Errorf("quorum rule %s error: percent must be in (0, 100]", s)
+		}
+		return stakeRule{percent: p}, nil
+	}
+	return nil, fmt.Errorf("unknown quorum rule %s", s)
+}
+
+// quorumRule 返回height高度使用的规则, ForkQuorumRule之前总是QuorumSeats
+func (n *node) quorumRule(height int64) (QuorumRule, error) {
+	return NewQuorumRule(pt.GetPos33QuorumRule(n.GetAPI().GetConfig(), height))
+}
+
+// quorumSufficient 区块的投票是否满足rule. ForkBlockCheck以前和以前的blockCheck一样按投票列表的长度计数
+func (n *node) quorumSufficient(rule QuorumRule, height int64, m *pt.Pos33MinerMsg) error {
+	voters := make(map[string]bool)
+	for _, pk := range m.BlsPkList {
+		voters[string(pk)] = true
+	}
+	nv := len(voters)
+	if !n.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkBlockCheck") {
+		nv = len(m.BlsPkList)
+	}
+	q := &QuorumVotes{Height: height, Voters: nv, Stake: func() (float64, error) { return n.voterStake(height, m) }}
+	return rule.Sufficient(q)
+}
+
+// voterStake 返回区块投票人的抵押占全网的比例, 同一个地址的多个bls公钥只计算一次.
+// 票数都是抽签快照高度的, bls公钥的地址按父区块的状态, 和执行器发放奖励时相同
+func (n *node) voterStake(height int64, m *pt.Pos33MinerMsg) (float64, error) {
+	all, err := n.sortTotal(height)
+	if err != nil {
+		return 0, err
+	}
+	owner := n.stateBlsOwner(height - 1)
+	voted := make(map[string]bool)
+	stake := int64(0)
+	for _, pk := range m.BlsPkList {
+		addr, err := owner(pk)
+		if err != nil {
+			return 0, fmt.Errorf("bls owner NOT found, height %d: %w", height, err)
+		}
+		if voted[addr] {
+			continue
+		}
+		voted[addr] = true
+		count, err := n.sortCount(addr, height)
+		if err != nil {
+			return 0, err
+		}
+		stake += count
+	}
+	if stake > all {
+		return 0, fmt.Errorf("voter stake %d > total stake %d, height %d", stake, all, height)
+	}
+	return float64(stake) / float64(all), nil
+}
@@ -0,0 +1,100 @@
+package pos33
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func TestNewQuorumRule(t *testing.T) {
+	for s, name := range map[string]string{"": QuorumSeats, "seats": QuorumSeats, "twothirds": QuorumTwoThirds, "stake:50": "stake:50", "stake:66.7": "stake:66.7"} {
+		r, err := NewQuorumRule(s)
+		require.Nil(t, err, s)
+		require.Equal(t, name, r.Name())
+	}
+	for _, s := range []string{"stake", "stake:0", "stake:101", "stake:x", "majority"} {
+		_, err := NewQuorumRule(s)
+		require.NotNil(t, err, s)
+	}
+}
+
+func TestQuorumRule(t *testing.T) {
+	n, api := newTestNodeCfg(t, testCfgString()+"quorumRule=\"stake:50\"\n", nil)
+	n.setTestMiner(newTestPriv(t))
+	cfg := n.GetAPI().GetConfig()
+
+	height := int64(100)
+	sh := height - pt.Pos33SortBlocks
+	seed := []byte("quorum rule seed")
+	all := pt.Pos33CommitteeSize
+	n.setTestCount(n.myAddr, sh, 10, all)
+	ss := n.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.NotEmpty(t, ss)
+	s := ss[0]
+	pb := newTestBlock(height-1, nil)
+	mockStateHeaders(api)
+
+	// owners的第i个地址有counts[i]张票, 区块的投票人依次属于这些地址
+	newBlock := func(nv int, owners []string, counts []int64) *types.Block {
+		b := newTestMinerBlock(t, n, s, s.SortHash.Hash, nv)
+		m, err := getMiner(b)
+		require.Nil(t, err)
+		for i, pk := range m.BlsPkList {
+			owner := owners[i%len(owners)]
+			onBlsAddr(api, pk, height-1, owner)
+		}
+		for i, owner := range owners {
+			n.setTestCount(owner, sh, counts[i], all)
+		}
+		return b
+	}
+
+	// 13个投票人, 每个1票, 过半但抵押只有13/75
+	var owners []string
+	var counts []int64
+	for i := 0; i < 13; i++ {
+		owners = append(owners, fmt.Sprintf("small%d", i))
+		counts = append(counts, 1)
+	}
+	small := newBlock(13, owners, counts)
+	// 5个投票人都属于一个有40票的地址, 不过半但抵押超过一半
+	big := newBlock(5, []string{"big"}, []int64{40})
+
+	// ForkQuorumRule之前按座位数
+	cfg.SetDappFork(pt.Pos33TicketX, "ForkQuorumRule", types.MaxHeight)
+	r := n.verifyBlockConsensus(small, pb, seed)
+	require.Nil(t, r.Err(), r.String())
+	requireOnlyFailed(t, n.verifyBlockConsensus(big, pb, seed), CheckQuorum)
+
+	// ForkQuorumRule之后按抵押比例, 同样的委员会结果相反
+	cfg.SetDappFork(pt.Pos33TicketX, "ForkQuorumRule", 0)
+	requireOnlyFailed(t, n.verifyBlockConsensus(small, pb, seed), CheckQuorum)
+	r = n.verifyBlockConsensus(big, pb, seed)
+	require.Nil(t, r.Err(), r.String())
+
+	// UseEntrust和ForkBlockCheck以后票数和全网票数都从快照高度的状态查询, 不使用本地缓存
+	cfg.SetDappFork(pt.Pos33TicketX, "UseEntrust", 0)
+	cfg.SetDappFork(pt.Pos33TicketX, "ForkBlockCheck", 0)
+	onSortTotal(api, sh).Return(&types.Int64{Data: 100}, nil)
+	onSortCount(api, "big", sh).Return(&types.Int64{Data: 40}, nil)
+	m, err := getMiner(big)
+	require.Nil(t, err)
+	f, err := n.voterStake(height, m)
+	require.Nil(t, err)
+	require.Equal(t, 0.4, f)
+	require.NotNil(t, n.quorumSufficient(stakeRule{percent: 50}, height, m))
+	n.setTestCount("big", sh, 1, all)
+	f, err = n.voterStake(height, m)
+	require.Nil(t, err)
+	require.Equal(t, 0.4, f)
+
+	// 超过2/3需要17个投票人
+	q := &QuorumVotes{Height: height, Voters: 13}
+	require.NotNil(t, twoThirdsRule{}.Sufficient(q))
+	q.Voters = 17
+	require.Nil(t, twoThirdsRule{}.Sufficient(q))
+}
@@ -0,0 +1,136 @@
+package pos33
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	"github.com/yccproject/ycc/plugin/consensus/pos33/signer"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// 就绪检查的各项, 按顺序检查, 前面的不满足时后面的跳过
+const (
+	ReadyAPI    = "api"
+	ReadyKey    = "key"
+	ReadyTicket = "ticket"
+	ReadySynced = "synced"
+)
+
+var errReadySkipped = errors.New("skipped")
+
+// readyProbe 就绪检查依赖的节点状态, 需要消息队列, 测试时替换
+type readyProbe struct {
+	height func() int64
+	synced func() bool
+}
+
+func (c *Client) defaultReadyProbe() readyProbe {
+	return readyProbe{height: c.GetCurrentHeight, synced: c.IsCaughtUp}
+}
+
+// checkKey 挖矿私钥已经加载, 并且签名可以用自己的公钥验证
+func (c *Client) checkKey() error {
+	c.getMiner()
+	priv := c.priv
+	if priv == nil {
+		return errors.New("wallet locked or mining account NOT set")
+	}
+	if address.PubKeyToAddr(ethID, priv.PubKey().Bytes()) != c.myAddr {
+		return fmt.Errorf("mining key NOT match address %s", c.myAddr)
+	}
+	msg := hash2([]byte("pos33 readiness"))
+	if !priv.PubKey().VerifyBytes(signer.Domain(signer.KindMsg, msg), signMsg(priv, signer.KindMsg, msg)) {
+		return errors.New("mining key self verify failed")
+	}
+	return nil
+}
+
+// readyTicketCount 查询自己的票数, 和queryMinerTicketCount不同, 返回查询的错误
+func (c *Client) readyTicketCount(height int64) (int64, error) {
+	cfg := c.GetAPI().GetConfig()
+	if cfg.IsDappFork(height, pt.Pos33TicketX, "UseEntrust") {
+		msg, err := c.GetAPI().Query(pt.Pos33TicketX, "Pos33ConsigneeEntrust", &types.ReqAddr{Addr: c.myAddr})
+		if err != nil {
+			return 0, err
+		}
+		price, err := c.ticketPrice(height)
+		if err != nil {
+			return 0, err
+		}
+		if price <= 0 {
+			return 0, fmt.Errorf("ticket price error, height %d", height)
+		}
+		l, err := c.queryLiveness(c.myAddr, height)
+		if err != nil {
+			return 0, err
+		}
+		if l.GetJailed() {
+			return 0, fmt.Errorf("jailed at height %d, send an unjail tx", l.JailHeight)
+		}
+		return msg.(*pt.Pos33Consignee).Amount / price, nil
+	}
+	msg, err := c.GetAPI().Query(pt.Pos33TicketX, "Pos33TicketCount", &types.ReqAddr{Addr: c.myAddr})
+	if err != nil {
+		return 0, err
+	}
+	return msg.(*types.Int64).Data, nil
+}
+
+// readiness 检查节点是否可以参与共识: api已经连接, 私钥可用, 可以查询到票数, 已经同步到最新高度
+func (c *Client) readiness(probe readyProbe) *pt.Pos33Readiness {
+	r := &pt.Pos33Readiness{Ready: true}
+	var prev error
+	check := func(name string, f func() error) {
+		err := errReadySkipped
+		if prev == nil {
+			err = f()
+		}
+		ck := &pt.Pos33ReadyCheck{Name: name, Ok: err == nil}
+		if err != nil {
+			ck.Err = err.Error()
+			r.Ready = false
+			if prev == nil {
+				prev = err
+			}
+		}
+		r.Checks = append(r.Checks, ck)
+	}
+
+	check(ReadyAPI, func() error {
+		if c.GetAPI() == nil {
+			return errors.New("api NOT set")
+		}
+		r.Height = probe.height()
+		return nil
+	})
+	check(ReadyKey, func() error {
+		err := c.checkKey()
+		r.Addr = c.myAddr
+		return err
+	})
+	check(ReadyTicket, func() error {
+		count, err := c.readyTicketCount(r.Height)
+		if err != nil {
+			return err
+		}
+		r.Count = count
+		if count <= 0 {
+			return fmt.Errorf("no tickets, addr %s", c.myAddr)
+		}
+		return nil
+	})
+	check(ReadySynced, func() error {
+		if !probe.synced() {
+			return errors.New("NOT caught up")
+		}
+		return nil
+	})
+	return r
+}
+
+// Query_GetReadiness 查询节点是否可以参与共识, 以及不满足的条件
+func (client *Client) Query_GetReadiness(req *types.ReqNil) (types.Message, error) {
+	return client.readiness(client.probe), nil
+}
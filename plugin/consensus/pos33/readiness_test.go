@@ -0,0 +1,90 @@
+package pos33
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func testReadyProbe(synced bool) readyProbe {
+	return readyProbe{
+		height: func() int64 { return 100 },
+		synced: func() bool { return synced },
+	}
+}
+
+// requireUnmet 检查第一个不满足的条件是name, 前面的都满足, 后面的都跳过
+func requireUnmet(t *testing.T, r *pt.Pos33Readiness, name string) {
+	require.False(t, r.Ready)
+	require.Equal(t, 4, len(r.Checks))
+	found := false
+	for _, ck := range r.Checks {
+		switch {
+		case ck.Name == name:
+			require.False(t, ck.Ok)
+			require.NotEqual(t, errReadySkipped.Error(), ck.Err)
+			found = true
+		case found:
+			require.False(t, ck.Ok, ck.Name)
+			require.Equal(t, errReadySkipped.Error(), ck.Err, ck.Name)
+		default:
+			require.True(t, ck.Ok, ck.Name)
+		}
+	}
+	require.True(t, found)
+}
+
+func TestReadinessNoAPI(t *testing.T) {
+	cfg := types.NewChain33Config(testCfgString())
+	client := New(cfg.GetModuleConfig().Consensus, nil).(*Client)
+	r := client.readiness(testReadyProbe(true))
+	requireUnmet(t, r, ReadyAPI)
+}
+
+func TestReadinessNoKey(t *testing.T) {
+	n, api := newTestNode(t, nil)
+	api.On("ExecWalletFunc", "pos33", "WalletGetMiner", mock.Anything).Return(nil, errors.New("wallet locked"))
+	r := n.readiness(testReadyProbe(true))
+	requireUnmet(t, r, ReadyKey)
+	require.Equal(t, int64(100), r.Height)
+}
+
+func TestReadinessTicket(t *testing.T) {
+	n, api := newTestNode(t, nil)
+	n.setTestMiner(newTestPriv(t))
+	n.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "UseEntrust", 1000)
+
+	// 查询票数出错
+	call := api.On("Query", pt.Pos33TicketX, "Pos33TicketCount", mock.Anything).Return(nil, types.ErrNotFound)
+	r := n.readiness(testReadyProbe(true))
+	requireUnmet(t, r, ReadyTicket)
+	require.Equal(t, n.myAddr, r.Addr)
+
+	// 没有票
+	call.Return(&types.Int64{Data: 0}, nil)
+	r = n.readiness(testReadyProbe(true))
+	requireUnmet(t, r, ReadyTicket)
+}
+
+func TestReadinessSynced(t *testing.T) {
+	n, api := newTestNode(t, nil)
+	n.setTestMiner(newTestPriv(t))
+	n.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "UseEntrust", 0)
+	price := pt.GetPos33MineParam(n.GetAPI().GetConfig(), 100).GetTicketPrice()
+	api.On("Query", pt.Pos33TicketX, "Pos33ConsigneeEntrust", &types.ReqAddr{Addr: n.myAddr}).Return(&pt.Pos33Consignee{Amount: 5 * price}, nil)
+
+	r := n.readiness(testReadyProbe(false))
+	requireUnmet(t, r, ReadySynced)
+	require.Equal(t, int64(5), r.Count)
+
+	r = n.readiness(testReadyProbe(true))
+	require.True(t, r.Ready)
+	for _, ck := range r.Checks {
+		require.True(t, ck.Ok, ck.Name)
+		require.Equal(t, "", ck.Err)
+	}
+}
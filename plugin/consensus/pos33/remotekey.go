@@ -0,0 +1,197 @@
+package pos33
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	bls33 "github.com/33cn/plugin/plugin/crypto/bls"
+	"github.com/yccproject/ycc/plugin/consensus/pos33/signer"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+const (
+	defaultRemoteSignerTimeout = 1000
+	defaultRemoteSignerRetries = 3
+)
+
+// vrfEvaluator 私钥不在本地时, 由签名服务计算vrf
+type vrfEvaluator interface {
+	EvaluateVrf(suite string, input []byte) ([]byte, []byte, error)
+}
+
+// blsSigner 私钥不在本地时, 由签名服务签名投票, 失败返回nil
+type blsSigner interface {
+	SignBls(msg []byte) *types.Signature
+}
+
+// remoteKey 远程签名服务的私钥, Bytes返回nil.
+// 签名服务按kind, height, round防止双签, 所以签名前要用with说明签的是什么
+type remoteKey struct {
+	c      *signer.Client
+	pub    crypto.PubKey
+	blsPub []byte
+
+	kind   string
+	height int64
+	round  int32
+}
+
+// newRemoteKey 连接签名服务, 查询公钥
+func newRemoteKey(conf *subConfig) (*remoteKey, error) {
+	timeout := conf.RemoteSignerTimeout
+	if timeout <= 0 {
+		timeout = defaultRemoteSignerTimeout
+	}
+	retries := conf.RemoteSignerRetries
+	if retries == 0 {
+		retries = defaultRemoteSignerRetries
+	} else if retries < 0 {
+		retries = 0
+	}
+	if conf.RemoteSignerToken == "" {
+		return nil, errors.New("remoteSignerToken is empty")
+	}
+	c, err := signer.Dial(conf.RemoteSigner, conf.RemoteSignerToken, time.Duration(timeout)*time.Millisecond, retries)
+	if err != nil {
+		return nil, err
+	}
+	info, err := c.Info()
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+	cr, err := crypto.Load(types.GetSignName("", types.SECP256K1), -1)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+	pub, err := cr.PubKeyFromBytes(info.Pubkey)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+	return &remoteKey{c: c, pub: pub, blsPub: info.BlsPubkey, kind: signer.KindMsg}, nil
+}
+
+// with 返回签名kind类型消息的私钥
+func (k *remoteKey) with(kind string, height int64, round int) *remoteKey {
+	nk := *k
+	nk.kind, nk.height, nk.round = kind, height, int32(round)
+	return &nk
+}
+
+func (k *remoteKey) Bytes() []byte {
+	return nil
+}
+
+// Sign 请求签名服务签名, 失败时返回空的签名, 验证不会通过
+func (k *remoteKey) Sign(msg []byte) crypto.Signature {
+	r, err := k.c.Sign(k.kind, k.height, k.round, msg)
+	if err != nil {
+		plog.Error("remote sign error", "err", err, "kind", k.kind, "height", k.height, "round", k.round)
+		return remoteSig(nil)
+	}
+	return remoteSig(r.Signature)
+}
+
+func (k *remoteKey) PubKey() crypto.PubKey {
+	return k.pub
+}
+
+func (k *remoteKey) Equals(o crypto.PrivKey) bool {
+	ok, is := o.(*remoteKey)
+	return is && ok.pub.Equals(k.pub)
+}
+
+func (k *remoteKey) EvaluateVrf(suite string, input []byte) ([]byte, []byte, error) {
+	r, err := k.c.SignVrf(suite, input)
+	if err != nil {
+		return nil, nil, err
+	}
+	return r.Hash, r.Proof, nil
+}
+
+func (k *remoteKey) SignBls(msg []byte) *types.Signature {
+	r, err := k.c.Sign(signer.KindVote, k.height, k.round, msg)
+	if err != nil {
+		plog.Error("remote bls sign error", "err", err, "height", k.height, "round", k.round)
+		return nil
+	}
+	return &types.Signature{Ty: bls33.ID, Pubkey: r.Pubkey, Signature: r.Signature}
+}
+
+// remoteSig 签名服务返回的签名
+type remoteSig []byte
+
+func (s remoteSig) Bytes() []byte {
+	return s
+}
+
+func (s remoteSig) IsZero() bool {
+	return len(s) == 0
+}
+
+func (s remoteSig) String() string {
+	return hex.EncodeToString(s)
+}
+
+func (s remoteSig) Equals(o crypto.Signature) bool {
+	return bytes.Equal(s, o.Bytes())
+}
+
+// signMsg 用priv签名kind类型的消息msg, 和签名服务一样签signer.Payload(kind, msg), 用于KindBlock和KindMsg
+func signMsg(priv crypto.PrivKey, kind string, msg []byte) crypto.Signature {
+	if rk, ok := priv.(*remoteKey); ok {
+		return rk.with(kind, rk.height, int(rk.round)).Sign(msg)
+	}
+	return priv.Sign(signer.Payload(kind, msg))
+}
+
+// checkMsgSign 检查signMsg的签名
+func checkMsgSign(kind string, msg []byte, sig *types.Signature, height int64) bool {
+	return types.CheckSign(signer.Payload(kind, msg), "", sig, height)
+}
+
+// signDigest 签名sha256(raw), 和pt里消息的Sign相同. 远程签名时发送raw, 签名服务检查格式以后计算hash
+func signDigest(priv crypto.PrivKey, raw []byte) crypto.Signature {
+	if _, ok := priv.(*remoteKey); ok {
+		return priv.Sign(raw)
+	}
+	return priv.Sign(crypto.Sha256(raw))
+}
+
+// signSortsVote 签名委员会投票, 和m.Sign相同
+func signSortsVote(m *pt.Pos33SortsVote, priv crypto.PrivKey) {
+	m.Sig = nil
+	sig := signDigest(priv, types.Encode(m))
+	m.Sig = &types.Signature{Ty: types.SECP256K1, Pubkey: priv.PubKey().Bytes(), Signature: sig.Bytes()}
+}
+
+// signNoSeats 签名没有中签的证明, 和m.Sign相同
+func signNoSeats(m *pt.Pos33NoSeats, priv crypto.PrivKey) {
+	m.Sig = nil
+	sig := signDigest(priv, types.Encode(m))
+	m.Sig = &types.Signature{Ty: types.SECP256K1, Pubkey: priv.PubKey().Bytes(), Signature: sig.Bytes()}
+}
+
+// signKey 签名kind类型消息使用的私钥, 本地私钥直接返回
+func (client *Client) signKey(kind string, height int64, round int) crypto.PrivKey {
+	priv := client.getPriv()
+	if rk, ok := priv.(*remoteKey); ok {
+		return rk.with(kind, height, round)
+	}
+	return priv
+}
+
+// tempP2PKey 远程签名时p2p使用的临时私钥
+func tempP2PKey() (crypto.PrivKey, error) {
+	cr, err := crypto.Load(types.GetSignName("", types.SECP256K1), -1)
+	if err != nil {
+		return nil, err
+	}
+	return cr.GenKey()
+}
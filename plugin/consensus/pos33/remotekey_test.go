@@ -0,0 +1,78 @@
+package pos33
+
+import (
+	"net"
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+	"github.com/yccproject/ycc/plugin/consensus/pos33/signer"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func TestRemoteSigner(t *testing.T) {
+	priv := newTestPriv(t)
+	s, err := signer.NewServer(priv, "", "token")
+	require.Nil(t, err)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	defer lis.Close()
+	go s.Serve(lis)
+
+	n, _ := newTestNode(t, &subConfig{RemoteSigner: lis.Addr().String(), RemoteSignerToken: "token"})
+	n.getMiner()
+	rk, ok := n.priv.(*remoteKey)
+	require.True(t, ok)
+	require.Nil(t, rk.Bytes())
+	require.Equal(t, n.mySortAddr(100), n.sortAddr(100, priv.PubKey().Bytes()))
+
+	// 远程计算的vrf和本地相同
+	input := &pt.VrfInput{Seed: []byte("seed"), Height: 100}
+	p1 := n.makeProof(input, rk)
+	p2 := n.makeProof(input, priv)
+	require.Equal(t, p2, p1)
+
+	// 远程签名的投票使用和本地相同的bls私钥, 同一轮不能投两个区块
+	vs := signVotes(n.signKey(signer.KindVote, 100, 0), newTestVotes(t, 3, 100))
+	require.Equal(t, 3, len(vs))
+	blsPub := pt.Hash2BlsSk(crypto.Sha256(priv.Bytes())).PubKey().Bytes()
+	for _, v := range vs {
+		require.True(t, v.Verify())
+		require.Equal(t, blsPub, v.Sig.Pubkey)
+	}
+	other := newTestVotes(t, 1, 100)
+	other[0].Hash = hash2([]byte("other block"))
+	require.Equal(t, 0, len(signVotes(n.signKey(signer.KindVote, 100, 0), other)))
+
+	m := &pt.Pos33NoSeats{Proof: p1, Count: 1}
+	signNoSeats(m, n.signKey(signer.KindNoSeats, 100, 0))
+	require.True(t, m.Verify())
+	// 委员会投票和本地签名的相同
+	cv := &pt.Pos33SortsVote{Height: 100, SelectSorts: [][]byte{[]byte("a")}}
+	signSortsVote(cv, n.signKey(signer.KindCommittee, 100, 0))
+	lv := &pt.Pos33SortsVote{Height: 100, SelectSorts: [][]byte{[]byte("a")}}
+	lv.Sign(priv)
+	require.Equal(t, lv.Sig.Signature, cv.Sig.Signature)
+	// 其他消息加上前缀, 和本地签名的相同
+	msg := hash2([]byte("msg"))
+	sig := signMsg(n.signKey(signer.KindMsg, 100, 0), signer.KindMsg, msg)
+	require.Equal(t, signMsg(priv, signer.KindMsg, msg).Bytes(), sig.Bytes())
+
+	// 同一轮只签一个minerTx
+	newTx := func(hash string) *types.Transaction {
+		s := &pt.Pos33SortMsg{Proof: &pt.HashProof{Input: &pt.VrfInput{Height: 100}}}
+		act := &pt.Pos33TicketAction{Value: &pt.Pos33TicketAction_Miner{Miner: &pt.Pos33MinerMsg{Sort: s, Hash: []byte(hash)}}, Ty: pt.Pos33TicketActionMiner}
+		return &types.Transaction{Execer: []byte(pt.Pos33TicketX), Payload: types.Encode(act)}
+	}
+	tx := newTx("a")
+	tx.Sign(types.EncodeSignID(types.SECP256K1, ethID), n.signKey(signer.KindMinerTx, 100, 0))
+	require.True(t, tx.CheckSign(100))
+	tx = newTx("b")
+	tx.Sign(types.EncodeSignID(types.SECP256K1, ethID), n.signKey(signer.KindMinerTx, 100, 0))
+	require.Equal(t, 0, len(tx.Signature.Signature))
+	// 不是miner交易的不签
+	tx = &types.Transaction{Execer: []byte(pt.Pos33TicketX), Payload: []byte("a")}
+	tx.Sign(types.EncodeSignID(types.SECP256K1, ethID), n.signKey(signer.KindMinerTx, 101, 0))
+	require.Equal(t, 0, len(tx.Signature.Signature))
+}
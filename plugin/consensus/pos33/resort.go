@@ -0,0 +1,83 @@
+package pos33
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// sortResult 我在height高度round轮的抽签结果
+type sortResult struct {
+	seed   []byte
+	height int64
+	round  int
+	ss     []*pt.Pos33SortMsg
+	tm     *sortTimer
+}
+
+// resorter 合并重新抽签的请求.
+// 抽签比请求慢时, 只计算最新的(height, round), 中间的请求直接丢弃, 正在计算的取消
+type resorter struct {
+	mu      sync.Mutex
+	pending *sortResult
+	cancel  context.CancelFunc
+	wake    chan struct{}
+	out     chan *sortResult
+
+	sort     func(ctx context.Context, seed []byte, height int64, round int) *sortResult
+	computed int64
+}
+
+func newResorter(sort func(ctx context.Context, seed []byte, height int64, round int) *sortResult) *resorter {
+	return &resorter{
+		wake: make(chan struct{}, 1),
+		out:  make(chan *sortResult, 1),
+		sort: sort,
+	}
+}
+
+func (r *resorter) trigger(seed []byte, height int64, round int) {
+	r.mu.Lock()
+	r.pending = &sortResult{seed: seed, height: height, round: round}
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.mu.Unlock()
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (r *resorter) run() {
+	for range r.wake {
+		r.mu.Lock()
+		req := r.pending
+		r.pending = nil
+		ctx, cancel := context.WithCancel(context.Background())
+		r.cancel = cancel
+		r.mu.Unlock()
+		if req == nil {
+			cancel()
+			continue
+		}
+		res := r.sort(ctx, req.seed, req.height, req.round)
+		r.mu.Lock()
+		r.cancel = nil
+		stale := ctx.Err() != nil
+		r.mu.Unlock()
+		cancel()
+		// 被新的请求取消, 结果不完整, 丢弃
+		if stale {
+			continue
+		}
+		atomic.AddInt64(&r.computed, 1)
+		r.out <- res
+	}
+}
+
+func (r *resorter) count() int64 {
+	return atomic.LoadInt64(&r.computed)
+}
@@ -0,0 +1,150 @@
+package pos33
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func TestResorterCoalesce(t *testing.T) {
+	r := newResorter(func(ctx context.Context, seed []byte, height int64, round int) *sortResult {
+		time.Sleep(time.Millisecond * 20)
+		return &sortResult{seed: seed, height: height, round: round}
+	})
+	go r.run()
+
+	for i := 0; i < 100; i++ {
+		r.trigger(nil, 100, i)
+	}
+
+	var last *sortResult
+	tm := time.After(time.Second)
+FOR:
+	for {
+		select {
+		case last = <-r.out:
+			if last.round == 99 {
+				break FOR
+			}
+		case <-tm:
+			t.Fatal("timeout")
+		}
+	}
+	require.Equal(t, int64(100), last.height)
+	require.True(t, r.count() <= 3, "computed %d", r.count())
+}
+
+func TestVrfMemo(t *testing.T) {
+	m := newVrfMemo(2, 0)
+	priv := newTestPriv(t)
+	in := &pt.VrfInput{Seed: []byte("seed"), Height: 100}
+
+	h1, p1 := m.evaluate("", in, priv)
+	h2, p2 := calcuVrfHash(in, priv)
+	require.Equal(t, h2, h1)
+	require.Nil(t, vrfVerify("", priv.PubKey().Bytes(), types.Encode(in), p2, h1))
+	require.Nil(t, vrfVerify("", priv.PubKey().Bytes(), types.Encode(in), p1, h2))
+	require.Equal(t, 1, m.cache.Len())
+
+	h3, _ := m.evaluate("", in, priv)
+	require.Equal(t, h1, h3)
+	require.Equal(t, 1, m.cache.Len())
+
+	// 其他的私钥结果不同
+	h4, _ := m.evaluate("", in, newTestPriv(t))
+	require.NotEqual(t, h1, h4)
+	require.Equal(t, 2, m.cache.Len())
+}
+
+func TestVrfMemoExpire(t *testing.T) {
+	m := newVrfMemo(100, 10)
+	priv := newTestPriv(t)
+	for h := int64(1); h <= 20; h++ {
+		m.evaluate("", &pt.VrfInput{Seed: []byte("seed"), Height: h}, priv)
+	}
+	require.Equal(t, 20, m.size())
+
+	m.expire(11)
+	require.Equal(t, 20, m.size())
+
+	// 高度增加后, 小于 height-maxAge 的结果被清除
+	m.expire(15)
+	require.Equal(t, 16, m.size())
+	require.Equal(t, int64(4), m.evictions())
+	require.Equal(t, int64(16), vrfMemoSizeGauge.Value())
+	for _, k := range m.cache.Keys() {
+		v, _ := m.cache.Peek(k)
+		require.True(t, v.(*vrfEntry).height >= 5)
+	}
+
+	m.expire(100)
+	require.Equal(t, 0, m.size())
+	require.Equal(t, int64(20), m.evictions())
+
+	// LRU的大小限制也计入
+	m = newVrfMemo(2, 10)
+	for h := int64(1); h <= 3; h++ {
+		m.evaluate("", &pt.VrfInput{Seed: []byte("seed"), Height: h}, priv)
+	}
+	require.Equal(t, 2, m.size())
+	require.Equal(t, int64(1), m.evictions())
+}
+
+func TestResorterCancel(t *testing.T) {
+	started := make(chan int, 2)
+	r := newResorter(func(ctx context.Context, seed []byte, height int64, round int) *sortResult {
+		started <- round
+		if round == 0 {
+			// 直到被新的请求取消
+			<-ctx.Done()
+		}
+		return &sortResult{height: height, round: round}
+	})
+	go r.run()
+
+	r.trigger(nil, 100, 0)
+	require.Equal(t, 0, <-started)
+	r.trigger(nil, 100, 1)
+	select {
+	case res := <-r.out:
+		require.Equal(t, 1, res.round)
+	case <-time.After(time.Second):
+		t.Fatal("timeout")
+	}
+	require.Equal(t, 1, <-started)
+	require.Equal(t, int64(1), r.count())
+}
+
+func TestVrfVerifyCache(t *testing.T) {
+	priv := newTestPriv(t)
+	pub := priv.PubKey().Bytes()
+	in := types.Encode(&pt.VrfInput{Seed: []byte("verify cache"), Height: 100})
+	hash, proof := calcuVrfHash(&pt.VrfInput{Seed: []byte("verify cache"), Height: 100}, priv)
+
+	hit, miss := vrfVerifyHitCounter.Count(), vrfVerifyMissCounter.Count()
+	require.Nil(t, vrfVerify("", pub, in, proof, hash))
+	require.Equal(t, miss+1, vrfVerifyMissCounter.Count())
+	require.Nil(t, vrfVerify("", pub, in, proof, hash))
+	require.Equal(t, hit+1, vrfVerifyHitCounter.Count())
+	require.Equal(t, miss+1, vrfVerifyMissCounter.Count())
+
+	// 命中时也检查hash, 正确的proof配伪造的hash验证失败
+	forged := hash2([]byte("forged vrf hash"))
+	require.Equal(t, pt.ErrVrfVerify, vrfVerify("", pub, in, proof, forged))
+	require.Equal(t, hit+2, vrfVerifyHitCounter.Count())
+
+	// 错误的proof不缓存
+	bad := append([]byte{}, proof...)
+	bad[0] ^= 1
+	require.Equal(t, pt.ErrVrfVerify, vrfVerify("", pub, in, bad, hash))
+	require.Equal(t, pt.ErrVrfVerify, vrfVerify("", pub, in, bad, hash))
+	require.Equal(t, miss+3, vrfVerifyMissCounter.Count())
+
+	// 别的input使用同样的proof
+	other := types.Encode(&pt.VrfInput{Seed: []byte("other"), Height: 100})
+	require.Equal(t, pt.ErrVrfVerify, vrfVerify("", pub, other, proof, hash))
+}
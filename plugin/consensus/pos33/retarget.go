@@ -0,0 +1,157 @@
+package pos33
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// ForkDiffV2以后的难度调整参数
+const (
+	// diffEmaBlocks 计算委员会大小移动平均的区块数, 到快照高度为止
+	diffEmaBlocks = 20
+	// diffRetargetMin, diffRetargetMax 移动平均调整难度的倍数范围
+	diffRetargetMin = 0.5
+	diffRetargetMax = 2.0
+	// diffRoundRelax 每一轮没有出块, 下一轮难度放宽的倍数
+	diffRoundRelax = 1.5
+	// diffRoundRelaxMax 按轮次放宽的最大倍数
+	diffRoundRelaxMax = 16.0
+)
+
+// emaSize 按高度从低到高的委员会大小的指数移动平均, 越近的高度权重越大
+func emaSize(sizes []int) float64 {
+	if len(sizes) == 0 {
+		return 0
+	}
+	alpha := 2 / float64(len(sizes)+1)
+	ema := float64(sizes[0])
+	for _, s := range sizes[1:] {
+		ema += alpha * (float64(s) - ema)
+	}
+	return ema
+}
+
+// retargetFactor 委员会大小的移动平均是ema, 目标是target时难度调整的倍数
+func retargetFactor(ema float64, target int) float64 {
+	if ema <= 0 {
+		return diffRetargetMax
+	}
+	f := float64(target) / ema
+	if f < diffRetargetMin {
+		return diffRetargetMin
+	}
+	if f > diffRetargetMax {
+		return diffRetargetMax
+	}
+	return f
+}
+
+// roundRelax round轮的难度放宽倍数, 用连乘保证所有节点的结果相同
+func roundRelax(round int) float64 {
+	f := 1.0
+	for i := 0; i < round && f < diffRoundRelaxMax; i++ {
+		f *= diffRoundRelax
+	}
+	if f > diffRoundRelaxMax {
+		return diffRoundRelaxMax
+	}
+	return f
+}
+
+// setBlockVotes 记录区块b的投票数, 作为这个高度实际的委员会大小, 需要持有mlock
+func (c *Client) setBlockVotes(b *types.Block) {
+	// 只记录分叉以后调整难度要用到的高度
+	h := b.Height + pt.Pos33SortBlocks + diffEmaBlocks
+	if b.Height == 0 || !c.GetAPI().GetConfig().IsDappFork(h, pt.Pos33TicketX, "ForkDiffV2") {
+		return
+	}
+	m, err := getMiner(b)
+	if err != nil {
+		plog.Error("block votes error", "err", err, "height", b.Height)
+		return
+	}
+	c.csMap[b.Height] = m.VoterCount()
+	delete(c.csMap, b.Height-diffScheduleBlocks)
+	// 回滚以后用到这个高度的调整倍数重新计算
+	for sh := range c.rtMap {
+		if sh >= b.Height {
+			delete(c.rtMap, sh)
+		}
+	}
+}
+
+// blockVotes 返回[from, to]高度区块的投票数, 没有记录的高度查询区块
+func (c *Client) blockVotes(from, to int64) ([]int, error) {
+	var sizes []int
+	for h := from; h <= to; h++ {
+		c.mlock.Lock()
+		nv, ok := c.csMap[h]
+		c.mlock.Unlock()
+		if !ok {
+			b, err := c.RequestBlock(h)
+			if err != nil {
+				return nil, err
+			}
+			m, err := getMiner(b)
+			if err != nil {
+				return nil, err
+			}
+			nv = m.VoterCount()
+		}
+		sizes = append(sizes, nv)
+	}
+	return sizes, nil
+}
+
+// voterTarget height高度区块投票数的目标: 治理参数的委员会大小, 不超过一个区块能打包的投票数
+func (c *Client) voterTarget(height int64) int {
+	size := int(c.chainParam(height).CommitteeSize)
+	if size > pt.Pos33VoterSize {
+		return pt.Pos33VoterSize
+	}
+	return size
+}
+
+// retarget 返回height高度按快照高度以前diffEmaBlocks个区块的投票数调整难度的倍数.
+// 只用已经确定的区块, 所有节点的结果相同
+func (c *Client) retarget(height int64) (float64, error) {
+	sh := c.sortHeight(height)
+	c.mlock.Lock()
+	f, ok := c.rtMap[sh]
+	c.mlock.Unlock()
+	if ok {
+		return f, nil
+	}
+	from := sh - diffEmaBlocks + 1
+	if from < 1 {
+		from = 1
+	}
+	if sh < from {
+		return 1, nil
+	}
+	sizes, err := c.blockVotes(from, sh)
+	if err != nil {
+		return 1, fmt.Errorf("retarget height %d: %v", height, err)
+	}
+	f = retargetFactor(emaSize(sizes), c.voterTarget(height))
+	c.mlock.Lock()
+	c.rtMap[sh] = f
+	delete(c.rtMap, sh-diffScheduleBlocks)
+	c.mlock.Unlock()
+	return f, nil
+}
+
+// diffV2 ForkDiffV2以后height高度round轮的难度: 按最近的委员会大小调整, 再按轮次放宽.
+// 读取区块出错时返回错误, 不能用倍数1代替, 否则节点之间的难度不同
+func (c *Client) diffV2(height int64, round int, diff float64) (float64, error) {
+	if !c.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkDiffV2") {
+		return diff, nil
+	}
+	f, err := c.retarget(height)
+	if err != nil {
+		return 0, err
+	}
+	return diff * f * roundRelax(round), nil
+}
@@ -0,0 +1,82 @@
+package pos33
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func TestRetargetHelpers(t *testing.T) {
+	require.Equal(t, float64(0), emaSize(nil))
+	require.Equal(t, float64(10), emaSize([]int{10, 10, 10}))
+	// 越近的高度权重越大
+	require.True(t, emaSize([]int{25, 25, 10}) < emaSize([]int{10, 25, 25}))
+
+	require.Equal(t, 1.0, retargetFactor(pt.Pos33VoterSize, pt.Pos33VoterSize))
+	require.Equal(t, 1.25, retargetFactor(20, pt.Pos33VoterSize))
+	require.Equal(t, diffRetargetMax, retargetFactor(5, pt.Pos33VoterSize))
+	require.Equal(t, diffRetargetMax, retargetFactor(0, pt.Pos33VoterSize))
+	require.Equal(t, diffRetargetMin, retargetFactor(100, pt.Pos33VoterSize))
+
+	require.Equal(t, 1.0, roundRelax(0))
+	require.Equal(t, 1.5, roundRelax(1))
+	require.Equal(t, 2.25, roundRelax(2))
+	require.Equal(t, diffRoundRelaxMax, roundRelax(100))
+}
+
+func TestDiffV2(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	cfg := n.GetAPI().GetConfig()
+	height := int64(100)
+	sh := n.sortHeight(height)
+	n.setTestCount(n.myAddr, sh, 10, 300)
+	base := n.calcDiff(height, 300)
+	requireDiff := func(expect float64, round int) {
+		diff, err := n.getDiff(height, round)
+		require.Nil(t, err)
+		require.Equal(t, expect, diff)
+	}
+
+	// 分叉以前和轮次无关
+	requireDiff(base, 0)
+	requireDiff(base, 3)
+
+	cfg.SetDappFork(pt.Pos33TicketX, "ForkDiffV2", 0)
+	n.mlock.Lock()
+	for h := sh - diffEmaBlocks + 1; h <= sh; h++ {
+		n.csMap[h] = 20
+	}
+	n.mlock.Unlock()
+	requireDiff(base*1.25, 0)
+	requireDiff(base*1.25*2.25, 2)
+
+	// 新的区块是回滚以后的, 快照高度以后的倍数重新计算
+	n.mlock.Lock()
+	for h := sh - diffEmaBlocks + 1; h <= sh; h++ {
+		n.csMap[h] = pt.Pos33VoterSize
+	}
+	n.setBlockVotes(newTestBlock(sh, nil))
+	n.mlock.Unlock()
+	f, err := n.retarget(height)
+	require.Nil(t, err)
+	require.True(t, f > 1 && f < 1.25)
+}
+
+func TestVoterTarget(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	cfg := n.GetAPI().GetConfig()
+	require.Equal(t, pt.Pos33VoterSize, n.voterTarget(100))
+
+	// 委员会大小按治理参数, 不超过区块能打包的投票数
+	cfg.SetDappFork(pt.Pos33TicketX, "ForkChainParam", 0)
+	ps := &pt.Pos33ChainParams{}
+	_, err := ps.Add(&pt.Pos33ChainParam{Height: 50, CommitteeSize: 10})
+	require.Nil(t, err)
+	_, err = ps.Add(&pt.Pos33ChainParam{Height: 80, CommitteeSize: 150})
+	require.Nil(t, err)
+	n.cparams.Store(ps)
+	require.Equal(t, pt.Pos33VoterSize, n.voterTarget(40))
+	require.Equal(t, 10, n.voterTarget(60))
+	require.Equal(t, pt.Pos33VoterSize, n.voterTarget(100))
+}
@@ -0,0 +1,124 @@
+package pos33
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// maxRewardsRange 一次最多统计多少个区块的奖励, 超过的从Next继续查询
+const maxRewardsRange = 1000
+
+// rewardsBatch 每次从blockchain取多少个区块
+const rewardsBatch = 100
+
+// rewardTally 统计一个地址在一段高度内的中签和奖励
+type rewardTally struct {
+	c     *Client
+	r     *pt.Pos33Rewards
+	owner blsOwnerFunc
+}
+
+// add 统计区块b的奖励, 和执行器Pos33MinerNew一致:
+// 每个投票得到voteReward, 出块的矿工每个投票再得到mineReward.
+// ForkRewardV2以后每个投票得到perVoteReward, 出块的矿工得到makerBonus和累积的超时轮次的奖励, 段里的mineReward是makerBonus.
+// 委员会使用committeeOf, 和ReconstructCommittee的结果一致
+func (t *rewardTally) add(b *types.Block) error {
+	comm, err := t.c.committeeOf(b, t.owner)
+	if err != nil {
+		return err
+	}
+	seats := int64(0)
+	for _, mb := range comm.Members {
+		if mb.Addr == t.r.Addr {
+			seats = mb.Seats
+		}
+	}
+	blocks, votes := int64(0), int64(0)
+	if comm.Maker == t.r.Addr {
+		blocks, votes = 1, comm.Seats
+	}
+
+	cfg := t.c.GetAPI().GetConfig()
+	mp := pt.GetPos33MineParam(cfg, b.Height)
+	if !cfg.IsDappFork(b.Height, pt.Pos33TicketX, "ForkRewardV2") {
+		t.tally(b.Height, mp.VoteReward, mp.MineReward, seats, blocks, votes, votes*mp.MineReward)
+		return nil
+	}
+	mine := int64(0)
+	if blocks > 0 {
+		mr, err := mp.MinerRewardV2(int(comm.Seats), int(comm.Round))
+		if err != nil {
+			return err
+		}
+		mine = mr.MakerReward
+	}
+	t.tally(b.Height, mp.PerVoteReward, mp.MakerBonus, seats, blocks, votes, mine)
+	return nil
+}
+
+// tally 把一个区块的奖励加到奖励参数相同的最后一段, 参数改变时开始新的一段
+func (t *rewardTally) tally(height, voteReward, mineReward, seats, blocks, votes, mine int64) {
+	r := t.r
+	var seg *pt.Pos33RewardSegment
+	if n := len(r.Segments); n > 0 && r.Segments[n-1].VoteReward == voteReward && r.Segments[n-1].MineReward == mineReward {
+		seg = r.Segments[n-1]
+	} else {
+		seg = &pt.Pos33RewardSegment{Start: height, VoteReward: voteReward, MineReward: mineReward}
+		r.Segments = append(r.Segments, seg)
+	}
+	seg.End = height
+	seg.Seats += seats
+	seg.Blocks += blocks
+	seg.BlockVotes += votes
+	seg.Reward += seats*voteReward + mine
+
+	r.Seats += seats
+	r.Blocks += blocks
+	r.VoteReward += seats * voteReward
+	r.MineReward += mine
+	r.Total = r.VoteReward + r.MineReward
+}
+
+// rewards 统计addr在[start, end]高度得到的奖励, 最多maxRewardsRange个区块
+func (c *Client) rewards(req *pt.ReqPos33Rewards) (*pt.Pos33Rewards, error) {
+	if req.Addr == "" {
+		return nil, fmt.Errorf("rewards error: addr is empty")
+	}
+	if req.Start <= 0 || req.End < req.Start {
+		return nil, fmt.Errorf("rewards error: range [%d, %d] error", req.Start, req.End)
+	}
+	r := &pt.Pos33Rewards{Addr: req.Addr, Start: req.Start, End: req.End}
+	if r.End-r.Start+1 > maxRewardsRange {
+		r.End = r.Start + maxRewardsRange - 1
+		r.Next = r.End + 1
+	}
+
+	t := &rewardTally{c: c, r: r, owner: c.newBlsOwner()}
+	for start := r.Start; start <= r.End; start += rewardsBatch {
+		end := start + rewardsBatch - 1
+		if end > r.End {
+			end = r.End
+		}
+		bs, err := c.GetAPI().GetBlocks(&types.ReqBlocks{Start: start, End: end})
+		if err != nil {
+			return nil, err
+		}
+		if len(bs.Items) != int(end-start+1) {
+			return nil, fmt.Errorf("rewards error: blocks [%d, %d] NOT found", start, end)
+		}
+		for _, d := range bs.Items {
+			err = t.add(d.Block)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return r, nil
+}
+
+// Query_GetRewards 查询addr在一段高度内得到的奖励
+func (client *Client) Query_GetRewards(req *pt.ReqPos33Rewards) (types.Message, error) {
+	return client.rewards(req)
+}
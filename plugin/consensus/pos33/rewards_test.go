@@ -0,0 +1,162 @@
+package pos33
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// 104高度开始投票奖励从25%变成50%
+const testRewardsCfg = `voteRewardPersent=25
+mineRewardPersent=11
+[mver.consensus.pos33.UseEntrust]
+voteRewardPersent=50
+[fork.sub.pos33]
+UseEntrust=104
+`
+
+// newTestRewardBlock maker出块, voters是投票的bls私钥
+func newTestRewardBlock(t *testing.T, height int64, maker crypto.PrivKey, voters []crypto.PrivKey) *types.Block {
+	m := &pt.Pos33MinerMsg{Sort: &pt.Pos33SortMsg{SortHash: &pt.SortHash{}, Proof: &pt.HashProof{Input: &pt.VrfInput{Height: height}}}}
+	for _, v := range voters {
+		m.BlsPkList = append(m.BlsPkList, v.PubKey().Bytes())
+	}
+	act := &pt.Pos33TicketAction{Value: &pt.Pos33TicketAction_Miner{Miner: m}, Ty: pt.Pos33TicketActionMiner}
+	tx := &types.Transaction{Execer: []byte(pt.Pos33TicketX), Payload: types.Encode(act)}
+	tx.Sign(types.EncodeSignID(types.SECP256K1, ethID), maker)
+	return &types.Block{Height: height, Txs: []*types.Transaction{tx}}
+}
+
+func TestRewards(t *testing.T) {
+	n, api := newTestNodeCfg(t, testCfgString()+testRewardsCfg, nil)
+	cfg := n.GetAPI().GetConfig()
+
+	a, b := newTestPriv(t), newTestPriv(t)
+	aAddr := address.PubKeyToAddr(ethID, a.PubKey().Bytes())
+	bAddr := address.PubKeyToAddr(ethID, b.PubKey().Bytes())
+	// a有两个投票, b有一个
+	var bls []crypto.PrivKey
+	for i, owner := range []string{aAddr, aAddr, bAddr} {
+		sk := pt.Hash2BlsSk(hash2([]byte{byte(i)}))
+		bls = append(bls, sk)
+		blsAddr := address.PubKeyToAddr(ethID, sk.PubKey().Bytes())
+		api.On("Query", pt.Pos33TicketX, "Pos33BlsAddr", &types.ReqAddr{Addr: blsAddr}).Return(&types.ReplyString{Data: owner}, nil)
+	}
+
+	// 101-106高度, a出了101和105, 105的投票里有重复的
+	blocks := []*types.Block{
+		newTestRewardBlock(t, 101, a, bls),
+		newTestRewardBlock(t, 102, b, bls),
+		newTestRewardBlock(t, 103, b, bls[2:]),
+		newTestRewardBlock(t, 104, b, bls[1:]),
+		newTestRewardBlock(t, 105, a, append(bls, bls[0])),
+		newTestRewardBlock(t, 106, b, bls[:1]),
+	}
+	var ds []*types.BlockDetail
+	for _, b := range blocks {
+		ds = append(ds, &types.BlockDetail{Block: b})
+	}
+	api.On("GetBlocks", &types.ReqBlocks{Start: 101, End: 106}).Return(&types.BlockDetails{Items: ds}, nil)
+
+	r, err := n.rewards(&pt.ReqPos33Rewards{Addr: aAddr, Start: 101, End: 106})
+	require.Nil(t, err)
+
+	mp1 := pt.GetPos33MineParam(cfg, 103)
+	mp2 := pt.GetPos33MineParam(cfg, 104)
+	require.Equal(t, 2*mp1.VoteReward, mp2.VoteReward)
+	require.Equal(t, mp1.MineReward, mp2.MineReward)
+
+	require.Equal(t, 2, len(r.Segments))
+	s1, s2 := r.Segments[0], r.Segments[1]
+	require.Equal(t, &pt.Pos33RewardSegment{Start: 101, End: 103, VoteReward: mp1.VoteReward, MineReward: mp1.MineReward,
+		Seats: 4, Blocks: 1, BlockVotes: 3, Reward: 4*mp1.VoteReward + 3*mp1.MineReward}, s1)
+	require.Equal(t, &pt.Pos33RewardSegment{Start: 104, End: 106, VoteReward: mp2.VoteReward, MineReward: mp2.MineReward,
+		Seats: 4, Blocks: 1, BlockVotes: 3, Reward: 4*mp2.VoteReward + 3*mp2.MineReward}, s2)
+
+	require.Equal(t, int64(8), r.Seats)
+	require.Equal(t, int64(2), r.Blocks)
+	require.Equal(t, s1.Reward+s2.Reward, r.Total)
+	require.Equal(t, r.VoteReward+r.MineReward, r.Total)
+	require.Equal(t, int64(0), r.Next)
+
+	// b的奖励
+	r, err = n.rewards(&pt.ReqPos33Rewards{Addr: bAddr, Start: 101, End: 106})
+	require.Nil(t, err)
+	require.Equal(t, int64(5), r.Seats)
+	require.Equal(t, int64(4), r.Blocks)
+	require.Equal(t, 3*mp1.VoteReward+2*mp2.VoteReward+(3+1)*mp1.MineReward+(2+1)*mp2.MineReward, r.Total)
+
+	// 超过最大范围时只统计maxRewardsRange个区块, 从Next继续
+	tx := blocks[0].Txs[0]
+	start := int64(1001)
+	for h := start; h < start+maxRewardsRange; h += rewardsBatch {
+		var ds []*types.BlockDetail
+		for i := h; i < h+rewardsBatch; i++ {
+			ds = append(ds, &types.BlockDetail{Block: &types.Block{Height: i, Txs: []*types.Transaction{tx}}})
+		}
+		api.On("GetBlocks", &types.ReqBlocks{Start: h, End: h + rewardsBatch - 1}).Return(&types.BlockDetails{Items: ds}, nil)
+	}
+	r, err = n.rewards(&pt.ReqPos33Rewards{Addr: aAddr, Start: start, End: start + 5000})
+	require.Nil(t, err)
+	require.Equal(t, start+maxRewardsRange-1, r.End)
+	require.Equal(t, start+maxRewardsRange, r.Next)
+	require.Equal(t, int64(2*maxRewardsRange), r.Seats)
+	require.Equal(t, int64(maxRewardsRange), r.Blocks)
+
+	_, err = n.rewards(&pt.ReqPos33Rewards{Addr: aAddr, Start: 106, End: 101})
+	require.NotNil(t, err)
+	_, err = n.rewards(&pt.ReqPos33Rewards{Start: 101, End: 106})
+	require.NotNil(t, err)
+}
+
+func TestRewardsV2(t *testing.T) {
+	n, api := newTestNodeCfg(t, testCfgString()+testRewardV2Cfg, nil)
+	cfg := n.GetAPI().GetConfig()
+	cfg.SetDappFork(pt.Pos33TicketX, "ForkRewardV2", 0)
+
+	a, b := newTestPriv(t), newTestPriv(t)
+	aAddr := address.PubKeyToAddr(ethID, a.PubKey().Bytes())
+	bAddr := address.PubKeyToAddr(ethID, b.PubKey().Bytes())
+	var bls []crypto.PrivKey
+	for i, owner := range []string{aAddr, aAddr, bAddr} {
+		sk := pt.Hash2BlsSk(hash2([]byte{byte(i)}))
+		bls = append(bls, sk)
+		blsAddr := address.PubKeyToAddr(ethID, sk.PubKey().Bytes())
+		api.On("Query", pt.Pos33TicketX, "Pos33BlsAddr", &types.ReqAddr{Addr: blsAddr}).Return(&types.ReplyString{Data: owner}, nil)
+	}
+
+	// a在102高度第2轮出块, 前面2轮的出块奖励累积给a
+	b102 := newTestRewardBlock(t, 102, a, bls[2:])
+	m, err := getMiner(b102)
+	require.Nil(t, err)
+	m.Sort.Proof.Input.Round = 2
+	act := &pt.Pos33TicketAction{Value: &pt.Pos33TicketAction_Miner{Miner: m}, Ty: pt.Pos33TicketActionMiner}
+	b102.Txs[0].Payload = types.Encode(act)
+	b102.Txs[0].Sign(types.EncodeSignID(types.SECP256K1, ethID), a)
+
+	var ds []*types.BlockDetail
+	for _, b := range []*types.Block{newTestRewardBlock(t, 101, a, bls), b102, newTestRewardBlock(t, 103, b, bls)} {
+		ds = append(ds, &types.BlockDetail{Block: b})
+	}
+	api.On("GetBlocks", &types.ReqBlocks{Start: 101, End: 103}).Return(&types.BlockDetails{Items: ds}, nil)
+
+	r, err := n.rewards(&pt.ReqPos33Rewards{Addr: aAddr, Start: 101, End: 103})
+	require.Nil(t, err)
+	mp := pt.GetPos33MineParam(cfg, 101)
+	require.Equal(t, int64(1e8), mp.MakerBonus)
+	require.Equal(t, 1, len(r.Segments))
+	require.Equal(t, &pt.Pos33RewardSegment{Start: 101, End: 103, VoteReward: mp.PerVoteReward, MineReward: mp.MakerBonus,
+		Seats: 4, Blocks: 2, BlockVotes: 4, Reward: 4*mp.PerVoteReward + 4*mp.MakerBonus}, r.Segments[0])
+	require.Equal(t, 4*mp.MakerBonus, r.MineReward)
+	require.Equal(t, r.VoteReward+r.MineReward, r.Total)
+
+	// 出块的奖励和投票数无关
+	r, err = n.rewards(&pt.ReqPos33Rewards{Addr: bAddr, Start: 101, End: 103})
+	require.Nil(t, err)
+	require.Equal(t, int64(3), r.Seats)
+	require.Equal(t, 3*mp.PerVoteReward+mp.MakerBonus, r.Total)
+}
@@ -0,0 +1,42 @@
+package pos33
+
+import (
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// roundState 记录runLoop正在处理的高度和轮次, 只给查询使用
+type roundState struct {
+	mu     sync.Mutex
+	height int64
+	round  int
+	start  time.Time
+}
+
+// enter runLoop开始处理height高度的round轮
+func (s *roundState) enter(height int64, round int, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.height = height
+	s.round = round
+	s.start = now
+}
+
+// current 返回正在处理的高度, 轮次和已经在这一轮的时间
+func (s *roundState) current(now time.Time) *pt.Pos33CurrentRound {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r := &pt.Pos33CurrentRound{Height: s.height, Round: int32(s.round)}
+	if !s.start.IsZero() {
+		r.TimeInRound = now.Sub(s.start).Milliseconds()
+	}
+	return r
+}
+
+// Query_GetCurrentRound 查询节点正在处理的高度和轮次
+func (client *Client) Query_GetCurrentRound(req *types.ReqNil) (types.Message, error) {
+	return client.n.rstate.current(time.Now()), nil
+}
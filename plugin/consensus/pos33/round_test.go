@@ -0,0 +1,37 @@
+package pos33
+
+import (
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func TestCurrentRound(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	now := time.Now().Add(-time.Minute)
+	require.Equal(t, &pt.Pos33CurrentRound{}, n.rstate.current(now))
+
+	// 新区块100以后处理101的第0轮, 两次超时以后是第2轮
+	n.rstate.enter(101, 0, now)
+	for round := 1; round <= 2; round++ {
+		n.rstate.enter(101, round, now.Add(time.Duration(round)*3*time.Second))
+	}
+	r := n.rstate.current(now.Add(7 * time.Second))
+	require.Equal(t, &pt.Pos33CurrentRound{Height: 101, Round: 2, TimeInRound: 1000}, r)
+
+	// 查询不改变状态
+	msg, err := n.Query_GetCurrentRound(&types.ReqNil{})
+	require.Nil(t, err)
+	cr := msg.(*pt.Pos33CurrentRound)
+	require.Equal(t, int64(101), cr.Height)
+	require.Equal(t, int32(2), cr.Round)
+	require.True(t, cr.TimeInRound >= 54000)
+	require.Equal(t, r, n.rstate.current(now.Add(7*time.Second)))
+
+	// 下一个区块回到第0轮
+	n.rstate.enter(102, 0, now.Add(8*time.Second))
+	require.Equal(t, &pt.Pos33CurrentRound{Height: 102}, n.rstate.current(now.Add(8*time.Second)))
+}
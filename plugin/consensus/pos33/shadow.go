@@ -0,0 +1,72 @@
+package pos33
+
+import (
+	"github.com/33cn/chain33/types"
+	metrics "github.com/rcrowley/go-metrics"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// 影子检查: 候选的共识规则在生效前和当前的规则一起验证区块, 只记录会拒绝多少区块, 不影响验证结果
+const (
+	ShadowQuorum        = "quorum"
+	ShadowRoundEvidence = "roundevidence"
+)
+
+// shadowCounter checked是检查过的区块数, rejected是候选规则会拒绝的区块数
+type shadowCounter struct {
+	checked  metrics.Counter
+	rejected metrics.Counter
+}
+
+var shadowCounters = map[string]*shadowCounter{
+	ShadowQuorum:        newShadowCounter(ShadowQuorum),
+	ShadowRoundEvidence: newShadowCounter(ShadowRoundEvidence),
+}
+
+func newShadowCounter(name string) *shadowCounter {
+	return &shadowCounter{
+		checked:  metrics.GetOrRegisterCounter("pos33/shadow/"+name+"/checked", nil),
+		rejected: metrics.GetOrRegisterCounter("pos33/shadow/"+name+"/rejected", nil),
+	}
+}
+
+// shadowRules 配置的候选规则
+type shadowRules struct {
+	quorum        QuorumRule
+	roundEvidence bool
+}
+
+func newShadowRules(conf *subConfig) (*shadowRules, error) {
+	s := &shadowRules{roundEvidence: conf.ShadowRoundEvidence}
+	if conf.ShadowQuorumRule != "" {
+		rule, err := NewQuorumRule(conf.ShadowQuorumRule)
+		if err != nil {
+			return nil, err
+		}
+		s.quorum = rule
+	}
+	return s, nil
+}
+
+// shadow 用候选规则f检查区块, 拒绝时只记录日志和metrics
+func shadow(name string, height int64, f func() error) {
+	c := shadowCounters[name]
+	c.checked.Inc(1)
+	err := f()
+	if err != nil {
+		c.rejected.Inc(1)
+		plog.Info("shadow rule would reject block", "rule", name, "height", height, "err", err)
+	}
+}
+
+// shadowBlock 当前规则接受的区块, 再用候选规则检查
+func (n *node) shadowBlock(b, pb *types.Block, m *pt.Pos33MinerMsg) {
+	if n.shadows.quorum != nil {
+		shadow(ShadowQuorum, b.Height, func() error {
+			return n.quorumSufficient(n.shadows.quorum, b.Height, m)
+		})
+	}
+	if n.shadows.roundEvidence {
+		shadow(ShadowRoundEvidence, b.Height, func() error { return roundEvidence(b, pb, m) })
+	}
+}
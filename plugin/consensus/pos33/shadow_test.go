@@ -0,0 +1,87 @@
+package pos33
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// newTestParentBlock 区块时间是blockTime的父区块
+func newTestParentBlock(height, blockTime int64) *types.Block {
+	m := &pt.Pos33MinerMsg{BlockTime: blockTime, Sort: &pt.Pos33SortMsg{SortHash: &pt.SortHash{}, Proof: &pt.HashProof{Input: &pt.VrfInput{Height: height}}}}
+	act := &pt.Pos33TicketAction{Value: &pt.Pos33TicketAction_Miner{Miner: m}, Ty: pt.Pos33TicketActionMiner}
+	tx := &types.Transaction{Execer: []byte(pt.Pos33TicketX), Payload: types.Encode(act)}
+	return &types.Block{Height: height, Txs: []*types.Transaction{tx}}
+}
+
+func TestShadowRules(t *testing.T) {
+	_, err := newShadowRules(&subConfig{ShadowQuorumRule: "majority"})
+	require.NotNil(t, err)
+
+	n, api := newTestNode(t, &subConfig{ShadowQuorumRule: "stake:50", ShadowRoundEvidence: true})
+	n.setTestMiner(newTestPriv(t))
+	require.False(t, n.GetAPI().GetConfig().IsDappFork(100, pt.Pos33TicketX, "ForkQuorumRule"))
+	require.False(t, n.GetAPI().GetConfig().IsDappFork(100, pt.Pos33TicketX, "ForkRoundEvidence"))
+
+	height := int64(100)
+	sh := height - pt.Pos33SortBlocks
+	seed := []byte("shadow rule seed")
+	all := pt.Pos33CommitteeSize
+	n.setTestCount(n.myAddr, sh, 10, all)
+	ss := n.committeeSort(context.Background(), seed, height, 1, Committee)
+	require.NotEmpty(t, ss)
+	s := ss[0]
+	mockStateHeaders(api)
+
+	newBlock := func(nv int, owner func(i int) string, count int64) *types.Block {
+		b := newTestMinerBlock(t, n, s, s.SortHash.Hash, nv)
+		m, err := getMiner(b)
+		require.Nil(t, err)
+		for i, pk := range m.BlsPkList {
+			onBlsAddr(api, pk, height-1, owner(i))
+			n.setTestCount(owner(i), sh, count, all)
+		}
+		return b
+	}
+	// 13个投票人每个1票: 当前规则接受, 候选规则会拒绝
+	small := newBlock(13, func(i int) string { return fmt.Sprintf("small%d", i) }, 1)
+	// 5个投票人属于同一个地址: 当前规则拒绝
+	big := newBlock(5, func(int) string { return "big" }, 40)
+	m, err := getMiner(small)
+	require.Nil(t, err)
+	// 父区块时间太近, 候选的轮次证据检查会拒绝
+	near := newTestParentBlock(height-1, m.BlockTime)
+	far := newTestParentBlock(height-1, m.BlockTime-2*roundEvidenceTime)
+
+	quorum, round := shadowCounters[ShadowQuorum], shadowCounters[ShadowRoundEvidence]
+	cases := []struct {
+		b, pb          *types.Block
+		accept         bool
+		quorumRejected int64
+		roundRejected  int64
+		checked        int64
+	}{
+		{small, near, true, 1, 1, 1},
+		{small, far, true, 1, 0, 1},
+		{big, near, false, 0, 0, 0},
+	}
+	for i, c := range cases {
+		qc, qr, rc, rr := quorum.checked.Count(), quorum.rejected.Count(), round.checked.Count(), round.rejected.Count()
+		r := n.verifyBlockConsensus(c.b, c.pb, seed)
+		require.Equal(t, c.accept, r.Err() == nil, "case %d: %s", i, r.String())
+		require.Equal(t, c.checked, quorum.checked.Count()-qc, i)
+		require.Equal(t, c.checked, round.checked.Count()-rc, i)
+		require.Equal(t, c.quorumRejected, quorum.rejected.Count()-qr, i)
+		require.Equal(t, c.roundRejected, round.rejected.Count()-rr, i)
+
+		// 没有影子检查时结果完全相同
+		shadows := n.shadows
+		n.shadows = &shadowRules{}
+		require.Equal(t, r.String(), n.verifyBlockConsensus(c.b, c.pb, seed).String(), i)
+		n.shadows = shadows
+	}
+}
@@ -0,0 +1,117 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Client 连接远程签名服务, 每个请求有超时, 连接失败时重试
+type Client struct {
+	conn    *grpc.ClientConn
+	c       pt.Pos33SignerClient
+	timeout time.Duration
+	retries int
+}
+
+// tokenCreds 每个请求带上签名服务的token
+type tokenCreds string
+
+func (t tokenCreds) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{tokenKey: string(t)}, nil
+}
+
+// RequireTransportSecurity 签名服务一般在本机或者unix socket上, 跨机器时使用tls隧道
+func (t tokenCreds) RequireTransportSecurity() bool {
+	return false
+}
+
+// Dial 连接签名服务addr(host:port或者unix:///path), 不等待连接建立, token是签名服务配置的token,
+// timeout是每个请求的超时, retries是请求失败时的重试次数
+func Dial(addr, token string, timeout time.Duration, retries int) (*Client, error) {
+	if token == "" {
+		return nil, fmt.Errorf("%w: token is empty", ErrToken)
+	}
+	// 签名服务重启时尽快重新连接, 请求在超时之前等待连接建立
+	bc := backoff.DefaultConfig
+	bc.BaseDelay = 100 * time.Millisecond
+	bc.MaxDelay = 5 * time.Second
+	conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithPerRPCCredentials(tokenCreds(token)),
+		grpc.WithConnectParams(grpc.ConnectParams{Backoff: bc, MinConnectTimeout: timeout}),
+		grpc.WithDefaultCallOptions(grpc.WaitForReady(true)))
+	if err != nil {
+		return nil, err
+	}
+	return &Client{conn: conn, c: pt.NewPos33SignerClient(conn), timeout: timeout, retries: retries}, nil
+}
+
+// Close 关闭连接
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// call 调用f, 不可重试的错误(拒绝签名, 参数错误, token不对)直接返回
+func (c *Client) call(f func(ctx context.Context) error) error {
+	var err error
+	for i := 0; i <= c.retries; i++ {
+		if i > 0 {
+			time.Sleep(time.Duration(i) * 50 * time.Millisecond)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+		err = f(ctx)
+		cancel()
+		if err == nil {
+			return nil
+		}
+		switch status.Code(err) {
+		case codes.FailedPrecondition, codes.InvalidArgument, codes.Unauthenticated:
+			return err
+		}
+		slog.Error("remote signer call error", "err", err, "try", i)
+	}
+	return err
+}
+
+// Info 查询签名服务的公钥
+func (c *Client) Info() (*pt.Pos33SignerInfo, error) {
+	var r *pt.Pos33SignerInfo
+	err := c.call(func(ctx context.Context) error {
+		var err error
+		r, err = c.c.GetSignerInfo(ctx, &pt.ReqPos33SignerInfo{})
+		return err
+	})
+	return r, err
+}
+
+// SignVrf 计算input的vrf
+func (c *Client) SignVrf(suite string, input []byte) (*pt.ReplyPos33SignVrf, error) {
+	var r *pt.ReplyPos33SignVrf
+	err := c.call(func(ctx context.Context) error {
+		var err error
+		r, err = c.c.SignVrf(ctx, &pt.ReqPos33SignVrf{Suite: suite, Input: input})
+		return err
+	})
+	return r, err
+}
+
+// Sign 签名msg, kind/height/round用于签名服务防止双签
+func (c *Client) Sign(kind string, height int64, round int32, msg []byte) (*pt.ReplyPos33Sign, error) {
+	var r *pt.ReplyPos33Sign
+	err := c.call(func(ctx context.Context) error {
+		var err error
+		r, err = c.c.Sign(ctx, &pt.ReqPos33Sign{Kind: kind, Height: height, Round: round, Msg: msg})
+		return err
+	})
+	return r, err
+}
+
+// IsDoubleSign err是否是签名服务拒绝了双签
+func IsDoubleSign(err error) bool {
+	return status.Code(err) == codes.FailedPrecondition
+}
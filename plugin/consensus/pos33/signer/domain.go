@@ -0,0 +1,144 @@
+package signer
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/golang/protobuf/proto"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// ErrDomain 消息不是请求的签名类型, 或者高度和轮次不对
+var ErrDomain = errors.New("sign message NOT match kind")
+
+// Payload 签名KindBlock和KindMsg类型的消息msg时实际签的内容.
+// 区块签的是区块hash本身, chain33检查区块签名时不认识前缀; KindMsg加上Domain前缀
+func Payload(kind string, msg []byte) []byte {
+	if kind == KindBlock {
+		return msg
+	}
+	return Domain(kind, msg)
+}
+
+// Domain 签名kind类型的消息msg时实际签的内容, 用于格式由pos33决定的KindMsg.
+// 0开头的不是合法的protobuf编码, 不会和交易, 预出块等消息混淆
+func Domain(kind string, msg []byte) []byte {
+	d := make([]byte, 0, len(kind)+len(msg)+8)
+	d = append(d, 0)
+	d = append(d, "pos33:"...)
+	d = append(d, kind...)
+	d = append(d, 0)
+	return append(d, msg...)
+}
+
+// decodeStrict 解码msg, 必须是m的规范编码
+func decodeStrict(msg []byte, m types.Message) error {
+	err := types.Decode(msg, m)
+	if err != nil {
+		return err
+	}
+	if len(proto.MessageReflect(m).GetUnknown()) > 0 || !bytes.Equal(types.Encode(m), msg) {
+		return errors.New("NOT canonical encoding")
+	}
+	return nil
+}
+
+// action 解码没有签名的pos33交易, 返回交易里的action
+func action(msg []byte) (*pt.Pos33TicketAction, error) {
+	tx := new(types.Transaction)
+	err := decodeStrict(msg, tx)
+	if err != nil {
+		return nil, err
+	}
+	if tx.Signature != nil || string(tx.Execer) != pt.Pos33TicketX {
+		return nil, fmt.Errorf("NOT unsigned %s tx", pt.Pos33TicketX)
+	}
+	act := new(pt.Pos33TicketAction)
+	err = decodeStrict(tx.Payload, act)
+	if err != nil {
+		return nil, err
+	}
+	return act, nil
+}
+
+// checkPosition 检查消息的高度和轮次和请求的相同, 双签检查按请求的高度和轮次记录
+func checkPosition(req *pt.ReqPos33Sign, in *pt.VrfInput) error {
+	if in == nil || in.Height != req.Height || in.Round != req.Round {
+		return fmt.Errorf("input %v NOT at height %d, round %d", in, req.Height, req.Round)
+	}
+	return nil
+}
+
+// message 检查req.Msg是req.Kind类型的消息, 返回要签名的内容.
+// 交易, 预出块, 委员会投票和无座位证明的格式由链上和共识的验证决定, 签名服务解码以后检查;
+// 投票用bls私钥只签抽签hash; 其他类型加上Domain前缀
+func message(req *pt.ReqPos33Sign) ([]byte, error) {
+	msg := req.Msg
+	var err error
+	switch req.Kind {
+	case KindPreBlock:
+		b := new(types.Block)
+		err = decodeStrict(msg, b)
+		if err == nil && (b.Height != req.Height || b.BlockTime != int64(req.Round) || len(b.Txs) > 0 || b.Signature != nil) {
+			err = fmt.Errorf("preblock NOT at height %d, round %d", req.Height, req.Round)
+		}
+	case KindMinerTx:
+		var act *pt.Pos33TicketAction
+		act, err = action(msg)
+		if err == nil && (act.Ty != pt.Pos33TicketActionMiner || act.GetMiner() == nil) {
+			err = errors.New("NOT miner tx")
+		}
+		if err == nil {
+			err = checkPosition(req, act.GetMiner().GetSort().GetProof().GetInput())
+		}
+	case KindSlash:
+		var act *pt.Pos33TicketAction
+		act, err = action(msg)
+		if err == nil && (act.Ty != pt.Pos33ActionSlash || len(act.GetSlash().GetEvidences()) == 0) {
+			err = errors.New("NOT slash tx")
+		}
+	case KindVote:
+		if len(msg) != sha256.Size {
+			err = errors.New("vote is NOT a sort hash")
+		}
+	case KindCommittee:
+		m := new(pt.Pos33SortsVote)
+		err = decodeStrict(msg, m)
+		if err == nil {
+			err = checkPosition(req, &pt.VrfInput{Height: m.Height, Round: m.Round})
+		}
+		if err == nil && m.Sig != nil {
+			err = errors.New("committee vote is signed")
+		}
+		// 和pt.Pos33SortsVote.Sign一样签名编码的hash
+		msg = crypto.Sha256(msg)
+	case KindNoSeats:
+		m := new(pt.Pos33NoSeats)
+		err = decodeStrict(msg, m)
+		if err == nil {
+			err = checkPosition(req, m.GetProof().GetInput())
+		}
+		if err == nil && m.Sig != nil {
+			err = errors.New("no seats is signed")
+		}
+		msg = crypto.Sha256(msg)
+	case KindBlock:
+		if len(msg) != sha256.Size {
+			err = errors.New("block msg is NOT a hash")
+		}
+		// 和chain33检查区块签名一样签区块hash本身
+		msg = Payload(req.Kind, msg)
+	case KindMsg:
+		msg = Domain(req.Kind, msg)
+	default:
+		return nil, ErrKind
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: kind %s: %v", ErrDomain, req.Kind, err)
+	}
+	return msg, nil
+}
@@ -0,0 +1,232 @@
+// Package signer pos33的远程签名服务, 共识私钥只保存在签名服务里, 节点通过grpc请求vrf和签名.
+// 签名服务检查每个消息是请求的类型(见message), 记录签过的(kind, height, round),
+// 同一个位置不会签两个不同的消息, 节点被攻破时也不能双签. 每个请求都要带配置的token
+package signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+
+	"github.com/33cn/chain33/common/crypto"
+	log "github.com/33cn/chain33/common/log/log15"
+	vrf "github.com/33cn/chain33/common/vrf/secp256k1"
+	secp256k1 "github.com/btcsuite/btcd/btcec"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+	"github.com/yccproject/ycc/plugin/dapp/pos33/verifier"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var slog = log.New("module", "pos33.signer")
+
+// 签名的类型
+const (
+	KindPreBlock  = "preblock"  // 预出块, 每个高度和轮次一个
+	KindMinerTx   = "minertx"   // 出块的miner交易, 每个高度和轮次一个
+	KindBlock     = "block"     // 执行以后的区块hash, 每个高度和轮次一个
+	KindVote      = "vote"      // 区块投票(bls), 每个高度和轮次只投一个区块
+	KindCommittee = "committee" // 委员会投票, 每个高度和轮次一个
+	KindNoSeats   = "noseats"   // 没有中签的证明, 每个高度和轮次一个
+	KindSlash     = "slash"     // 处罚交易
+	KindMsg       = "msg"       // 其他消息, 比如检查点, 节点清单和就绪检查, 签名加上Domain前缀
+)
+
+// guarded 这些类型同一个高度和轮次只签一个消息
+var guarded = map[string]bool{KindPreBlock: true, KindMinerTx: true, KindBlock: true, KindVote: true, KindCommittee: true, KindNoSeats: true}
+
+// keepHeights 签名记录保留最近多少个高度
+const keepHeights = 100
+
+var (
+	// ErrDoubleSign 同一个位置已经签过不同的消息
+	ErrDoubleSign = errors.New("double sign refused")
+	// ErrKind 不认识的签名类型
+	ErrKind = errors.New("unknown sign kind")
+	// ErrToken 没有配置token, 或者请求的token不对
+	ErrToken = errors.New("signer token error")
+)
+
+// tokenKey 请求的metadata里token的key
+const tokenKey = "pos33-signer-token"
+
+type position struct {
+	Kind   string `json:"kind"`
+	Height int64  `json:"height"`
+	Round  int32  `json:"round"`
+}
+
+type signRecord struct {
+	position
+	Hash []byte `json:"hash"` // 签过的消息的sha256
+}
+
+// Server 远程签名服务, 实现pt.Pos33SignerServer
+type Server struct {
+	priv  crypto.PrivKey
+	bls   crypto.PrivKey
+	file  string
+	token string
+
+	mu     sync.Mutex
+	signed map[position][]byte
+	top    int64
+}
+
+// NewServer 使用secp256k1私钥priv创建签名服务, file不为空时签名记录保存到这个文件, 重启以后加载.
+// token不能为空, 节点的remoteSignerToken必须相同
+func NewServer(priv crypto.PrivKey, file, token string) (*Server, error) {
+	if token == "" {
+		return nil, fmt.Errorf("%w: token is empty", ErrToken)
+	}
+	s := &Server{
+		priv:   priv,
+		bls:    pt.Hash2BlsSk(crypto.Sha256(priv.Bytes())),
+		file:   file,
+		token:  token,
+		signed: make(map[position][]byte),
+	}
+	if file == "" {
+		return s, nil
+	}
+	data, err := os.ReadFile(file)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var rs []*signRecord
+	err = json.Unmarshal(data, &rs)
+	if err != nil {
+		return nil, fmt.Errorf("signer state %s error: %v", file, err)
+	}
+	for _, r := range rs {
+		s.signed[r.position] = r.Hash
+		if r.Height > s.top {
+			s.top = r.Height
+		}
+	}
+	return s, nil
+}
+
+// Serve 在lis上提供grpc服务, 直到lis关闭
+func (s *Server) Serve(lis net.Listener) error {
+	g := grpc.NewServer(grpc.UnaryInterceptor(s.auth))
+	pt.RegisterPos33SignerServer(g, s)
+	return g.Serve(lis)
+}
+
+// auth 检查每个请求的token, token不对的请求不处理
+func (s *Server) auth(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, h grpc.UnaryHandler) (interface{}, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ts := md.Get(tokenKey)
+	if len(ts) != 1 || subtle.ConstantTimeCompare([]byte(ts[0]), []byte(s.token)) != 1 {
+		slog.Error("signer request refused", "err", ErrToken, "method", info.FullMethod)
+		return nil, status.Error(codes.Unauthenticated, ErrToken.Error())
+	}
+	return h(ctx, req)
+}
+
+// GetSignerInfo 返回secp256k1和bls公钥
+func (s *Server) GetSignerInfo(ctx context.Context, req *pt.ReqPos33SignerInfo) (*pt.Pos33SignerInfo, error) {
+	return &pt.Pos33SignerInfo{Pubkey: s.priv.PubKey().Bytes(), BlsPubkey: s.bls.PubKey().Bytes()}, nil
+}
+
+// SignVrf 计算vrf, vrf是确定的, 不需要防止双签
+func (s *Server) SignVrf(ctx context.Context, req *pt.ReqPos33SignVrf) (*pt.ReplyPos33SignVrf, error) {
+	if req.Suite != "" && req.Suite != verifier.SuiteSecp256k1SHA256 {
+		return nil, status.Error(codes.InvalidArgument, verifier.ErrSuite.Error())
+	}
+	privKey, _ := secp256k1.PrivKeyFromBytes(secp256k1.S256(), s.priv.Bytes())
+	vrfPriv := &vrf.PrivateKey{PrivateKey: (*ecdsa.PrivateKey)(privKey)}
+	vrfHash, vrfProof := vrfPriv.Evaluate(req.Input)
+	return &pt.ReplyPos33SignVrf{Hash: vrfHash[:], Proof: vrfProof}, nil
+}
+
+// Sign 签名, KindVote使用bls私钥, 其他使用secp256k1私钥
+func (s *Server) Sign(ctx context.Context, req *pt.ReqPos33Sign) (*pt.ReplyPos33Sign, error) {
+	msg, err := message(req)
+	if err != nil {
+		slog.Error("refuse to sign", "err", err, "kind", req.Kind, "height", req.Height, "round", req.Round)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = s.check(req)
+	if err != nil {
+		slog.Error("refuse to sign", "err", err, "kind", req.Kind, "height", req.Height, "round", req.Round)
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	key := s.priv
+	if req.Kind == KindVote {
+		key = s.bls
+	}
+	sig := key.Sign(msg)
+	return &pt.ReplyPos33Sign{Signature: sig.Bytes(), Pubkey: key.PubKey().Bytes()}, nil
+}
+
+// check 检查并记录签名的位置, 同一个位置相同的消息可以重复签名(请求超时后重试)
+func (s *Server) check(req *pt.ReqPos33Sign) error {
+	if !guarded[req.Kind] {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pos := position{req.Kind, req.Height, req.Round}
+	hash := crypto.Sha256(req.Msg)
+	if h, ok := s.signed[pos]; ok {
+		if !bytes.Equal(h, hash) {
+			return fmt.Errorf("%w: kind %s, height %d, round %d", ErrDoubleSign, req.Kind, req.Height, req.Round)
+		}
+		return nil
+	}
+	if req.Height < s.top-keepHeights {
+		return fmt.Errorf("%w: height %d too old, signed %d", ErrDoubleSign, req.Height, s.top)
+	}
+	s.signed[pos] = hash
+	if req.Height > s.top {
+		s.top = req.Height
+		for p := range s.signed {
+			if p.Height < s.top-keepHeights {
+				delete(s.signed, p)
+			}
+		}
+	}
+	// 先保存再签名, 保存失败的不签
+	err := s.save()
+	if err != nil {
+		delete(s.signed, pos)
+		return err
+	}
+	return nil
+}
+
+// save 先写临时文件再rename, 写到一半退出时不会留下不完整的文件
+func (s *Server) save() error {
+	if s.file == "" {
+		return nil
+	}
+	var rs []*signRecord
+	for p, h := range s.signed {
+		rs = append(rs, &signRecord{p, h})
+	}
+	data, err := json.Marshal(rs)
+	if err != nil {
+		return err
+	}
+	tmp := s.file + ".tmp"
+	err = os.WriteFile(tmp, data, 0600)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.file)
+}
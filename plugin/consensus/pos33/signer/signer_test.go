@@ -0,0 +1,231 @@
+package signer
+
+import (
+	"errors"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTestKey(t *testing.T) crypto.PrivKey {
+	c, err := crypto.Load(types.GetSignName("", types.SECP256K1), -1)
+	require.Nil(t, err)
+	priv, err := c.GenKey()
+	require.Nil(t, err)
+	return priv
+}
+
+const testToken = "test token"
+
+// startTestServer 启动签名服务, 返回连接它的客户端
+func startTestServer(t *testing.T, s *Server) *Client {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	go s.Serve(lis)
+	t.Cleanup(func() { lis.Close() })
+
+	c, err := Dial(lis.Addr().String(), testToken, time.Second, 1)
+	require.Nil(t, err)
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+// minerTxMsg height高度round轮的miner交易签名的内容, hash区分不同的交易
+func minerTxMsg(height int64, round int32, hash string) []byte {
+	s := &pt.Pos33SortMsg{Proof: &pt.HashProof{Input: &pt.VrfInput{Height: height, Round: round}}}
+	act := &pt.Pos33TicketAction{
+		Value: &pt.Pos33TicketAction_Miner{Miner: &pt.Pos33MinerMsg{Sort: s, Hash: []byte(hash)}},
+		Ty:    pt.Pos33TicketActionMiner,
+	}
+	return types.Encode(&types.Transaction{Execer: []byte(pt.Pos33TicketX), Payload: types.Encode(act)})
+}
+
+func committeeMsg(height int64, round int32, sel string) []byte {
+	return types.Encode(&pt.Pos33SortsVote{Height: height, Round: round, SelectSorts: [][]byte{[]byte(sel)}})
+}
+
+func preBlockMsg(height int64, round int32) []byte {
+	return types.Encode(&types.Block{Height: height, BlockTime: int64(round), TxHash: []byte("sort hash")})
+}
+
+func TestSignerGuard(t *testing.T) {
+	priv := newTestKey(t)
+	file := filepath.Join(t.TempDir(), "signer.json")
+	s, err := NewServer(priv, file, testToken)
+	require.Nil(t, err)
+	c := startTestServer(t, s)
+
+	info, err := c.Info()
+	require.Nil(t, err)
+	require.Equal(t, priv.PubKey().Bytes(), info.Pubkey)
+
+	a, b := minerTxMsg(10, 0, "a"), minerTxMsg(10, 0, "b")
+	r, err := c.Sign(KindMinerTx, 10, 0, a)
+	require.Nil(t, err)
+	require.True(t, priv.PubKey().VerifyBytes(a, sigOf(t, r.Signature)))
+
+	// 相同的消息可以重复签名, 不同的消息拒绝
+	_, err = c.Sign(KindMinerTx, 10, 0, a)
+	require.Nil(t, err)
+	_, err = c.Sign(KindMinerTx, 10, 0, b)
+	require.True(t, IsDoubleSign(err))
+	// 其他轮次不受影响
+	_, err = c.Sign(KindMinerTx, 10, 1, minerTxMsg(10, 1, "b"))
+	require.Nil(t, err)
+
+	// 委员会投票签名编码的hash, 和Pos33SortsVote.Sign相同, 同一轮只投一次
+	cm := committeeMsg(10, 0, "b")
+	r, err = c.Sign(KindCommittee, 10, 0, cm)
+	require.Nil(t, err)
+	require.True(t, priv.PubKey().VerifyBytes(crypto.Sha256(cm), sigOf(t, r.Signature)))
+	_, err = c.Sign(KindCommittee, 10, 0, committeeMsg(10, 0, "c"))
+	require.True(t, IsDoubleSign(err))
+	_, err = c.Sign(KindCommittee, 10, 1, committeeMsg(10, 1, "c"))
+	require.Nil(t, err)
+	_, err = c.Sign("unknown", 10, 0, cm)
+	require.NotNil(t, err)
+	require.False(t, IsDoubleSign(err))
+
+	// 投票使用bls私钥
+	hash := crypto.Sha256([]byte("hash"))
+	r, err = c.Sign(KindVote, 10, 0, hash)
+	require.Nil(t, err)
+	require.Equal(t, info.BlsPubkey, r.Pubkey)
+	v := &pt.Pos33VoteMsg{Hash: hash}
+	v.Sign(priv)
+	require.Equal(t, v.Sig.Signature, r.Signature)
+
+	// 重启以后仍然拒绝
+	s2, err := NewServer(priv, file, testToken)
+	require.Nil(t, err)
+	c2 := startTestServer(t, s2)
+	_, err = c2.Sign(KindMinerTx, 10, 0, b)
+	require.True(t, IsDoubleSign(err))
+	_, err = c2.Sign(KindMinerTx, 10, 0, a)
+	require.Nil(t, err)
+
+	// 太旧的高度不签
+	_, err = c2.Sign(KindPreBlock, 10+keepHeights+1, 0, preBlockMsg(10+keepHeights+1, 0))
+	require.Nil(t, err)
+	_, err = c2.Sign(KindPreBlock, 9, 0, preBlockMsg(9, 0))
+	require.True(t, IsDoubleSign(err))
+}
+
+func TestSignerDomain(t *testing.T) {
+	priv := newTestKey(t)
+	s, err := NewServer(priv, "", testToken)
+	require.Nil(t, err)
+	c := startTestServer(t, s)
+
+	refused := func(kind string, height int64, round int32, msg []byte) {
+		_, err := c.Sign(kind, height, round, msg)
+		require.Equal(t, codes.InvalidArgument, status.Code(err), kind)
+	}
+	// 消息的类型, 高度或者轮次和请求的不同
+	refused(KindMinerTx, 10, 0, committeeMsg(10, 0, "a"))
+	refused(KindMinerTx, 10, 0, minerTxMsg(11, 0, "a"))
+	refused(KindMinerTx, 10, 0, minerTxMsg(10, 1, "a"))
+	refused(KindCommittee, 10, 0, minerTxMsg(10, 0, "a"))
+	refused(KindCommittee, 10, 0, committeeMsg(10, 1, "a"))
+	refused(KindNoSeats, 10, 0, committeeMsg(10, 0, "a"))
+	refused(KindPreBlock, 10, 0, minerTxMsg(10, 0, "a"))
+	refused(KindPreBlock, 10, 0, preBlockMsg(10, 1))
+	refused(KindSlash, 10, 0, minerTxMsg(10, 0, "a"))
+	refused(KindVote, 10, 0, []byte("short"))
+	refused(KindBlock, 10, 0, []byte("short"))
+	// 不是规范编码
+	refused(KindMinerTx, 10, 0, append(minerTxMsg(10, 0, "a"), 0x78, 1))
+
+	ns := &pt.Pos33NoSeats{Proof: &pt.HashProof{Input: &pt.VrfInput{Height: 10}}, Count: 1}
+	msg := types.Encode(ns)
+	r, err := c.Sign(KindNoSeats, 10, 0, msg)
+	require.Nil(t, err)
+	require.True(t, priv.PubKey().VerifyBytes(crypto.Sha256(msg), sigOf(t, r.Signature)))
+
+	sl := &pt.Pos33TicketAction{
+		Value: &pt.Pos33TicketAction_Slash{Slash: &pt.Pos33Slash{Evidences: []*pt.Pos33Evidence{{}}}},
+		Ty:    pt.Pos33ActionSlash,
+	}
+	msg = types.Encode(&types.Transaction{Execer: []byte(pt.Pos33TicketX), Payload: types.Encode(sl)})
+	_, err = c.Sign(KindSlash, 10, 0, msg)
+	require.Nil(t, err)
+
+	// KindMsg加上前缀, 签名不能当作委员会投票或者miner交易使用
+	cm := committeeMsg(10, 0, "a")
+	r, err = c.Sign(KindMsg, 10, 0, crypto.Sha256(cm))
+	require.Nil(t, err)
+	require.False(t, priv.PubKey().VerifyBytes(crypto.Sha256(cm), sigOf(t, r.Signature)))
+	require.True(t, priv.PubKey().VerifyBytes(Domain(KindMsg, crypto.Sha256(cm)), sigOf(t, r.Signature)))
+	hash := crypto.Sha256([]byte("block"))
+	r, err = c.Sign(KindBlock, 10, 0, hash)
+	require.Nil(t, err)
+	require.True(t, priv.PubKey().VerifyBytes(hash, sigOf(t, r.Signature)))
+	require.False(t, priv.PubKey().VerifyBytes(Domain(KindBlock, hash), sigOf(t, r.Signature)))
+	require.False(t, priv.PubKey().VerifyBytes(Domain(KindMsg, hash), sigOf(t, r.Signature)))
+}
+
+func TestSignerToken(t *testing.T) {
+	_, err := NewServer(newTestKey(t), "", "")
+	require.True(t, errors.Is(err, ErrToken))
+	_, err = Dial("127.0.0.1:1", "", time.Second, 0)
+	require.True(t, errors.Is(err, ErrToken))
+
+	s, err := NewServer(newTestKey(t), "", testToken)
+	require.Nil(t, err)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	go s.Serve(lis)
+	defer lis.Close()
+
+	c, err := Dial(lis.Addr().String(), "wrong token", time.Second, 3)
+	require.Nil(t, err)
+	defer c.Close()
+	_, err = c.Info()
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = c.Sign(KindMsg, 10, 0, []byte("a"))
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func sigOf(t *testing.T, b []byte) crypto.Signature {
+	c, err := crypto.Load(types.GetSignName("", types.SECP256K1), -1)
+	require.Nil(t, err)
+	sig, err := c.SignatureFromBytes(b)
+	require.Nil(t, err)
+	return sig
+}
+
+func TestSignerRetry(t *testing.T) {
+	// 签名服务晚一点启动, 客户端重试以后成功
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	addr := lis.Addr().String()
+	lis.Close()
+
+	c, err := Dial(addr, testToken, 200*time.Millisecond, 5)
+	require.Nil(t, err)
+	defer c.Close()
+
+	s, err := NewServer(newTestKey(t), "", testToken)
+	require.Nil(t, err)
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		lis, err := net.Listen("tcp", addr)
+		if err != nil {
+			return
+		}
+		s.Serve(lis)
+	}()
+	_, err = c.SignVrf("", []byte("input"))
+	require.Nil(t, err)
+
+	_, err = c.SignVrf("unknown", []byte("input"))
+	require.NotNil(t, err)
+}
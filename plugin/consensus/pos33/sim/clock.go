@@ -0,0 +1,68 @@
+package sim
+
+import (
+	"container/heap"
+	"time"
+)
+
+type event struct {
+	at  time.Duration
+	seq uint64
+	f   func()
+}
+
+type eventQueue []*event
+
+func (q eventQueue) Len() int { return len(q) }
+func (q eventQueue) Less(i, j int) bool {
+	if q[i].at != q[j].at {
+		return q[i].at < q[j].at
+	}
+	return q[i].seq < q[j].seq
+}
+func (q eventQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *eventQueue) Push(x interface{}) { *q = append(*q, x.(*event)) }
+func (q *eventQueue) Pop() interface{} {
+	old := *q
+	e := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return e
+}
+
+// Clock 虚拟时钟, 从0开始. 事件按时间执行, 时间相同的按加入的顺序执行,
+// 所有的事件在一个协程里执行, 同样的输入总是同样的执行顺序
+type Clock struct {
+	now time.Duration
+	seq uint64
+	q   eventQueue
+}
+
+// Now 当前的虚拟时间
+func (c *Clock) Now() time.Duration {
+	return c.now
+}
+
+// AfterFunc d以后执行f
+func (c *Clock) AfterFunc(d time.Duration, f func()) {
+	if d < 0 {
+		d = 0
+	}
+	c.seq++
+	heap.Push(&c.q, &event{at: c.now + d, seq: c.seq, f: f})
+}
+
+// Step 执行下一个事件, 没有事件时返回false
+func (c *Clock) Step() bool {
+	if len(c.q) == 0 {
+		return false
+	}
+	e := heap.Pop(&c.q).(*event)
+	c.now = e.at
+	e.f()
+	return true
+}
+
+// Pending 还没有执行的事件数
+func (c *Clock) Pending() int {
+	return len(c.q)
+}
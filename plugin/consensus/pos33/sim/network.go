@@ -0,0 +1,165 @@
+package sim
+
+import (
+	"math/rand"
+	"time"
+)
+
+// MsgType 模拟的共识消息类型, 对应pos33的gossip消息
+type MsgType int
+
+const (
+	// MsgSort 委员会抽签
+	MsgSort MsgType = iota
+	// MsgCommittee 对委员会的投票
+	MsgCommittee
+	// MsgBlock 候选人的预区块
+	MsgBlock
+	// MsgVote 委员会对候选人的投票
+	MsgVote
+	// MsgCommit 出块人广播的确定区块
+	MsgCommit
+	// MsgSync 请求从Height开始的确定区块
+	MsgSync
+)
+
+var msgNames = []string{"sort", "committee", "block", "vote", "commit", "sync"}
+
+func (t MsgType) String() string {
+	if t < 0 || int(t) >= len(msgNames) {
+		return "unknown"
+	}
+	return msgNames[t]
+}
+
+// Message 节点之间传递的消息, 发出以后不能修改, 所有的接收者共用一个
+type Message struct {
+	Ty     MsgType
+	From   int
+	Height int64
+	Round  int
+	// MsgSort和MsgCommittee的抽签
+	Sorts []*Sort
+	// MsgCommittee选中的抽签hash
+	Select [][]byte
+	// MsgBlock和MsgCommit的区块
+	Block *Block
+	// MsgVote的投票
+	Votes []*Vote
+}
+
+// Fault 故障注入, 在消息发给to之前调用. 返回drop时丢弃消息, 否则消息额外延迟delay.
+// rnd是模拟使用的随机数, 故障需要随机时只能用它, 保证同样的输入同样的结果
+type Fault interface {
+	Apply(now time.Duration, m *Message, to int, rnd *rand.Rand) (drop bool, delay time.Duration)
+}
+
+// FaultFunc 函数实现的Fault
+type FaultFunc func(now time.Duration, m *Message, to int, rnd *rand.Rand) (bool, time.Duration)
+
+// Apply 实现Fault
+func (f FaultFunc) Apply(now time.Duration, m *Message, to int, rnd *rand.Rand) (bool, time.Duration) {
+	return f(now, m, to, rnd)
+}
+
+// nodeSet 节点集合, 为空时包括所有节点
+type nodeSet map[int]bool
+
+func newNodeSet(ids []int) nodeSet {
+	s := make(nodeSet)
+	for _, id := range ids {
+		s[id] = true
+	}
+	return s
+}
+
+func (s nodeSet) has(id int) bool {
+	return len(s) == 0 || s[id]
+}
+
+// DropVotes from里的节点发出的区块投票以rate的概率丢弃, from为空时是所有节点
+func DropVotes(rate float64, from ...int) Fault {
+	s := newNodeSet(from)
+	return FaultFunc(func(now time.Duration, m *Message, to int, rnd *rand.Rand) (bool, time.Duration) {
+		if m.Ty != MsgVote || !s.has(m.From) {
+			return false, 0
+		}
+		return rnd.Float64() < rate, 0
+	})
+}
+
+// DelaySorts from里的节点发出的抽签延迟delay, from为空时是所有节点
+func DelaySorts(delay time.Duration, from ...int) Fault {
+	s := newNodeSet(from)
+	return FaultFunc(func(now time.Duration, m *Message, to int, rnd *rand.Rand) (bool, time.Duration) {
+		if m.Ty != MsgSort || !s.has(m.From) {
+			return false, 0
+		}
+		return false, delay
+	})
+}
+
+// Partition 虚拟时间[start, end)内不同分组之间的消息全部丢弃, 不在任何分组里的节点单独一组
+func Partition(start, end time.Duration, groups ...[]int) Fault {
+	group := make(map[int]int)
+	for i, g := range groups {
+		for _, id := range g {
+			group[id] = i
+		}
+	}
+	groupOf := func(id int) int {
+		g, ok := group[id]
+		if !ok {
+			return -1 - id
+		}
+		return g
+	}
+	return FaultFunc(func(now time.Duration, m *Message, to int, rnd *rand.Rand) (bool, time.Duration) {
+		if now < start || now >= end {
+			return false, 0
+		}
+		return groupOf(m.From) != groupOf(to), 0
+	})
+}
+
+// network 内存里的p2p网络, 消息按随机的延迟在虚拟时钟上投递
+type network struct {
+	clk    *Clock
+	rnd    *rand.Rand
+	nodes  []*Node
+	faults []Fault
+	min    time.Duration
+	max    time.Duration
+	res    *Result
+}
+
+func (nw *network) latency() time.Duration {
+	if nw.max <= nw.min {
+		return nw.min
+	}
+	return nw.min + time.Duration(nw.rnd.Int63n(int64(nw.max-nw.min)))
+}
+
+func (nw *network) send(to int, m *Message) {
+	nw.res.Sent++
+	d := nw.latency()
+	for _, f := range nw.faults {
+		drop, delay := f.Apply(nw.clk.Now(), m, to, nw.rnd)
+		if drop {
+			nw.res.Dropped++
+			return
+		}
+		d += delay
+	}
+	n := nw.nodes[to]
+	nw.clk.AfterFunc(d, func() { n.handle(m) })
+}
+
+// broadcast 发给除了发送者以外的所有节点
+func (nw *network) broadcast(m *Message) {
+	for i := range nw.nodes {
+		if i != m.From {
+			nw.send(i, m)
+		}
+	}
+}
@@ -0,0 +1,550 @@
+package sim
+
+import (
+	"bytes"
+	"sort"
+	"time"
+
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+	"github.com/yccproject/ycc/plugin/dapp/pos33/verifier"
+)
+
+// Sort 一张票在一个高度和轮次的委员会抽签
+type Sort struct {
+	Addr   string
+	Height int64
+	Round  int
+	Index  int
+	Hash   []byte
+}
+
+// Vote 委员会的一个座位对候选人的投票, Hash是候选人的抽签hash
+type Vote struct {
+	Sort *Sort
+	Hash []byte
+}
+
+// Block 模拟的区块, 只有共识需要的字段.
+// 和pos33一样, 委员会投票给候选人的抽签, 出块人把投票放进区块
+type Block struct {
+	Height int64
+	Round  int
+	Parent []byte
+	Maker  *Sort
+	Votes  []*Vote
+	Hash   []byte
+}
+
+type hr struct {
+	h int64
+	r int
+}
+
+// roundState 一个高度一轮的共识状态, 对应pos33的committee
+type roundState struct {
+	// 收到的抽签, sort hash -> sort
+	sorts map[string]*Sort
+	mine  []*Sort
+	// 每个抽签得到的委员会投票
+	svmp map[string]int
+	// 委员会座位和候选人的抽签hash
+	comm       map[string]*Sort
+	candidates []string
+	setted     bool
+	// 候选人的预区块, 候选人的抽签hash -> 区块
+	blocks  map[string]*Block
+	waiting bool
+	voted   bool
+	// 出块人收到的投票, 投票的sort hash -> 投票
+	votes map[string]*Vote
+	// 每个座位投给的候选人, 用来发现双签
+	voteOf  map[string]string
+	made    bool
+	myBlock *Block
+}
+
+func newRoundState() *roundState {
+	return &roundState{
+		sorts:  make(map[string]*Sort),
+		svmp:   make(map[string]int),
+		comm:   make(map[string]*Sort),
+		blocks: make(map[string]*Block),
+		votes:  make(map[string]*Vote),
+		voteOf: make(map[string]string),
+	}
+}
+
+// Node 一个模拟的pos33节点, 所有方法都在虚拟时钟的协程里调用
+type Node struct {
+	ID      int
+	Addr    string
+	Tickets int64
+
+	s          *Sim
+	equivocate bool
+	chain      []*Block
+	round      int
+	rs         map[hr]*roundState
+	// 高度还没有到的消息和确定区块
+	later  map[int64][]*Message
+	future map[int64]*Block
+	asked  map[int64]bool
+}
+
+// Height 已经确定的最高区块的高度
+func (n *Node) Height() int64 {
+	return n.head().Height
+}
+
+// Round 正在处理的下一个高度的轮次
+func (n *Node) Round() int {
+	return n.round
+}
+
+// BlockAt 已经确定的height高度的区块
+func (n *Node) BlockAt(height int64) *Block {
+	if height < 0 || height >= int64(len(n.chain)) {
+		return nil
+	}
+	return n.chain[height]
+}
+
+func (n *Node) head() *Block {
+	return n.chain[len(n.chain)-1]
+}
+
+func (n *Node) state(h int64, r int) *roundState {
+	k := hr{h, r}
+	st, ok := n.rs[k]
+	if !ok {
+		st = newRoundState()
+		n.rs[k] = st
+	}
+	return st
+}
+
+// after d以后还在h高度的r轮时执行f
+func (n *Node) after(d time.Duration, h int64, r int, f func(int64, int)) {
+	n.s.clk.AfterFunc(d, func() {
+		if n.Height()+1 == h && n.round == r {
+			f(h, r)
+		}
+	})
+}
+
+// startRound 开始h高度的第r轮, 时间安排和runLoop相同.
+// pos33在几个高度以前就已经抽签和投票选出了第0轮的委员会, 这里在上一个区块确定以后马上抽签,
+// 一半的出块间隔以后投票选委员会
+func (n *Node) startRound(h int64, r int) {
+	t := n.s.cfg.Timing
+	n.round = r
+	n.sortition(h, r)
+	voteAt, makeAt := t.BlockDelay/2, t.BlockDelay
+	if r > 0 {
+		voteAt, makeAt = t.ResortTimeout, t.ResortTimeout+t.VoteCommittee
+	}
+	n.after(voteAt, h, r, n.voteCommittee)
+	n.after(makeAt, h, r, func(h int64, r int) {
+		n.makeBlock(h, r)
+		n.after(t.BlockTimeout, h, r, n.timeout)
+	})
+}
+
+// timeout 进入下一轮, 同时向一个随机的节点请求区块, 分区恢复以后落后的节点可以追上
+func (n *Node) timeout(h int64, r int) {
+	n.s.res.Timeouts++
+	if len(n.s.nodes) > 1 {
+		to := n.s.net.rnd.Intn(len(n.s.nodes) - 1)
+		if to >= n.ID {
+			to++
+		}
+		n.s.net.send(to, &Message{Ty: MsgSync, From: n.ID, Height: h})
+	}
+	n.startRound(h, r+1)
+}
+
+func (n *Node) sortition(h int64, r int) {
+	st := n.state(h, r)
+	if len(st.mine) > 0 {
+		return
+	}
+	seed := n.head().Hash
+	diff := n.s.diff(r)
+	for i := 0; i < int(n.Tickets); i++ {
+		s := n.s.sort(seed, n.Addr, h, r, i)
+		if verifier.Win(s.Hash, diff) {
+			st.mine = append(st.mine, s)
+		}
+	}
+	if len(st.mine) == 0 {
+		return
+	}
+	for _, s := range st.mine {
+		st.sorts[string(s.Hash)] = s
+	}
+	n.s.net.broadcast(&Message{Ty: MsgSort, From: n.ID, Height: h, Round: r, Sorts: st.mine})
+}
+
+// validSort 检查抽签是按当前的seed和难度中签的
+func (n *Node) validSort(s *Sort, h int64, r int) bool {
+	if s == nil || s.Height != h || s.Round != r {
+		return false
+	}
+	if s.Index < 0 || int64(s.Index) >= n.s.tickets[s.Addr] {
+		return false
+	}
+	e := n.s.sort(n.head().Hash, s.Addr, h, r, s.Index)
+	return bytes.Equal(e.Hash, s.Hash) && verifier.Win(s.Hash, n.s.diff(r))
+}
+
+func (n *Node) handle(m *Message) {
+	switch m.Ty {
+	case MsgCommit:
+		n.handleCommit(m.Block, m.From)
+		return
+	case MsgSync:
+		n.handleSync(m)
+		return
+	}
+	next := n.Height() + 1
+	if m.Height < next {
+		return
+	}
+	// 更高的高度要等前面的区块确定以后才能检查
+	if m.Height > next {
+		n.later[m.Height] = append(n.later[m.Height], m)
+		return
+	}
+	switch m.Ty {
+	case MsgSort:
+		n.handleSorts(m)
+	case MsgCommittee:
+		n.handleCommittee(m)
+	case MsgBlock:
+		n.handleBlock(m.Block)
+	case MsgVote:
+		n.handleVotes(m)
+	}
+}
+
+func (n *Node) handleSorts(m *Message) {
+	st := n.state(m.Height, m.Round)
+	for _, s := range m.Sorts {
+		if !n.validSort(s, m.Height, m.Round) {
+			return
+		}
+	}
+	for _, s := range m.Sorts {
+		st.sorts[string(s.Hash)] = s
+	}
+}
+
+// topSorts hash最小的num个抽签
+func topSorts(mp map[string]*Sort, num int) []*Sort {
+	ss := make([]*Sort, 0, len(mp))
+	for _, s := range mp {
+		ss = append(ss, s)
+	}
+	sort.Slice(ss, func(i, j int) bool { return bytes.Compare(ss[i].Hash, ss[j].Hash) < 0 })
+	if len(ss) > num {
+		ss = ss[:num]
+	}
+	return ss
+}
+
+// voteCommittee 和pos33一样, 对收到的前Pos33VoterSize个抽签投票, 自己的抽签要在里面
+func (n *Node) voteCommittee(h int64, r int) {
+	st := n.state(h, r)
+	if len(st.mine) == 0 {
+		return
+	}
+	sel := topSorts(st.sorts, pt.Pos33VoterSize)
+	var my []*Sort
+	var hs [][]byte
+	for _, s := range sel {
+		hs = append(hs, s.Hash)
+		if s.Addr == n.Addr {
+			my = append(my, s)
+		}
+	}
+	if len(my) == 0 {
+		return
+	}
+	m := &Message{Ty: MsgCommittee, From: n.ID, Height: h, Round: r, Sorts: my, Select: hs}
+	n.handleCommittee(m)
+	n.s.net.broadcast(m)
+}
+
+func (n *Node) handleCommittee(m *Message) {
+	sel := make(map[string]bool)
+	for _, h := range m.Select {
+		sel[string(h)] = true
+	}
+	for _, s := range m.Sorts {
+		if !sel[string(s.Hash)] || !n.validSort(s, m.Height, m.Round) {
+			return
+		}
+	}
+	st := n.state(m.Height, m.Round)
+	for h := range sel {
+		st.svmp[h] += len(m.Sorts)
+	}
+}
+
+// setCommittee 得到Pos33MustVotes个委员会投票的抽签是委员会座位, 前3个不同地址的座位是候选人
+func (n *Node) setCommittee(st *roundState) {
+	if st.setted {
+		return
+	}
+	st.setted = true
+	var ss []*Sort
+	for h, s := range st.sorts {
+		if st.svmp[h] > 0 {
+			ss = append(ss, s)
+		}
+	}
+	sort.Slice(ss, func(i, j int) bool { return bytes.Compare(ss[i].Hash, ss[j].Hash) < 0 })
+	if size := pt.DefaultPos33ChainParam().CommitteeSize; len(ss) > int(size) {
+		ss = ss[:size]
+	}
+	addrs := make(map[string]bool)
+	for _, s := range ss {
+		if st.svmp[string(s.Hash)] < pt.Pos33MustVotes {
+			continue
+		}
+		st.comm[string(s.Hash)] = s
+		if len(st.candidates) < 3 && !addrs[s.Addr] {
+			addrs[s.Addr] = true
+			st.candidates = append(st.candidates, string(s.Hash))
+		}
+	}
+}
+
+// makeBlock 自己是候选人时广播预区块
+func (n *Node) makeBlock(h int64, r int) {
+	st := n.state(h, r)
+	n.setCommittee(st)
+	var maker *Sort
+	for _, c := range st.candidates {
+		if s := st.comm[c]; s.Addr == n.Addr {
+			maker = s
+			break
+		}
+	}
+	if maker == nil {
+		return
+	}
+	b := &Block{Height: h, Round: r, Parent: n.head().Hash, Maker: maker}
+	st.myBlock = b
+	n.handleBlock(b)
+	n.s.net.broadcast(&Message{Ty: MsgBlock, From: n.ID, Height: h, Round: r, Block: b})
+}
+
+func (n *Node) handleBlock(b *Block) {
+	if !bytes.Equal(b.Parent, n.head().Hash) || !n.validSort(b.Maker, b.Height, b.Round) {
+		return
+	}
+	st := n.state(b.Height, b.Round)
+	k := string(b.Maker.Hash)
+	if _, ok := st.blocks[k]; ok {
+		return
+	}
+	st.blocks[k] = b
+	// 和handleBlockMsg一样, 收到第一个预区块以后等一会儿再投票, 收到3个时马上投票
+	if !st.waiting {
+		st.waiting = true
+		n.after(n.s.cfg.Timing.BlockVoteWait, b.Height, b.Round, n.voteBlock)
+	}
+	if len(st.blocks) > 2 {
+		n.voteBlock(b.Height, b.Round)
+	}
+}
+
+// voteBlock 用自己的委员会座位投票给第一个收到预区块的候选人, 双签的节点投票给所有收到的候选人
+func (n *Node) voteBlock(h int64, r int) {
+	st := n.state(h, r)
+	n.setCommittee(st)
+	if st.voted {
+		return
+	}
+	var hashes [][]byte
+	for _, c := range st.candidates {
+		if _, ok := st.blocks[c]; ok {
+			hashes = append(hashes, []byte(c))
+			if !n.equivocate {
+				break
+			}
+		}
+	}
+	if len(hashes) == 0 {
+		return
+	}
+	var my []*Sort
+	for _, s := range topSorts(st.comm, len(st.comm)) {
+		if s.Addr == n.Addr {
+			my = append(my, s)
+		}
+	}
+	if len(my) == 0 {
+		return
+	}
+	st.voted = true
+	for _, hash := range hashes {
+		var vs []*Vote
+		for _, s := range my {
+			vs = append(vs, &Vote{Sort: s, Hash: hash})
+		}
+		m := &Message{Ty: MsgVote, From: n.ID, Height: h, Round: r, Votes: vs}
+		n.handleVotes(m)
+		n.s.net.broadcast(m)
+	}
+}
+
+// handleVotes 出块人收集投给自己的投票, 满足Config.Quorum时广播确定的区块.
+// 所有节点都检查同一个座位有没有投票给不同的候选人
+func (n *Node) handleVotes(m *Message) {
+	st := n.state(m.Height, m.Round)
+	for _, v := range m.Votes {
+		if !n.validSort(v.Sort, m.Height, m.Round) {
+			return
+		}
+	}
+	for _, v := range m.Votes {
+		k := string(v.Sort.Hash)
+		if prev, ok := st.voteOf[k]; ok && prev != string(v.Hash) {
+			n.s.equivocation(n, v.Sort)
+		}
+		st.voteOf[k] = string(v.Hash)
+		if st.myBlock == nil || !bytes.Equal(v.Hash, st.myBlock.Maker.Hash) {
+			continue
+		}
+		if _, ok := st.comm[k]; !ok {
+			continue
+		}
+		st.votes[k] = v
+	}
+	if st.made || st.myBlock == nil || !n.s.sufficient(m.Height, voteList(st.votes)) {
+		return
+	}
+	st.made = true
+	n.commitMine(st.myBlock, st)
+}
+
+// commitMine 出块人用收集到的投票生成确定的区块. 双签的出块人给一半的节点发另一个区块
+func (n *Node) commitMine(pre *Block, st *roundState) {
+	var vs []*Vote
+	for _, s := range topSorts(st.comm, len(st.comm)) {
+		if v, ok := st.votes[string(s.Hash)]; ok && bytes.Equal(v.Hash, pre.Maker.Hash) {
+			vs = append(vs, v)
+		}
+	}
+	b := &Block{Height: pre.Height, Round: pre.Round, Parent: pre.Parent, Maker: pre.Maker, Votes: vs}
+	b.Hash = blockHash(b, 0)
+	if !n.equivocate {
+		n.s.net.broadcast(&Message{Ty: MsgCommit, From: n.ID, Height: b.Height, Block: b})
+		n.commit(b)
+		return
+	}
+	b2 := *b
+	b2.Hash = blockHash(b, 1)
+	for i := range n.s.nodes {
+		if i == n.ID {
+			continue
+		}
+		x := b
+		if i%2 == 1 {
+			x = &b2
+		}
+		n.s.net.send(i, &Message{Ty: MsgCommit, From: n.ID, Height: b.Height, Block: x})
+	}
+	n.commit(b)
+}
+
+// checkCommit 确定的区块接在自己的链上, 而且有足够的委员会投票
+func (n *Node) checkCommit(b *Block) bool {
+	if b.Height != n.Height()+1 || !bytes.Equal(b.Parent, n.head().Hash) {
+		return false
+	}
+	if !n.validSort(b.Maker, b.Height, b.Round) || (!bytes.Equal(blockHash(b, 0), b.Hash) && !bytes.Equal(blockHash(b, 1), b.Hash)) {
+		return false
+	}
+	seats := make(map[string]*Vote)
+	for _, v := range b.Votes {
+		if !bytes.Equal(v.Hash, b.Maker.Hash) || !n.validSort(v.Sort, b.Height, b.Round) {
+			return false
+		}
+		seats[string(v.Sort.Hash)] = v
+	}
+	return n.s.sufficient(b.Height, voteList(seats))
+}
+
+func voteList(mp map[string]*Vote) []*Vote {
+	vs := make([]*Vote, 0, len(mp))
+	for _, v := range mp {
+		vs = append(vs, v)
+	}
+	return vs
+}
+
+func (n *Node) handleCommit(b *Block, from int) {
+	if b.Height <= n.Height() {
+		return
+	}
+	if b.Height > n.Height()+1 {
+		n.future[b.Height] = b
+		n.requestSync(from)
+		return
+	}
+	if !n.checkCommit(b) {
+		return
+	}
+	n.commit(b)
+}
+
+// requestSync 收到不连续的区块时向发送者请求缺少的区块, 对应chain33的区块同步
+func (n *Node) requestSync(from int) {
+	next := n.Height() + 1
+	if n.asked[next] {
+		return
+	}
+	n.asked[next] = true
+	n.s.net.send(from, &Message{Ty: MsgSync, From: n.ID, Height: next})
+}
+
+func (n *Node) handleSync(m *Message) {
+	for h := m.Height; h <= n.Height(); h++ {
+		n.s.net.send(m.From, &Message{Ty: MsgCommit, From: n.ID, Height: h, Block: n.chain[h]})
+	}
+}
+
+// commit 确定区块b, 处理已经收到的下一个高度的区块和消息, 然后开始下一个高度
+func (n *Node) commit(b *Block) {
+	n.chain = append(n.chain, b)
+	n.s.record(n, b)
+	for k := range n.rs {
+		if k.h <= b.Height {
+			delete(n.rs, k)
+		}
+	}
+	delete(n.future, b.Height)
+	delete(n.later, b.Height)
+	delete(n.asked, b.Height)
+	n.round = 0
+	next := b.Height + 1
+	if nb, ok := n.future[next]; ok {
+		delete(n.future, next)
+		if n.checkCommit(nb) {
+			n.commit(nb)
+			return
+		}
+	}
+	if b.Height >= n.s.cfg.Heights {
+		return
+	}
+	n.startRound(next, 0)
+	ms := n.later[next]
+	delete(n.later, next)
+	for _, m := range ms {
+		n.handle(m)
+	}
+}
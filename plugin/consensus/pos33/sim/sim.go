@@ -0,0 +1,346 @@
+// Package sim 在一个进程里模拟N个pos33节点的共识, 用来复现验证者很多时才出现的活性问题.
+//
+// 节点之间用内存里的网络通信, 所有的定时和消息投递都在虚拟时钟上执行, 不使用真实时间,
+// 同样的Config总是得到同样的Result. 模拟的是共识的消息流程:
+// 委员会抽签, 对委员会投票, 候选人的预区块, 委员会对候选人投票, 出块人广播确定的区块,
+// 轮次的超时和runLoop相同, 抽签的中签判断和难度使用pos33的verifier和链上参数.
+// 没有交易, 执行和chain33的存储, vrf用hash代替, 分叉以后不做分叉选择, 只在Result.Conflicts里报告.
+//
+// 故障通过Config.Faults注入: DropVotes丢弃投票, DelaySorts延迟抽签, Partition分区,
+// Config.Equivocators里的节点双签: 作为出块人给两半的节点发不同的区块, 作为委员会投票给所有的候选人.
+package sim
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/yccproject/ycc/plugin/consensus/pos33"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+	"github.com/yccproject/ycc/plugin/dapp/pos33/verifier"
+)
+
+// 和共识的retarget.go相同, 每一轮没有出块时难度放宽的倍数和上限
+const (
+	diffRoundRelax    = 1.5
+	diffRoundRelaxMax = 16.0
+)
+
+// Timing 共识的定时, 默认值和runLoop相同
+type Timing struct {
+	// BlockDelay 上一个区块确定以后到出块的间隔
+	BlockDelay time.Duration
+	// BlockTimeout 出块以后没有确定区块, 进入下一轮的超时
+	BlockTimeout time.Duration
+	// ResortTimeout 超时重新抽签以后到对委员会投票的间隔
+	ResortTimeout time.Duration
+	// VoteCommittee 对委员会投票以后到出块的间隔
+	VoteCommittee time.Duration
+	// BlockVoteWait 收到第一个预区块以后到投票的间隔
+	BlockVoteWait time.Duration
+}
+
+// DefaultTiming runLoop使用的定时
+func DefaultTiming() Timing {
+	return Timing{
+		BlockDelay:    900 * time.Millisecond,
+		BlockTimeout:  3 * time.Second,
+		ResortTimeout: time.Second,
+		VoteCommittee: time.Second,
+		BlockVoteWait: 700 * time.Millisecond,
+	}
+}
+
+// Config 模拟的参数, 为0的使用默认值
+type Config struct {
+	// Nodes 节点数, 每个节点一个挖矿地址
+	Nodes int
+	// Tickets 每个节点的票数, 默认10
+	Tickets int64
+	// Heights 模拟到所有节点都确定了这个高度
+	Heights int64
+	// Seed 网络延迟, 故障注入和创世区块使用的随机数种子
+	Seed int64
+	// MinLatency, MaxLatency 消息的延迟在这个范围内均匀分布, 默认10ms到50ms
+	MinLatency time.Duration
+	MaxLatency time.Duration
+	// MaxTime 虚拟时间超过MaxTime时停止, 默认每个高度60秒
+	MaxTime time.Duration
+	Timing  Timing
+	Faults  []Fault
+	// Equivocators 双签的节点
+	Equivocators []int
+	// Quorum 出块人判断区块的投票是否足够, 默认和共识一样是pos33.QuorumSeats
+	Quorum pos33.QuorumRule
+}
+
+// BlockRecord 一个高度第一个确定的区块
+type BlockRecord struct {
+	Height int64
+	Round  int
+	Maker  string
+	Hash   []byte
+	// Time 确定的虚拟时间
+	Time time.Duration
+}
+
+// Result 模拟的结果
+type Result struct {
+	// Blocks 每个高度第一个确定的区块, 按高度排列, 从高度1开始
+	Blocks []*BlockRecord
+	// Conflicts 不同节点确定了不同区块的高度
+	Conflicts []int64
+	// Equivocations 节点发现的同一个座位投票给不同候选人的次数
+	Equivocations int
+	// Timeouts 所有节点进入下一轮的次数
+	Timeouts int
+	// Sent, Dropped 发出的和故障注入丢弃的消息数
+	Sent    int
+	Dropped int
+	// Heights 每个节点最后确定的高度
+	Heights []int64
+	// Elapsed 结束时的虚拟时间
+	Elapsed time.Duration
+	// Stalled MaxTime以前没有所有节点都确定到Config.Heights
+	Stalled bool
+}
+
+// MaxRound 确定的区块里最大的轮次
+func (r *Result) MaxRound() int {
+	max := 0
+	for _, b := range r.Blocks {
+		if b.Round > max {
+			max = b.Round
+		}
+	}
+	return max
+}
+
+// Sim 一次模拟
+type Sim struct {
+	cfg      Config
+	clk      *Clock
+	net      *network
+	nodes    []*Node
+	tickets  map[string]int64
+	allCount int64
+	res      *Result
+	heights  map[int64]*BlockRecord
+	conflict map[int64]bool
+	evidence map[string]bool
+}
+
+func (cfg *Config) check() error {
+	if cfg.Nodes <= 0 {
+		return errors.New("sim: nodes must > 0")
+	}
+	if cfg.Heights <= 0 {
+		return errors.New("sim: heights must > 0")
+	}
+	if cfg.Tickets == 0 {
+		cfg.Tickets = 10
+	}
+	if cfg.Tickets < 0 {
+		return fmt.Errorf("sim: tickets %d < 0", cfg.Tickets)
+	}
+	if cfg.MinLatency == 0 && cfg.MaxLatency == 0 {
+		cfg.MinLatency, cfg.MaxLatency = 10*time.Millisecond, 50*time.Millisecond
+	}
+	if cfg.MaxLatency < cfg.MinLatency {
+		return fmt.Errorf("sim: max latency %v < min latency %v", cfg.MaxLatency, cfg.MinLatency)
+	}
+	if cfg.MaxTime == 0 {
+		cfg.MaxTime = time.Duration(cfg.Heights) * time.Minute
+	}
+	if cfg.Timing == (Timing{}) {
+		cfg.Timing = DefaultTiming()
+	}
+	if cfg.Quorum == nil {
+		cfg.Quorum, _ = pos33.NewQuorumRule("")
+	}
+	for _, id := range cfg.Equivocators {
+		if id < 0 || id >= cfg.Nodes {
+			return fmt.Errorf("sim: equivocator %d out of %d nodes", id, cfg.Nodes)
+		}
+	}
+	return nil
+}
+
+// New 创建模拟, 所有节点从同一个创世区块开始
+func New(cfg Config) (*Sim, error) {
+	err := cfg.check()
+	if err != nil {
+		return nil, err
+	}
+	s := &Sim{
+		cfg:      cfg,
+		clk:      new(Clock),
+		tickets:  make(map[string]int64),
+		res:      new(Result),
+		heights:  make(map[int64]*BlockRecord),
+		conflict: make(map[int64]bool),
+		evidence: make(map[string]bool),
+	}
+	var seed [8]byte
+	binary.BigEndian.PutUint64(seed[:], uint64(cfg.Seed))
+	gh := sha256.Sum256(append([]byte("pos33 sim genesis"), seed[:]...))
+	genesis := &Block{Hash: gh[:]}
+
+	bad := newNodeSet(cfg.Equivocators)
+	for i := 0; i < cfg.Nodes; i++ {
+		n := &Node{
+			ID:         i,
+			Addr:       fmt.Sprintf("node-%03d", i),
+			Tickets:    cfg.Tickets,
+			s:          s,
+			equivocate: len(cfg.Equivocators) > 0 && bad.has(i),
+			chain:      []*Block{genesis},
+			rs:         make(map[hr]*roundState),
+			later:      make(map[int64][]*Message),
+			future:     make(map[int64]*Block),
+			asked:      make(map[int64]bool),
+		}
+		s.nodes = append(s.nodes, n)
+		s.tickets[n.Addr] = n.Tickets
+		s.allCount += n.Tickets
+	}
+	s.net = &network{
+		clk:    s.clk,
+		rnd:    rand.New(rand.NewSource(cfg.Seed)),
+		nodes:  s.nodes,
+		faults: cfg.Faults,
+		min:    cfg.MinLatency,
+		max:    cfg.MaxLatency,
+		res:    s.res,
+	}
+	return s, nil
+}
+
+// Nodes 模拟的节点
+func (s *Sim) Nodes() []*Node {
+	return s.nodes
+}
+
+// Clock 模拟使用的虚拟时钟, 可以在Run以前加入事件
+func (s *Sim) Clock() *Clock {
+	return s.clk
+}
+
+func (s *Sim) done() bool {
+	for _, n := range s.nodes {
+		if n.Height() < s.cfg.Heights {
+			return false
+		}
+	}
+	return true
+}
+
+// Run 运行到所有节点都确定了Config.Heights, 或者虚拟时间超过MaxTime
+func (s *Sim) Run() *Result {
+	for _, n := range s.nodes {
+		n := n
+		s.clk.AfterFunc(0, func() { n.startRound(1, 0) })
+	}
+	for !s.done() && s.clk.Pending() > 0 {
+		if !s.clk.Step() || s.clk.Now() > s.cfg.MaxTime {
+			break
+		}
+	}
+	s.res.Stalled = !s.done()
+	s.res.Elapsed = s.clk.Now()
+	s.res.Heights = s.res.Heights[:0]
+	for _, n := range s.nodes {
+		s.res.Heights = append(s.res.Heights, n.Height())
+	}
+	return s.res
+}
+
+// roundRelax 和共识的roundRelax相同, ForkDiffV2以后每一轮没有出块时放宽难度
+func roundRelax(round int) float64 {
+	f := 1.0
+	for i := 0; i < round && f < diffRoundRelaxMax; i++ {
+		f *= diffRoundRelax
+	}
+	if f > diffRoundRelaxMax {
+		return diffRoundRelaxMax
+	}
+	return f
+}
+
+func (s *Sim) diff(round int) float64 {
+	return pt.DefaultPos33ChainParam().Diff(int(s.allCount)) * roundRelax(round)
+}
+
+// sort 用hash代替vrf, 每个地址在每个高度和轮次的输出是确定的
+func (s *Sim) sort(seed []byte, addr string, height int64, round, index int) *Sort {
+	var buf [16]byte
+	binary.BigEndian.PutUint64(buf[:8], uint64(height))
+	binary.BigEndian.PutUint64(buf[8:], uint64(round))
+	h := sha256.New()
+	h.Write(seed)
+	h.Write(buf[:])
+	h.Write([]byte(addr))
+	return &Sort{Addr: addr, Height: height, Round: round, Index: index, Hash: verifier.SortHash(h.Sum(nil), index, 0)}
+}
+
+func blockHash(b *Block, variant byte) []byte {
+	var buf [17]byte
+	binary.BigEndian.PutUint64(buf[:8], uint64(b.Height))
+	binary.BigEndian.PutUint64(buf[8:16], uint64(b.Round))
+	buf[16] = variant
+	h := sha256.New()
+	h.Write(b.Parent)
+	h.Write(buf[:])
+	h.Write(b.Maker.Hash)
+	for _, v := range b.Votes {
+		h.Write(v.Sort.Hash)
+	}
+	return h.Sum(nil)
+}
+
+// sufficient 区块的投票是否满足Config.Quorum, 和共识一样按不重复的投票地址计算
+func (s *Sim) sufficient(height int64, vs []*Vote) bool {
+	voters := make(map[string]bool)
+	stake := int64(0)
+	for _, v := range vs {
+		if voters[v.Sort.Addr] {
+			continue
+		}
+		voters[v.Sort.Addr] = true
+		stake += s.tickets[v.Sort.Addr]
+	}
+	q := &pos33.QuorumVotes{
+		Height: height,
+		Voters: len(voters),
+		Stake:  func() (float64, error) { return float64(stake) / float64(s.allCount), nil },
+	}
+	return s.cfg.Quorum.Sufficient(q) == nil
+}
+
+// record 记录节点n确定的区块, 和其他节点在这个高度确定的区块不同时是冲突
+func (s *Sim) record(n *Node, b *Block) {
+	r, ok := s.heights[b.Height]
+	if !ok {
+		r = &BlockRecord{Height: b.Height, Round: b.Round, Maker: b.Maker.Addr, Hash: b.Hash, Time: s.clk.Now()}
+		s.heights[b.Height] = r
+		s.res.Blocks = append(s.res.Blocks, r)
+		return
+	}
+	if string(r.Hash) != string(b.Hash) && !s.conflict[b.Height] {
+		s.conflict[b.Height] = true
+		s.res.Conflicts = append(s.res.Conflicts, b.Height)
+	}
+}
+
+// equivocation 一个座位投票给不同候选人, 每个座位只算一次
+func (s *Sim) equivocation(n *Node, st *Sort) {
+	k := string(st.Hash)
+	if s.evidence[k] {
+		return
+	}
+	s.evidence[k] = true
+	s.res.Equivocations++
+}
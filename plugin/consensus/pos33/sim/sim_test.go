@@ -0,0 +1,130 @@
+package sim
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yccproject/ycc/plugin/consensus/pos33"
+)
+
+func runSim(t *testing.T, cfg Config) *Result {
+	s, err := New(cfg)
+	require.Nil(t, err)
+	return s.Run()
+}
+
+func nodeRange(start, end int) []int {
+	var ids []int
+	for i := start; i < end; i++ {
+		ids = append(ids, i)
+	}
+	return ids
+}
+
+func TestClock(t *testing.T) {
+	c := new(Clock)
+	var got []int
+	c.AfterFunc(time.Second, func() { got = append(got, 2) })
+	c.AfterFunc(0, func() {
+		got = append(got, 0)
+		c.AfterFunc(time.Second, func() { got = append(got, 3) })
+	})
+	c.AfterFunc(-time.Second, func() { got = append(got, 1) })
+	for c.Step() {
+	}
+	// 时间相同的按加入的顺序执行
+	require.Equal(t, []int{0, 1, 2, 3}, got)
+	require.Equal(t, time.Second, c.Now())
+	require.Equal(t, 0, c.Pending())
+}
+
+func TestConfig(t *testing.T) {
+	_, err := New(Config{Heights: 1})
+	require.NotNil(t, err)
+	_, err = New(Config{Nodes: 1})
+	require.NotNil(t, err)
+	_, err = New(Config{Nodes: 3, Heights: 1, Equivocators: []int{3}})
+	require.NotNil(t, err)
+	_, err = New(Config{Nodes: 3, Heights: 1, MinLatency: time.Second, MaxLatency: time.Millisecond})
+	require.NotNil(t, err)
+}
+
+func TestHonest(t *testing.T) {
+	r := runSim(t, Config{Nodes: 30, Heights: 10, Seed: 1})
+	require.False(t, r.Stalled)
+	require.Empty(t, r.Conflicts)
+	require.Equal(t, 10, len(r.Blocks))
+	require.Equal(t, 0, r.MaxRound())
+	require.Equal(t, 0, r.Timeouts)
+	for i, b := range r.Blocks {
+		require.Equal(t, int64(i+1), b.Height)
+	}
+
+	// 少于QuorumSeats需要的投票地址数时不能出块
+	r = runSim(t, Config{Nodes: 5, Heights: 1, Seed: 1, MaxTime: time.Minute})
+	require.True(t, r.Stalled)
+	require.Empty(t, r.Blocks)
+}
+
+func TestDeterministic(t *testing.T) {
+	cfg := Config{Nodes: 25, Heights: 8, Seed: 7, Faults: []Fault{DropVotes(0.2), DelaySorts(300*time.Millisecond, 1, 2)}}
+	r1 := runSim(t, cfg)
+	r2 := runSim(t, cfg)
+	require.Equal(t, r1, r2)
+	require.True(t, r1.Dropped > 0)
+
+	cfg.Seed = 8
+	r3 := runSim(t, cfg)
+	require.NotEqual(t, r1.Blocks, r3.Blocks)
+}
+
+func TestDropVotes(t *testing.T) {
+	r := runSim(t, Config{Nodes: 30, Heights: 10, Seed: 1, Faults: []Fault{DropVotes(0.2)}})
+	require.False(t, r.Stalled)
+	require.True(t, r.Dropped > 0)
+
+	r = runSim(t, Config{Nodes: 30, Heights: 1, Seed: 1, MaxTime: time.Minute, Faults: []Fault{DropVotes(1)}})
+	require.True(t, r.Stalled)
+	require.Empty(t, r.Blocks)
+}
+
+func TestDelaySorts(t *testing.T) {
+	// 一部分节点的抽签晚到, 换轮以后还能出块
+	r := runSim(t, Config{Nodes: 30, Heights: 10, Seed: 1, Faults: []Fault{DelaySorts(2*time.Second, nodeRange(0, 10)...)}})
+	require.False(t, r.Stalled)
+	require.Empty(t, r.Conflicts)
+
+	// 所有的抽签都比对委员会投票晚到, 每一轮都选不出委员会
+	r = runSim(t, Config{Nodes: 30, Heights: 1, Seed: 1, MaxTime: time.Minute, Faults: []Fault{DelaySorts(2 * time.Second)}})
+	require.True(t, r.Stalled)
+	require.Empty(t, r.Blocks)
+}
+
+func TestPartition(t *testing.T) {
+	// 少数节点分区期间落后, 恢复以后同步追上
+	part := Partition(5*time.Second, 40*time.Second, nodeRange(0, 24))
+	r := runSim(t, Config{Nodes: 30, Heights: 20, Seed: 1, Faults: []Fault{part}})
+	require.False(t, r.Stalled)
+	require.Empty(t, r.Conflicts)
+	for _, h := range r.Heights {
+		require.Equal(t, int64(20), h)
+	}
+
+	// 平分以后两边都有QuorumSeats需要的投票地址数, 各自出块
+	halves := Partition(5*time.Second, 40*time.Second, nodeRange(0, 15), nodeRange(15, 30))
+	r = runSim(t, Config{Nodes: 30, Heights: 20, Seed: 1, Faults: []Fault{halves}})
+	require.NotEmpty(t, r.Conflicts)
+
+	// 超过2/3的规则两边都不能出块
+	rule, err := pos33.NewQuorumRule(pos33.QuorumTwoThirds)
+	require.Nil(t, err)
+	r = runSim(t, Config{Nodes: 30, Heights: 20, Seed: 1, Quorum: rule, Faults: []Fault{halves}})
+	require.False(t, r.Stalled)
+	require.Empty(t, r.Conflicts)
+}
+
+func TestEquivocation(t *testing.T) {
+	r := runSim(t, Config{Nodes: 30, Heights: 20, Seed: 1, Equivocators: []int{3}})
+	require.True(t, r.Equivocations > 0)
+}
@@ -0,0 +1,78 @@
+package pos33
+
+import (
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/yccproject/ycc/plugin/consensus/pos33/signer"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// slashEvidences 选出可以在height+1高度处罚的证据: 没有发送过, 在处罚窗口内, 有出块抽签
+func (n *node) slashEvidences(height int64) []*pt.Pos33Evidence {
+	var es []*pt.Pos33Evidence
+	for _, e := range n.evpool.next(evidencePoolSize) {
+		if _, ok := n.slashSent[string(e.Hash)]; ok {
+			continue
+		}
+		if height+1-e.Height > slashWindow {
+			continue
+		}
+		err := e.Check(n.GetAPI().GetConfig())
+		if err != nil {
+			plog.Debug("evidence can't slash", "err", err, "height", e.Height, "offender", e.Offender)
+			continue
+		}
+		es = append(es, e)
+		if len(es) == maxBlockEvidences {
+			break
+		}
+	}
+	return es
+}
+
+func (n *node) slashTx(es []*pt.Pos33Evidence, priv crypto.PrivKey) (*types.Transaction, error) {
+	act := &pt.Pos33TicketAction{
+		Value: &pt.Pos33TicketAction_Slash{Slash: &pt.Pos33Slash{Evidences: es}},
+		Ty:    pt.Pos33ActionSlash,
+	}
+	tx, err := types.CreateFormatTx(n.GetAPI().GetConfig(), "pos33", types.Encode(act))
+	if err != nil {
+		return nil, err
+	}
+	tx.Sign(types.EncodeSignID(types.SECP256K1, ethID), priv)
+	return tx, nil
+}
+
+// reportSlash 配置了SlashReport时, 把证据池里可以处罚的证据作为Pos33Slash交易发送到mempool.
+// 发送过的证据不再发送, 超过处罚窗口以后忘记
+func (n *node) reportSlash(height int64) {
+	for h, sent := range n.slashSent {
+		if sent < height-slashWindow {
+			delete(n.slashSent, h)
+		}
+	}
+	if !n.conf.SlashReport || !n.GetAPI().GetConfig().IsDappFork(height+1, pt.Pos33TicketX, "ForkSlash") {
+		return
+	}
+	if n.priv == nil {
+		return
+	}
+	es := n.slashEvidences(height)
+	if len(es) == 0 {
+		return
+	}
+	tx, err := n.slashTx(es, n.signKey(signer.KindSlash, height, 0))
+	if err != nil {
+		plog.Error("slash tx error", "err", err, "height", height)
+		return
+	}
+	_, err = n.GetAPI().SendTx(tx)
+	if err != nil {
+		plog.Error("send slash tx error", "err", err, "height", height)
+		return
+	}
+	for _, e := range es {
+		n.slashSent[string(e.Hash)] = height
+	}
+	plog.Info("report slash", "height", height, "evidences", len(es))
+}
@@ -0,0 +1,114 @@
+package pos33
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"sort"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+var (
+	errSnapshotHash    = errors.New("consensus snapshot hash error")
+	errSnapshotTrusted = errors.New("consensus snapshot hash NOT match the trusted hash")
+)
+
+// exportSnapshot 导出共识模块的缓存: 全网票数, 矿工票数, diff记录, 委员会
+func (c *Client) exportSnapshot() *pt.Pos33ConsensusSnapshot {
+	s := &pt.Pos33ConsensusSnapshot{}
+	c.mlock.Lock()
+	for h, count := range c.acMap {
+		s.AllCounts = append(s.AllCounts, &pt.Pos33HeightCount{Height: h, Count: int64(count)})
+		if h > s.Height {
+			s.Height = h
+		}
+	}
+	for h, mp := range c.tcMap {
+		t := &pt.Pos33HeightTickets{Height: h}
+		for addr, count := range mp {
+			t.Validators = append(t.Validators, &pt.Pos33Validator{Addr: addr, Count: count})
+		}
+		sort.Slice(t.Validators, func(i, j int) bool { return t.Validators[i].Addr < t.Validators[j].Addr })
+		s.Tickets = append(s.Tickets, t)
+	}
+	for h, count := range c.dsMap {
+		s.DiffSchedule = append(s.DiffSchedule, &pt.Pos33HeightCount{Height: h, Count: int64(count)})
+	}
+	c.mlock.Unlock()
+
+	sort.Slice(s.AllCounts, func(i, j int) bool { return s.AllCounts[i].Height < s.AllCounts[j].Height })
+	sort.Slice(s.Tickets, func(i, j int) bool { return s.Tickets[i].Height < s.Tickets[j].Height })
+	sort.Slice(s.DiffSchedule, func(i, j int) bool { return s.DiffSchedule[i].Height < s.DiffSchedule[j].Height })
+	s.Committees = c.n.cstore.records()
+	s.Hash = s.CalcHash()
+	return s
+}
+
+// importSnapshot 校验快照的hash后替换共识模块的缓存.
+// trusted不为空时, 快照的hash必须和它相同
+func (c *Client) importSnapshot(s *pt.Pos33ConsensusSnapshot, trusted []byte) error {
+	if !bytes.Equal(s.CalcHash(), s.Hash) {
+		return errSnapshotHash
+	}
+	if len(trusted) > 0 && !bytes.Equal(trusted, s.Hash) {
+		return errSnapshotTrusted
+	}
+	rs := s.GetCommittees().GetRecords()
+	err := checkCommitteeRecords(rs)
+	if err != nil {
+		return err
+	}
+
+	acMap := make(map[int64]int)
+	for _, hc := range s.AllCounts {
+		acMap[hc.Height] = int(hc.Count)
+	}
+	tcMap := make(map[int64]map[string]int64)
+	for _, t := range s.Tickets {
+		mp := make(map[string]int64)
+		for _, v := range t.Validators {
+			mp[v.Addr] = v.Count
+		}
+		tcMap[t.Height] = mp
+	}
+	dsMap := make(map[int64]int)
+	for _, hc := range s.DiffSchedule {
+		dsMap[hc.Height] = int(hc.Count)
+	}
+
+	c.mlock.Lock()
+	c.acMap, c.tcMap, c.dsMap = acMap, tcMap, dsMap
+	c.mlock.Unlock()
+	c.n.cstore.restore(rs)
+	plog.Info("consensus snapshot imported", "height", s.Height, "hash", common.HashHex(s.Hash))
+	return nil
+}
+
+// loadSnapshot 从文件导入快照, 文件是GetConsensusSnapshot返回的json
+func (c *Client) loadSnapshot(file, trusted string) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	var s pt.Pos33ConsensusSnapshot
+	err = types.JSONToPB(data, &s)
+	if err != nil {
+		return err
+	}
+	var th []byte
+	if trusted != "" {
+		th, err = common.FromHex(trusted)
+		if err != nil {
+			return err
+		}
+	}
+	return c.importSnapshot(&s, th)
+}
+
+// Query_GetConsensusSnapshot 导出共识模块缓存的快照
+func (client *Client) Query_GetConsensusSnapshot(req *types.ReqNil) (types.Message, error) {
+	return client.exportSnapshot(), nil
+}
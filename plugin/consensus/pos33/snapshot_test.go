@@ -0,0 +1,88 @@
+package pos33
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func writeSnapshot(t *testing.T, s *pt.Pos33ConsensusSnapshot) string {
+	data, err := types.PBToJSON(s)
+	require.Nil(t, err)
+	file := filepath.Join(t.TempDir(), "snapshot.json")
+	require.Nil(t, os.WriteFile(file, data, 0666))
+	return file
+}
+
+func TestConsensusSnapshot(t *testing.T) {
+	a, _ := newTestNode(t, nil)
+	a.setTestMiner(newTestPriv(t))
+
+	height := int64(100)
+	sh := height - pt.Pos33SortBlocks
+	seed := []byte("snapshot seed")
+	a.setTestCount(a.myAddr, sh, 10, pt.Pos33CommitteeSize)
+	a.setTestCount("other", sh, 20, pt.Pos33CommitteeSize)
+	a.mlock.Lock()
+	a.setDiffSchedule(sh, pt.Pos33CommitteeSize)
+	a.mlock.Unlock()
+	ss := a.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.NotEmpty(t, ss)
+	a.cstore.add(height, 0, ss)
+
+	s := a.exportSnapshot()
+	require.Equal(t, s.Hash, s.CalcHash())
+	file := writeSnapshot(t, s)
+
+	// 新节点启动时导入, 不需要查询就能验证抽签
+	b, _ := newTestNode(t, &subConfig{SnapshotFile: file, SnapshotHash: common.ToHex(s.Hash)})
+	require.Equal(t, s.Hash, b.exportSnapshot().Hash)
+	require.Nil(t, b.verifySort(height, Committee, seed, ss[0]))
+
+	va, err := a.validatorSet(height)
+	require.Nil(t, err)
+	vb, err := b.validatorSet(height)
+	require.Nil(t, err)
+	require.Equal(t, va.Hash, vb.Hash)
+
+	ra, err := a.cstore.get(height)
+	require.Nil(t, err)
+	rb, err := b.cstore.get(height)
+	require.Nil(t, err)
+	require.Equal(t, types.Encode(ra), types.Encode(rb))
+
+	blk := newTestBlock(height, ss[0])
+	da, err := a.Client.blockDiffOf(blk)
+	require.Nil(t, err)
+	db, err := b.Client.blockDiffOf(blk)
+	require.Nil(t, err)
+	require.Equal(t, da.Diff, db.Diff)
+}
+
+func TestConsensusSnapshotTampered(t *testing.T) {
+	a, _ := newTestNode(t, nil)
+	height := int64(100)
+	a.setTestCount("miner", height, 10, 100)
+	s := a.exportSnapshot()
+
+	// 修改了票数, hash不对
+	ts := types.Clone(s).(*pt.Pos33ConsensusSnapshot)
+	ts.Tickets[0].Validators[0].Count = 1000
+	b, _ := newTestNode(t, &subConfig{SnapshotFile: writeSnapshot(t, ts)})
+	require.Empty(t, b.exportSnapshot().Tickets)
+	require.Equal(t, errSnapshotHash, b.importSnapshot(ts, nil))
+
+	// 重新计算了hash, 但是和信任的hash不同
+	ts.Hash = ts.CalcHash()
+	require.Equal(t, errSnapshotTrusted, b.importSnapshot(ts, s.Hash))
+	require.Empty(t, b.exportSnapshot().Tickets)
+
+	require.Nil(t, b.importSnapshot(s, s.Hash))
+	require.Equal(t, int64(10), b.queryTicketCount("miner", height))
+}
@@ -2,19 +2,23 @@ package pos33
 
 import (
 	"bytes"
+	"context"
 	"crypto/ecdsa"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"math/big"
+	"runtime"
+	"sync"
 
-	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/common/crypto"
-	"github.com/33cn/chain33/common/difficulty"
 	vrf "github.com/33cn/chain33/common/vrf/secp256k1"
 	"github.com/33cn/chain33/types"
 	secp256k1 "github.com/btcsuite/btcd/btcec"
 	"github.com/golang/protobuf/proto"
+	metrics "github.com/rcrowley/go-metrics"
 	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+	"github.com/yccproject/ycc/plugin/dapp/pos33/verifier"
 )
 
 var big1 = big.NewInt(1)
@@ -30,24 +34,20 @@ const (
 // 2. 那么从N个选票中抽出M个选票，等价于计算N次Hash, 并且Hash/max < M/N
 
 func calcuVrfHash(input proto.Message, priv crypto.PrivKey) ([]byte, []byte) {
+	return calcuVrfInput(types.Encode(input), priv)
+}
+
+// calcuVrfInput 计算编码以后的input的secp256k1 vrf
+func calcuVrfInput(in []byte, priv crypto.PrivKey) ([]byte, []byte) {
 	privKey, _ := secp256k1.PrivKeyFromBytes(secp256k1.S256(), priv.Bytes())
 	vrfPriv := &vrf.PrivateKey{PrivateKey: (*ecdsa.PrivateKey)(privKey)}
-	in := types.Encode(input)
 	vrfHash, vrfProof := vrfPriv.Evaluate(in)
 	return vrfHash[:], vrfProof
 }
 
 func sortF(vrfHash []byte, index, num int, diff float64, proof *pt.HashProof) *pt.Pos33SortMsg {
-	data := fmt.Sprintf("%x+%d+%d", vrfHash, index, num)
-	hash := hash2([]byte(data))
-
-	tmpHash := make([]byte, len(hash))
-	copy(tmpHash, hash)
-
-	// 转为big.Float计算，比较难度diff
-	y := difficulty.HashToBig(tmpHash)
-	z := new(big.Float).SetInt(y)
-	if new(big.Float).Quo(z, fmax).Cmp(big.NewFloat(diff)) > 0 {
+	hash := verifier.SortHash(vrfHash, index, num)
+	if !verifier.Win(hash, diff) {
 		return nil
 	}
 
@@ -59,82 +59,238 @@ func sortF(vrfHash []byte, index, num int, diff float64, proof *pt.HashProof) *p
 	return m
 }
 
+// sortArg 一批票号[from, to)的抽签, 结果一次写入ch
 type sortArg struct {
+	ctx     context.Context
 	vrfHash []byte
-	index   int
+	from    int
+	to      int
 	num     int
 	diff    float64
 	proof   *pt.HashProof
-	ch      chan<- *pt.Pos33SortMsg
+	ch      chan<- []*pt.Pos33SortMsg
+}
+
+// sortBatch 一次提交给抽签协程的票数, 票数很多时减少排队的消息
+const sortBatch = 256
+
+// sortCheckEvery 抽签协程每计算这么多张票检查一次ctx
+const sortCheckEvery = 64
+
+// sort 计算这一批票, ctx取消以后剩下的票不再计算
+func (s *sortArg) sort() []*pt.Pos33SortMsg {
+	var msgs []*pt.Pos33SortMsg
+	for i := s.from; i < s.to; i++ {
+		if (i-s.from)%sortCheckEvery == 0 && s.ctx.Err() != nil {
+			break
+		}
+		if m := sortF(s.vrfHash, i, s.num, s.diff, s.proof); m != nil {
+			msgs = append(msgs, m)
+		}
+	}
+	return msgs
+}
+
+// sortitionWorkers 抽签协程的数量, 默认GOMAXPROCS, 不超过NumCPU的4倍
+func sortitionWorkers(w int) int {
+	if w <= 0 {
+		w = runtime.GOMAXPROCS(0)
+	}
+	if limit := runtime.NumCPU() * 4; w > limit {
+		w = limit
+	}
+	return w
 }
 
+func (n *node) sortitionWorkers() int {
+	return sortitionWorkers(n.conf.SortitionWorkers)
+}
+
+// sortQueueSize 等待抽签协程计算的批数, 默认是协程数的2倍. 队列满了以后doSort等待, 不再提交
+func sortQueueSize(size, workers int) int {
+	if size <= 0 {
+		size = workers * 2
+	}
+	return size
+}
+
+// runSortition 启动抽签协程, n.quit关闭以后所有协程退出时返回.
+// sortCh不关闭, doSort同时等待n.quit, 不会因为没有协程计算而阻塞
 func (n *node) runSortition() {
-	for i := 0; i < 8; i++ {
+	w := n.sortitionWorkers()
+	plog.Debug("sortition workers", "workers", w, "config", n.conf.SortitionWorkers, "queue", cap(n.sortCh))
+	var wg sync.WaitGroup
+	wg.Add(w)
+	for i := 0; i < w; i++ {
 		go func() {
-			for s := range n.sortCh {
-				s.ch <- sortF(s.vrfHash, s.index, s.num, s.diff, s.proof)
+			defer wg.Done()
+			for {
+				select {
+				case s := <-n.sortCh:
+					s.ch <- s.sort()
+				case <-n.quit:
+					return
+				}
 			}
 		}()
 	}
+	wg.Wait()
+	plog.Debug("sortition workers stopped")
 }
 
-func (n *node) doSort(vrfHash []byte, count, num int, diff float64, proof *pt.HashProof) []*pt.Pos33SortMsg {
-	ch := make(chan *pt.Pos33SortMsg)
-	go func() {
-		for i := 0; i < count; i++ {
-			n.sortCh <- &sortArg{vrfHash, i, num, diff, proof, ch}
+// doSort 计算count张票的抽签, ctx取消或者n.quit关闭以后不再提交新的票, 返回已经得到的结果.
+// 在当前协程按批提交, 队列满了就等待; 已经提交的批看到ctx取消以后很快结束.
+// ch的缓冲和批数相同并且不关闭, 返回以后还在计算的协程写入时不会阻塞也不会panic
+func (n *node) doSort(ctx context.Context, vrfHash []byte, count, num int, diff float64, proof *pt.HashProof) []*pt.Pos33SortMsg {
+	ch := make(chan []*pt.Pos33SortMsg, (count+sortBatch-1)/sortBatch)
+	sent := 0
+feed:
+	for from := 0; from < count; from += sortBatch {
+		to := from + sortBatch
+		if to > count {
+			to = count
 		}
-	}()
-	j := 0
+		select {
+		case n.sortCh <- &sortArg{ctx, vrfHash, from, to, num, diff, proof, ch}:
+			sent++
+		case <-ctx.Done():
+			break feed
+		case <-n.quit:
+			return nil
+		}
+	}
 	var msgs []*pt.Pos33SortMsg
-	for j < count {
-		m := <-ch
-		if m != nil {
-			msgs = append(msgs, m)
+	for i := 0; i < sent; i++ {
+		select {
+		case ms := <-ch:
+			msgs = append(msgs, ms...)
+		case <-n.quit:
+			return msgs
 		}
-		j++
 	}
-	close(ch)
 	return msgs
 }
 
-func (n *node) committeeSort(seed []byte, height int64, round, ty int) []*pt.Pos33SortMsg {
-	count := n.queryTicketCount(n.myAddr, height-10)
-	priv := n.getPriv()
-	if priv == nil {
+func (n *node) committeeSort(ctx context.Context, seed []byte, height int64, round, ty int) []*pt.Pos33SortMsg {
+	return n.timedCommitteeSort(ctx, seed, height, round, ty, nil)
+}
+
+// timedCommitteeSort 用所有挖矿地址抽签, tm不为nil时记录第一个地址各个阶段的耗时
+func (n *node) timedCommitteeSort(ctx context.Context, seed []byte, height int64, round, ty int, tm *sortTimer) []*pt.Pos33SortMsg {
+	ks := n.minerKeys()
+	if len(ks) == 0 {
 		return nil
 	}
+	var msgs []*pt.Pos33SortMsg
+	var mycount int64
+	for i, k := range ks {
+		if i > 0 {
+			tm = nil
+		}
+		ss, count := n.keySort(ctx, k.priv, seed, height, round, ty, tm)
+		msgs = append(msgs, ss...)
+		mycount += count
+	}
+	ticketMyCountGauge.Update(mycount)
+	if len(ks) > 1 {
+		msgs = pt.CanonicalSorts(msgs, 0)
+	}
+	return msgs
+}
 
-	diff := n.getDiff(height, round)
+// keySort 用私钥priv抽签, 返回中签的结果和这个地址的票数
+func (n *node) keySort(ctx context.Context, priv crypto.PrivKey, seed []byte, height int64, round, ty int, tm *sortTimer) ([]*pt.Pos33SortMsg, int64) {
+	addr := n.sortAddr(height, priv.PubKey().Bytes())
+	if !pt.GetPos33Participants(n.GetAPI().GetConfig(), height).Permit(addr) {
+		plog.Debug("voter sort: NOT permitted", "height", height, "addr", addr)
+		return nil, 0
+	}
+	// 和验证使用相同的票数, 否则中签的抽签可能验证不过
+	count, err := n.sortCount(addr, height)
+	if err != nil {
+		plog.Error("voter sort: count error", "err", err, "height", height, "addr", addr)
+		return nil, 0
+	}
+
+	diff, err := n.getDiff(height, round)
+	if err != nil {
+		plog.Error("voter sort: diff error", "err", err, "height", height, "round", round)
+		return nil, 0
+	}
+	tm.stage(stageCount)
 
 	input := &pt.VrfInput{Seed: seed, Height: height, Round: int32(round), Ty: int32(ty)}
-	vrfHash, vrfProof := calcuVrfHash(input, priv)
-	proof := &pt.HashProof{
+	proof := n.makeProof(input, priv)
+	if proof == nil {
+		return nil, count
+	}
+	vrfHash := proof.VrfHash
+	tm.stage(stageVrf)
+
+	var msgs []*pt.Pos33SortMsg
+	draws := 0
+	for num := 0; num < n.subCommittees(height) && ctx.Err() == nil; num++ {
+		msgs = append(msgs, n.doSort(ctx, vrfHash, int(count), num, diff, proof)...)
+		draws += int(count)
+	}
+	// doSort的结果顺序和协程调度有关, 排序以后所有节点相同
+	msgs = pt.CanonicalSorts(msgs, 0)
+	tm.stage(stageSort)
+	// 取消的抽签没有计算完, 不计入中签率
+	if ctx.Err() == nil {
+		sortWins.add(height, draws, len(msgs), diff)
+		n.audit.addMySorts(height, msgs)
+	}
+	plog.Debug("voter sort", "height", height, "round", round, "mycount", count, "n", len(msgs), "diff", diff*1000000, "addr", addr[:16])
+	return msgs, count
+}
+
+// makeProof 计算input的vrf proof, ForkVrfSuite之后使用配置的vrf算法, 并且在proof中写明.
+// 远程签名服务计算失败时返回nil
+func (n *node) makeProof(input *pt.VrfInput, priv crypto.PrivKey) *pt.HashProof {
+	suite := n.proofSuite(input.Height)
+	vrfHash, vrfProof := n.vrfMemo.evaluate(suite, input, priv)
+	if vrfHash == nil {
+		return nil
+	}
+	return &pt.HashProof{
 		Input:    input,
 		VrfHash:  vrfHash,
 		VrfProof: vrfProof,
 		Pubkey:   priv.PubKey().Bytes(),
+		VrfSuite: suite,
 	}
+}
 
-	msgs := n.doSort(vrfHash, int(count), 0, diff, proof)
-	plog.Debug("voter sort", "height", height, "round", round, "mycount", count, "n", len(msgs), "diff", diff*1000000, "addr", address.PubKeyToAddr(ethID, proof.Pubkey)[:16])
-	return msgs
+// subCommittees 返回height高度的子委员会数量, 每个子委员会使用SortHash.Num区分，独立抽签.
+// 数量是共识规则, 从链的配置读取, 所有节点相同
+func (n *node) subCommittees(height int64) int {
+	return pt.GetPos33SubCommittees(n.GetAPI().GetConfig(), height)
 }
 
-func vrfVerify(pub []byte, input []byte, proof []byte, hash []byte) error {
-	pubKey, err := secp256k1.ParsePubKey(pub, secp256k1.S256())
+var vrfProofAnomalyCounter = metrics.GetOrRegisterCounter("pos33/vrf/proofanomaly", nil)
+
+// vrfVerify 验证suite算法的vrf proof, 不支持的算法返回ErrVrfVerify
+func vrfVerify(suite string, pub []byte, input []byte, proof []byte, hash []byte) error {
+	b, err := getVrfBackend(suite)
 	if err != nil {
-		plog.Error("vrfVerify", "err", err)
+		plog.Error("vrfVerify", "err", err, "suite", suite)
 		return pt.ErrVrfVerify
 	}
-	vrfPub := &vrf.PublicKey{PublicKey: (*ecdsa.PublicKey)(pubKey)}
-	vrfHash, err := vrfPub.ProofToHash(input, proof)
+	err = b.CheckProof(proof, hash)
+	if err != nil {
+		vrfProofAnomalyCounter.Inc(1)
+		plog.Debug("vrfVerify", "err", err, "proof len", len(proof), "hash len", len(hash))
+		return pt.ErrVrfProofSize
+	}
+	vrfHash, err := verifiedVrf.proofToHash(suite, b, pub, input, proof)
 	if err != nil {
 		plog.Error("vrfVerify", "err", err)
 		return pt.ErrVrfVerify
 	}
-	if !bytes.Equal(vrfHash[:], hash) {
-		plog.Error("vrfVerify", "err", fmt.Errorf("invalid VRF hash"))
+	// 缓存的是proof对应的hash, 命中时也要比较, 不能用正确的proof配伪造的hash
+	if !bytes.Equal(vrfHash, hash) {
+		plog.Error("vrfVerify", "err", verifier.ErrVrfHash)
 		return pt.ErrVrfVerify
 	}
 	return nil
@@ -142,6 +298,19 @@ func vrfVerify(pub []byte, input []byte, proof []byte, hash []byte) error {
 
 var errDiff = errors.New("diff error")
 
+// errParticipant 地址不允许参与共识
+var errParticipant = errors.New("address NOT permitted to participate in consensus")
+
+// verifySort的错误, 返回时用%w包装上下文, 调用者用errors.Is区分
+var (
+	errSortMsgNil      = errors.New("sort msg is nil")
+	errIndexTooLarge   = errors.New("sort index NOT less than ticket count")
+	errHeightMismatch  = errors.New("sort height NOT match")
+	errSeedMismatch    = errors.New("sort seed NOT match")
+	errTyMismatch      = errors.New("sort step NOT match")
+	errSubCommitteeNum = errors.New("sort sub committee NOT exist")
+)
+
 func (n *node) queryDeposit(addr string) (*pt.Pos33DepositMsg, error) {
 	resp, err := n.GetAPI().Query(pt.Pos33TicketX, "Pos33Deposit", &types.ReqAddr{Addr: addr})
 	if err != nil {
@@ -152,57 +321,311 @@ func (n *node) queryDeposit(addr string) (*pt.Pos33DepositMsg, error) {
 }
 
 func (n *node) verifySort(height int64, ty int, seed []byte, m *pt.Pos33SortMsg) error {
+	return n.verifySorts(height, ty, seed, []*pt.Pos33SortMsg{m})[0]
+}
+
+// verifySorts 并行验证height高度的多个抽签, 返回的错误和ms一一对应.
+// 先验证不依赖票数的vrf证明, 通过以后才查询公钥绑定的地址和票数, 伪造的消息不会引起状态查询.
+// 同一个地址的票数和同一轮的diff只查询一次, 完全相同的消息只验证一次,
+// 一个消息验证失败不影响其他消息, 同一个矿工重复的抽签只有第一个通过
+func (n *node) verifySorts(height int64, ty int, seed []byte, ms []*pt.Pos33SortMsg) []error {
+	errs := make([]error, len(ms))
 	if height <= pt.Pos33SortBlocks {
-		return nil
+		return errs
+	}
+	type countResult struct {
+		count int64
+		err   error
 	}
-	if m == nil || m.Proof == nil || m.SortHash == nil || m.Proof.Input == nil {
-		return fmt.Errorf("verifySort error: sort msg is nil")
+	type diffResult struct {
+		diff float64
+		err  error
+	}
+	// 重复的消息ms[i]和第一次出现的ms[same[i]]结果相同
+	first := make(map[string]int)
+	same := make(map[int]int)
+	var uniq []int
+	for i, m := range ms {
+		if m == nil || m.Proof == nil || m.SortHash == nil || m.Proof.Input == nil {
+			errs[i] = fmt.Errorf("verifySort error: %w", errSortMsgNil)
+			continue
+		}
+		key := string(types.Encode(m))
+		if j, ok := first[key]; ok {
+			same[i] = j
+			continue
+		}
+		first[key] = i
+		uniq = append(uniq, i)
 	}
 
-	addr := address.PubKeyToAddr(ethID, m.Proof.Pubkey)
-	count := n.queryTicketCount(addr, height-pt.Pos33SortBlocks)
-	if count <= m.SortHash.Index {
-		return fmt.Errorf("sort index %d > %d your count, height %d", m.SortHash.Index, count, height)
+	parallel(len(uniq), func(k int) {
+		i := uniq[k]
+		errs[i] = n.verifySortProof(height, ty, seed, ms[i])
+	})
+
+	participants := pt.GetPos33Participants(n.GetAPI().GetConfig(), height)
+	addrs := make([]string, len(ms))
+	counts := make(map[string]*countResult)
+	diffs := make(map[int32]*diffResult)
+	cnts := make([]int64, len(ms))
+	// 记录证明错误和比较了票数难度的抽签, 按记录的输入重新验证结果相同.
+	// 查询地址和票数失败的依赖本地状态, 不记录
+	var audited []int
+	for _, i := range uniq {
+		if errs[i] != nil {
+			audited = append(audited, i)
+			continue
+		}
+		m := ms[i]
+		addr, err := n.sortOwner(height, m.Proof.Pubkey)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		if !participants.Permit(addr) {
+			errs[i] = errParticipant
+			continue
+		}
+		c, ok := counts[addr]
+		if !ok {
+			c = &countResult{}
+			c.count, c.err = n.sortCount(addr, height)
+			counts[addr] = c
+		}
+		if c.err != nil {
+			errs[i] = c.err
+			continue
+		}
+		round := m.Proof.Input.Round
+		d, ok := diffs[round]
+		if !ok {
+			d = &diffResult{}
+			d.diff, d.err = n.getDiff(height, int(round))
+			diffs[round] = d
+		}
+		if d.err != nil {
+			errs[i] = d.err
+			continue
+		}
+		addrs[i] = addr
+		cnts[i] = c.count
+		errs[i] = checkSortWin(height, m, c.count, d.diff)
+		audited = append(audited, i)
+	}
+
+	if n.audit != nil {
+		var as []*pt.Pos33AuditSort
+		for _, i := range audited {
+			// 证明错误的抽签没有计算难度
+			var diff float64
+			if d, ok := diffs[ms[i].Proof.Input.Round]; ok {
+				diff = d.diff
+			}
+			as = append(as, &pt.Pos33AuditSort{
+				Sort:  ms[i],
+				Ty:    int32(ty),
+				Seed:  seed,
+				Addr:  addrs[i],
+				Count: cnts[i],
+				Diff:  diff,
+				Err:   errString(errs[i]),
+			})
+		}
+		n.audit.addSorts(height, as)
+	}
+	for i, j := range same {
+		errs[i] = errs[j]
+	}
+	rejectRepeated(ms, errs)
+	recordSortOutcomes(errs)
+	return errs
+}
+
+// parallel 用所有cpu执行f(0)...f(num-1)
+func parallel(num int, f func(i int)) {
+	workers := runtime.NumCPU()
+	if workers > num {
+		workers = num
+	}
+	if workers <= 1 {
+		for i := 0; i < num; i++ {
+			f(i)
+		}
+		return
+	}
+	ch := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range ch {
+				f(i)
+			}
+		}()
 	}
+	for i := 0; i < num; i++ {
+		ch <- i
+	}
+	close(ch)
+	wg.Wait()
+}
 
+// verifySortCount 矿工有count张票, 难度是diff时验证抽签m, 和verifySorts的顺序相同
+func (n *node) verifySortCount(height int64, ty int, seed []byte, m *pt.Pos33SortMsg, count int64, diff float64) error {
+	err := n.verifySortProof(height, ty, seed, m)
+	if err != nil {
+		return err
+	}
+	return checkSortWin(height, m, count, diff)
+}
+
+// verifySortProof 验证抽签里不依赖票数的部分: 高度, seed, 步骤, 子委员会, vrf证明和抽签hash
+func (n *node) verifySortProof(height int64, ty int, seed []byte, m *pt.Pos33SortMsg) error {
 	if m.Proof.Input.Height != height {
-		return fmt.Errorf("verifySort error, height NOT match: %d!=%d", m.Proof.Input.Height, height)
+		return fmt.Errorf("verifySort error, %w: %d!=%d", errHeightMismatch, m.Proof.Input.Height, height)
 	}
 	if string(m.Proof.Input.Seed) != string(seed) {
-		return fmt.Errorf("verifySort error, seed NOT match")
+		return fmt.Errorf("verifySort error, %w, height %d", errSeedMismatch, height)
 	}
 	if m.Proof.Input.Ty != int32(ty) {
-		return fmt.Errorf("verifySort error, step NOT match")
+		return fmt.Errorf("verifySort error, %w: %d!=%d", errTyMismatch, m.Proof.Input.Ty, ty)
+	}
+	// num必须是height高度存在的子委员会, 没有子委员会时只能是0.
+	// hash按num计算, 不检查的话可以用任意num构造一致的hash
+	if m.SortHash.Num < 0 || int(m.SortHash.Num) >= n.subCommittees(height) {
+		return fmt.Errorf("verifySort error, %w: num %d, height %d", errSubCommitteeNum, m.SortHash.Num, height)
 	}
 
 	round := m.Proof.Input.Round
 	input := &pt.VrfInput{Seed: seed, Height: height, Round: round, Ty: int32(ty)}
 	in := types.Encode(input)
-	err := vrfVerify(m.Proof.Pubkey, in, m.Proof.VrfProof, m.Proof.VrfHash)
+	err := n.checkProofSuite(height, m.Proof.VrfSuite)
+	if err != nil {
+		return fmt.Errorf("verifySort error, %w: %v", pt.ErrVrfVerify, err)
+	}
+	err = vrfVerify(m.Proof.VrfSuite, m.Proof.Pubkey, in, m.Proof.VrfProof, m.Proof.VrfHash)
 	if err != nil {
-		plog.Debug("vrfVerify error", "err", err, "height", height, "round", round, "ty", ty, "who", addr[:16])
+		plog.Debug("vrfVerify error", "err", err, "height", height, "round", round, "ty", ty)
 		return err
 	}
-	data := fmt.Sprintf("%x+%d+%d", m.Proof.VrfHash, m.SortHash.Index, m.SortHash.Num)
-	hash := hash2([]byte(data))
-	if string(hash) != string(m.SortHash.Hash) {
-		return fmt.Errorf("sort hash error")
+	err = checkSortHashBinding(m)
+	if err != nil {
+		plog.Debug("checkSortHashBinding error", "err", err, "height", height, "round", round, "index", m.SortHash.Index, "num", m.SortHash.Num)
+		return err
 	}
+	return nil
+}
 
-	tmpHash := make([]byte, len(hash))
-	copy(tmpHash, hash)
-	diff := n.getDiff(height, int(round))
-
-	y := difficulty.HashToBig(tmpHash)
-	z := new(big.Float).SetInt(y)
-	if new(big.Float).Quo(z, fmax).Cmp(big.NewFloat(diff)) > 0 {
-		plog.Error("verifySort diff error", "height", height, "ty", ty, "round", round, "diff", diff*1000000, "addr", address.PubKeyToAddr(ethID, m.Proof.Pubkey))
+// checkSortWin 证明正确的抽签m, 票号要小于票数count, hash要满足难度diff
+func checkSortWin(height int64, m *pt.Pos33SortMsg, count int64, diff float64) error {
+	if count <= m.SortHash.Index {
+		return fmt.Errorf("%w: index %d, count %d, height %d", errIndexTooLarge, m.SortHash.Index, count, height)
+	}
+	if !verifier.Win(m.SortHash.Hash, diff) {
+		plog.Error("verifySort diff error", "height", height, "round", m.Proof.Input.Round, "diff", diff*1000000)
 		return errDiff
 	}
+	return nil
+}
 
+var (
+	errSortVrfHash      = errors.New("sort hash binding error: vrf hash size error")
+	errSortIndex        = errors.New("sort hash binding error: index < 0")
+	errSortNum          = errors.New("sort hash binding error: num out of range")
+	errSortHashSize     = errors.New("sort hash binding error: sort hash size error")
+	errSortNumMismatch  = errors.New("sort hash binding error: sort hash is derived from another num")
+	errSortHashMismatch = errors.New("sort hash binding error: sort hash NOT derived from vrf hash, index and num")
+)
+
+// checkSortHashBinding 检查抽签hash是由proof里的vrf hash, 票的index和子委员会num计算出来的.
+// 先检查各个部分的格式, 再重新计算hash比较, 返回的错误说明是哪一部分不对
+func checkSortHashBinding(m *pt.Pos33SortMsg) error {
+	if m.GetSortHash() == nil || m.GetProof() == nil {
+		return fmt.Errorf("sort hash binding error: sort msg is nil")
+	}
+	sh := m.SortHash
+	if len(m.Proof.VrfHash) != sha256.Size {
+		return errSortVrfHash
+	}
+	if sh.Index < 0 {
+		return errSortIndex
+	}
+	if sh.Num < 0 || sh.Num >= pt.Pos33MaxSubCommittees {
+		return errSortNum
+	}
+	if len(sh.Hash) != sha256.Size {
+		return errSortHashSize
+	}
+	if bytes.Equal(verifier.SortHash(m.Proof.VrfHash, int(sh.Index), int(sh.Num)), sh.Hash) {
+		return nil
+	}
+	// 子委员会只有几个, 找一下是不是冒充了其他子委员会的抽签
+	for num := 0; num < pt.Pos33MaxSubCommittees; num++ {
+		if num != int(sh.Num) && bytes.Equal(verifier.SortHash(m.Proof.VrfHash, int(sh.Index), num), sh.Hash) {
+			return errSortNumMismatch
+		}
+	}
+	return errSortHashMismatch
+}
+
+var errSortIndexRepeated = errors.New("sort index repeated by the same miner")
+
+// checkDistinctIndices 检查同一个矿工在同一个子委员会的中签index不重复.
+// 每张票最多中签一次, 重复的index会被当成多张票计算投票权重
+func checkDistinctIndices(ss []*pt.Pos33SortMsg) error {
+	type seat struct {
+		pubkey string
+		num    int32
+		index  int64
+	}
+	mp := make(map[seat]bool, len(ss))
+	for _, s := range ss {
+		k := seat{string(s.GetProof().GetPubkey()), s.GetSortHash().GetNum(), s.GetSortHash().GetIndex()}
+		if mp[k] {
+			return errSortIndexRepeated
+		}
+		mp[k] = true
+	}
 	return nil
 }
 
+var errSortHashRepeated = errors.New("sort hash repeated by the same miner")
+
+// rejectRepeated 按顺序检查验证通过的抽签, 同一个矿工在同一个子委员会重复的index,
+// 或者重复的抽签hash, 后面的验证失败. 只比较验证通过的, 伪造的消息不能让正确的失败
+func rejectRepeated(ms []*pt.Pos33SortMsg, errs []error) {
+	type seat struct {
+		pubkey string
+		num    int32
+		index  int64
+	}
+	type sortHash struct {
+		pubkey string
+		hash   string
+	}
+	seats := make(map[seat]bool, len(ms))
+	hashes := make(map[sortHash]bool, len(ms))
+	for i, m := range ms {
+		if errs[i] != nil {
+			continue
+		}
+		pk := string(m.Proof.Pubkey)
+		s := seat{pk, m.SortHash.Num, m.SortHash.Index}
+		h := sortHash{pk, string(m.SortHash.Hash)}
+		switch {
+		case seats[s]:
+			errs[i] = errSortIndexRepeated
+		case hashes[h]:
+			errs[i] = errSortHashRepeated
+		default:
+			seats[s] = true
+			hashes[h] = true
+		}
+	}
+}
+
 func hash2(data []byte) []byte {
 	return crypto.Sha256(crypto.Sha256(data))
 }
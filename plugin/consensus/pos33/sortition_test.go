@@ -0,0 +1,612 @@
+package pos33
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+	"github.com/yccproject/ycc/plugin/dapp/pos33/verifier"
+)
+
+// testCfgString 非local的配置, fork高度使用注册的默认值
+func testCfgString() string {
+	s := strings.Replace(types.GetDefaultCfgstring(), `Title="local"`, `Title="pos33test"`+"\nDisableForkCheck=true", 1)
+	return s + `
+[mver.consensus.pos33]
+ticketPrice1=10000
+ticketPrice2=100000
+`
+}
+
+// newSubCommitteeNode 创建链的配置里有num个子委员会的测试node
+func newSubCommitteeNode(t testing.TB, num int) (*node, *mocks.QueueProtocolAPI) {
+	return newTestNodeCfg(t, testCfgString()+fmt.Sprintf("subCommittees=%d\n", num), nil)
+}
+
+// newTestNode 创建一个不依赖网络和区块链的node, 票数和全网票数通过缓存给出
+func newTestNode(t testing.TB, conf *subConfig) (*node, *mocks.QueueProtocolAPI) {
+	return newTestNodeCfg(t, testCfgString(), conf)
+}
+
+// newTestNodeCfg 使用配置cfgString创建测试的node
+func newTestNodeCfg(t testing.TB, cfgString string, conf *subConfig) (*node, *mocks.QueueProtocolAPI) {
+	cfg := types.NewChain33Config(cfgString)
+	api := new(mocks.QueueProtocolAPI)
+	api.On("GetConfig").Return(cfg)
+
+	if conf == nil {
+		conf = &subConfig{}
+	}
+	sub, err := json.Marshal(conf)
+	require.Nil(t, err)
+	client := New(cfg.GetModuleConfig().Consensus, sub).(*Client)
+	client.SetAPI(api)
+	go client.n.runSortition()
+	return client.n, api
+}
+
+func newTestPriv(t testing.TB) crypto.PrivKey {
+	c, err := crypto.Load(types.GetSignName("", types.SECP256K1), -1)
+	require.Nil(t, err)
+	priv, err := c.GenKey()
+	require.Nil(t, err)
+	return priv
+}
+
+// setTestCount 设置height高度时addr的票数和全网总票数
+func (n *node) setTestCount(addr string, height int64, count int64, all int) {
+	n.mlock.Lock()
+	defer n.mlock.Unlock()
+	mp, ok := n.tcMap[height]
+	if !ok {
+		mp = make(map[string]int64)
+		n.tcMap[height] = mp
+	}
+	mp[addr] = count
+	n.acMap[height] = all
+}
+
+func (n *node) setTestMiner(priv crypto.PrivKey) {
+	n.priv = priv
+	n.myAddr = address.PubKeyToAddr(ethID, priv.PubKey().Bytes())
+}
+
+func TestSubCommitteeSort(t *testing.T) {
+	n, _ := newSubCommitteeNode(t, 3)
+	n.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "ForkSubCommittee", 0)
+	priv := newTestPriv(t)
+	n.setTestMiner(priv)
+
+	height := int64(100)
+	seed := []byte("sub committee seed")
+	// 总票数和委员会大小相同, diff为1, 每张票都中签
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+
+	ss := n.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.Equal(t, 30, len(ss))
+
+	nums := make(map[int32]int)
+	for _, s := range ss {
+		nums[s.SortHash.Num]++
+		require.Nil(t, n.verifySort(height, Committee, seed, s))
+	}
+	require.Equal(t, map[int32]int{0: 10, 1: 10, 2: 10}, nums)
+
+	// 相同的vrf, 不同子委员会的抽签hash互相独立
+	require.NotEqual(t, findSort(ss, 0, 0).SortHash.Hash, findSort(ss, 1, 0).SortHash.Hash)
+
+	// 冒充其他子委员会的抽签
+	s := findSort(ss, 1, 0)
+	fake := &pt.SortHash{Index: s.SortHash.Index, Hash: s.SortHash.Hash, Num: 2}
+	require.NotNil(t, n.verifySort(height, Committee, seed, &pt.Pos33SortMsg{SortHash: fake, Proof: s.Proof}))
+
+	// 不存在的子委员会
+	fake.Num = 3
+	fake.Hash = sortF(s.Proof.VrfHash, 0, 3, 1, s.Proof).SortHash.Hash
+	require.NotNil(t, n.verifySort(height, Committee, seed, &pt.Pos33SortMsg{SortHash: fake, Proof: s.Proof}))
+}
+
+func TestSubCommitteesConfig(t *testing.T) {
+	n, _ := newSubCommitteeNode(t, 5)
+	cfg := n.GetAPI().GetConfig()
+	cfg.SetDappFork(pt.Pos33TicketX, "ForkSubCommittee", 100)
+	require.Equal(t, 1, n.subCommittees(99))
+	require.Equal(t, pt.Pos33MaxSubCommittees, n.subCommittees(100))
+
+	// 链的配置里没有subCommittees
+	n, _ = newTestNode(t, nil)
+	n.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "ForkSubCommittee", 0)
+	require.Equal(t, 1, n.subCommittees(100))
+}
+
+func TestSubCommitteeVoting(t *testing.T) {
+	n, _ := newSubCommitteeNode(t, 2)
+	n.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "ForkSubCommittee", 0)
+	n.setTestMiner(newTestPriv(t))
+	height := int64(100)
+	seed := []byte("sub committee voting seed")
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 40, pt.Pos33CommitteeSize)
+	ss := n.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.Equal(t, 80, len(ss))
+
+	// 和handleVoterSort一样, 按子委员会保存
+	comm := n.getCommittee(height, 0)
+	comm.subss[1] = make(map[string]*pt.Pos33SortMsg)
+	for _, s := range ss {
+		comm.shard(int(s.SortHash.Num))[string(s.SortHash.Hash)] = s
+	}
+	require.Equal(t, 80, len(comm.sortMap()))
+
+	// 投票的人数按子委员会分配, 余数给第0个
+	vs := comm.shardSorts(height, pt.Pos33VoterSize, nil)
+	require.Equal(t, pt.Pos33VoterSize, len(vs))
+	nums := make(map[int32]int)
+	for _, s := range vs {
+		nums[s.SortHash.Num]++
+	}
+	require.Equal(t, map[int32]int{0: 13, 1: 12}, nums)
+
+	// 两个子委员会的抽签都能选进委员会
+	for _, s := range vs {
+		comm.svmp[string(s.SortHash.Hash)] = pt.Pos33MustVotes
+	}
+	comm.setCommittee(height, 0)
+	require.Equal(t, vs, comm.comm)
+}
+
+func TestSubCommitteeBeforeFork(t *testing.T) {
+	n, _ := newSubCommitteeNode(t, 3)
+	n.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "ForkSubCommittee", 1000)
+	priv := newTestPriv(t)
+	n.setTestMiner(priv)
+
+	height := int64(100)
+	seed := []byte("sub committee seed")
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+
+	ss := n.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.Equal(t, 10, len(ss))
+
+	s := ss[0]
+	m := &pt.Pos33SortMsg{SortHash: sortF(s.Proof.VrfHash, int(s.SortHash.Index), 1, 1, s.Proof).SortHash, Proof: s.Proof}
+	require.NotNil(t, n.verifySort(height, Committee, seed, m))
+}
+
+func TestCommitteeSortOrder(t *testing.T) {
+	n, _ := newSubCommitteeNode(t, 3)
+	n.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "ForkSubCommittee", 0)
+	n.setTestMiner(newTestPriv(t))
+	height := int64(100)
+	seed := []byte("sort order seed")
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 50, pt.Pos33CommitteeSize)
+
+	ss := n.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.Equal(t, 150, len(ss))
+	require.True(t, sort.IsSorted(pt.Sorts(ss)))
+	for i := 0; i < 3; i++ {
+		require.Equal(t, ss, n.committeeSort(context.Background(), seed, height, 0, Committee))
+	}
+}
+
+func TestVerifySortNum(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	n.setTestMiner(newTestPriv(t))
+	height := int64(100)
+	seed := []byte("sort num seed")
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+	s := n.committeeSort(context.Background(), seed, height, 0, Committee)[0]
+	require.Equal(t, int32(0), s.SortHash.Num)
+
+	// 没有子委员会时Committee只有num 0, 即使hash按num重新计算并且中签也验证不过
+	for _, num := range []int32{1, -1, pt.Pos33MaxSubCommittees} {
+		fake := &pt.SortHash{Index: s.SortHash.Index, Hash: verifier.SortHash(s.Proof.VrfHash, int(s.SortHash.Index), int(num)), Num: num}
+		require.True(t, verifier.Win(fake.Hash, 1))
+		err := n.verifySort(height, Committee, seed, &pt.Pos33SortMsg{SortHash: fake, Proof: s.Proof})
+		require.True(t, errors.Is(err, errSubCommitteeNum), "num %d: %v", num, err)
+	}
+}
+
+func findSort(ss []*pt.Pos33SortMsg, num int32, index int64) *pt.Pos33SortMsg {
+	for _, s := range ss {
+		if s.SortHash.Num == num && s.SortHash.Index == index {
+			return s
+		}
+	}
+	return nil
+}
+
+func TestCheckSortHashBinding(t *testing.T) {
+	n, _ := newSubCommitteeNode(t, 3)
+	n.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "ForkSubCommittee", 0)
+	n.setTestMiner(newTestPriv(t))
+	height := int64(100)
+	seed := []byte("binding seed")
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+	ss := n.committeeSort(context.Background(), seed, height, 0, Committee)
+	s := findSort(ss, 1, 3)
+	require.Nil(t, checkSortHashBinding(s))
+
+	clone := func() *pt.Pos33SortMsg { return proto.Clone(s).(*pt.Pos33SortMsg) }
+
+	m := clone()
+	m.Proof.VrfHash[0] ^= 1
+	require.Equal(t, errSortHashMismatch, checkSortHashBinding(m))
+	m.Proof.VrfHash = m.Proof.VrfHash[:16]
+	require.Equal(t, errSortVrfHash, checkSortHashBinding(m))
+
+	m = clone()
+	m.SortHash.Index++
+	require.Equal(t, errSortHashMismatch, checkSortHashBinding(m))
+	m.SortHash.Index = -1
+	require.Equal(t, errSortIndex, checkSortHashBinding(m))
+
+	m = clone()
+	m.SortHash.Num = 2
+	require.Equal(t, errSortNumMismatch, checkSortHashBinding(m))
+	m.SortHash.Num = pt.Pos33MaxSubCommittees
+	require.Equal(t, errSortNum, checkSortHashBinding(m))
+	m.SortHash.Num = -1
+	require.Equal(t, errSortNum, checkSortHashBinding(m))
+
+	m = clone()
+	m.SortHash.Hash[31] ^= 1
+	require.Equal(t, errSortHashMismatch, checkSortHashBinding(m))
+	m.SortHash.Hash = append(m.SortHash.Hash, 0)
+	require.Equal(t, errSortHashSize, checkSortHashBinding(m))
+
+	require.NotNil(t, checkSortHashBinding(&pt.Pos33SortMsg{}))
+
+	// verifySort返回同样的错误
+	m = clone()
+	m.SortHash.Num = 2
+	require.Equal(t, errSortNumMismatch, n.verifySort(height, Committee, seed, m))
+	require.Nil(t, n.verifySort(height, Committee, seed, s))
+}
+
+func TestCheckDistinctIndices(t *testing.T) {
+	n, _ := newSubCommitteeNode(t, 2)
+	n.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "ForkSubCommittee", 0)
+	n.setTestMiner(newTestPriv(t))
+	height := int64(100)
+	seed := []byte("distinct seed")
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+	ss := n.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.Len(t, ss, 20)
+
+	// 不同子委员会的相同index不算重复
+	require.Nil(t, checkDistinctIndices(ss))
+
+	// 同一个矿工重复一个index, 每个抽签单独验证都是有效的
+	s := findSort(ss, 0, 3)
+	double := append(append([]*pt.Pos33SortMsg{}, ss...), proto.Clone(s).(*pt.Pos33SortMsg))
+	require.Nil(t, n.verifySort(height, Committee, seed, double[len(double)-1]))
+	require.Equal(t, errSortIndexRepeated, checkDistinctIndices(double))
+
+	// 其他矿工的相同index不算重复
+	other, _ := newSubCommitteeNode(t, 2)
+	other.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "ForkSubCommittee", 0)
+	other.setTestMiner(newTestPriv(t))
+	other.setTestCount(other.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+	require.Nil(t, checkDistinctIndices(append(ss, other.committeeSort(context.Background(), seed, height, 0, Committee)...)))
+
+	// 委员会记录中重复的index不能加载
+	r := &pt.Pos33CommitteeRecord{Height: height, Comm: double}
+	require.NotNil(t, checkCommitteeRecords([]*pt.Pos33CommitteeRecord{r}))
+	r.Comm = ss
+	require.Nil(t, checkCommitteeRecords([]*pt.Pos33CommitteeRecord{r}))
+}
+
+func TestVrfSuite(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	priv := newTestPriv(t)
+	height := int64(100)
+	n.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "ForkVrfSuite", height)
+
+	// 分叉前的proof没有vrf算法标识, 仍然可以验证
+	input := &pt.VrfInput{Seed: []byte("seed"), Height: height - 1}
+	p := n.makeProof(input, priv)
+	require.Equal(t, "", p.VrfSuite)
+	require.Nil(t, vrfVerify(p.VrfSuite, p.Pubkey, types.Encode(input), p.VrfProof, p.VrfHash))
+
+	input = &pt.VrfInput{Seed: []byte("seed"), Height: height}
+	p = n.makeProof(input, priv)
+	require.Equal(t, verifier.SuiteSecp256k1SHA256, p.VrfSuite)
+	in := types.Encode(input)
+	require.Nil(t, vrfVerify(p.VrfSuite, p.Pubkey, in, p.VrfProof, p.VrfHash))
+
+	// 不支持的vrf算法
+	for _, suite := range []string{"ed25519-SHA512", "secp256k1-sha256", "P256-SHA256-TAI"} {
+		require.Equal(t, pt.ErrVrfVerify, vrfVerify(suite, p.Pubkey, in, p.VrfProof, p.VrfHash), suite)
+	}
+}
+
+func TestVrfVerifyDegeneratePubkey(t *testing.T) {
+	priv := newTestPriv(t)
+	input := &pt.VrfInput{Seed: []byte("seed"), Height: 100}
+	vrfHash, vrfProof := calcuVrfHash(input, priv)
+	in := types.Encode(input)
+	require.Nil(t, vrfVerify("", priv.PubKey().Bytes(), in, vrfProof, vrfHash))
+
+	degenerate := [][]byte{
+		nil,
+		{0x00}, // 无穷远点的编码
+		append([]byte{0x02}, make([]byte, 32)...),            // x=0, 压缩格式
+		append([]byte{0x04}, make([]byte, 64)...),            // (0, 0), 非压缩格式
+		append(append([]byte{0x04}, make([]byte, 63)...), 1), // (0, 1) 不在曲线上
+	}
+	for i, pub := range degenerate {
+		require.Equal(t, pt.ErrVrfVerify, vrfVerify("", pub, in, vrfProof, vrfHash), "case %d", i)
+	}
+}
+
+func TestVrfVerifyProofSize(t *testing.T) {
+	priv := newTestPriv(t)
+	input := &pt.VrfInput{Seed: []byte("seed"), Height: 100}
+	vrfHash, vrfProof := calcuVrfHash(input, priv)
+	in := types.Encode(input)
+	pub := priv.PubKey().Bytes()
+
+	c := vrfProofAnomalyCounter.Count()
+	bad := [][]byte{
+		nil,
+		vrfProof[:len(vrfProof)-1],
+		append(vrfProof[:len(vrfProof):len(vrfProof)], 0),
+	}
+	for i, p := range bad {
+		require.Equal(t, pt.ErrVrfProofSize, vrfVerify("", pub, in, p, vrfHash), "case %d", i)
+	}
+	require.Equal(t, pt.ErrVrfProofSize, vrfVerify("", pub, in, vrfProof, vrfHash[:16]))
+	require.Equal(t, c+4, vrfProofAnomalyCounter.Count())
+
+	// 长度检查在解析公钥和ProofToHash之前
+	require.Equal(t, pt.ErrVrfProofSize, vrfVerify("", []byte{0x00}, in, vrfProof[:10], vrfHash))
+	require.Nil(t, vrfVerify("", pub, in, vrfProof, vrfHash))
+}
+
+func BenchmarkVrfVerifyProofSize(b *testing.B) {
+	priv := newTestPriv(b)
+	input := &pt.VrfInput{Seed: []byte("seed"), Height: 100}
+	vrfHash, vrfProof := calcuVrfHash(input, priv)
+	in := types.Encode(input)
+	pub := priv.PubKey().Bytes()
+	b.Run("valid", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			vrfVerify("", pub, in, vrfProof, vrfHash)
+		}
+	})
+	b.Run("short", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			vrfVerify("", pub, in, vrfProof[:100], vrfHash)
+		}
+	})
+}
+
+func TestSortitionWorkers(t *testing.T) {
+	limit := runtime.NumCPU() * 4
+	def := runtime.GOMAXPROCS(0)
+	for conf, want := range map[int]int{0: def, -1: def, 1: 1, limit: limit, limit + 1: limit} {
+		n, _ := newTestNode(t, &subConfig{SortitionWorkers: conf})
+		// 不影响后面测试的协程数
+		n.stop()
+		if want > limit {
+			want = limit
+		}
+		require.Equal(t, want, n.sortitionWorkers(), conf)
+	}
+}
+
+func TestDoSortCancel(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	proof := &pt.HashProof{}
+	// 先让抽签协程都启动
+	require.Equal(t, 10, len(n.doSort(context.Background(), testVrfHash(0), 10, 0, 1, proof)))
+	base := runtime.NumGoroutine()
+
+	count := 1000000
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	msgs := n.doSort(ctx, testVrfHash(1), count, 0, 1, proof)
+	require.True(t, time.Since(start) < 500*time.Millisecond, "%v", time.Since(start))
+	require.True(t, len(msgs) < count, "%d", len(msgs))
+
+	// 提交的协程退出, 抽签协程写入结果后继续等待新的票
+	for i := 0; runtime.NumGoroutine() > base; i++ {
+		require.True(t, i < 100, "goroutines %d > %d", runtime.NumGoroutine(), base)
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.Equal(t, 10, len(n.doSort(context.Background(), testVrfHash(2), 10, 0, 1, proof)))
+
+	// 已经取消的ctx直接返回
+	require.Empty(t, n.committeeSort(ctx, []byte("seed"), 100, 0, Committee))
+}
+
+func TestSortitionStop(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	proof := &pt.HashProof{}
+	// newTestNode已经启动了一组抽签协程, 这里再启动一组, 返回时这一组都已经退出
+	stopped := make(chan struct{})
+	go func() {
+		n.runSortition()
+		close(stopped)
+	}()
+	require.Equal(t, 10, len(n.doSort(context.Background(), testVrfHash(0), 10, 0, 1, proof)))
+
+	// 计算中关闭, doSort不会等待还没有计算的票
+	sorted := make(chan []*pt.Pos33SortMsg)
+	count := 1000000
+	go func() {
+		sorted <- n.doSort(context.Background(), testVrfHash(1), count, 0, 1, proof)
+	}()
+	time.Sleep(20 * time.Millisecond)
+	n.stop()
+	n.stop()
+	select {
+	case msgs := <-sorted:
+		require.True(t, len(msgs) < count, "%d", len(msgs))
+	case <-time.After(time.Second):
+		t.Fatal("doSort NOT return after stop")
+	}
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("sortition workers NOT stopped")
+	}
+
+	// 关闭以后直接返回
+	require.Empty(t, n.doSort(context.Background(), testVrfHash(2), 10, 0, 1, proof))
+}
+
+func TestVerifySorts(t *testing.T) {
+	n, api := newTestNode(t, nil)
+	a, b := newTestPriv(t), newTestPriv(t)
+	height := int64(100)
+	sh := height - pt.Pos33SortBlocks
+	seed := []byte("verify sorts seed")
+
+	n.setTestMiner(a)
+	n.setTestCount(n.myAddr, sh, 10, pt.Pos33CommitteeSize)
+	as := n.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.Equal(t, 10, len(as))
+	n.setTestMiner(b)
+	bAddr := n.myAddr
+	n.setTestCount(bAddr, sh, 10, pt.Pos33CommitteeSize)
+	bs := n.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.Equal(t, 10, len(bs))
+
+	// b的票数从状态查询, 只查询一次
+	n.mlock.Lock()
+	delete(n.tcMap[sh], bAddr)
+	n.mlock.Unlock()
+	api.On("Query", pt.Pos33TicketX, "Pos33TicketCount", &types.ReqAddr{Addr: bAddr}).Return(&types.Int64{Data: 10}, nil)
+
+	// 一个vrf hash错误, 一个消息为空, 一个是别的高度的
+	bad := proto.Clone(as[3]).(*pt.Pos33SortMsg)
+	bad.Proof.VrfHash = hash2([]byte("bad vrf hash"))
+	other := proto.Clone(as[5]).(*pt.Pos33SortMsg)
+	other.Proof.Input.Height = height + 1
+	ms := append(append([]*pt.Pos33SortMsg{}, as...), bs...)
+	ms[3] = bad
+	ms[7] = nil
+	ms[12] = other
+
+	errs := n.verifySorts(height, Committee, seed, ms)
+	require.Equal(t, len(ms), len(errs))
+	for i, err := range errs {
+		switch i {
+		case 3, 7, 12:
+			require.NotNil(t, err, i)
+		default:
+			require.Nil(t, err, i)
+		}
+		// 和逐个验证的结果相同
+		require.Equal(t, err, n.verifySort(height, Committee, seed, ms[i]), i)
+	}
+	api.AssertNumberOfCalls(t, "Query", 1)
+}
+
+func TestVerifySortsRepeated(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	n.setTestMiner(newTestPriv(t))
+	height := int64(100)
+	seed := []byte("repeated sorts seed")
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+	ss := n.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.Equal(t, 10, len(ss))
+
+	// 第二个和第一个是同一张票
+	dup := proto.Clone(ss[1]).(*pt.Pos33SortMsg)
+	errs := n.verifySorts(height, Committee, seed, []*pt.Pos33SortMsg{ss[0], ss[1], dup, ss[2]})
+	require.Equal(t, []error{nil, nil, errSortIndexRepeated, nil}, errs)
+
+	// 伪造的消息在前面, 正确的不受影响
+	bad := proto.Clone(ss[1]).(*pt.Pos33SortMsg)
+	bad.Proof.VrfHash = hash2([]byte("bad vrf hash"))
+	errs = n.verifySorts(height, Committee, seed, []*pt.Pos33SortMsg{bad, ss[1]})
+	require.NotNil(t, errs[0])
+	require.Nil(t, errs[1])
+
+	// 相同的抽签hash用在不同的index上
+	other := proto.Clone(ss[3]).(*pt.Pos33SortMsg)
+	other.SortHash.Index = ss[4].SortHash.Index + 100
+	errs = []error{nil, nil}
+	rejectRepeated([]*pt.Pos33SortMsg{ss[3], other}, errs)
+	require.Equal(t, errSortHashRepeated, errs[1])
+
+	// 分叉中缓存的结果也检查重复
+	rs := n.forks.verify([]*forkReq{{parent: []byte("p"), height: height, seed: seed, ty: Committee, msgs: ss[:2]}})
+	require.Equal(t, 2, len(rs[0].valid))
+	rs = n.forks.verify([]*forkReq{{parent: []byte("p"), height: height, seed: seed, ty: Committee, msgs: []*pt.Pos33SortMsg{ss[0], ss[1], dup}}})
+	require.Equal(t, 2, len(rs[0].valid))
+	require.Equal(t, errSortIndexRepeated, rs[0].errs[2])
+}
+
+func TestVerifySortsDuplicate(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	n.setTestMiner(newTestPriv(t))
+	height := int64(100)
+	seed := []byte("verify sorts duplicate seed")
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+	ss := n.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.Equal(t, 10, len(ss))
+
+	// 3个相同的消息, 只验证一次vrf, 后面的按重复抽签拒绝
+	bad := proto.Clone(ss[1]).(*pt.Pos33SortMsg)
+	bad.Proof.VrfHash = hash2([]byte("other"))
+	ms := []*pt.Pos33SortMsg{ss[0], proto.Clone(ss[0]).(*pt.Pos33SortMsg), ss[0], bad, proto.Clone(bad).(*pt.Pos33SortMsg)}
+	calls := vrfVerifyHitCounter.Count() + vrfVerifyMissCounter.Count()
+	errs := n.verifySorts(height, Committee, seed, ms)
+	require.Equal(t, calls+2, vrfVerifyHitCounter.Count()+vrfVerifyMissCounter.Count())
+	require.Nil(t, errs[0])
+	require.Equal(t, errSortIndexRepeated, errs[1])
+	require.Equal(t, errSortIndexRepeated, errs[2])
+	require.Equal(t, pt.ErrVrfVerify, errs[3])
+	require.Equal(t, pt.ErrVrfVerify, errs[4])
+}
+
+func TestVerifySortErrors(t *testing.T) {
+	n, _ := newSubCommitteeNode(t, 2)
+	n.setTestMiner(newTestPriv(t))
+	height := int64(100)
+	seed := []byte("sort errors seed")
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+	s := n.committeeSort(context.Background(), seed, height, 0, Committee)[0]
+	require.Nil(t, n.verifySort(height, Committee, seed, s))
+
+	cases := []struct {
+		change func(m *pt.Pos33SortMsg)
+		err    error
+	}{
+		// 证明正确但是票号超过票数
+		{func(m *pt.Pos33SortMsg) {
+			m.SortHash.Index = 10
+			m.SortHash.Hash = verifier.SortHash(m.Proof.VrfHash, 10, int(m.SortHash.Num))
+		}, errIndexTooLarge},
+		{func(m *pt.Pos33SortMsg) { m.Proof.Input.Height = height + 1 }, errHeightMismatch},
+		{func(m *pt.Pos33SortMsg) { m.Proof.Input.Seed = []byte("other seed") }, errSeedMismatch},
+		{func(m *pt.Pos33SortMsg) { m.Proof.Input.Ty = 1 }, errTyMismatch},
+		{func(m *pt.Pos33SortMsg) { m.SortHash.Num = 1 }, errSubCommitteeNum},
+		{func(m *pt.Pos33SortMsg) { m.Proof.VrfHash = hash2([]byte("other")) }, pt.ErrVrfVerify},
+		{func(m *pt.Pos33SortMsg) { m.SortHash.Hash = hash2([]byte("other")) }, errSortHashMismatch},
+	}
+	for i, c := range cases {
+		m := proto.Clone(s).(*pt.Pos33SortMsg)
+		c.change(m)
+		err := n.verifySort(height, Committee, seed, m)
+		require.True(t, errors.Is(err, c.err), "case %d: %v", i, err)
+	}
+	require.True(t, errors.Is(n.verifySort(height, Committee, seed, nil), errSortMsgNil))
+}
@@ -0,0 +1,106 @@
+package pos33
+
+import (
+	"errors"
+	"sync"
+
+	metrics "github.com/rcrowley/go-metrics"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+	"github.com/yccproject/ycc/plugin/dapp/pos33/verifier"
+)
+
+// verifySort结果的分类, 记录到pos33/sort/verify/<outcome>
+const (
+	sortOutcomeOk       = "ok"
+	sortOutcomeDiff     = "diff"
+	sortOutcomeVrf      = "vrf"
+	sortOutcomeMismatch = "mismatch" // 高度, seed或者步骤不对
+	sortOutcomeOther    = "other"
+)
+
+// sortOutcome verifySort返回的错误属于哪一类
+func sortOutcome(err error) string {
+	switch {
+	case err == nil:
+		return sortOutcomeOk
+	case errors.Is(err, errDiff):
+		return sortOutcomeDiff
+	case errors.Is(err, pt.ErrVrfVerify), errors.Is(err, pt.ErrVrfProofSize):
+		return sortOutcomeVrf
+	case errors.Is(err, errHeightMismatch), errors.Is(err, errSeedMismatch), errors.Is(err, errTyMismatch):
+		return sortOutcomeMismatch
+	default:
+		return sortOutcomeOther
+	}
+}
+
+// recordSortOutcomes 按结果分类计数, 计数器在第一次用到时注册
+func recordSortOutcomes(errs []error) {
+	cs := make(map[string]int64)
+	for _, err := range errs {
+		cs[sortOutcome(err)]++
+	}
+	for outcome, c := range cs {
+		metrics.GetOrRegisterCounter("pos33/sort/verify/"+outcome, nil).Inc(c)
+	}
+}
+
+// sortWinStats 自己抽签的中签率. winrate是累计的中签数量和期望数量count*diff的比值,
+// 长期偏离1说明抵押的票数或者难度有问题
+// 最后抽签的高度上所有抽签的中签和没中签数量记录到pos33/sort/height/won和lost
+type sortWinStats struct {
+	mu       sync.Mutex
+	wins     int64
+	expected float64
+	height   int64
+	hwon     int64
+	hlost    int64
+
+	draws      metrics.Counter
+	won        metrics.Counter
+	lost       metrics.Counter
+	diff       metrics.GaugeFloat64
+	winRate    metrics.GaugeFloat64
+	lastHeight metrics.Gauge
+	heightWon  metrics.Gauge
+	heightLost metrics.Gauge
+	initOnce   sync.Once
+}
+
+var sortWins sortWinStats
+
+func (s *sortWinStats) init() {
+	s.draws = metrics.GetOrRegisterCounter("pos33/sort/draws", nil)
+	s.won = metrics.GetOrRegisterCounter("pos33/sort/wins", nil)
+	s.lost = metrics.GetOrRegisterCounter("pos33/sort/losses", nil)
+	s.diff = metrics.GetOrRegisterGaugeFloat64("pos33/sort/diff", nil)
+	s.winRate = metrics.GetOrRegisterGaugeFloat64("pos33/sort/winrate", nil)
+	s.lastHeight = metrics.GetOrRegisterGauge("pos33/sort/height", nil)
+	s.heightWon = metrics.GetOrRegisterGauge("pos33/sort/height/won", nil)
+	s.heightLost = metrics.GetOrRegisterGauge("pos33/sort/height/lost", nil)
+}
+
+// add 在height高度抽签了draws张票, 使用diff, 中签wins个
+func (s *sortWinStats) add(height int64, draws, wins int, diff float64) {
+	s.initOnce.Do(s.init)
+	s.draws.Inc(int64(draws))
+	s.won.Inc(int64(wins))
+	s.lost.Inc(int64(draws - wins))
+	s.diff.Update(diff)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if height != s.height {
+		s.height, s.hwon, s.hlost = height, 0, 0
+	}
+	s.hwon += int64(wins)
+	s.hlost += int64(draws - wins)
+	s.lastHeight.Update(height)
+	s.heightWon.Update(s.hwon)
+	s.heightLost.Update(s.hlost)
+	s.wins += int64(wins)
+	s.expected += verifier.ExpectedWinners(draws, diff)
+	if s.expected > 0 {
+		s.winRate.Update(float64(s.wins) / s.expected)
+	}
+}
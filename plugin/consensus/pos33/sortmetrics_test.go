@@ -0,0 +1,83 @@
+package pos33
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func sortOutcomeCount(outcome string) int64 {
+	return metrics.GetOrRegisterCounter("pos33/sort/verify/"+outcome, nil).Count()
+}
+
+func TestSortOutcome(t *testing.T) {
+	require.Equal(t, sortOutcomeOk, sortOutcome(nil))
+	require.Equal(t, sortOutcomeDiff, sortOutcome(errDiff))
+	require.Equal(t, sortOutcomeVrf, sortOutcome(pt.ErrVrfVerify))
+	require.Equal(t, sortOutcomeVrf, sortOutcome(pt.ErrVrfProofSize))
+	require.Equal(t, sortOutcomeMismatch, sortOutcome(fmt.Errorf("verifySort error, %w", errSeedMismatch)))
+	require.Equal(t, sortOutcomeMismatch, sortOutcome(fmt.Errorf("verifySort error, %w", errHeightMismatch)))
+	require.Equal(t, sortOutcomeOther, sortOutcome(errSortHashMismatch))
+}
+
+func TestSortMetrics(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	n.setTestMiner(newTestPriv(t))
+	height := int64(100)
+	seed := []byte("sort metrics seed")
+	// diff为1, 10张票都中签
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+
+	sortWins.initOnce.Do(sortWins.init)
+	draws, wins := sortWins.draws.Count(), sortWins.won.Count()
+	ss := n.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.Equal(t, 10, len(ss))
+	require.Equal(t, draws+10, sortWins.draws.Count())
+	require.Equal(t, wins+10, sortWins.won.Count())
+	require.Equal(t, float64(1), sortWins.diff.Value())
+
+	ok, vrf, mismatch := sortOutcomeCount(sortOutcomeOk), sortOutcomeCount(sortOutcomeVrf), sortOutcomeCount(sortOutcomeMismatch)
+	ms := []*pt.Pos33SortMsg{ss[0], proto.Clone(ss[1]).(*pt.Pos33SortMsg), proto.Clone(ss[2]).(*pt.Pos33SortMsg)}
+	ms[1].Proof.VrfHash = hash2([]byte("other"))
+	ms[2].Proof.Input.Seed = []byte("other seed")
+	n.verifySorts(height, Committee, seed, ms)
+	require.Equal(t, ok+1, sortOutcomeCount(sortOutcomeOk))
+	require.Equal(t, vrf+1, sortOutcomeCount(sortOutcomeVrf))
+	require.Equal(t, mismatch+1, sortOutcomeCount(sortOutcomeMismatch))
+
+	// 全网票数增加, diff变小, 原来的抽签大部分不再中签
+	diff := sortOutcomeCount(sortOutcomeDiff)
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize*1000000)
+	errs := n.verifySorts(height, Committee, seed, ss)
+	failed := int64(0)
+	for _, err := range errs {
+		if err != nil {
+			require.Equal(t, errDiff, err)
+			failed++
+		}
+	}
+	require.True(t, failed > 0)
+	require.Equal(t, diff+failed, sortOutcomeCount(sortOutcomeDiff))
+}
+
+func TestSortWinRate(t *testing.T) {
+	s := &sortWinStats{}
+	s.add(10, 100, 12, 0.1)
+	require.InDelta(t, 1.2, s.winRate.Value(), 1e-9)
+	s.add(10, 100, 8, 0.1)
+	require.InDelta(t, 1.0, s.winRate.Value(), 1e-9)
+	require.Equal(t, 0.1, s.diff.Value())
+	require.Equal(t, int64(20), s.heightWon.Value())
+	require.Equal(t, int64(180), s.heightLost.Value())
+
+	// 新的高度重新统计
+	s.add(11, 10, 1, 0.1)
+	require.Equal(t, int64(11), s.lastHeight.Value())
+	require.Equal(t, int64(1), s.heightWon.Value())
+	require.Equal(t, int64(9), s.heightLost.Value())
+}
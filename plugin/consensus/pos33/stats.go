@@ -0,0 +1,138 @@
+package pos33
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// stakingStatsTTL 抵押统计要查询每个矿工的委托, 计算比较慢, 缓存这么久
+const stakingStatsTTL = time.Second * 30
+
+type stakingStatsCache struct {
+	mu    sync.Mutex
+	stats *pt.Pos33StakingStats
+	at    time.Time
+}
+
+// calcStakingStats 统计height高度的全网抵押.
+// 矿工来自本节点按高度记录的票数快照, 抵押金额和委托人来自每个矿工的委托记录.
+// 查询票价失败返回错误
+func (c *Client) calcStakingStats(height int64) (*pt.Pos33StakingStats, error) {
+	c.mlock.Lock()
+	all := c.acMap[height]
+	mp := make(map[string]int64)
+	for addr, count := range c.tcMap[height] {
+		if count > 0 {
+			mp[addr] = count
+		}
+	}
+	c.mlock.Unlock()
+
+	price, err := c.ticketPrice(height)
+	if err != nil {
+		return nil, err
+	}
+	st := &pt.Pos33StakingStats{Height: height, TotalTickets: int64(all), Miners: int64(len(mp))}
+	depositors := make(map[string]bool)
+	for addr, count := range mp {
+		msg, err := c.GetAPI().Query(pt.Pos33TicketX, "Pos33ConsigneeEntrust", &types.ReqAddr{Addr: addr})
+		if err != nil {
+			// 没有委托记录, 矿工自己抵押
+			st.TotalDeposit += count * price
+			depositors[addr] = true
+			continue
+		}
+		consignee := msg.(*pt.Pos33Consignee)
+		st.TotalDeposit += consignee.Amount
+		for _, cr := range consignee.Consignors {
+			if cr.Amount > 0 {
+				depositors[cr.Address] = true
+			}
+		}
+	}
+	st.Depositors = int64(len(depositors))
+
+	size := int(c.chainParam(height).CommitteeSize)
+	if all < size {
+		size = all
+	}
+	st.ExpectedCommittee = int64(size * c.n.subCommittees(height))
+	return st, nil
+}
+
+// stakingStats 返回缓存的抵押统计, 超过stakingStatsTTL重新计算, 计算失败时不更新缓存
+func (c *Client) stakingStats(height int64) (*pt.Pos33StakingStats, error) {
+	c.sstats.mu.Lock()
+	defer c.sstats.mu.Unlock()
+
+	now := time.Now()
+	if c.sstats.stats == nil || now.Sub(c.sstats.at) > stakingStatsTTL {
+		st, err := c.calcStakingStats(height)
+		if err != nil {
+			return nil, err
+		}
+		c.sstats.stats = st
+		c.sstats.at = now
+	}
+	st := types.Clone(c.sstats.stats).(*pt.Pos33StakingStats)
+	st.CacheAge = now.Sub(c.sstats.at).Milliseconds()
+	return st, nil
+}
+
+// totalStake 返回抽签快照高度sh的全网票数.
+// 优先使用dsMap, 它记录的是当时的值而且保留得比acMap久
+func (c *Client) totalStake(sh int64) (int64, error) {
+	c.mlock.Lock()
+	defer c.mlock.Unlock()
+	all, ok := c.dsMap[sh]
+	if !ok {
+		all, ok = c.acMap[sh]
+	}
+	if !ok || all <= 0 {
+		return 0, fmt.Errorf("total stake NOT found, height %d", sh)
+	}
+	return int64(all), nil
+}
+
+// CommitteeStakeFraction 返回委员会成员的抵押占全网抵押的比例, 比座位数更能说明参与的经济权重.
+// 抵押按抽签快照高度的票数计算(每张票的价格相同), 执行器已经没有Pos33Deposit查询,
+// 所以不使用queryDeposit. 同一个成员的多个座位只计算一次
+func (client *Client) CommitteeStakeFraction(msgs []*pt.Pos33SortMsg, height int64) (float64, error) {
+	sh := client.sortHeight(height)
+	if sh < 0 {
+		sh = 0
+	}
+	all, err := client.totalStake(sh)
+	if err != nil {
+		return 0, err
+	}
+	members := make(map[string]bool)
+	stake := int64(0)
+	for _, m := range msgs {
+		if m.GetProof().GetInput() == nil || m.Proof.Input.Height != height {
+			return 0, fmt.Errorf("committee sort NOT match height %d", height)
+		}
+		addr, err := client.n.sortOwner(height, m.Proof.Pubkey)
+		if err != nil {
+			return 0, err
+		}
+		if members[addr] {
+			continue
+		}
+		members[addr] = true
+		stake += client.queryTicketCount(addr, sh)
+	}
+	if stake > all {
+		return 0, fmt.Errorf("committee stake %d > total stake %d, height %d", stake, all, sh)
+	}
+	return float64(stake) / float64(all), nil
+}
+
+// Query_GetStakingStats 查询全网抵押统计
+func (client *Client) Query_GetStakingStats(req *types.ReqNil) (types.Message, error) {
+	return client.stakingStats(client.GetCurrentHeight())
+}
@@ -0,0 +1,116 @@
+package pos33
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func TestStakingStats(t *testing.T) {
+	n, api := newTestNode(t, nil)
+	cfg := n.GetAPI().GetConfig()
+	cfg.SetDappFork(pt.Pos33TicketX, "UseEntrust", 0)
+	height := int64(100)
+	price := pt.GetPos33MineParam(cfg, height).GetTicketPrice()
+
+	// m1, m2有委托, 委托人c2同时委托了两个矿工; m3没有委托记录, 自己抵押; m4没有票
+	n.setTestCount("m1", height, 3, 10)
+	n.setTestCount("m2", height, 5, 10)
+	n.setTestCount("m3", height, 2, 10)
+	n.setTestCount("m4", height, 0, 10)
+	consignee := func(addr string, crs ...*pt.Consignor) *pt.Pos33Consignee {
+		c := &pt.Pos33Consignee{Address: addr, Consignors: crs}
+		for _, cr := range crs {
+			c.Amount += cr.Amount
+		}
+		return c
+	}
+	api.On("Query", pt.Pos33TicketX, "Pos33ConsigneeEntrust", &types.ReqAddr{Addr: "m1"}).Return(consignee("m1",
+		&pt.Consignor{Address: "c1", Amount: 1 * price}, &pt.Consignor{Address: "c2", Amount: 2 * price}), nil)
+	api.On("Query", pt.Pos33TicketX, "Pos33ConsigneeEntrust", &types.ReqAddr{Addr: "m2"}).Return(consignee("m2",
+		&pt.Consignor{Address: "c2", Amount: 4 * price}, &pt.Consignor{Address: "c3", Amount: price}, &pt.Consignor{Address: "c4"}), nil)
+	api.On("Query", pt.Pos33TicketX, "Pos33ConsigneeEntrust", &types.ReqAddr{Addr: "m3"}).Return(nil, errors.New("not found"))
+
+	st, err := n.stakingStats(height)
+	require.Nil(t, err)
+	require.Equal(t, height, st.Height)
+	require.Equal(t, int64(10), st.TotalTickets)
+	require.Equal(t, 10*price, st.TotalDeposit)
+	require.Equal(t, int64(3), st.Miners)
+	require.Equal(t, int64(4), st.Depositors) // c1, c2, c3, m3
+	require.Equal(t, int64(10), st.ExpectedCommittee)
+	api.AssertNumberOfCalls(t, "Query", 3)
+
+	// 缓存期间不再查询
+	n.setTestCount("m5", height, 100, 110)
+	st2, err := n.stakingStats(height)
+	require.Nil(t, err)
+	require.Equal(t, st.TotalTickets, st2.TotalTickets)
+	require.True(t, st2.CacheAge >= st.CacheAge)
+	api.AssertNumberOfCalls(t, "Query", 3)
+
+	// 缓存过期后重新计算
+	n.sstats.at = n.sstats.at.Add(-stakingStatsTTL * 2)
+	api.On("Query", pt.Pos33TicketX, "Pos33ConsigneeEntrust", &types.ReqAddr{Addr: "m5"}).Return(nil, errors.New("not found"))
+	st3, err := n.stakingStats(height)
+	require.Nil(t, err)
+	require.Equal(t, int64(110), st3.TotalTickets)
+	require.Equal(t, int64(pt.Pos33CommitteeSize), st3.ExpectedCommittee)
+	require.Zero(t, st3.CacheAge)
+}
+
+func TestCommitteeStakeFraction(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	height := int64(100)
+	sh := height - pt.Pos33SortBlocks
+
+	a, b, c := newTestPriv(t), newTestPriv(t), newTestPriv(t)
+	addr := func(p crypto.PrivKey) string { return address.PubKeyToAddr(ethID, p.PubKey().Bytes()) }
+	n.setTestCount(addr(a), sh, 10, 100)
+	n.setTestCount(addr(b), sh, 20, 100)
+	n.setTestCount(addr(c), sh, 30, 100)
+	sort := func(p crypto.PrivKey, index int64) *pt.Pos33SortMsg {
+		return &pt.Pos33SortMsg{
+			SortHash: &pt.SortHash{Index: index},
+			Proof:    &pt.HashProof{Pubkey: p.PubKey().Bytes(), Input: &pt.VrfInput{Height: height}},
+		}
+	}
+
+	// a有两个座位, 抵押只算一次
+	comm := []*pt.Pos33SortMsg{sort(a, 0), sort(a, 1), sort(b, 0)}
+	f, err := n.CommitteeStakeFraction(comm, height)
+	require.Nil(t, err)
+	require.InDelta(t, 0.3, f, 1e-9)
+
+	f, err = n.CommitteeStakeFraction(append(comm, sort(c, 5)), height)
+	require.Nil(t, err)
+	require.InDelta(t, 0.6, f, 1e-9)
+
+	// 按高度记录的全网票数优先
+	n.mlock.Lock()
+	n.dsMap[sh] = 60
+	n.mlock.Unlock()
+	f, err = n.CommitteeStakeFraction(comm, height)
+	require.Nil(t, err)
+	require.InDelta(t, 0.5, f, 1e-9)
+
+	// 委员会的抵押不能超过全网
+	n.mlock.Lock()
+	n.dsMap[sh] = 25
+	n.mlock.Unlock()
+	_, err = n.CommitteeStakeFraction(comm, height)
+	require.NotNil(t, err)
+
+	// 全网票数未知, 或者抽签不是这个高度的
+	_, err = n.CommitteeStakeFraction(comm, height+1)
+	require.NotNil(t, err)
+	other := sort(a, 0)
+	other.Proof.Input.Height = height - 1
+	_, err = n.CommitteeStakeFraction([]*pt.Pos33SortMsg{other}, height)
+	require.NotNil(t, err)
+}
@@ -0,0 +1,30 @@
+package pos33
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// validatorSet 导出height高度抽签时使用的验证者集合(sortHeight(height)的快照)
+func (c *Client) validatorSet(height int64) (*pt.Pos33ValidatorSet, error) {
+	sh := c.sortHeight(height)
+	if sh < 0 {
+		sh = 0
+	}
+
+	c.mlock.Lock()
+	defer c.mlock.Unlock()
+
+	mp, ok := c.tcMap[sh]
+	if !ok {
+		return nil, fmt.Errorf("validator set snapshot NOT found, height %d, snapshot height %d", height, sh)
+	}
+	return pt.NewPos33ValidatorSet(height, sh, int64(c.acMap[sh]), mp), nil
+}
+
+// Query_GetValidatorSet 查询height高度抽签使用的验证者集合
+func (client *Client) Query_GetValidatorSet(req *types.ReqInt) (types.Message, error) {
+	return client.validatorSet(req.Height)
+}
@@ -0,0 +1,43 @@
+package pos33
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func TestValidatorSetDump(t *testing.T) {
+	height := int64(100)
+	sh := height - pt.Pos33SortBlocks
+
+	n1, _ := newTestNode(t, nil)
+	n2, _ := newTestNode(t, nil)
+	for i := 0; i < 20; i++ {
+		n1.setTestCount(fmt.Sprintf("addr%02d", i), sh, int64(i), 190)
+		n2.setTestCount(fmt.Sprintf("addr%02d", 19-i), sh, int64(19-i), 190)
+	}
+
+	// 相同的状态, 插入顺序不同, 导出的结果完全相同
+	vs1, err := n1.validatorSet(height)
+	require.Nil(t, err)
+	vs2, err := n2.validatorSet(height)
+	require.Nil(t, err)
+	require.Equal(t, types.Encode(vs1), types.Encode(vs2))
+	require.Equal(t, vs1.CalcHash(), vs1.Hash)
+	require.Empty(t, pt.DiffPos33ValidatorSet(vs1, vs2))
+	// 票数为0的地址不在集合里
+	require.Len(t, vs1.Validators, 19)
+
+	n2.setTestCount("addr05", sh, 6, 191)
+	n2.setTestCount("addr99", sh, 1, 191)
+	vs2, err = n2.validatorSet(height)
+	require.Nil(t, err)
+	require.NotEqual(t, vs1.Hash, vs2.Hash)
+	require.Equal(t, []string{"allCount: 190 != 191", "addr05: 5 != 6", "addr99: 0 != 1"}, pt.DiffPos33ValidatorSet(vs1, vs2))
+
+	_, err = n1.validatorSet(height + 1)
+	require.NotNil(t, err)
+}
@@ -0,0 +1,157 @@
+package pos33
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sync/atomic"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	lru "github.com/hashicorp/golang-lru"
+	metrics "github.com/rcrowley/go-metrics"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+const vrfMemoSize = 1024
+
+// vrfMemoMaxAge 默认保留最近多少个高度的vrf结果
+const vrfMemoMaxAge = 100
+
+var (
+	vrfMemoSizeGauge    = metrics.GetOrRegisterGauge("pos33/vrfmemo/size", nil)
+	vrfMemoEvictCounter = metrics.GetOrRegisterCounter("pos33/vrfmemo/evicted", nil)
+)
+
+type vrfEntry struct {
+	height int64
+	hash   []byte
+	proof  []byte
+}
+
+// vrfMemo 缓存自己的vrf计算结果, 相同的VrfInput不用重复计算.
+// 除了LRU的大小限制, 超过maxAge个高度的结果在区块增加时清除
+type vrfMemo struct {
+	cache   *lru.Cache
+	maxAge  int64
+	evicted int64
+}
+
+func newVrfMemo(size int, maxAge int64) *vrfMemo {
+	if maxAge <= 0 {
+		maxAge = vrfMemoMaxAge
+	}
+	cache, err := lru.New(size)
+	if err != nil {
+		panic(err)
+	}
+	return &vrfMemo{cache: cache, maxAge: maxAge}
+}
+
+func (m *vrfMemo) evict(n int64) {
+	atomic.AddInt64(&m.evicted, n)
+	vrfMemoEvictCounter.Inc(n)
+}
+
+// evaluate 使用suite的vrf算法计算, suite必须已经注册. 计算失败时返回nil, 不缓存
+func (m *vrfMemo) evaluate(suite string, input *pt.VrfInput, priv crypto.PrivKey) ([]byte, []byte) {
+	in := types.Encode(input)
+	key := suite + "-" + string(priv.PubKey().Bytes()) + string(in)
+	if v, ok := m.cache.Get(key); ok {
+		e := v.(*vrfEntry)
+		return e.hash, e.proof
+	}
+	var vrfHash, vrfProof []byte
+	if e, ok := priv.(vrfEvaluator); ok {
+		var err error
+		vrfHash, vrfProof, err = e.EvaluateVrf(suite, in)
+		if err != nil {
+			plog.Error("remote vrf error", "err", err, "height", input.Height, "round", input.Round)
+			return nil, nil
+		}
+	} else {
+		b, err := getVrfBackend(suite)
+		if err != nil {
+			panic(err)
+		}
+		vrfHash, vrfProof = b.Evaluate(priv, in)
+		if vrfHash == nil {
+			return nil, nil
+		}
+	}
+	if m.cache.Add(key, &vrfEntry{height: input.Height, hash: vrfHash, proof: vrfProof}) {
+		m.evict(1)
+	}
+	vrfMemoSizeGauge.Update(int64(m.cache.Len()))
+	return vrfHash, vrfProof
+}
+
+// expire 区块高度增加到height时, 清除太旧的结果
+func (m *vrfMemo) expire(height int64) {
+	for _, k := range m.cache.Keys() {
+		v, ok := m.cache.Peek(k)
+		if ok && v.(*vrfEntry).height < height-m.maxAge && m.cache.Remove(k) {
+			m.evict(1)
+		}
+	}
+	vrfMemoSizeGauge.Update(int64(m.cache.Len()))
+}
+
+func (m *vrfMemo) size() int {
+	return m.cache.Len()
+}
+
+func (m *vrfMemo) evictions() int64 {
+	return atomic.LoadInt64(&m.evicted)
+}
+
+// vrfVerifyCacheSize 缓存多少个验证过的vrf proof
+const vrfVerifyCacheSize = 4096
+
+var (
+	vrfVerifyHitCounter  = metrics.GetOrRegisterCounter("pos33/vrfverify/hit", nil)
+	vrfVerifyMissCounter = metrics.GetOrRegisterCounter("pos33/vrfverify/miss", nil)
+)
+
+// verifiedVrf 所有节点共用的验证缓存, vrfVerify是包函数
+var verifiedVrf = newVrfVerifyCache(vrfVerifyCacheSize)
+
+// vrfVerifyCache 缓存验证通过的vrf proof对应的hash, 同一个抽签再次验证时不用重新计算曲线运算.
+// 验证失败的不缓存
+type vrfVerifyCache struct {
+	cache *lru.Cache
+}
+
+func newVrfVerifyCache(size int) *vrfVerifyCache {
+	cache, err := lru.New(size)
+	if err != nil {
+		panic(err)
+	}
+	return &vrfVerifyCache{cache: cache}
+}
+
+func vrfVerifyKey(suite string, pub, input, proof []byte) string {
+	h := sha256.New()
+	for _, b := range [][]byte{[]byte(suite), pub, input, proof} {
+		var l [4]byte
+		binary.BigEndian.PutUint32(l[:], uint32(len(b)))
+		h.Write(l[:])
+		h.Write(b)
+	}
+	return string(h.Sum(nil))
+}
+
+// proofToHash 使用suite的vrf算法b验证proof并返回对应的vrf hash
+func (c *vrfVerifyCache) proofToHash(suite string, b VrfVerifier, pub, input, proof []byte) ([]byte, error) {
+	key := vrfVerifyKey(suite, pub, input, proof)
+	if v, ok := c.cache.Get(key); ok {
+		vrfVerifyHitCounter.Inc(1)
+		return v.([]byte), nil
+	}
+	vrfVerifyMissCounter.Inc(1)
+	vrfHash, err := b.ProofToHash(pub, input, proof)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.Add(key, vrfHash)
+	return vrfHash, nil
+}
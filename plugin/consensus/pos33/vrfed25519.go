@@ -0,0 +1,244 @@
+package pos33
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"errors"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/system/crypto/ed25519/ed25519/edwards25519"
+	"github.com/yccproject/ycc/plugin/dapp/pos33/verifier"
+)
+
+// SuiteEd25519SHA512 RFC 9381的ECVRF-EDWARDS25519-SHA512-TAI, 共识私钥必须是ed25519.
+// proof和RFC相同, vrf hash取beta的前32字节, 和secp256k1的vrf hash一样长.
+// ed25519公钥推导不出eth地址, 矿工需要先用KeyRotate绑定抵押地址
+const SuiteEd25519SHA512 = "ed25519-SHA512-TAI"
+
+const (
+	ed25519VrfSuiteByte = 0x03
+	ed25519VrfCLen      = 16
+	// ed25519VrfProofSize Gamma(32) || c(16) || s(32)
+	ed25519VrfProofSize = 32 + ed25519VrfCLen + 32
+)
+
+var (
+	errEd25519VrfKey   = errors.New("ed25519 vrf: invalid public key")
+	errEd25519VrfProof = errors.New("ed25519 vrf: invalid proof")
+)
+
+// ed25519Vrf ECVRF-EDWARDS25519-SHA512-TAI
+type ed25519Vrf struct{}
+
+// Evaluate priv必须是chain33的ed25519私钥(seed || 公钥), 否则返回nil
+func (ed25519Vrf) Evaluate(priv crypto.PrivKey, input []byte) ([]byte, []byte) {
+	sk := priv.Bytes()
+	if len(sk) != 64 {
+		plog.Error("ed25519 vrf evaluate", "err", "NOT ed25519 private key", "len", len(sk))
+		return nil, nil
+	}
+	h := sha512.Sum512(sk[:32])
+	var x [32]byte
+	copy(x[:], h[:32])
+	x[0] &= 248
+	x[31] &= 127
+	x[31] |= 64
+
+	var y edwards25519.ExtendedGroupElement
+	edwards25519.GeScalarMultBase(&y, &x)
+	var pk [32]byte
+	y.ToBytes(&pk)
+	if !bytes.Equal(pk[:], priv.PubKey().Bytes()) {
+		plog.Error("ed25519 vrf evaluate", "err", "public key mismatch")
+		return nil, nil
+	}
+	hp, hs, ok := ed25519VrfHashToCurve(pk[:], input)
+	if !ok {
+		plog.Error("ed25519 vrf evaluate", "err", "hash to curve failed")
+		return nil, nil
+	}
+
+	// nonce和RFC 8032的签名一样, 由私钥和H确定, 同一个input只有一个proof
+	var kd [64]byte
+	kh := sha512.New()
+	kh.Write(h[32:])
+	kh.Write(hs[:])
+	kh.Sum(kd[:0])
+	var k [32]byte
+	edwards25519.ScReduce(&k, &kd)
+
+	var gamma, kb, khp [32]byte
+	ed25519ScalarMult(&x, hp).ToBytes(&gamma)
+	var p edwards25519.ExtendedGroupElement
+	edwards25519.GeScalarMultBase(&p, &k)
+	p.ToBytes(&kb)
+	ed25519ScalarMult(&k, hp).ToBytes(&khp)
+
+	c := ed25519VrfChallenge(pk[:], hs[:], gamma[:], kb[:], khp[:])
+	var s [32]byte
+	edwards25519.ScMulAdd(&s, &c, &x, &k)
+
+	proof := make([]byte, 0, ed25519VrfProofSize)
+	proof = append(proof, gamma[:]...)
+	proof = append(proof, c[:ed25519VrfCLen]...)
+	proof = append(proof, s[:]...)
+
+	var g edwards25519.ExtendedGroupElement
+	g.FromBytes(&gamma)
+	return ed25519VrfBeta(&g), proof
+}
+
+func (ed25519Vrf) CheckProof(proof, hash []byte) error {
+	if len(proof) != ed25519VrfProofSize || len(hash) != sha512.Size256 {
+		return verifier.ErrProofSize
+	}
+	return nil
+}
+
+func (ed25519Vrf) ProofToHash(pub, input, proof []byte) ([]byte, error) {
+	if len(pub) != 32 {
+		return nil, errEd25519VrfKey
+	}
+	if len(proof) != ed25519VrfProofSize {
+		return nil, verifier.ErrProofSize
+	}
+	var pk, gb, c, s [32]byte
+	copy(pk[:], pub)
+	copy(gb[:], proof[:32])
+	copy(c[:], proof[32:32+ed25519VrfCLen])
+	copy(s[:], proof[32+ed25519VrfCLen:])
+
+	var y, gamma edwards25519.ExtendedGroupElement
+	if !ed25519DecodePoint(&y, &pk) || ed25519SmallOrder(&y) {
+		return nil, errEd25519VrfKey
+	}
+	if !ed25519DecodePoint(&gamma, &gb) || !edwards25519.ScCheck(&s) {
+		return nil, errEd25519VrfProof
+	}
+	hp, hs, ok := ed25519VrfHashToCurve(pub, input)
+	if !ok {
+		return nil, errEd25519VrfProof
+	}
+
+	// U = s*B - c*Y
+	var u edwards25519.ProjectiveGroupElement
+	ed25519Neg(&y)
+	edwards25519.GeDoubleScalarMultVartime(&u, &c, &y, &s)
+	var ub [32]byte
+	u.ToBytes(&ub)
+
+	// V = s*H - c*Gamma
+	ng := gamma
+	ed25519Neg(&ng)
+	var cg edwards25519.CachedGroupElement
+	ed25519ScalarMult(&c, &ng).ToCached(&cg)
+	var v edwards25519.CompletedGroupElement
+	edwards25519.GeAdd(&v, ed25519ScalarMult(&s, hp), &cg)
+	var vb [32]byte
+	var ve edwards25519.ExtendedGroupElement
+	v.ToExtended(&ve)
+	ve.ToBytes(&vb)
+
+	c2 := ed25519VrfChallenge(pub, hs[:], gb[:], ub[:], vb[:])
+	if !bytes.Equal(c2[:ed25519VrfCLen], c[:ed25519VrfCLen]) {
+		return nil, errEd25519VrfProof
+	}
+	return ed25519VrfBeta(&gamma), nil
+}
+
+// ed25519VrfHashToCurve RFC 9381的encode_to_curve_try_and_increment, 返回H和它的编码
+func ed25519VrfHashToCurve(pk, alpha []byte) (*edwards25519.ExtendedGroupElement, [32]byte, bool) {
+	var hs [32]byte
+	for ctr := 0; ctr < 256; ctr++ {
+		d := sha512.New()
+		d.Write([]byte{ed25519VrfSuiteByte, 0x01})
+		d.Write(pk)
+		d.Write(alpha)
+		d.Write([]byte{byte(ctr), 0x00})
+		sum := d.Sum(nil)
+		var s [32]byte
+		copy(s[:], sum[:32])
+		var p edwards25519.ExtendedGroupElement
+		if !ed25519DecodePoint(&p, &s) {
+			continue
+		}
+		ed25519MulCofactor(&p)
+		p.ToBytes(&hs)
+		return &p, hs, true
+	}
+	return nil, hs, false
+}
+
+// ed25519VrfChallenge RFC 9381的challenge_generation, 返回的前16字节是c, 其余为0
+func ed25519VrfChallenge(points ...[]byte) [32]byte {
+	d := sha512.New()
+	d.Write([]byte{ed25519VrfSuiteByte, 0x02})
+	for _, p := range points {
+		d.Write(p)
+	}
+	d.Write([]byte{0x00})
+	var c [32]byte
+	copy(c[:ed25519VrfCLen], d.Sum(nil))
+	return c
+}
+
+// ed25519VrfBeta proof_to_hash, 截取前32字节
+func ed25519VrfBeta(gamma *edwards25519.ExtendedGroupElement) []byte {
+	g := *gamma
+	ed25519MulCofactor(&g)
+	var gb [32]byte
+	g.ToBytes(&gb)
+	d := sha512.New()
+	d.Write([]byte{ed25519VrfSuiteByte, 0x03})
+	d.Write(gb[:])
+	d.Write([]byte{0x00})
+	return d.Sum(nil)[:sha512.Size256]
+}
+
+// ed25519DecodePoint RFC 8032的点解码, 不接受y >= p和x = 0时符号位为1的编码
+func ed25519DecodePoint(p *edwards25519.ExtendedGroupElement, s *[32]byte) bool {
+	if !p.FromBytes(s) {
+		return false
+	}
+	var b [32]byte
+	p.ToBytes(&b)
+	return b == *s
+}
+
+// ed25519ScalarMult a*A, a[31]不能大于127
+func ed25519ScalarMult(a *[32]byte, p *edwards25519.ExtendedGroupElement) *edwards25519.ExtendedGroupElement {
+	var r edwards25519.ProjectiveGroupElement
+	edwards25519.GeScalarMult(&r, a, p)
+	e := new(edwards25519.ExtendedGroupElement)
+	edwards25519.FeMul(&e.X, &r.X, &r.Z)
+	edwards25519.FeMul(&e.Y, &r.Y, &r.Z)
+	edwards25519.FeSquare(&e.Z, &r.Z)
+	edwards25519.FeMul(&e.T, &r.X, &r.Y)
+	return e
+}
+
+func ed25519MulCofactor(p *edwards25519.ExtendedGroupElement) {
+	var c edwards25519.CompletedGroupElement
+	for i := 0; i < 3; i++ {
+		p.Double(&c)
+		c.ToExtended(p)
+	}
+}
+
+func ed25519Neg(p *edwards25519.ExtendedGroupElement) {
+	edwards25519.FeNeg(&p.X, &p.X)
+	edwards25519.FeNeg(&p.T, &p.T)
+}
+
+// ed25519SmallOrder 8*P是否为单位元
+func ed25519SmallOrder(p *edwards25519.ExtendedGroupElement) bool {
+	q := *p
+	ed25519MulCofactor(&q)
+	var b [32]byte
+	q.ToBytes(&b)
+	return b == [32]byte{1}
+}
+
+func init() {
+	RegisterVrfBackend(SuiteEd25519SHA512, ed25519Vrf{})
+}
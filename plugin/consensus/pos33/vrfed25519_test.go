@@ -0,0 +1,76 @@
+package pos33
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/33cn/chain33/system/crypto/ed25519"
+	"github.com/stretchr/testify/require"
+	"github.com/yccproject/ycc/plugin/dapp/pos33/verifier"
+)
+
+func TestEd25519VrfRFC9381(t *testing.T) {
+	// RFC 9381 B.3 Example 16
+	sk, _ := hex.DecodeString("9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60")
+	pub, _ := hex.DecodeString("d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a")
+	pi, _ := hex.DecodeString("8657106690b5526245a92b003bb079ccd1a92130477671f6fc01ad16f26f723f26f8a57ccaed74ee1b190bed1f479d9727d2d0f9b005a6e456a35d4fb0daab1268a1b0db10836d9826a528ca76567805")
+	beta, _ := hex.DecodeString("90cf1df3b703cce59e2a35b925d411164068269d7b2d29f3301c03dd757876ff66b71dda49d2de59d03450451af026798e8f81cd2e333de5cdf4f3e140fdd8ae")
+
+	priv, err := ed25519.Driver{}.PrivKeyFromBytes(sk)
+	require.Nil(t, err)
+	require.Equal(t, pub, priv.PubKey().Bytes())
+
+	b := ed25519Vrf{}
+	hash, proof := b.Evaluate(priv, nil)
+	require.Equal(t, pi, proof)
+	require.Equal(t, beta[:32], hash)
+	h, err := b.ProofToHash(pub, nil, pi)
+	require.Nil(t, err)
+	require.Equal(t, beta[:32], h)
+}
+
+func TestEd25519Vrf(t *testing.T) {
+	b, err := getVrfBackend(SuiteEd25519SHA512)
+	require.Nil(t, err)
+	priv, err := ed25519.Driver{}.GenKey()
+	require.Nil(t, err)
+	pub := priv.PubKey().Bytes()
+	input := []byte("ed25519 vrf input")
+
+	hash, proof := b.Evaluate(priv, input)
+	require.Nil(t, b.CheckProof(proof, hash))
+	require.Nil(t, vrfVerify(SuiteEd25519SHA512, pub, input, proof, hash))
+	// 同一个私钥和input只有一个proof
+	h2, p2 := b.Evaluate(priv, input)
+	require.Equal(t, hash, h2)
+	require.Equal(t, proof, p2)
+
+	require.Equal(t, verifier.ErrProofSize, b.CheckProof(proof[1:], hash))
+	require.Equal(t, verifier.ErrProofSize, b.CheckProof(proof, append(hash, 0)))
+	for i := 0; i < len(proof); i += 7 {
+		bad := append([]byte{}, proof...)
+		bad[i] ^= 1
+		_, err = b.ProofToHash(pub, input, bad)
+		require.NotNil(t, err, "byte %d", i)
+	}
+	_, err = b.ProofToHash(pub, []byte("other input"), proof)
+	require.Equal(t, errEd25519VrfProof, err)
+	other, _ := ed25519.Driver{}.GenKey()
+	_, err = b.ProofToHash(other.PubKey().Bytes(), input, proof)
+	require.Equal(t, errEd25519VrfProof, err)
+	_, err = b.ProofToHash(pub[1:], input, proof)
+	require.Equal(t, errEd25519VrfKey, err)
+	// 小阶的公钥
+	_, err = b.ProofToHash(make([]byte, 32), input, proof)
+	require.Equal(t, errEd25519VrfKey, err)
+
+	// hash被替换
+	bad := append([]byte{}, hash...)
+	bad[0] ^= 1
+	require.NotNil(t, vrfVerify(SuiteEd25519SHA512, pub, input, proof, bad))
+
+	// secp256k1的私钥不能计算
+	h, p := b.Evaluate(newTestPriv(t), input)
+	require.Nil(t, h)
+	require.Nil(t, p)
+}
@@ -0,0 +1,121 @@
+package pos33
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/33cn/chain33/common/crypto"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+	"github.com/yccproject/ycc/plugin/dapp/pos33/verifier"
+)
+
+// VrfSigner 用私钥计算input的vrf hash和proof
+type VrfSigner interface {
+	Evaluate(priv crypto.PrivKey, input []byte) (hash, proof []byte)
+}
+
+// VrfVerifier 验证vrf proof
+type VrfVerifier interface {
+	// CheckProof 只检查proof和hash的格式, 在ProofToHash之前拒绝畸形的消息
+	CheckProof(proof, hash []byte) error
+	// ProofToHash 验证pub对input的proof, 返回proof对应的vrf hash
+	ProofToHash(pub, input, proof []byte) ([]byte, error)
+}
+
+// VrfBackend 一种vrf算法, 按HashProof.VrfSuite的名字注册和查找.
+//
+// vrf要求同一个私钥和input只有一个合法的proof, 否则矿工可以重复计算挑选中签的结果,
+// 所以不能直接用普通签名代替: ed25519签名的nonce由签名者决定, 验证者无法检查,
+// 需要ECVRF-EDWARDS25519这样的算法, 只暴露签名接口的HSM也没有办法计算.
+// 抽签的矿工地址从proof的公钥推导, 新的算法的公钥也要能推导出链上记录票数的地址
+type VrfBackend interface {
+	VrfSigner
+	VrfVerifier
+}
+
+var (
+	vrfBackendsMu sync.RWMutex
+	vrfBackends   = make(map[string]VrfBackend)
+)
+
+// RegisterVrfBackend 注册suite的vrf算法, 名字为空或者重复注册会panic
+func RegisterVrfBackend(suite string, b VrfBackend) {
+	vrfBackendsMu.Lock()
+	defer vrfBackendsMu.Unlock()
+	if suite == "" || b == nil {
+		panic("pos33: register vrf backend with empty suite or nil backend")
+	}
+	if _, ok := vrfBackends[suite]; ok {
+		panic("pos33: vrf backend registered twice: " + suite)
+	}
+	vrfBackends[suite] = b
+}
+
+// getVrfBackend 查找suite的vrf算法, 空表示ForkVrfSuite之前的proof, 使用secp256k1
+func getVrfBackend(suite string) (VrfBackend, error) {
+	if suite == "" {
+		suite = verifier.SuiteSecp256k1SHA256
+	}
+	vrfBackendsMu.RLock()
+	defer vrfBackendsMu.RUnlock()
+	b, ok := vrfBackends[suite]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", verifier.ErrSuite, suite)
+	}
+	return b, nil
+}
+
+// secp256k1Vrf chain33的secp256k1 vrf
+type secp256k1Vrf struct{}
+
+func (secp256k1Vrf) Evaluate(priv crypto.PrivKey, input []byte) ([]byte, []byte) {
+	return calcuVrfInput(input, priv)
+}
+
+func (secp256k1Vrf) CheckProof(proof, hash []byte) error {
+	return verifier.CheckProof(proof, hash)
+}
+
+func (secp256k1Vrf) ProofToHash(pub, input, proof []byte) ([]byte, error) {
+	return verifier.ProofToHash(pub, input, proof)
+}
+
+func init() {
+	RegisterVrfBackend(verifier.SuiteSecp256k1SHA256, secp256k1Vrf{})
+}
+
+// newVrfSuite 检查配置的vrf算法, 为空使用secp256k1
+func newVrfSuite(suite string) (string, error) {
+	if suite == "" {
+		suite = verifier.SuiteSecp256k1SHA256
+	}
+	_, err := getVrfBackend(suite)
+	if err != nil {
+		return "", err
+	}
+	return suite, nil
+}
+
+// checkProofSuite 检查height高度的proof写明的vrf算法: ForkVrfSuite以前不能写,
+// 以后必须写明注册过的算法, 否则分叉不起作用
+func (n *node) checkProofSuite(height int64, suite string) error {
+	if !n.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkVrfSuite") {
+		if suite != "" {
+			return fmt.Errorf("%w: %s before ForkVrfSuite", verifier.ErrSuite, suite)
+		}
+		return nil
+	}
+	if suite == "" {
+		return fmt.Errorf("%w: empty after ForkVrfSuite", verifier.ErrSuite)
+	}
+	_, err := getVrfBackend(suite)
+	return err
+}
+
+// proofSuite height高度的proof使用的vrf算法, ForkVrfSuite之前为空
+func (n *node) proofSuite(height int64) string {
+	if !n.GetAPI().GetConfig().IsDappFork(height, pt.Pos33TicketX, "ForkVrfSuite") {
+		return ""
+	}
+	return n.vrfSuite
+}
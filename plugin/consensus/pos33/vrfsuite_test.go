@@ -0,0 +1,127 @@
+package pos33
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+	"github.com/yccproject/ycc/plugin/dapp/pos33/verifier"
+)
+
+const testVrfSuite = "test-sha256"
+
+// testVrf 只用于测试的vrf: hash是sha256(pub, input), proof是公钥
+type testVrf struct{}
+
+func (testVrf) Evaluate(priv crypto.PrivKey, input []byte) ([]byte, []byte) {
+	pub := priv.PubKey().Bytes()
+	h := sha256.Sum256(append(append([]byte{}, pub...), input...))
+	return h[:], pub
+}
+
+func (testVrf) CheckProof(proof, hash []byte) error {
+	if len(hash) != sha256.Size {
+		return verifier.ErrProofSize
+	}
+	return nil
+}
+
+func (testVrf) ProofToHash(pub, input, proof []byte) ([]byte, error) {
+	if !bytes.Equal(pub, proof) {
+		return nil, errors.New("test vrf proof error")
+	}
+	h := sha256.Sum256(append(append([]byte{}, pub...), input...))
+	return h[:], nil
+}
+
+func init() {
+	RegisterVrfBackend(testVrfSuite, testVrf{})
+}
+
+func TestVrfBackendRegistry(t *testing.T) {
+	b1, err := getVrfBackend("")
+	require.Nil(t, err)
+	b2, err := getVrfBackend(verifier.SuiteSecp256k1SHA256)
+	require.Nil(t, err)
+	require.Equal(t, b1, b2)
+	_, err = getVrfBackend("unknown")
+	require.True(t, errors.Is(err, verifier.ErrSuite))
+
+	require.Panics(t, func() { RegisterVrfBackend(testVrfSuite, testVrf{}) })
+	require.Panics(t, func() { RegisterVrfBackend("", testVrf{}) })
+	require.Panics(t, func() { newNode(&subConfig{VrfSuite: "unknown"}) })
+}
+
+func TestVrfSuiteConfig(t *testing.T) {
+	n, _ := newTestNode(t, &subConfig{VrfSuite: testVrfSuite})
+	n.setTestMiner(newTestPriv(t))
+	height := int64(100)
+	seed := []byte("vrf suite seed")
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+
+	// 分叉之前总是secp256k1
+	n.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "ForkVrfSuite", height+1)
+	ss := n.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.Equal(t, 10, len(ss))
+	require.Equal(t, "", ss[0].Proof.VrfSuite)
+	require.Equal(t, verifier.ProofSize, len(ss[0].Proof.VrfProof))
+	require.Nil(t, n.verifySort(height, Committee, seed, ss[0]))
+
+	// 分叉以后使用配置的算法, 验证时按proof里的算法
+	n.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "ForkVrfSuite", height)
+	ss = n.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.Equal(t, 10, len(ss))
+	require.Equal(t, testVrfSuite, ss[0].Proof.VrfSuite)
+	require.Equal(t, n.priv.PubKey().Bytes(), ss[0].Proof.VrfProof)
+	require.Nil(t, n.verifySort(height, Committee, seed, ss[0]))
+
+	// secp256k1节点验证其他算法的proof
+	en, _ := newTestNode(t, nil)
+	en.GetAPI().GetConfig().SetDappFork(pt.Pos33TicketX, "ForkVrfSuite", height)
+	en.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+	require.Nil(t, en.verifySort(height, Committee, seed, ss[0]))
+
+	// 改了算法名字, proof按secp256k1验证不过
+	ss[1].Proof.VrfSuite = verifier.SuiteSecp256k1SHA256
+	require.NotNil(t, n.verifySort(height, Committee, seed, ss[1]))
+	ss[2].Proof.VrfSuite = "unknown"
+	require.True(t, errors.Is(n.verifySort(height, Committee, seed, ss[2]), pt.ErrVrfVerify))
+}
+
+func TestCheckProofSuite(t *testing.T) {
+	n, _ := newTestNode(t, nil)
+	n.setTestMiner(newTestPriv(t))
+	height := int64(100)
+	seed := []byte("proof suite seed")
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+	cfg := n.GetAPI().GetConfig()
+
+	// 分叉以前写明了算法的proof验证不过
+	cfg.SetDappFork(pt.Pos33TicketX, "ForkVrfSuite", height+1)
+	require.Nil(t, n.checkProofSuite(height, ""))
+	s := n.committeeSort(context.Background(), seed, height, 0, Committee)[0]
+	require.Nil(t, n.verifySort(height, Committee, seed, s))
+	s.Proof.VrfSuite = verifier.SuiteSecp256k1SHA256
+	require.True(t, errors.Is(n.checkProofSuite(height, s.Proof.VrfSuite), verifier.ErrSuite))
+	require.True(t, errors.Is(n.verifySort(height, Committee, seed, s), pt.ErrVrfVerify))
+
+	// 分叉以后必须写明注册过的算法
+	cfg.SetDappFork(pt.Pos33TicketX, "ForkVrfSuite", height)
+	require.Nil(t, n.verifySort(height, Committee, seed, s))
+	s.Proof.VrfSuite = ""
+	require.True(t, errors.Is(n.checkProofSuite(height, s.Proof.VrfSuite), verifier.ErrSuite))
+	require.True(t, errors.Is(n.verifySort(height, Committee, seed, s), pt.ErrVrfVerify))
+	require.NotNil(t, n.checkProofSuite(height, "unknown"))
+
+	// 无状态的验证只支持secp256k1
+	require.Nil(t, verifier.CheckSuite("", false))
+	require.NotNil(t, verifier.CheckSuite(verifier.SuiteSecp256k1SHA256, false))
+	require.Nil(t, verifier.CheckSuite(verifier.SuiteSecp256k1SHA256, true))
+	require.NotNil(t, verifier.CheckSuite("", true))
+	require.NotNil(t, verifier.CheckSuite(testVrfSuite, true))
+}
@@ -0,0 +1,289 @@
+package pos33
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// walDefaultDir 默认的共识状态日志目录, 和bv.data一样在datadir下
+const walDefaultDir = "./datadir/pos33wal"
+
+// walMaxRecord 一条记录的最大字节数
+const walMaxRecord = 1 << 24
+
+// 共识状态日志记录的类型
+const (
+	walRound     = iota + 1 // 进入新的一轮
+	walSorts                // 抽签, 同一个公钥和子委员会的一组
+	walVotes                // 区块投票
+	walBlock                // 预出块
+	walCommittee            // 委员会投票
+	walMade                 // 自己出块
+)
+
+// roundWal 共识状态日志. 还没有出块的每个高度一个文件, 记录进入的轮次, 发出和收到的抽签, 投票和预出块.
+// 自己的投票和出块在广播以前写入并且sync, 重启后不会在同一轮再投一次或者再出一个不同的块.
+// runLoop开始时调用load读出所有记录, 重放以后调用start开始记录, 之前add什么都不做.
+// 区块加入链以后删除这个高度和以前的文件
+type roundWal struct {
+	dir string
+
+	mu      sync.Mutex
+	started bool
+	// 有日志文件的高度, 还没有打开时是nil
+	files map[int64]*os.File
+	// 重启前每个高度到达的轮次
+	rounds map[int64]int
+}
+
+// newRoundWal disable为true时返回nil, 所有方法什么都不做
+func newRoundWal(dir string, disable bool) *roundWal {
+	if disable {
+		return nil
+	}
+	if dir == "" {
+		dir = walDefaultDir
+	}
+	return &roundWal{dir: dir, files: make(map[int64]*os.File), rounds: make(map[int64]int)}
+}
+
+func (w *roundWal) file(height int64) string {
+	return filepath.Join(w.dir, fmt.Sprintf("%d.wal", height))
+}
+
+// heights 返回目录里所有日志文件的高度, 从小到大
+func (w *roundWal) heights() ([]int64, error) {
+	es, err := os.ReadDir(w.dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var hs []int64
+	for _, e := range es {
+		name := e.Name()
+		if !strings.HasSuffix(name, ".wal") {
+			continue
+		}
+		h, err := strconv.ParseInt(strings.TrimSuffix(name, ".wal"), 10, 64)
+		if err != nil {
+			continue
+		}
+		hs = append(hs, h)
+	}
+	sort.Slice(hs, func(i, j int) bool { return hs[i] < hs[j] })
+	return hs, nil
+}
+
+// load 删除last和以前高度的文件, 返回以后高度的所有记录
+func (w *roundWal) load(last int64) ([]*pt.Pos33WalRecord, error) {
+	if w == nil {
+		return nil, nil
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	err := os.MkdirAll(w.dir, 0755)
+	if err != nil {
+		return nil, err
+	}
+	hs, err := w.heights()
+	if err != nil {
+		return nil, err
+	}
+	var rs []*pt.Pos33WalRecord
+	for _, h := range hs {
+		if h <= last {
+			os.Remove(w.file(h))
+			continue
+		}
+		hrs, err := readWalFile(w.file(h))
+		if err != nil {
+			plog.Error("read wal error", "err", err, "height", h)
+		}
+		w.files[h] = nil
+		for _, r := range hrs {
+			if r.Ty == walRound && int(r.Round) > w.rounds[r.Height] {
+				w.rounds[r.Height] = int(r.Round)
+			}
+		}
+		rs = append(rs, hrs...)
+	}
+	return rs, nil
+}
+
+// readWalFile 读出文件里的所有记录, 最后一条没有写完时丢弃
+func readWalFile(file string) ([]*pt.Pos33WalRecord, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	r := NewDelimitedReader(f, walMaxRecord)
+	defer r.Close()
+	var rs []*pt.Pos33WalRecord
+	for {
+		rec := new(pt.Pos33WalRecord)
+		err = r.ReadMsg(rec)
+		if err == io.EOF {
+			return rs, nil
+		}
+		if err != nil {
+			return rs, err
+		}
+		rs = append(rs, rec)
+	}
+}
+
+// start 开始记录, 重放的记录不会再写一次
+func (w *roundWal) start() {
+	if w == nil {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.started = true
+}
+
+// add 追加一条记录, sync为true时写入磁盘后返回
+func (w *roundWal) add(r *pt.Pos33WalRecord, sync bool) {
+	if w == nil {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if !w.started {
+		return
+	}
+	f := w.files[r.Height]
+	if f == nil {
+		var err error
+		f, err = os.OpenFile(w.file(r.Height), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			plog.Error("open wal error", "err", err, "height", r.Height)
+			return
+		}
+		w.files[r.Height] = f
+	}
+	err := NewDelimitedWriter(f).WriteMsg(r)
+	if err == nil && sync {
+		err = f.Sync()
+	}
+	if err != nil {
+		plog.Error("write wal error", "err", err, "height", r.Height, "ty", r.Ty)
+	}
+}
+
+// prune 区块height加入链以后删除这个高度和以前的文件
+func (w *roundWal) prune(height int64) {
+	if w == nil {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for h, f := range w.files {
+		if h <= height {
+			if f != nil {
+				f.Close()
+			}
+			os.Remove(w.file(h))
+			delete(w.files, h)
+		}
+	}
+	for h := range w.rounds {
+		if h <= height {
+			delete(w.rounds, h)
+		}
+	}
+}
+
+// round 重启前height高度到达的轮次, 没有记录时是0
+func (w *roundWal) round(height int64) int {
+	if w == nil {
+		return 0
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.rounds[height]
+}
+
+// close 关闭打开的文件
+func (w *roundWal) close() {
+	if w == nil {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for h, f := range w.files {
+		if f != nil {
+			f.Close()
+		}
+		delete(w.files, h)
+	}
+	w.started = false
+}
+
+// restoreWalFlags 恢复重启前自己投过票, 预出块和出块的标记
+func (n *node) restoreWalFlags(rs []*pt.Pos33WalRecord) {
+	for _, r := range rs {
+		comm := n.getCommittee(r.Height, int(r.Round))
+		switch r.Ty {
+		case walVotes:
+			if r.Myself {
+				comm.voted = true
+			}
+		case walBlock:
+			if r.Myself {
+				comm.preMaked = true
+			}
+		case walMade:
+			comm.maked = true
+		}
+	}
+}
+
+// replayWal 重放重启前的记录. 先恢复自己的标记, 重放收到的消息时不会在同一轮再投票或者出一个不同的块
+func (n *node) replayWal(rs []*pt.Pos33WalRecord) {
+	n.restoreWalFlags(rs)
+	for _, r := range rs {
+		switch r.Ty {
+		case walSorts:
+			n.handleVoterSort(r.Sorts, r.Myself, int(pt.Pos33Msg_VS))
+			if r.Myself {
+				comm := n.getCommittee(r.Height, int(r.Round))
+				for _, s := range r.Sorts {
+					if !hasSort(comm.myss, s) {
+						comm.myss = append(comm.myss, s)
+					}
+				}
+			}
+		case walVotes:
+			n.handleVoteMsg(r.Votes, r.Myself, int(pt.Pos33Msg_BV))
+		case walBlock:
+			if r.Block.GetB() != nil {
+				n.handleBlockMsg(r.Block, r.Myself)
+			}
+		case walCommittee:
+			if r.Committee != nil {
+				n.handleCommittee(r.Committee, r.Myself)
+			}
+		}
+	}
+	plog.Info("wal replayed", "records", len(rs))
+}
+
+func hasSort(ss []*pt.Pos33SortMsg, s *pt.Pos33SortMsg) bool {
+	for _, x := range ss {
+		if string(x.SortHash.Hash) == string(s.SortHash.Hash) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+package pos33
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func TestRoundWal(t *testing.T) {
+	dir := t.TempDir()
+	n, _ := newTestNode(t, &subConfig{WalDir: dir})
+	n.setTestMiner(newTestPriv(t))
+	height := int64(100)
+	seed := []byte("wal seed")
+	n.setTestCount(n.myAddr, height-pt.Pos33SortBlocks, 10, pt.Pos33CommitteeSize)
+	ss := n.committeeSort(context.Background(), seed, height, 0, Committee)
+	require.Equal(t, 10, len(ss))
+
+	// start以前不记录
+	w := n.wal
+	w.add(&pt.Pos33WalRecord{Height: height, Ty: walRound, Round: 5}, false)
+	rs, err := w.load(0)
+	require.Nil(t, err)
+	require.Empty(t, rs)
+	w.start()
+
+	w.add(&pt.Pos33WalRecord{Height: height - 1, Ty: walSorts, Sorts: ss}, false)
+	w.add(&pt.Pos33WalRecord{Height: height, Round: 0, Ty: walSorts, Myself: true, Sorts: ss}, false)
+	w.add(&pt.Pos33WalRecord{Height: height, Round: 1, Ty: walRound}, false)
+	w.add(&pt.Pos33WalRecord{Height: height, Round: 2, Ty: walRound}, false)
+	w.add(&pt.Pos33WalRecord{Height: height, Round: 1, Ty: walVotes, Myself: true}, true)
+	w.add(&pt.Pos33WalRecord{Height: height, Round: 1, Ty: walVotes}, false)
+	w.add(&pt.Pos33WalRecord{Height: height, Round: 2, Ty: walMade, Myself: true}, true)
+	w.add(&pt.Pos33WalRecord{Height: height + 1, Round: 0, Ty: walBlock, Myself: true}, true)
+	w.close()
+
+	// 最后一条没有写完
+	f, err := os.OpenFile(w.file(height+1), os.O_WRONLY|os.O_APPEND, 0644)
+	require.Nil(t, err)
+	_, err = f.Write([]byte{100, 1, 2})
+	require.Nil(t, err)
+	require.Nil(t, f.Close())
+
+	// 重启, height-1已经出块
+	n2, _ := newTestNode(t, &subConfig{WalDir: dir})
+	rs, err = n2.wal.load(height - 1)
+	require.Nil(t, err)
+	require.Equal(t, 7, len(rs))
+	require.Equal(t, ss[0].SortHash.Hash, rs[0].Sorts[0].SortHash.Hash)
+	require.Equal(t, 2, n2.wal.round(height))
+	require.Equal(t, 0, n2.wal.round(height+1))
+	_, err = os.Stat(n2.wal.file(height - 1))
+	require.True(t, os.IsNotExist(err))
+
+	n2.restoreWalFlags(rs)
+	require.False(t, n2.getCommittee(height, 0).voted)
+	require.True(t, n2.getCommittee(height, 1).voted)
+	require.True(t, n2.getCommittee(height, 2).maked)
+	require.True(t, n2.getCommittee(height+1, 0).preMaked)
+
+	// height出块以后删除
+	n2.wal.start()
+	n2.wal.prune(height)
+	require.Equal(t, 0, n2.wal.round(height))
+	_, err = os.Stat(n2.wal.file(height))
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(n2.wal.file(height + 1))
+	require.Nil(t, err)
+
+	// 关闭时不记录
+	require.Nil(t, newRoundWal(dir, true))
+	var nw *roundWal
+	nw.add(&pt.Pos33WalRecord{Height: height}, true)
+	require.Equal(t, 0, nw.round(height))
+}
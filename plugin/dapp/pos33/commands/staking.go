@@ -0,0 +1,484 @@
+package commands
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	cmdtypes "github.com/33cn/chain33/system/dapp/commands/types"
+	"github.com/33cn/chain33/types"
+	"github.com/spf13/cobra"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// 一天的秒数, 估算每天的出块数
+const secondsPerDay = 24 * 3600
+
+// stakingClient 抵押相关命令共用的rpc查询
+type stakingClient struct {
+	rpc *jsonclient.JSONClient
+	cfg *rpctypes.ChainConfigInfo
+}
+
+func newStakingClient(rpcLaddr string) (*stakingClient, error) {
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
+	if err != nil {
+		return nil, err
+	}
+	return &stakingClient{rpc: rpc, cfg: cfg}, nil
+}
+
+func (c *stakingClient) amount(v int64) string {
+	return types.FormatAmount2FloatDisplay(v, c.cfg.CoinPrecision, false)
+}
+
+func (c *stakingClient) info() (*ty.ReplyPos33Info, error) {
+	var res ty.ReplyPos33Info
+	err := c.rpc.Call("pos33.GetPos33Info", &types.ReqNil{}, &res)
+	if err != nil {
+		return nil, err
+	}
+	if res.Price <= 0 {
+		return nil, fmt.Errorf("ticket price error: %d", res.Price)
+	}
+	return &res, nil
+}
+
+func (c *stakingClient) lastHeader() (*rpctypes.Header, error) {
+	var res rpctypes.Header
+	err := c.rpc.Call("Chain33.GetLastHeader", &types.ReqNil{}, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *stakingClient) header(height int64) (*rpctypes.Header, error) {
+	var res rpctypes.Headers
+	err := c.rpc.Call("Chain33.GetHeaders", &types.ReqBlocks{Start: height, End: height}, &res)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Items) == 0 {
+		return nil, fmt.Errorf("header %d not found", height)
+	}
+	return res.Items[0], nil
+}
+
+// sortBlocks height高度的快照间隔, 改变的票数在这么多个高度以后用于抽签
+func (c *stakingClient) sortBlocks(height int64) int64 {
+	var res ty.Pos33ChainParams
+	err := c.rpc.Call("pos33.GetChainParams", &types.ReqNil{}, &res)
+	if err != nil {
+		return ty.Pos33SortBlocks
+	}
+	return int64(res.At(height).SortBlocks)
+}
+
+func (c *stakingClient) consignor(addr string) (*ty.Pos33Consignor, error) {
+	var res ty.Pos33Consignor
+	err := c.rpc.Call("pos33.GetPos33ConsignorEntrust", &types.ReqAddr{Addr: addr}, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *stakingClient) consignee(addr string) (*ty.Pos33Consignee, error) {
+	var res ty.Pos33Consignee
+	err := c.rpc.Call("pos33.GetPos33ConsigneeEntrust", &types.ReqAddr{Addr: addr}, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// sortCount addr在height高度抽签使用的票数, 不在验证者集合里为0
+func (c *stakingClient) sortCount(addr string, height int64) (int64, int64, error) {
+	var res ty.Pos33ValidatorSet
+	err := c.rpc.Call("pos33.GetValidatorSet", &types.ReqInt{Height: height}, &res)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, v := range res.Validators {
+		if v.Addr == addr {
+			return v.Count, res.AllCount, nil
+		}
+	}
+	return 0, res.AllCount, nil
+}
+
+func printJSON(v interface{}) {
+	data, err := json.MarshalIndent(v, "", "    ")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(string(data))
+}
+
+// BuyTicketsCmd 创建批量委托的交易, 给每个受托人委托count张票
+func BuyTicketsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buy",
+		Short: "create txs to entrust tickets to miners in bulk",
+		Run:   buyTickets,
+	}
+	addTicketsFlags(cmd)
+	return cmd
+}
+
+// SellTicketsCmd 创建批量取回委托的交易, 从每个受托人取回count张票
+func SellTicketsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sell",
+		Short: "create txs to take back tickets from miners in bulk",
+		Run:   sellTickets,
+	}
+	addTicketsFlags(cmd)
+	return cmd
+}
+
+func addTicketsFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("consignor", "r", "", "address for consignor")
+	cmd.MarkFlagRequired("consignor")
+	cmd.Flags().StringSliceP("consignees", "e", nil, "miner addresses, separated by ','")
+	cmd.MarkFlagRequired("consignees")
+	cmd.Flags().Int64P("count", "n", 1, "ticket count for each miner")
+}
+
+func buyTickets(cmd *cobra.Command, args []string) {
+	createTicketsTxs(cmd, 1)
+}
+
+func sellTickets(cmd *cobra.Command, args []string) {
+	createTicketsTxs(cmd, -1)
+}
+
+// createTicketsTxs 每个受托人一个委托交易, 每行输出一个未签名的交易
+func createTicketsTxs(cmd *cobra.Command, sign int64) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	consignor, _ := cmd.Flags().GetString("consignor")
+	consignees, _ := cmd.Flags().GetStringSlice("consignees")
+	count, _ := cmd.Flags().GetInt64("count")
+	if count <= 0 {
+		fmt.Fprintln(os.Stderr, "ticket count must > 0")
+		return
+	}
+
+	c, err := newStakingClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	info, err := c.info()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	for _, consignee := range consignees {
+		entrust := &ty.Pos33Entrust{
+			Consignor: consignor,
+			Consignee: consignee,
+			Amount:    sign * count * info.Price,
+		}
+		act := &ty.Pos33TicketAction{
+			Ty:    ty.Pos33ActionEntrust,
+			Value: &ty.Pos33TicketAction_Entrust{Entrust: entrust},
+		}
+		rawTx := &types.Transaction{Payload: types.Encode(act)}
+		tx, err := types.FormatTxExt(c.cfg.ChainID, len(paraName) > 0, c.cfg.MinTxFeeRate, ty.Pos33TicketX, rawTx)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		fmt.Println(hex.EncodeToString(types.Encode(tx)))
+	}
+}
+
+// MyTicketsCmd 查询地址委托的票数, 作为矿工的票数和新的票数开始抽签的高度
+func MyTicketsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tickets",
+		Short: "get the ticket count of an address and the height new tickets take part in sortition",
+		Run:   myTickets,
+	}
+	cmd.Flags().StringP("addr", "a", "", "consignor or miner address")
+	cmd.MarkFlagRequired("addr")
+	return cmd
+}
+
+// EntrustTickets 委托给一个矿工的票数
+type EntrustTickets struct {
+	Consignee string
+	Amount    string
+	Count     int64
+}
+
+// MyTickets 地址的票数.
+// SortCount是当前高度抽签使用的票数, 和Count不同时差额在MatureHeight开始抽签
+type MyTickets struct {
+	Address      string
+	Height       int64
+	Entrusts     []*EntrustTickets
+	Count        int64
+	SortCount    int64
+	MatureHeight int64
+}
+
+func myTickets(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+
+	c, err := newStakingClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	info, err := c.info()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	h, err := c.lastHeader()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	res := &MyTickets{Address: addr, Height: h.Height}
+	// 没有委托或者不是矿工时查询出错, 对应的部分为空
+	if cr, err := c.consignor(addr); err == nil {
+		for _, e := range cr.Consignees {
+			res.Entrusts = append(res.Entrusts, &EntrustTickets{Consignee: e.Address, Amount: c.amount(e.Amount), Count: e.Amount / info.Price})
+		}
+	}
+	if ce, err := c.consignee(addr); err == nil {
+		res.Count = ce.Amount / info.Price
+	}
+	res.SortCount, _, err = c.sortCount(addr, h.Height)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	if res.Count != res.SortCount {
+		res.MatureHeight = h.Height + c.sortBlocks(h.Height)
+	}
+	printJSON(res)
+}
+
+// EstimateCmd 按当前的总票数和难度估算每天出块和投票的期望
+func EstimateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate",
+		Short: "estimate expected blocks and votes per day with the current total tickets and diff",
+		Run:   estimate,
+	}
+	cmd.Flags().StringP("addr", "a", "", "miner address, use its sortition ticket count")
+	cmd.Flags().Int64P("count", "n", 0, "ticket count, used when addr is empty")
+	cmd.Flags().Int64P("blocks", "b", 1000, "recent blocks to measure the block time")
+	return cmd
+}
+
+// Pos33Estimate 每天的期望, 出块人在每一轮的出块人抽签里选出, 概率近似为票数占总票数的比例
+type Pos33Estimate struct {
+	Height       int64
+	Count        int64
+	AllCount     int64
+	Diff         float64
+	BlockTime    float64
+	BlocksPerDay float64
+	MakePerDay   float64
+	SeatsMean    float64
+	SeatsStddev  float64
+	VotesPerDay  float64
+}
+
+func estimate(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+	count, _ := cmd.Flags().GetInt64("count")
+	blocks, _ := cmd.Flags().GetInt64("blocks")
+	if addr == "" && count <= 0 {
+		fmt.Fprintln(os.Stderr, "addr or count must be set")
+		return
+	}
+
+	c, err := newStakingClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	bt, last, err := c.blockTime(blocks)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var d ty.Pos33BlockDiff
+	err = c.rpc.Call("pos33.GetBlockDiff", &types.ReqInt{Height: last}, &d)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	if addr != "" {
+		count, _, err = c.sortCount(addr, last)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+	}
+	var ss ty.Pos33SeatsStats
+	err = c.rpc.Call("pos33.GetSeatsStats", &ty.ReqPos33Seats{Count: count, Diff: d.Diff}, &ss)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	res := &Pos33Estimate{
+		Height:       last,
+		Count:        count,
+		AllCount:     d.AllCount,
+		Diff:         d.Diff,
+		BlockTime:    bt,
+		BlocksPerDay: secondsPerDay / bt,
+		SeatsMean:    ss.Mean,
+		SeatsStddev:  ss.Stddev,
+	}
+	if d.AllCount > 0 {
+		res.MakePerDay = res.BlocksPerDay * float64(count) / float64(d.AllCount)
+	}
+	res.VotesPerDay = res.BlocksPerDay * ss.Mean
+	printJSON(res)
+}
+
+// blockTime 最近blocks个区块的平均出块时间(秒), 返回最新的高度
+func (c *stakingClient) blockTime(blocks int64) (float64, int64, error) {
+	h, err := c.lastHeader()
+	if err != nil {
+		return 0, 0, err
+	}
+	if blocks > h.Height {
+		blocks = h.Height
+	}
+	if blocks <= 0 {
+		return 0, 0, errors.New("too few blocks to measure the block time")
+	}
+	start, err := c.header(h.Height - blocks)
+	if err != nil {
+		return 0, 0, err
+	}
+	if h.BlockTime <= start.BlockTime {
+		return 0, 0, fmt.Errorf("block time error: %d <= %d", h.BlockTime, start.BlockTime)
+	}
+	return float64(h.BlockTime-start.BlockTime) / float64(blocks), h.Height, nil
+}
+
+// PendingRewardsCmd 查询还没有转给地址的奖励
+func PendingRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending",
+		Short: "get rewards not transferred to an address yet",
+		Run:   pendingRewards,
+	}
+	cmd.Flags().StringP("addr", "a", "", "consignor or miner address")
+	cmd.MarkFlagRequired("addr")
+	return cmd
+}
+
+// PendingReward 委托给一个矿工的待转奖励, 已经扣除了矿工的抽成
+type PendingReward struct {
+	Consignee string
+	Reward    string
+}
+
+// PendingRewards 地址的待转奖励. 奖励累计到一定数量以后才转账, FeeReward是作为矿工的抽成
+type PendingRewards struct {
+	Address   string
+	Rewards   []*PendingReward
+	FeeReward string
+	Total     string
+}
+
+func pendingRewards(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+
+	c, err := newStakingClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	res := &PendingRewards{Address: addr}
+	total := int64(0)
+	if cr, err := c.consignor(addr); err == nil {
+		for _, e := range cr.Consignees {
+			ce, err := c.consignee(e.Address)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				return
+			}
+			for _, o := range ce.Consignors {
+				if o.Address != addr {
+					continue
+				}
+				// 和转账时一样先扣除矿工的抽成
+				r := o.RemainReward - o.RemainReward*ce.FeePersent/100
+				res.Rewards = append(res.Rewards, &PendingReward{Consignee: e.Address, Reward: c.amount(r)})
+				total += r
+			}
+		}
+	}
+	fee := int64(0)
+	if ce, err := c.consignee(addr); err == nil {
+		fee = ce.RemainFeeReward
+	}
+	res.FeeReward = c.amount(fee)
+	res.Total = c.amount(total + fee)
+	printJSON(res)
+}
+
+// BindKeyCmd 离线创建矿工绑定bls地址的交易, 交易需要矿工的私钥签名
+func BindKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bind",
+		Short: "create a tx to bind the bls address derived from a miner private key",
+		Run:   bindKey,
+	}
+	cmd.Flags().StringP("key", "k", "", "miner private key (hex)")
+	cmd.MarkFlagRequired("key")
+	return cmd
+}
+
+func bindKey(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	key, _ := cmd.Flags().GetString("key")
+
+	cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	priv := HexToPrivkey(key)
+	blsPk := ty.Hash2BlsSk(crypto.Sha256(priv.Bytes())).PubKey()
+	act := &ty.Pos33TicketAction{
+		Ty:    ty.Pos33ActionBlsBind,
+		Value: &ty.Pos33TicketAction_BlsBind{BlsBind: &ty.Pos33BlsBind{BlsAddr: address.PubKeyToAddr(ty.EthAddrID, blsPk.Bytes())}},
+	}
+	rawTx := &types.Transaction{Payload: types.Encode(act)}
+	tx, err := types.FormatTxExt(cfg.ChainID, len(paraName) > 0, cfg.MinTxFeeRate, ty.Pos33TicketX, rawTx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(hex.EncodeToString(types.Encode(tx)))
+}
@@ -5,9 +5,11 @@
 package commands
 
 import (
+	"bytes"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
 
 	"github.com/33cn/chain33/common"
@@ -19,6 +21,7 @@ import (
 	"github.com/33cn/chain33/types"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/yccproject/ycc/plugin/dapp/pos33/light"
 	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
 )
 
@@ -37,6 +40,41 @@ func Pos33TicketCmd() *cobra.Command {
 		BlsBind(),
 		BlsAddr(),
 		GetMinerList(),
+		GetValidatorSetCmd(),
+		DiffValidatorSetCmd(),
+		GetSnapshotCmd(),
+		GetReadinessCmd(),
+		GetPeerScoresCmd(),
+		GetRewardsCmd(),
+		GetManifestCmd(),
+		GetCurrentRoundCmd(),
+		GetGrindingCmd(),
+		SetChainParamCmd(),
+		GetChainParamsCmd(),
+		ReplayAuditCmd(),
+		ExportCheckpointCmd(),
+		ImportCheckpointCmd(),
+		CommitteeAtCmd(),
+		DelegateCmd(),
+		UndelegateCmd(),
+		GetOperatorCmd(),
+		SetFeeRateCmd(),
+		JailCmd(),
+		UnjailCmd(),
+		GetLivenessCmd(),
+		GetSortRecordCmd(),
+		GetMakerBlocksCmd(),
+		GetSortStatsCmd(),
+		GetTicketPriceCmd(),
+		KeyRotateCmd(),
+		GetKeyOwnerCmd(),
+		GetFinalityProofCmd(),
+		BuyTicketsCmd(),
+		SellTicketsCmd(),
+		MyTicketsCmd(),
+		EstimateCmd(),
+		PendingRewardsCmd(),
+		BindKeyCmd(),
 	)
 
 	return cmd
@@ -89,6 +127,194 @@ func GetMinerList() *cobra.Command {
 	return cmd
 }
 
+// GetGrindingCmd 离线分析抽签结果的自相关, score远大于3的地址值得进一步检查
+func GetGrindingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grinding",
+		Short: "score addresses for sortition grinding over a height range",
+		Run:   getGrinding,
+	}
+	cmd.Flags().Int64P("start", "s", 1, "start height")
+	cmd.Flags().Int64P("end", "e", 0, "end height")
+	cmd.MarkFlagRequired("end")
+	cmd.Flags().Int64P("lag", "l", 0, "height lag from the producer to the sortition it seeds, 0 is the sort blocks")
+	return cmd
+}
+
+func getGrinding(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	start, _ := cmd.Flags().GetInt64("start")
+	end, _ := cmd.Flags().GetInt64("end")
+	lag, _ := cmd.Flags().GetInt64("lag")
+	var res ty.Pos33GrindingReport
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetGrindingScores", &ty.ReqPos33Grinding{Start: start, End: end, Lag: lag}, &res)
+	ctx.Run()
+}
+
+// ReplayAuditCmd 用节点审计日志里记录的输入重新验证height高度的抽签, 打印不一致的结果
+func ReplayAuditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "re-run sort verification of a height from the node audit log and print divergences",
+		Run:   replayAudit,
+	}
+	cmd.Flags().Int64P("height", "g", 0, "block height")
+	cmd.MarkFlagRequired("height")
+	return cmd
+}
+
+func replayAudit(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	height, _ := cmd.Flags().GetInt64("height")
+	var res ty.Pos33AuditReplay
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.ReplayAudit", &types.ReqInt{Height: height}, &res)
+	ctx.Run()
+}
+
+// ExportCheckpointCmd 导出快速同步的检查点, 可以合并其他验证者导出的相同检查点的签名
+func ExportCheckpointCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cpexport",
+		Short: "export a fast sync checkpoint bundle of a height",
+		Run:   exportCheckpoint,
+	}
+	cmd.Flags().Int64P("height", "g", 0, "block height, 0 for the current height")
+	cmd.Flags().BoolP("sign", "s", false, "sign the checkpoint with the mining key")
+	cmd.Flags().StringP("merge", "m", "", "bundle file of the same checkpoint to merge signatures from")
+	cmd.Flags().StringP("out", "o", "", "output file")
+	cmd.MarkFlagRequired("out")
+	return cmd
+}
+
+func readCheckpoint(file string) (*ty.Pos33Checkpoint, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var c ty.Pos33Checkpoint
+	err = types.JSONToPB(data, &c)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func exportCheckpoint(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	height, _ := cmd.Flags().GetInt64("height")
+	sign, _ := cmd.Flags().GetBool("sign")
+	merge, _ := cmd.Flags().GetString("merge")
+	out, _ := cmd.Flags().GetString("out")
+
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var res ty.Pos33Checkpoint
+	err = rpc.Call("pos33.GetCheckpoint", &ty.ReqPos33Checkpoint{Height: height, Sign: sign}, &res)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	if merge != "" {
+		m, err := readCheckpoint(merge)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		if !bytes.Equal(m.Hash, res.Hash) {
+			fmt.Fprintln(os.Stderr, "checkpoint NOT same, can't merge signatures")
+			return
+		}
+		for _, sig := range m.Sigs {
+			found := false
+			for _, s := range res.Sigs {
+				if bytes.Equal(s.Pubkey, sig.Pubkey) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				res.Sigs = append(res.Sigs, sig)
+			}
+		}
+	}
+	data, err := types.PBToJSON(&res)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	err = ioutil.WriteFile(out, data, 0666)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println("height:", res.Height, "block:", common.ToHex(res.BlockHash), "sigs:", len(res.Sigs))
+}
+
+// ImportCheckpointCmd 导入快速同步的检查点
+func ImportCheckpointCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cpimport",
+		Short: "import a fast sync checkpoint bundle exported by cpexport",
+		Run:   importCheckpoint,
+	}
+	cmd.Flags().StringP("file", "f", "", "bundle file")
+	cmd.MarkFlagRequired("file")
+	return cmd
+}
+
+func importCheckpoint(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	file, _ := cmd.Flags().GetString("file")
+	c, err := readCheckpoint(file)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var res types.Reply
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.ImportCheckpoint", c, &res)
+	ctx.Run()
+}
+
+// CommitteeAtCmd 查询一个高度的委员会成员和投票情况
+func CommitteeAtCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "committee",
+		Short: "get the committee members and their votes of a height",
+		Run:   committeeAt,
+	}
+	cmd.Flags().Int64P("height", "g", 0, "block height")
+	cmd.MarkFlagRequired("height")
+	return cmd
+}
+
+func committeeAt(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	height, _ := cmd.Flags().GetInt64("height")
+	var res ty.Pos33CommitteeAt
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.CommitteeAt", &types.ReqInt{Height: height}, &res)
+	ctx.Run()
+}
+
+// GetCurrentRoundCmd 节点正在处理的高度和轮次
+func GetCurrentRoundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "round",
+		Short: "get the height and round the node is processing",
+		Run:   getCurrentRound,
+	}
+	return cmd
+}
+
+func getCurrentRound(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	var res ty.Pos33CurrentRound
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetCurrentRound", &types.ReqNil{}, &res)
+	ctx.Run()
+}
+
 func getMinerList(cmd *cobra.Command, args []string) {
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
 	var res []string
@@ -96,6 +322,279 @@ func getMinerList(cmd *cobra.Command, args []string) {
 	ctx.Run()
 }
 
+func GetValidatorSetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vset",
+		Short: "export the validator set used by sortition at height",
+		Run:   getValidatorSet,
+	}
+	cmd.Flags().Int64P("height", "g", 0, "block height")
+	cmd.MarkFlagRequired("height")
+	return cmd
+}
+
+func getValidatorSet(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	height, _ := cmd.Flags().GetInt64("height")
+
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var res ty.Pos33ValidatorSet
+	err = rpc.Call("pos33.GetValidatorSet", &types.ReqInt{Height: height}, &res)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	data, err := types.PBToJSON(&res)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var buf bytes.Buffer
+	err = json.Indent(&buf, data, "", "    ")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(buf.String())
+}
+
+// GetReadinessCmd 节点没有就绪时退出码为1, 可以用作k8s的readiness probe
+func GetReadinessCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ready",
+		Short: "check whether the node is ready to participate in consensus",
+		Run:   getReadiness,
+	}
+	return cmd
+}
+
+func getReadiness(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	var res ty.Pos33Readiness
+	err = rpc.Call("pos33.GetReadiness", &types.ReqNil{}, &res)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	data, err := json.MarshalIndent(&res, "", "    ")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Println(string(data))
+	if !res.Ready {
+		os.Exit(1)
+	}
+}
+
+// GetPeerScoresCmd 查看转发共识消息的节点的计分, 哪些节点被暂时禁止
+func GetPeerScoresCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "peers",
+		Short: "get the scores and bans of peers relaying consensus messages",
+		Run:   getPeerScores,
+	}
+	return cmd
+}
+
+func getPeerScores(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	var res ty.Pos33PeerScores
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetPeerScores", &types.ReqNil{}, &res)
+	ctx.Run()
+}
+
+// GetManifestCmd 导出共识参数清单, 比较hash确认节点的共识配置相同
+func GetManifestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "manifest",
+		Short: "export the consensus config manifest",
+		Run:   getManifest,
+	}
+	cmd.Flags().Int64P("height", "t", 0, "height, 0 is the current height")
+	cmd.Flags().BoolP("sign", "s", false, "sign the manifest with the mining key")
+	return cmd
+}
+
+func getManifest(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	height, _ := cmd.Flags().GetInt64("height")
+	sign, _ := cmd.Flags().GetBool("sign")
+
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var res ty.Pos33ConfigManifest
+	err = rpc.Call("pos33.GetConfigManifest", &ty.ReqPos33Manifest{Height: height, Sign: sign}, &res)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	data, err := types.PBToJSON(&res)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(string(data))
+}
+
+// GetRewardsCmd 统计地址在一段高度内的奖励, 范围太大时分多次查询后合计
+func GetRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards",
+		Short: "get the rewards of an address over a height range",
+		Run:   getRewards,
+	}
+	cmd.Flags().StringP("addr", "a", "", "miner address")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().Int64P("start", "s", 1, "start height")
+	cmd.Flags().Int64P("end", "e", 0, "end height")
+	cmd.MarkFlagRequired("end")
+	return cmd
+}
+
+func getRewards(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+	start, _ := cmd.Flags().GetInt64("start")
+	end, _ := cmd.Flags().GetInt64("end")
+
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	all := &ty.Pos33Rewards{Addr: addr, Start: start, End: end}
+	for req := (&ty.ReqPos33Rewards{Addr: addr, Start: start, End: end}); ; {
+		var res ty.Pos33Rewards
+		err = rpc.Call("pos33.GetRewards", req, &res)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+		all.Seats += res.Seats
+		all.Blocks += res.Blocks
+		all.VoteReward += res.VoteReward
+		all.MineReward += res.MineReward
+		all.Total += res.Total
+		all.Segments = append(all.Segments, res.Segments...)
+		if res.Next == 0 {
+			break
+		}
+		req.Start = res.Next
+	}
+	data, err := json.MarshalIndent(all, "", "    ")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(string(data))
+}
+
+func GetSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "export the consensus cache snapshot for fast sync",
+		Run:   getSnapshot,
+	}
+	cmd.Flags().StringP("out", "o", "", "output file")
+	cmd.MarkFlagRequired("out")
+	return cmd
+}
+
+func getSnapshot(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	out, _ := cmd.Flags().GetString("out")
+
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var res ty.Pos33ConsensusSnapshot
+	err = rpc.Call("pos33.GetConsensusSnapshot", &types.ReqNil{}, &res)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	data, err := types.PBToJSON(&res)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	err = ioutil.WriteFile(out, data, 0666)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println("height:", res.Height, "hash:", common.ToHex(res.Hash))
+}
+
+func DiffValidatorSetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vdiff",
+		Short: "diff two validator set files exported by vset",
+		Run:   diffValidatorSet,
+	}
+	cmd.Flags().StringP("a", "a", "", "validator set file a")
+	cmd.Flags().StringP("b", "b", "", "validator set file b")
+	cmd.MarkFlagRequired("a")
+	cmd.MarkFlagRequired("b")
+	return cmd
+}
+
+func readValidatorSet(file string) (*ty.Pos33ValidatorSet, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	vs := new(ty.Pos33ValidatorSet)
+	err = types.JSONToPB(data, vs)
+	if err != nil {
+		return nil, err
+	}
+	if string(vs.Hash) != string(vs.CalcHash()) {
+		return nil, fmt.Errorf("%s: hash NOT match the content", file)
+	}
+	return vs, nil
+}
+
+func diffValidatorSet(cmd *cobra.Command, args []string) {
+	fa, _ := cmd.Flags().GetString("a")
+	fb, _ := cmd.Flags().GetString("b")
+	a, err := readValidatorSet(fa)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	b, err := readValidatorSet(fb)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	if string(a.Hash) == string(b.Hash) {
+		fmt.Println("same validator set, hash", common.ToHex(a.Hash))
+		return
+	}
+	fmt.Println("a hash", common.ToHex(a.Hash))
+	fmt.Println("b hash", common.ToHex(b.Hash))
+	for _, d := range ty.DiffPos33ValidatorSet(a, b) {
+		fmt.Println(d)
+	}
+}
+
 func GetPos33Info() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "info",
@@ -142,7 +641,7 @@ func getPos33Info(cmd *cobra.Command, args []string) {
 func SetFeeRateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "fee",
-		Short: "set miner fee",
+		Short: "set miner commission persent on delegators reward",
 		Run:   setMinerFeeRate,
 	}
 	addSetMinerFeeRateFlags(cmd)
@@ -151,7 +650,7 @@ func SetFeeRateCmd() *cobra.Command {
 
 func addSetMinerFeeRateFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("addr", "a", "", "address for miner")
-	cmd.Flags().Int32P("fee", "f", 10, "miner entrust mine fee rate persent (default 10% if nil)")
+	cmd.Flags().Int32P("fee", "f", 10, "miner entrust mine fee rate persent, 0 is allowed after ForkDelegate")
 	cmd.MarkFlagRequired("fee")
 }
 
@@ -245,6 +744,483 @@ func setEntrust(cmd *cobra.Command, args []string) {
 	fmt.Println(hex.EncodeToString(txHex))
 }
 
+// DelegateCmd 创建委托交易, 把币委托给operator挖矿
+func DelegateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate",
+		Short: "create a tx to delegate coins to a miner",
+		Run:   delegate,
+	}
+	addDelegateFlags(cmd)
+	return cmd
+}
+
+func addDelegateFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("operator", "o", "", "miner address to delegate to")
+	cmd.Flags().Float64P("amount", "a", 0, "amount of coins")
+	cmd.MarkFlagRequired("operator")
+	cmd.MarkFlagRequired("amount")
+}
+
+func delegate(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	operator, _ := cmd.Flags().GetString("operator")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+
+	realAmount, err := delegateAmount(rpcLaddr, amount)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	d := &ty.Pos33Delegate{Operator: operator, Amount: realAmount}
+	err = d.Check()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var res ty.ReplyTxHex
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.CreateDelegate", d, &res)
+	ctx.Run()
+}
+
+// UndelegateCmd 创建取回委托的交易
+func UndelegateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegate",
+		Short: "create a tx to take back coins delegated to a miner",
+		Run:   undelegate,
+	}
+	addDelegateFlags(cmd)
+	return cmd
+}
+
+func undelegate(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	operator, _ := cmd.Flags().GetString("operator")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+
+	realAmount, err := delegateAmount(rpcLaddr, amount)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	u := &ty.Pos33Undelegate{Operator: operator, Amount: realAmount}
+	err = u.Check()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var res ty.ReplyTxHex
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.CreateUndelegate", u, &res)
+	ctx.Run()
+}
+
+func delegateAmount(rpcLaddr string, amount float64) (int64, error) {
+	cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
+	if err != nil {
+		return 0, errors.Wrapf(err, "GetChainConfig")
+	}
+	return types.FormatFloatDisplay2Value(amount, cfg.CoinPrecision)
+}
+
+// GetOperatorCmd 查询矿工的委托情况和票数
+func GetOperatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "operator",
+		Short: "get the delegation summary of a miner",
+		Run:   getOperator,
+	}
+	cmd.Flags().StringP("addr", "a", "", "miner address")
+	cmd.MarkFlagRequired("addr")
+	return cmd
+}
+
+type Pos33OperatorInfo struct {
+	Address    string
+	Amount     string
+	SelfAmount string
+	Delegated  string
+	Count      int64
+	Commission string
+	Delegators int32
+}
+
+func parseOperatorRes(arg ...interface{}) (interface{}, error) {
+	res := arg[0].(*ty.Pos33OperatorInfo)
+	cfg := arg[1].(*rpctypes.ChainConfigInfo)
+	return &Pos33OperatorInfo{
+		Address:    res.Address,
+		Amount:     types.FormatAmount2FloatDisplay(res.Amount, cfg.CoinPrecision, false),
+		SelfAmount: types.FormatAmount2FloatDisplay(res.SelfAmount, cfg.CoinPrecision, false),
+		Delegated:  types.FormatAmount2FloatDisplay(res.Delegated, cfg.CoinPrecision, false),
+		Count:      res.Count,
+		Commission: fmt.Sprintf("%d%%", res.Commission),
+		Delegators: res.Delegators,
+	}, nil
+}
+
+func getOperator(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+
+	var res ty.Pos33OperatorInfo
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetOperator", &types.ReqAddr{Addr: addr}, &res)
+	ctx.SetResultCbExt(parseOperatorRes)
+	cfg, err := cmdtypes.GetChainConfig(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	ctx.RunExt(cfg)
+}
+
+// JailCmd 创建关押不在线矿工的交易
+func JailCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "jail",
+		Short: "create a tx to jail miners that missed too many blocks",
+		Run:   jail,
+	}
+	cmd.Flags().StringSliceP("addrs", "a", nil, "miner addresses, separated by ','")
+	cmd.MarkFlagRequired("addrs")
+	return cmd
+}
+
+func jail(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addrs, _ := cmd.Flags().GetStringSlice("addrs")
+
+	var res ty.ReplyTxHex
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.CreateJail", &ty.Pos33Jail{Addrs: addrs}, &res)
+	ctx.Run()
+}
+
+// UnjailCmd 创建解除关押的交易, 由被关押的矿工签名
+func UnjailCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unjail",
+		Short: "create a tx to unjail the signer",
+		Run:   unjail,
+	}
+	return cmd
+}
+
+func unjail(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	var res ty.ReplyTxHex
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.CreateUnjail", &ty.Pos33Unjail{}, &res)
+	ctx.Run()
+}
+
+// GetLivenessCmd 查询矿工的参与记录和关押状态
+func GetLivenessCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liveness",
+		Short: "get the last active height and jail status of a miner",
+		Run:   getLiveness,
+	}
+	cmd.Flags().StringP("addr", "a", "", "miner address")
+	cmd.MarkFlagRequired("addr")
+	return cmd
+}
+
+func getLiveness(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+	var res ty.Pos33Liveness
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetLiveness", &types.ReqAddr{Addr: addr}, &res)
+	ctx.Run()
+}
+
+// GetSortRecordCmd 查询抽签统计索引里一个高度的记录, 需要打开exec.sub.pos33.enableSortIndex
+func GetSortRecordCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sortrec",
+		Short: "get the indexed sortition record of a height",
+		Run:   getSortRecord,
+	}
+	cmd.Flags().Int64P("height", "g", 0, "block height")
+	cmd.MarkFlagRequired("height")
+	return cmd
+}
+
+func getSortRecord(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	height, _ := cmd.Flags().GetInt64("height")
+	var res ty.Pos33SortRecord
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetSortRecord", &types.ReqInt{Height: height}, &res)
+	ctx.Run()
+}
+
+// GetMakerBlocksCmd 查询地址在一段高度内出的区块
+func GetMakerBlocksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "made",
+		Short: "get the blocks made by an address in a range of heights",
+		Run:   getMakerBlocks,
+	}
+	cmd.Flags().StringP("addr", "a", "", "miner address")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().Int64P("start", "s", 0, "start height")
+	cmd.Flags().Int64P("end", "e", 0, "end height, 0 for the latest")
+	cmd.Flags().Int32P("count", "c", 0, "max blocks returned, 0 for 1000")
+	return cmd
+}
+
+func getMakerBlocks(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+	start, _ := cmd.Flags().GetInt64("start")
+	end, _ := cmd.Flags().GetInt64("end")
+	count, _ := cmd.Flags().GetInt32("count")
+	var res ty.Pos33MakerBlocks
+	req := &ty.ReqPos33MakerBlocks{Addr: addr, Start: start, End: end, Count: count}
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetMakerBlocks", req, &res)
+	ctx.Run()
+}
+
+// GetSortStatsCmd 查询一段高度内的平均委员会大小和出块轮次的分布
+func GetSortStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sortstats",
+		Short: "get the average committee size and round distribution in a range of heights",
+		Run:   getSortStats,
+	}
+	cmd.Flags().Int64P("start", "s", 0, "start height")
+	cmd.Flags().Int64P("end", "e", 0, "end height, 0 for the latest")
+	cmd.Flags().Int64P("last", "l", 0, "count the last blocks before end instead of from start")
+	return cmd
+}
+
+func getSortStats(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	start, _ := cmd.Flags().GetInt64("start")
+	end, _ := cmd.Flags().GetInt64("end")
+	last, _ := cmd.Flags().GetInt64("last")
+	var res ty.Pos33SortStats
+	req := &ty.ReqPos33SortStats{Start: start, End: end, Last: last}
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetSortStats", req, &res)
+	ctx.Run()
+}
+
+// GetTicketPriceCmd 查询当前的票价和下一个周期的票价
+func GetTicketPriceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price",
+		Short: "get the current ticket price and the price of the next epoch",
+		Run:   getTicketPrice,
+	}
+	return cmd
+}
+
+func getTicketPrice(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	var res ty.Pos33TicketPriceInfo
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetTicketPrice", &types.ReqNil{}, &res)
+	ctx.Run()
+}
+
+// KeyRotateCmd 创建换共识公钥的交易, 用新的私钥签名绑定, 交易由staker签名
+func KeyRotateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate",
+		Short: "create a tx to rotate the consensus key of a staker",
+		Run:   keyRotate,
+	}
+	cmd.Flags().StringP("staker", "s", "", "staking address")
+	cmd.MarkFlagRequired("staker")
+	cmd.Flags().StringP("key", "k", "", "new consensus private key (hex)")
+	cmd.MarkFlagRequired("key")
+	cmd.Flags().Int64P("height", "t", 0, "height the new key takes effect")
+	cmd.MarkFlagRequired("height")
+	return cmd
+}
+
+func keyRotate(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	staker, _ := cmd.Flags().GetString("staker")
+	key, _ := cmd.Flags().GetString("key")
+	height, _ := cmd.Flags().GetInt64("height")
+
+	k := &ty.Pos33KeyRotate{Staker: staker, Height: height}
+	k.Sign(HexToPrivkey(key))
+	var res ty.ReplyTxHex
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.CreateKeyRotate", k, &res)
+	ctx.Run()
+}
+
+// GetKeyOwnerCmd 查询共识公钥绑定的staker
+func GetKeyOwnerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "keyowner",
+		Short: "get the staker bound to a consensus pubkey",
+		Run:   getKeyOwner,
+	}
+	cmd.Flags().StringP("pubkey", "p", "", "consensus pubkey (hex)")
+	cmd.MarkFlagRequired("pubkey")
+	cmd.Flags().StringP("addr", "a", "", "address of the pubkey, check whether its own key is rotated")
+	return cmd
+}
+
+func getKeyOwner(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	pubkey, _ := cmd.Flags().GetString("pubkey")
+	addr, _ := cmd.Flags().GetString("addr")
+	pk, err := common.FromHex(pubkey)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var res ty.Pos33KeyOwner
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetKeyOwner", &ty.ReqPos33KeyOwner{Pubkey: pk, Addr: addr}, &res)
+	ctx.Run()
+}
+
+// GetFinalityProofCmd 查询区块的最终性证明, 给出可信的anchor区块hash时在本地验证
+func GetFinalityProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality",
+		Short: "get the finality proof of a block, verify it with a trusted anchor block hash",
+		Run:   getFinalityProof,
+	}
+	cmd.Flags().Int64P("height", "g", 0, "block height")
+	cmd.MarkFlagRequired("height")
+	cmd.Flags().StringP("trusted", "c", "", "trusted hash (hex) of the block after the snapshot height, empty only print the proof")
+	cmd.Flags().StringP("owner_rpc", "o", "", "trusted node to query the owners of the bls keys, required to verify")
+	cmd.Flags().Int64P("fork", "f", light.DefaultBlockHashFork, "ForkBlockHash height of the chain")
+	return cmd
+}
+
+// blsOwnerFrom 从节点rpcLaddr查询bls公钥绑定的矿工地址
+func blsOwnerFrom(rpcLaddr string) func(pk []byte) (string, error) {
+	return func(pk []byte) (string, error) {
+		cli, err := jsonclient.NewJSONClient(rpcLaddr)
+		if err != nil {
+			return "", err
+		}
+		params := rpctypes.Query4Jrpc{
+			Execer:   ty.Pos33TicketX,
+			FuncName: "Pos33BlsAddr",
+			Payload:  types.MustPBToJSON(&types.ReqAddr{Addr: address.PubKeyToAddr(ty.EthAddrID, pk)}),
+		}
+		var res types.ReplyString
+		err = cli.Call("Chain33.Query", params, &res)
+		if err != nil {
+			return "", err
+		}
+		return res.Data, nil
+	}
+}
+
+func getFinalityProof(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	height, _ := cmd.Flags().GetInt64("height")
+	trusted, _ := cmd.Flags().GetString("trusted")
+	ownerRPC, _ := cmd.Flags().GetString("owner_rpc")
+	fork, _ := cmd.Flags().GetInt64("fork")
+	if trusted == "" {
+		var res ty.Pos33FinalityProof
+		ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetFinalityProof", &types.ReqInt{Height: height}, &res)
+		ctx.Run()
+		return
+	}
+	if ownerRPC == "" {
+		fmt.Fprintln(os.Stderr, "owner_rpc is required to verify the proof")
+		return
+	}
+	hash, err := common.FromHex(trusted)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var res json.RawMessage
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.GetFinalityProof", &types.ReqInt{Height: height}, &res)
+	_, err = ctx.RunResult()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var p ty.Pos33FinalityProof
+	err = types.JSONToPB(res, &p)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	v := light.NewVerifier()
+	v.BlockHashFork = fork
+	v.BlsOwner = blsOwnerFrom(ownerRPC)
+	bh, err := v.Verify(&p, hash)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println("block", height, common.ToHex(bh), "is final")
+}
+
+// SetChainParamCmd 创建设置共识参数的交易, 需要manage的超级管理员签名发送
+func SetChainParamCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chain_param",
+		Short: "create a tx to set consensus chain params from a height",
+		Run:   setChainParam,
+	}
+	cmd.Flags().Int64P("height", "t", 0, "height the params take effect")
+	cmd.Flags().Int32P("committee", "c", 0, "committee size, 0 is unchanged")
+	cmd.Flags().Int32P("diff", "d", 0, "sortition difficulty persent, 0 is unchanged")
+	cmd.Flags().Int32P("sort_blocks", "b", 0, "sortition seed and snapshot lag in blocks, 0 is unchanged")
+	cmd.MarkFlagRequired("height")
+	return cmd
+}
+
+func setChainParam(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	height, _ := cmd.Flags().GetInt64("height")
+	committee, _ := cmd.Flags().GetInt32("committee")
+	diff, _ := cmd.Flags().GetInt32("diff")
+	sortBlocks, _ := cmd.Flags().GetInt32("sort_blocks")
+
+	p := &ty.Pos33ChainParam{Height: height, CommitteeSize: committee, DiffPersent: diff, SortBlocks: sortBlocks}
+	err := p.Check()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var res ty.ReplyTxHex
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "pos33.SetChainParam", p, &res)
+	ctx.Run()
+}
+
+// GetChainParamsCmd 查询按生效高度排列的共识参数
+func GetChainParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chain_params",
+		Short: "get the consensus chain params schedule",
+		Run:   getChainParams,
+	}
+	return cmd
+}
+
+func getChainParams(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	var res ty.Pos33ChainParams
+	err = rpc.Call("pos33.GetChainParams", &types.ReqNil{}, &res)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	data, err := types.PBToJSON(&res)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(string(data))
+}
+
 func addGetConsignorFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("addr", "a", "", "address for deposit")
 	cmd.MarkFlagRequired("addr")
@@ -0,0 +1,99 @@
+package executor
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// BlsIndexKey 编号为i的bls公钥
+func BlsIndexKey(i int64) []byte {
+	return []byte(fmt.Sprintf("mavl-pos33-blsidx-%d", i))
+}
+
+// BlsIndexOfKey bls地址对应的公钥编号
+func BlsIndexOfKey(blsAddr string) []byte {
+	return append([]byte("mavl-pos33-blsidxof-"), address.FormatAddrKey(blsAddr)...)
+}
+
+// BlsCountKey 已经编号的bls公钥数量
+func BlsCountKey() []byte {
+	return []byte("mavl-pos33-blsidx-count")
+}
+
+func getInt64(db dbm.KV, key []byte) (int64, error) {
+	val, err := db.Get(key)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(string(val), 10, 64)
+}
+
+func getBlsPubkeys(db dbm.KV, indices []int64) ([][]byte, error) {
+	pks := make([][]byte, 0, len(indices))
+	for _, i := range indices {
+		pk, err := db.Get(BlsIndexKey(i))
+		if err != nil {
+			return nil, fmt.Errorf("%w: bls index %d: %v", ty.ErrAggVote, i, err)
+		}
+		pks = append(pks, pk)
+	}
+	return pks, nil
+}
+
+func getBlsIndex(db dbm.KV, blsAddr string) (int64, error) {
+	return getInt64(db, BlsIndexOfKey(blsAddr))
+}
+
+// expandAggVote ForkAggVote以后展开miner里的聚合投票, 之前不能有聚合投票
+func (action *Action) expandAggVote(miner *ty.Pos33MinerMsg) error {
+	if !action.api.GetConfig().IsDappFork(action.height, ty.Pos33TicketX, "ForkAggVote") {
+		if miner.GetAgg() != nil {
+			return fmt.Errorf("%w: agg vote before fork", ty.ErrAggVote)
+		}
+		return nil
+	}
+	return miner.Expand(func(is []int64) ([][]byte, error) { return getBlsPubkeys(action.db, is) })
+}
+
+// registerBls ForkAggVote以后按投票顺序给还没有编号的bls公钥编号, 以后的区块用位图表示.
+// 读取状态出错时返回错误, 否则会从错误的编号开始覆盖已经登记的公钥
+func (action *Action) registerBls(pks [][]byte) ([]*types.KeyValue, error) {
+	if !action.api.GetConfig().IsDappFork(action.height, ty.Pos33TicketX, "ForkAggVote") {
+		return nil, nil
+	}
+	count, err := getInt64(action.db, BlsCountKey())
+	if err != nil && err != types.ErrNotFound {
+		tlog.Error("get bls count error", "err", err, "height", action.height)
+		return nil, err
+	}
+	var kvs []*types.KeyValue
+	added := make(map[string]bool)
+	for _, pk := range pks {
+		addr := address.PubKeyToAddr(ethID, pk)
+		if added[addr] {
+			continue
+		}
+		_, err := getBlsIndex(action.db, addr)
+		if err == nil {
+			continue
+		}
+		if err != types.ErrNotFound {
+			tlog.Error("get bls index error", "err", err, "height", action.height, "addr", addr)
+			return nil, err
+		}
+		added[addr] = true
+		kvs = append(kvs, &types.KeyValue{Key: BlsIndexKey(count), Value: pk})
+		kvs = append(kvs, &types.KeyValue{Key: BlsIndexOfKey(addr), Value: []byte(fmt.Sprintf("%d", count))})
+		count++
+	}
+	if len(kvs) == 0 {
+		return nil, nil
+	}
+	tlog.Debug("bls register", "height", action.height, "new", len(added), "count", count)
+	return append(kvs, &types.KeyValue{Key: BlsCountKey(), Value: []byte(fmt.Sprintf("%d", count))}), nil
+}
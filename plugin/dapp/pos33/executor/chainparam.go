@@ -0,0 +1,58 @@
+package executor
+
+import (
+	"fmt"
+
+	dbm "github.com/33cn/chain33/common/db"
+	manage "github.com/33cn/chain33/system/dapp/manage/executor"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// ChainParamKey 按生效高度排列的共识参数
+func ChainParamKey() []byte {
+	return []byte("mavl-pos33-chain-params")
+}
+
+func getChainParams(db dbm.KV) (*ty.Pos33ChainParams, error) {
+	val, err := db.Get(ChainParamKey())
+	if err == types.ErrNotFound {
+		return &ty.Pos33ChainParams{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	ps := new(ty.Pos33ChainParams)
+	err = types.Decode(val, ps)
+	if err != nil {
+		return nil, err
+	}
+	return ps, nil
+}
+
+// Pos33ChainParam manage的超级管理员设置从p.Height开始生效的共识参数.
+// 生效高度至少在Pos33ChainParamLead个区块以后, 共识节点提前抽签时已经知道新的参数
+func (action *Action) Pos33ChainParam(p *ty.Pos33ChainParam) (*types.Receipt, error) {
+	if !manage.IsSuperManager(action.api.GetConfig(), action.fromaddr) {
+		return nil, fmt.Errorf("%w: not super manager %s", types.ErrNotAllow, action.fromaddr)
+	}
+	err := p.Check()
+	if err != nil {
+		return nil, err
+	}
+	if p.Height < action.height+ty.Pos33ChainParamLead {
+		return nil, fmt.Errorf("%w: height %d < %d", ty.ErrChainParam, p.Height, action.height+ty.Pos33ChainParamLead)
+	}
+	ps, err := getChainParams(action.db)
+	if err != nil {
+		return nil, err
+	}
+	np, err := ps.Add(p)
+	if err != nil {
+		return nil, err
+	}
+	tlog.Info("pos33 chain param", "height", action.height, "active height", np.Height, "committeeSize", np.CommitteeSize, "diffPersent", np.DiffPersent, "sortBlocks", np.SortBlocks)
+	kv := &types.KeyValue{Key: ChainParamKey(), Value: types.Encode(ps)}
+	log := &types.ReceiptLog{Ty: ty.TyLogPos33ChainParam, Log: types.Encode(np)}
+	return &types.Receipt{KV: []*types.KeyValue{kv}, Logs: []*types.ReceiptLog{log}, Ty: types.ExecOk}, nil
+}
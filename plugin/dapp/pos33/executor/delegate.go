@@ -0,0 +1,93 @@
+package executor
+
+import (
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// Pos33Delegate 交易的发送者把币委托给operator, 冻结在合约里, operator的票数增加
+func (action *Action) Pos33Delegate(d *ty.Pos33Delegate) (*types.Receipt, error) {
+	err := d.Check()
+	if err != nil {
+		return nil, err
+	}
+	return action.Pos33Entrust(&ty.Pos33Entrust{Consignee: d.Operator, Consignor: action.fromaddr, Amount: d.Amount})
+}
+
+// Pos33Undelegate 取回委托给operator的币, 还没有转出的奖励扣除抽成以后一起转给委托人
+func (action *Action) Pos33Undelegate(u *ty.Pos33Undelegate) (*types.Receipt, error) {
+	err := u.Check()
+	if err != nil {
+		return nil, err
+	}
+	consignee, err := action.getConsignee(u.Operator)
+	if err != nil {
+		tlog.Error("pos33 undelegate error", "err", err, "height", action.height, "operator", u.Operator)
+		return nil, ty.ErrDelegate
+	}
+	var consignor *ty.Consignor
+	for _, cr := range consignee.Consignors {
+		if cr.Address == action.fromaddr {
+			consignor = cr
+			break
+		}
+	}
+	if consignor == nil || consignor.Amount < u.Amount {
+		return nil, types.ErrAmount
+	}
+
+	var kvs []*types.KeyValue
+	var logs []*types.ReceiptLog
+	if consignor.RemainReward > 0 {
+		fee := consignor.RemainReward * consignee.FeePersent / 100
+		consignee.FeeReward += fee
+		consignee.RemainFeeReward += fee
+		amount := consignor.RemainReward - fee
+		receipt, err := action.coinsAccount.Transfer(action.execaddr, consignor.Address, amount)
+		if err != nil {
+			tlog.Error("undelegate reward transfer error", "to", consignor.Address, "execaddr", action.execaddr, "amount", amount)
+			return nil, err
+		}
+		consignor.RemainReward = 0
+		kvs = append(kvs, receipt.KV...)
+		logs = append(logs, receipt.Logs...)
+		tlog.Debug("undelegate reward transfer to", "addr", consignor.Address, "height", action.height, "amount", amount, "fee", fee)
+	}
+
+	receipt, err := action.addEntrust(consignee, &ty.Pos33Entrust{Consignee: u.Operator, Consignor: action.fromaddr, Amount: -u.Amount})
+	if err != nil {
+		return nil, err
+	}
+	kvs = append(kvs, receipt.KV...)
+	receipt, err = action.freeze(action.fromaddr, -u.Amount)
+	if err != nil {
+		return nil, err
+	}
+	kvs = append(kvs, receipt.KV...)
+	logs = append(logs, receipt.Logs...)
+	return &types.Receipt{KV: kvs, Logs: logs, Ty: types.ExecOk}, nil
+}
+
+// operatorInfo 统计consignee的委托, 票数和共识使用的相同
+func operatorInfo(consignee *ty.Pos33Consignee, price int64) *ty.Pos33OperatorInfo {
+	info := &ty.Pos33OperatorInfo{
+		Address:    consignee.Address,
+		Amount:     consignee.Amount,
+		Commission: consignee.FeePersent,
+	}
+	if price > 0 {
+		info.Count = consignee.Amount / price
+	}
+	for _, cr := range consignee.Consignors {
+		if cr.Amount == 0 {
+			continue
+		}
+		if cr.Address == consignee.Address {
+			info.SelfAmount += cr.Amount
+			continue
+		}
+		info.Delegated += cr.Amount
+		info.Delegators++
+	}
+	return info
+}
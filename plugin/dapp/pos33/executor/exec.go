@@ -53,6 +53,10 @@ import (
 //Exec_Miner exec miner
 func (t *Pos33Ticket) Exec_Miner(payload *ty.Pos33MinerMsg, tx *types.Transaction, index int) (*types.Receipt, error) {
 	actiondb := NewAction(t, tx)
+	err := actiondb.resolveKeyOwner(tx)
+	if err != nil {
+		panic(err)
+	}
 	r, err := actiondb.Pos33MinerNew(payload, index)
 	if err != nil {
 		panic(err)
@@ -85,17 +89,95 @@ func (t *Pos33Ticket) Exec_Entrust(payload *ty.Pos33Entrust, tx *types.Transacti
 // Exec_BlsBind exec bls bind
 func (t *Pos33Ticket) Exec_BlsBind(payload *ty.Pos33BlsBind, tx *types.Transaction, index int) (*types.Receipt, error) {
 	action := NewAction(t, tx)
+	err := action.resolveKeyOwner(tx)
+	if err != nil {
+		return nil, err
+	}
 	return action.Pos33BlsBind(payload)
 }
 
+// Exec_Slash exec slash
+func (t *Pos33Ticket) Exec_Slash(payload *ty.Pos33Slash, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(t, tx)
+	chain33Cfg := action.api.GetConfig()
+	if !chain33Cfg.IsDappFork(action.height, ty.Pos33TicketX, "ForkSlash") {
+		return nil, errors.New("config exec.pos33.ForkSlash error")
+	}
+	return action.Pos33Slash(payload)
+}
+
+// Exec_ChainParam exec set chain param
+func (t *Pos33Ticket) Exec_ChainParam(payload *ty.Pos33ChainParam, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(t, tx)
+	chain33Cfg := action.api.GetConfig()
+	if !chain33Cfg.IsDappFork(action.height, ty.Pos33TicketX, "ForkChainParam") {
+		return nil, errors.New("config exec.pos33.ForkChainParam error")
+	}
+	return action.Pos33ChainParam(payload)
+}
+
+// Exec_Delegate exec delegate
+func (t *Pos33Ticket) Exec_Delegate(payload *ty.Pos33Delegate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(t, tx)
+	chain33Cfg := action.api.GetConfig()
+	if !chain33Cfg.IsDappFork(action.height, ty.Pos33TicketX, "ForkDelegate") {
+		return nil, errors.New("config exec.pos33.ForkDelegate error")
+	}
+	return action.Pos33Delegate(payload)
+}
+
+// Exec_Undelegate exec undelegate
+func (t *Pos33Ticket) Exec_Undelegate(payload *ty.Pos33Undelegate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(t, tx)
+	chain33Cfg := action.api.GetConfig()
+	if !chain33Cfg.IsDappFork(action.height, ty.Pos33TicketX, "ForkDelegate") {
+		return nil, errors.New("config exec.pos33.ForkDelegate error")
+	}
+	return action.Pos33Undelegate(payload)
+}
+
+// Exec_FeeRate exec set miner fee rate, 设置operator从委托人的奖励里抽成的百分比
+func (t *Pos33Ticket) Exec_FeeRate(payload *ty.Pos33MinerFeeRate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(t, tx)
+	chain33Cfg := action.api.GetConfig()
+	if !chain33Cfg.IsDappFork(action.height, ty.Pos33TicketX, "ForkDelegate") {
+		return nil, errors.New("config exec.pos33.ForkDelegate error")
+	}
+	return action.Pos33SetMinerFeeRate(payload)
+}
+
+// Exec_Jail exec jail
+func (t *Pos33Ticket) Exec_Jail(payload *ty.Pos33Jail, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(t, tx)
+	chain33Cfg := action.api.GetConfig()
+	if !chain33Cfg.IsDappFork(action.height, ty.Pos33TicketX, "ForkJail") {
+		return nil, errors.New("config exec.pos33.ForkJail error")
+	}
+	return action.Pos33Jail(payload)
+}
+
+// Exec_Unjail exec unjail
+func (t *Pos33Ticket) Exec_Unjail(payload *ty.Pos33Unjail, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(t, tx)
+	chain33Cfg := action.api.GetConfig()
+	if !chain33Cfg.IsDappFork(action.height, ty.Pos33TicketX, "ForkJail") {
+		return nil, errors.New("config exec.pos33.ForkJail error")
+	}
+	return action.Pos33Unjail(payload)
+}
+
+// Exec_KeyRotate exec key rotate
+func (t *Pos33Ticket) Exec_KeyRotate(payload *ty.Pos33KeyRotate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(t, tx)
+	chain33Cfg := action.api.GetConfig()
+	if !chain33Cfg.IsDappFork(action.height, ty.Pos33TicketX, "ForkKeyRotate") {
+		return nil, errors.New("config exec.pos33.ForkKeyRotate error")
+	}
+	return action.Pos33KeyRotate(payload)
+}
+
 // // Exec_WithdrawReward exec withdraw reward
 // func (t *Pos33Ticket) Exec_Withdraw(payload *ty.Pos33WithdrawReward, tx *types.Transaction, index int) (*types.Receipt, error) {
 // 	action := NewAction(t, tx)
 // 	return action.Pos33WithdrawReward(payload)
 // }
-
-// // Exec_SetMinerFeeRate exec set miner fee rate
-// func (t *Pos33Ticket) Exec_FeeRate(payload *ty.Pos33MinerFeeRate, tx *types.Transaction, index int) (*types.Receipt, error) {
-// 	action := NewAction(t, tx)
-// 	return action.Pos33SetMinerFeeRate(payload)
-// }
@@ -35,11 +35,21 @@ func (t *Pos33Ticket) ExecDelLocal_Tclose(payload *ty.Pos33TicketClose, tx *type
 // ExecDelLocal_Miner exec del local miner
 func (t *Pos33Ticket) ExecDelLocal_Miner(payload *ty.Pos33MinerMsg, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	tlog.Info("ExecDelLocal_Miner", "height", t.GetHeight())
-	return t.execDelLocal(receiptData)
+	dbSet, err := t.execDelLocal(receiptData)
+	if err != nil {
+		return nil, err
+	}
+	dbSet.KV = append(dbSet.KV, t.sortIndexDelLocal(tx)...)
+	return dbSet, nil
 }
 
 // ExecDelLocal_Bind exec del local miner
 func (t *Pos33Ticket) ExecDelLocal_Bind(payload *ty.Pos33TicketBind, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	tlog.Info("ExecDelLocal_Miner", "height", t.GetHeight())
-	return t.execDelLocal(receiptData)
+	dbSet, err := t.execDelLocal(receiptData)
+	if err != nil {
+		return nil, err
+	}
+	dbSet.KV = append(dbSet.KV, t.sortIndexDelLocal(tx)...)
+	return dbSet, nil
 }
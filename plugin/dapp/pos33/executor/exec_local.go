@@ -39,6 +39,7 @@ func (t *Pos33Ticket) ExecLocal_Miner(payload *ty.Pos33MinerMsg, tx *types.Trans
 	if err != nil {
 		return nil, err
 	}
+	dbSet.KV = append(dbSet.KV, t.sortIndexLocal(payload, tx)...)
 	return dbSet, nil
 }
 
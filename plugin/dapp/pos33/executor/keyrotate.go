@@ -0,0 +1,114 @@
+package executor
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// ConsensusKeyKey 共识公钥绑定的staker
+func ConsensusKeyKey(pubkey []byte) []byte {
+	return []byte("mavl-pos33-ckey-" + common.ToHex(pubkey))
+}
+
+// StakerKeyKey staker当前的共识公钥
+func StakerKeyKey(addr string) []byte {
+	return []byte("mavl-pos33-skey-" + string(address.FormatAddrKey(addr)))
+}
+
+func getConsensusKey(db dbm.KV, pubkey []byte) (*ty.Pos33ConsensusKey, error) {
+	val, err := db.Get(ConsensusKeyKey(pubkey))
+	if err != nil {
+		return nil, err
+	}
+	k := new(ty.Pos33ConsensusKey)
+	err = types.Decode(val, k)
+	if err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+func getStakerKey(db dbm.KV, addr string) (*ty.Pos33StakerKey, error) {
+	val, err := db.Get(StakerKeyKey(addr))
+	if err != nil {
+		return nil, err
+	}
+	k := new(ty.Pos33StakerKey)
+	err = types.Decode(val, k)
+	if err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// keyOwner 查询pubkey的绑定和pubkey推导出的地址addr换公钥的记录, 没有记录的为nil
+func keyOwner(db dbm.KV, pubkey []byte, addr string) *ty.Pos33KeyOwner {
+	o := new(ty.Pos33KeyOwner)
+	o.Key, _ = getConsensusKey(db, pubkey)
+	o.Staker, _ = getStakerKey(db, addr)
+	return o
+}
+
+// Pos33KeyRotate staker把共识公钥换成k.Sig里的公钥, 从k.Height高度开始生效.
+// 旧的公钥到k.Height失效, 抵押和委托不变
+func (action *Action) Pos33KeyRotate(k *ty.Pos33KeyRotate) (*types.Receipt, error) {
+	err := k.Check()
+	if err != nil {
+		return nil, err
+	}
+	if k.Staker != action.fromaddr {
+		return nil, types.ErrFromAddr
+	}
+	if k.Height < action.height+ty.Pos33KeyRotateDelay {
+		return nil, fmt.Errorf("%w: height %d must >= %d", ty.ErrKeyRotate, k.Height, action.height+ty.Pos33KeyRotateDelay)
+	}
+	pubkey := k.Sig.Pubkey
+	if _, err := getConsensusKey(action.db, pubkey); err == nil {
+		return nil, fmt.Errorf("%w: pubkey already bound", ty.ErrKeyRotate)
+	}
+
+	var kvs []*types.KeyValue
+	r := &ty.ReceiptPos33KeyRotate{Staker: k.Staker, Pubkey: pubkey, Height: k.Height}
+	sk, err := getStakerKey(action.db, k.Staker)
+	if err == nil {
+		if sk.Height > action.height {
+			return nil, fmt.Errorf("%w: rotation at %d is pending", ty.ErrKeyRotate, sk.Height)
+		}
+		prev, err := getConsensusKey(action.db, sk.Pubkey)
+		if err != nil {
+			tlog.Error("pos33 key rotate error", "err", err, "height", action.height, "staker", k.Staker)
+			return nil, err
+		}
+		prev.Revoke = k.Height
+		kvs = append(kvs, &types.KeyValue{Key: ConsensusKeyKey(prev.Pubkey), Value: types.Encode(prev)})
+		r.PrevPubkey = prev.Pubkey
+	} else {
+		sk = &ty.Pos33StakerKey{Staker: k.Staker, SelfRevoke: k.Height}
+	}
+	sk.Pubkey = pubkey
+	sk.Height = k.Height
+	ck := &ty.Pos33ConsensusKey{Staker: k.Staker, Pubkey: pubkey, Height: k.Height}
+	kvs = append(kvs, &types.KeyValue{Key: ConsensusKeyKey(pubkey), Value: types.Encode(ck)})
+	kvs = append(kvs, &types.KeyValue{Key: StakerKeyKey(k.Staker), Value: types.Encode(sk)})
+	tlog.Info("pos33 key rotate", "height", action.height, "staker", k.Staker, "from", k.Height)
+	logs := []*types.ReceiptLog{{Ty: ty.TyLogPos33KeyRotate, Log: types.Encode(r)}}
+	return &types.Receipt{KV: kvs, Logs: logs, Ty: types.ExecOk}, nil
+}
+
+// resolveKeyOwner ForkKeyRotate以后把共识公钥签名的交易的发送者换成公钥绑定的staker
+func (action *Action) resolveKeyOwner(tx *types.Transaction) error {
+	if !action.api.GetConfig().IsDappFork(action.height, ty.Pos33TicketX, "ForkKeyRotate") {
+		return nil
+	}
+	staker := keyOwner(action.db, tx.GetSignature().GetPubkey(), action.fromaddr).Owner(action.fromaddr, action.height)
+	if staker == "" {
+		return fmt.Errorf("%w: key of %s is rotated", ty.ErrKeyRotate, action.fromaddr)
+	}
+	action.fromaddr = staker
+	return nil
+}
@@ -0,0 +1,125 @@
+package executor
+
+import (
+	"sort"
+
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// LivenessKey 矿工的参与记录
+func LivenessKey(addr string) []byte {
+	return []byte("mavl-pos33-live-" + string(address.FormatAddrKey(addr)))
+}
+
+// getLiveness 返回addr的参与记录, 没有记录的矿工从ForkJail高度开始计算
+func getLiveness(db dbm.KV, cfg *types.Chain33Config, addr string) *ty.Pos33Liveness {
+	val, err := db.Get(LivenessKey(addr))
+	if err == nil {
+		l := new(ty.Pos33Liveness)
+		if types.Decode(val, l) == nil {
+			return l
+		}
+	}
+	return &ty.Pos33Liveness{Address: addr, LastActive: cfg.GetDappFork(ty.Pos33TicketX, "ForkJail")}
+}
+
+func livenessKV(l *ty.Pos33Liveness) *types.KeyValue {
+	return &types.KeyValue{Key: LivenessKey(l.Address), Value: types.Encode(l)}
+}
+
+// markActive ForkJail以后记录区块的出块人和投票人在这个高度参与了共识, mp是地址的投票数
+func (action *Action) markActive(mp map[string]int) []*types.KeyValue {
+	cfg := action.api.GetConfig()
+	if !cfg.IsDappFork(action.height, ty.Pos33TicketX, "ForkJail") {
+		return nil
+	}
+	addrs := make([]string, 0, len(mp))
+	for addr := range mp {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+	var kvs []*types.KeyValue
+	for _, addr := range addrs {
+		l := getLiveness(action.db, cfg, addr)
+		l.LastActive = action.height
+		l.Votes += int64(mp[addr])
+		kvs = append(kvs, livenessKV(l))
+	}
+	return kvs
+}
+
+// resetLiveness 没有抵押的矿工重新有了抵押, 从这个高度开始计算没有参与的高度
+func (action *Action) resetLiveness(addr string) []*types.KeyValue {
+	cfg := action.api.GetConfig()
+	if !cfg.IsDappFork(action.height, ty.Pos33TicketX, "ForkJail") {
+		return nil
+	}
+	l := getLiveness(action.db, cfg, addr)
+	l.LastActive = action.height
+	return []*types.KeyValue{livenessKV(l)}
+}
+
+func jailLog(l *ty.Pos33Liveness, height int64) *types.ReceiptLog {
+	r := &ty.ReceiptPos33Jail{Addr: l.Address, LastActive: l.LastActive, Height: height, Jailed: l.Jailed}
+	return &types.ReceiptLog{Ty: ty.TyLogPos33Jail, Log: types.Encode(r)}
+}
+
+// Pos33Jail 关押连续jailBlocks个高度没有参与的矿工, 关押期间共识使用的票数为0.
+// 不能关押的地址跳过, 一个都不能关押时交易失败
+func (action *Action) Pos33Jail(j *ty.Pos33Jail) (*types.Receipt, error) {
+	if len(j.Addrs) == 0 {
+		return nil, ty.ErrJail
+	}
+	cfg := action.api.GetConfig()
+	jailBlocks := ty.GetPos33MineParam(cfg, action.height).JailBlocks
+	allAmount, err := getAllAmount(action.db)
+	if err != nil {
+		tlog.Error("pos33 jail error", "err", err, "height", action.height)
+		return nil, err
+	}
+
+	var kvs []*types.KeyValue
+	var logs []*types.ReceiptLog
+	done := make(map[string]bool)
+	for _, addr := range j.Addrs {
+		if done[addr] {
+			continue
+		}
+		done[addr] = true
+		consignee, err := getConsignee(action.db, addr)
+		if err != nil {
+			tlog.Debug("pos33 jail skip", "err", err, "height", action.height, "addr", addr)
+			continue
+		}
+		l := getLiveness(action.db, cfg, addr)
+		err = l.Jailable(action.height, jailBlocks, consignee.Amount, allAmount)
+		if err != nil {
+			tlog.Debug("pos33 jail skip", "err", err, "height", action.height)
+			continue
+		}
+		l.Jailed = true
+		l.JailHeight = action.height
+		kvs = append(kvs, livenessKV(l))
+		logs = append(logs, jailLog(l, action.height))
+		tlog.Info("pos33 jail", "height", action.height, "addr", addr, "lastActive", l.LastActive)
+	}
+	if len(logs) == 0 {
+		return nil, ty.ErrJail
+	}
+	return &types.Receipt{KV: kvs, Logs: logs, Ty: types.ExecOk}, nil
+}
+
+// Pos33Unjail 交易的发送者解除自己的关押, 从这个高度开始重新计算没有参与的高度
+func (action *Action) Pos33Unjail(u *ty.Pos33Unjail) (*types.Receipt, error) {
+	l := getLiveness(action.db, action.api.GetConfig(), action.fromaddr)
+	if !l.Jailed {
+		return nil, ty.ErrJail
+	}
+	l.Jailed = false
+	l.LastActive = action.height
+	tlog.Info("pos33 unjail", "height", action.height, "addr", l.Address, "jailHeight", l.JailHeight)
+	return &types.Receipt{KV: []*types.KeyValue{livenessKV(l)}, Logs: []*types.ReceiptLog{jailLog(l, action.height)}, Ty: types.ExecOk}, nil
+}
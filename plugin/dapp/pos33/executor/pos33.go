@@ -135,17 +135,19 @@ func (act *Action) minerReward(consignee *ty.Pos33Consignee, mineReward int64) (
 	chain33Cfg := act.api.GetConfig()
 	mp := ty.GetPos33MineParam(chain33Cfg, act.height)
 	needTransfer := mp.RewardTransfer
-	tprice := mp.GetTicketPrice()
+	tprice, err := ticketPrice(act.db, chain33Cfg, act.height)
+	if err != nil {
+		return nil, err
+	}
 
 	var kvs []*types.KeyValue
 	var logs []*types.ReceiptLog
 
-	r1 := float64(mineReward) / float64(consignee.Amount/tprice)
 	for _, cr := range consignee.Consignors {
 		if cr.Amount == 0 {
 			continue
 		}
-		crr := int64(r1 * float64(cr.Amount/tprice))
+		crr := act.rewardShare(mineReward, consignee, cr, tprice)
 		cr.Reward += crr
 		tlog.Debug("mine reward add", "addr", cr.Address, "reward", cr.Reward, "height", act.height)
 		cr.RemainReward += crr
@@ -180,11 +182,24 @@ func (act *Action) minerReward(consignee *ty.Pos33Consignee, mineReward int64) (
 	return &types.Receipt{KV: kvs, Logs: logs, Ty: types.ExecOk}, nil
 }
 
+// rewardShare 委托人cr从consignee的奖励reward里分到的部分.
+// ForkDelegate以后按委托数量分配, 以前按票数分配
+func (act *Action) rewardShare(reward int64, consignee *ty.Pos33Consignee, cr *ty.Consignor, tprice int64) int64 {
+	if act.api.GetConfig().IsDappFork(act.height, ty.Pos33TicketX, "ForkDelegate") {
+		return ty.DelegatorShare(reward, cr.Amount, consignee.Amount)
+	}
+	r1 := float64(reward) / float64(consignee.Amount/tprice)
+	return int64(r1 * float64(cr.Amount/tprice))
+}
+
 func (act *Action) voteReward(mis []*minerInfo, voteReward int64) (*types.Receipt, error) {
 	chain33Cfg := act.api.GetConfig()
 	mp := ty.GetPos33MineParam(chain33Cfg, act.height)
 	needTransfer := mp.RewardTransfer
-	tprice := mp.GetTicketPrice()
+	tprice, err := ticketPrice(act.db, chain33Cfg, act.height)
+	if err != nil {
+		return nil, err
+	}
 
 	var kvs []*types.KeyValue
 	var logs []*types.ReceiptLog
@@ -198,12 +213,11 @@ func (act *Action) voteReward(mis []*minerInfo, voteReward int64) (*types.Receip
 			continue
 		}
 		vr := voteReward * int64(mi.nv)
-		r1 := float64(vr) / float64(consignee.Amount/tprice)
 		for _, cr := range consignee.Consignors {
 			if cr.Amount == 0 {
 				continue
 			}
-			crr := int64(r1 * float64(cr.Amount/tprice))
+			crr := act.rewardShare(vr, consignee, cr, tprice)
 			cr.Reward += crr
 			tlog.Debug("vote reward add", "addr", cr.Address, "reward", cr.Reward, "height", act.height)
 			cr.RemainReward += crr
@@ -268,6 +282,11 @@ func (action *Action) Pos33MinerNew(miner *ty.Pos33MinerMsg, index int) (*types.
 	Pos33VoteReward := pmp.VoteReward
 	Pos33MakerReward := pmp.MineReward
 
+	err := action.expandAggVote(miner)
+	if err != nil {
+		return nil, err
+	}
+
 	var kvs []*types.KeyValue
 	var logs []*types.ReceiptLog
 
@@ -296,6 +315,12 @@ func (action *Action) Pos33MinerNew(miner *ty.Pos33MinerMsg, index int) (*types.
 	if !ok {
 		mp[action.fromaddr] = 0
 	}
+	bkvs, err := action.registerBls(miner.BlsPkList)
+	if err != nil {
+		return nil, err
+	}
+	kvs = append(kvs, bkvs...)
+	kvs = append(kvs, action.markActive(mp)...)
 
 	var bm *ty.Pos33Consignee
 	mis := make([]*minerInfo, 0, len(mp))
@@ -310,6 +335,22 @@ func (action *Action) Pos33MinerNew(miner *ty.Pos33MinerMsg, index int) (*types.
 		mis = append(mis, &minerInfo{addr: k, nv: v, miner: consignee})
 	}
 	sort.Slice(mis, func(i, j int) bool { return mis[i].addr < mis[j].addr })
+
+	// ForkRewardV2以后出块人的奖励是固定的, 超时的轮次累积给出块人
+	bpReward := Pos33MakerReward * int64(len(miner.BlsPkList))
+	fundReward := Pos33BlockReward - (Pos33VoteReward+Pos33MakerReward)*int64(len(miner.BlsPkList))
+	rewardV2 := chain33Cfg.IsDappFork(action.height, ty.Pos33TicketX, "ForkRewardV2")
+	if rewardV2 {
+		mr, err := action.minerRewardV2(pmp, miner)
+		if err != nil {
+			tlog.Error("Pos33MinerNew error", "err", err, "height", action.height)
+			return nil, err
+		}
+		Pos33VoteReward, bpReward, fundReward = mr.VoteReward, mr.MakerReward, mr.FundReward
+	} else if miner.Reward != nil {
+		return nil, fmt.Errorf("%w: miner reward before fork, height %d", ty.ErrMinerReward, action.height)
+	}
+
 	receipt, err := action.voteReward(mis, Pos33VoteReward)
 	if err != nil {
 		tlog.Error("Pos33MinerNew error", "err", err, "height", action.height)
@@ -320,7 +361,6 @@ func (action *Action) Pos33MinerNew(miner *ty.Pos33MinerMsg, index int) (*types.
 	}
 
 	// bp reward
-	bpReward := Pos33MakerReward * int64(len(miner.BlsPkList))
 	receipt, err = action.minerReward(bm, bpReward)
 	if err != nil {
 		tlog.Error("Pos33MinerNew error", "err", err, "height", action.height)
@@ -336,21 +376,47 @@ func (action *Action) Pos33MinerNew(miner *ty.Pos33MinerMsg, index int) (*types.
 	}
 
 	// fund reward
-	fundReward := Pos33BlockReward - (Pos33VoteReward+Pos33MakerReward)*int64(len(miner.BlsPkList))
 	fundaddr := chain33Cfg.MGStr("mver.consensus.fundKeyAddr", action.height)
 	tlog.Debug("fund rerward", "fundaddr", fundaddr, "height", action.height, "reward", fundReward)
 
-	receipt, err = action.coinsAccount.Transfer(action.execaddr, fundaddr, fundReward)
+	// ForkRewardV2以后累积的出块奖励可能把基金的部分分完
+	if fundReward > 0 || !rewardV2 {
+		receipt, err = action.coinsAccount.Transfer(action.execaddr, fundaddr, fundReward)
+		if err != nil {
+			tlog.Error("fund reward error", "error", err, "fund", fundaddr, "value", fundReward)
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kvs = append(kvs, receipt.KV...)
+	}
+
+	receipt, err = action.adjustTicketPrice(pmp)
 	if err != nil {
-		tlog.Error("fund reward error", "error", err, "fund", fundaddr, "value", fundReward)
+		tlog.Error("adjust ticket price error", "error", err, "height", action.height)
 		return nil, err
 	}
-	logs = append(logs, receipt.Logs...)
-	kvs = append(kvs, receipt.KV...)
+	if receipt != nil {
+		logs = append(logs, receipt.Logs...)
+		kvs = append(kvs, receipt.KV...)
+	}
 
 	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
 }
 
+// minerRewardV2 按区块的投票数和轮次重新计算奖励, 和出块人在miner tx里声明的比较
+func (action *Action) minerRewardV2(pmp *ty.Pos33MineParam, miner *ty.Pos33MinerMsg) (*ty.Pos33MinerReward, error) {
+	round := int(miner.GetSort().GetProof().GetInput().GetRound())
+	expect, err := pmp.MinerRewardV2(len(miner.BlsPkList), round)
+	if err != nil {
+		return nil, err
+	}
+	err = miner.Reward.Check(expect)
+	if err != nil {
+		return nil, err
+	}
+	return expect, nil
+}
+
 func (action *Action) Pos33BlsBind(pm *ty.Pos33BlsBind) (*types.Receipt, error) {
 	miner := action.fromaddr
 	if action.height == 0 {
@@ -446,6 +512,11 @@ func (action *Action) setEntrust(pe *ty.Pos33Entrust) (*types.Receipt, error) {
 		tlog.Error("setEntrust error", "err", err, "height", action.height, "consignee", pe.Consignee)
 		consignee = &ty.Pos33Consignee{Address: pe.Consignee, FeePersent: mp.MinerFeePersent}
 	}
+	return action.addEntrust(consignee, pe)
+}
+
+// addEntrust 在consignee上增加pe.Amount的委托, 小于0是取回
+func (action *Action) addEntrust(consignee *ty.Pos33Consignee, pe *ty.Pos33Entrust) (*types.Receipt, error) {
 	var consignor *ty.Consignor
 	for _, cr := range consignee.Consignors {
 		if cr.Address == pe.Consignor {
@@ -456,15 +527,24 @@ func (action *Action) setEntrust(pe *ty.Pos33Entrust) (*types.Receipt, error) {
 	if pe.Amount < 0 && consignor == nil {
 		return nil, types.ErrAmount
 	}
+	// ForkDelegate以后不能取回超过委托给这个consignee的数量
+	chain33Cfg := action.api.GetConfig()
+	if pe.Amount < 0 && chain33Cfg.IsDappFork(action.height, ty.Pos33TicketX, "ForkDelegate") && consignor.Amount+pe.Amount < 0 {
+		return nil, types.ErrAmount
+	}
 
 	if consignor == nil {
 		consignor = &ty.Consignor{Address: pe.Consignor, Amount: 0}
 		consignee.Consignors = append(consignee.Consignors, consignor)
 	}
 
+	restart := consignee.Amount == 0 && pe.Amount > 0
 	consignee.Amount += pe.Amount
 	consignor.Amount += pe.Amount
 	kvs := action.updateConsignor(consignor, pe.Consignee)
+	if restart {
+		kvs = append(kvs, action.resetLiveness(pe.Consignee)...)
+	}
 	kvs = append(kvs, action.updateConsignee(consignee)...)
 	kvs = append(kvs, action.updateAllAmount(pe.Amount))
 
@@ -549,7 +629,12 @@ func (action *Action) Pos33SetMinerFeeRate(fr *ty.Pos33MinerFeeRate) (*types.Rec
 		tlog.Error("pos33 set miner feerate error", "err", err, "height", action.height, "miner", action.fromaddr)
 		return nil, err
 	}
-	if fr.FeeRatePersent <= 0 || fr.FeeRatePersent >= 100 {
+	min := int32(1)
+	if action.api.GetConfig().IsDappFork(action.height, ty.Pos33TicketX, "ForkDelegate") {
+		// ForkDelegate以后可以不抽成
+		min = 0
+	}
+	if fr.FeeRatePersent < min || fr.FeeRatePersent >= 100 {
 		return nil, errors.New("minerFeeRate is error")
 	}
 	consignee.FeePersent = int64(fr.FeeRatePersent)
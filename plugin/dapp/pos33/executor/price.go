@@ -0,0 +1,96 @@
+package executor
+
+import (
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// TicketPriceKey ForkTicketPrice之后最后一次调整的票价
+func TicketPriceKey() []byte {
+	return []byte("mavl-pos33-ticket-price")
+}
+
+// getTicketPrice 返回最后一次调整的票价, 还没有调整时返回分叉高度的票价
+func getTicketPrice(db dbm.KV, mp *ty.Pos33MineParam) (*ty.Pos33TicketPrice, error) {
+	val, err := db.Get(TicketPriceKey())
+	if err == types.ErrNotFound {
+		return mp.InitTicketPrice(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	p := new(ty.Pos33TicketPrice)
+	err = types.Decode(val, p)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// ticketPrice 返回height高度的票价, ForkTicketPrice之前是配置的票价.
+// 读取状态出错时返回错误, 不能用配置的票价代替, 否则不同节点算出的票数不同
+func ticketPrice(db dbm.KV, cfg *types.Chain33Config, height int64) (int64, error) {
+	mp := ty.GetPos33MineParam(cfg, height)
+	if !cfg.IsDappFork(height, ty.Pos33TicketX, "ForkTicketPrice") {
+		return mp.GetTicketPrice(), nil
+	}
+	p, err := getTicketPrice(db, mp)
+	if err != nil {
+		tlog.Error("get ticket price error", "err", err, "height", height)
+		return 0, err
+	}
+	return p.Price, nil
+}
+
+// adjustTicketPrice 每个周期开始的区块按上一个区块以后的总抵押调整票价
+func (action *Action) adjustTicketPrice(mp *ty.Pos33MineParam) (*types.Receipt, error) {
+	if !mp.PriceEpochStart(action.height) {
+		return nil, nil
+	}
+	prev, err := getTicketPrice(action.db, mp)
+	if err != nil {
+		return nil, err
+	}
+	staked, err := getAllAmount(action.db)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	p, err := mp.NextTicketPrice(prev, staked, action.height)
+	if err != nil {
+		return nil, err
+	}
+	tlog.Info("pos33 ticket price", "height", action.height, "epoch", p.Epoch, "price", p.Price, "prev", prev.Price, "bondRatio", p.BondRatio)
+	kv := &types.KeyValue{Key: TicketPriceKey(), Value: types.Encode(p)}
+	log := &types.ReceiptLog{Ty: ty.TyLogPos33TicketPrice, Log: types.Encode(p)}
+	return &types.Receipt{KV: []*types.KeyValue{kv}, Logs: []*types.ReceiptLog{log}, Ty: types.ExecOk}, nil
+}
+
+// Query_Pos33TicketPrice query the current ticket price and the price of the next epoch at the current stake
+func (ticket *Pos33Ticket) Query_Pos33TicketPrice(*types.ReqNil) (types.Message, error) {
+	cfg := ticket.GetAPI().GetConfig()
+	height := ticket.GetHeight()
+	mp := ty.GetPos33MineParam(cfg, height)
+	if !cfg.IsDappFork(height, ty.Pos33TicketX, "ForkTicketPrice") {
+		return &ty.Pos33TicketPriceInfo{Current: &ty.Pos33TicketPrice{Price: mp.GetTicketPrice()}}, nil
+	}
+	db := ticket.GetStateDB()
+	cur, err := getTicketPrice(db, mp)
+	if err != nil {
+		return nil, err
+	}
+	r := &ty.Pos33TicketPriceInfo{Current: cur}
+	next := mp.NextPriceHeight(height)
+	if next == 0 {
+		return r, nil
+	}
+	staked, err := getAllAmount(db)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	r.Next, err = mp.NextTicketPrice(cur, staked, next)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
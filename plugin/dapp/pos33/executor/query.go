@@ -5,7 +5,9 @@
 package executor
 
 import (
+	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
 )
 
 // // Query_AllPos33TicketCount query all ticket count
@@ -73,3 +75,102 @@ func (ticket *Pos33Ticket) Query_Pos33ConsigneeEntrust(param *types.ReqAddr) (ty
 	}
 	return entrust, nil
 }
+
+// Query_Pos33Operator query the delegation summary of an operator
+func (ticket *Pos33Ticket) Query_Pos33Operator(param *types.ReqAddr) (types.Message, error) {
+	consignee, err := getConsignee(ticket.GetStateDB(), param.Addr)
+	if err != nil {
+		return nil, err
+	}
+	price, err := ticketPrice(ticket.GetStateDB(), ticket.GetAPI().GetConfig(), ticket.GetHeight())
+	if err != nil {
+		return nil, err
+	}
+	return operatorInfo(consignee, price), nil
+}
+
+// Query_Pos33Liveness query the liveness record of a miner
+func (ticket *Pos33Ticket) Query_Pos33Liveness(param *types.ReqAddr) (types.Message, error) {
+	return getLiveness(ticket.GetStateDB(), ticket.GetAPI().GetConfig(), param.Addr), nil
+}
+
+// Query_Pos33SortCount query the ticket count of a miner used by sortition.
+// The consensus runs it on the state of the sort height, so every node gets the same count
+func (ticket *Pos33Ticket) Query_Pos33SortCount(param *ty.ReqPos33SortCount) (types.Message, error) {
+	count, err := sortCount(ticket.GetStateDB(), ticket.GetAPI().GetConfig(), param.Addr, param.Height)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Int64{Data: count}, nil
+}
+
+// sortCount UseEntrust以后height高度抽签时addr的票数: 受托的抵押按票价折算, 被关押的是0
+func sortCount(db dbm.KV, cfg *types.Chain33Config, addr string, height int64) (int64, error) {
+	if !cfg.IsDappFork(height, ty.Pos33TicketX, "UseEntrust") {
+		return 0, types.ErrNotSupport
+	}
+	consignee, err := getConsignee(db, addr)
+	if err == types.ErrNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if cfg.IsDappFork(height, ty.Pos33TicketX, "ForkJail") && getLiveness(db, cfg, addr).Jailed {
+		return 0, nil
+	}
+	price, err := ticketPrice(db, cfg, height)
+	if err != nil {
+		return 0, err
+	}
+	return consignee.Amount / price, nil
+}
+
+// Query_Pos33SortTotal query the ticket count of all miners used by sortition at the sort height
+func (ticket *Pos33Ticket) Query_Pos33SortTotal(param *types.ReqInt) (types.Message, error) {
+	cfg := ticket.GetAPI().GetConfig()
+	if !cfg.IsDappFork(param.Height, ty.Pos33TicketX, "UseEntrust") {
+		return nil, types.ErrNotSupport
+	}
+	amount, err := getAllAmount(ticket.GetStateDB())
+	if err != nil {
+		return nil, err
+	}
+	price, err := ticketPrice(ticket.GetStateDB(), cfg, param.Height)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Int64{Data: amount / price}, nil
+}
+
+// Query_Pos33KeyOwner query the staker bound to a consensus pubkey
+func (ticket *Pos33Ticket) Query_Pos33KeyOwner(param *ty.ReqPos33KeyOwner) (types.Message, error) {
+	return keyOwner(ticket.GetStateDB(), param.Pubkey, param.Addr), nil
+}
+
+// Query_Pos33ChainParams query pos33 chain params
+func (ticket *Pos33Ticket) Query_Pos33ChainParams(*types.ReqNil) (types.Message, error) {
+	return getChainParams(ticket.GetStateDB())
+}
+
+// Query_Pos33BlsPubkeys query bls pubkeys by index
+func (ticket *Pos33Ticket) Query_Pos33BlsPubkeys(param *ty.ReqPos33BlsPubkeys) (types.Message, error) {
+	pks, err := getBlsPubkeys(ticket.GetStateDB(), param.Indices)
+	if err != nil {
+		return nil, err
+	}
+	return &ty.Pos33BlsPubkeys{Pks: pks}, nil
+}
+
+// Query_Pos33BlsIndices query bls pubkey indices by bls address, -1 if not indexed
+func (ticket *Pos33Ticket) Query_Pos33BlsIndices(param *types.ReqAddrs) (types.Message, error) {
+	is := make([]int64, 0, len(param.Addrs))
+	for _, addr := range param.Addrs {
+		i, err := getBlsIndex(ticket.GetStateDB(), addr)
+		if err != nil {
+			i = -1
+		}
+		is = append(is, i)
+	}
+	return &ty.Pos33BlsIndices{Indices: is}, nil
+}
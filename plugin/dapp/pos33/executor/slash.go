@@ -0,0 +1,197 @@
+package executor
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// SlashKey 作恶地址在一个高度和轮次已经被处罚的记录, 同一次作恶只处罚一次
+func SlashKey(addr string, height int64, round int32) []byte {
+	return []byte(fmt.Sprintf("mavl-pos33-slash-%s-%d-%d", address.FormatAddrKey(addr), height, round))
+}
+
+// SlashBurnAddr 销毁的抵押转到这个地址, 没有人有它的私钥
+func SlashBurnAddr() string {
+	return address.ExecAddress("pos33.slash.burn")
+}
+
+// slashTally 一个交易里的处罚, 同一个地址的多个证据在缓存的委托上累计
+type slashTally struct {
+	action     *Action
+	consignees map[string]*ty.Pos33Consignee
+	// consignor -> consignee -> 处罚后的委托数量
+	consignors map[string]map[string]int64
+	done       map[string]bool
+	total      int64
+
+	kvs  []*types.KeyValue
+	logs []*types.ReceiptLog
+}
+
+// Pos33Slash 处罚双重投票: 作恶地址的每个委托人按slashPersent扣除抵押,
+// 其中slashBountyPersent奖励给举报人(交易的发送者), 剩下的销毁. 已经处罚过的证据跳过
+func (action *Action) Pos33Slash(ps *ty.Pos33Slash) (*types.Receipt, error) {
+	if len(ps.Evidences) == 0 {
+		return nil, ty.ErrSlashEvidence
+	}
+	t := &slashTally{
+		action:     action,
+		consignees: make(map[string]*ty.Pos33Consignee),
+		consignors: make(map[string]map[string]int64),
+		done:       make(map[string]bool),
+	}
+	for _, e := range ps.Evidences {
+		err := t.slash(e)
+		if err == ty.ErrSlashed {
+			continue
+		}
+		if err != nil {
+			tlog.Error("pos33 slash error", "err", err, "height", action.height, "evidence height", e.Height, "offender", e.Offender)
+			return nil, err
+		}
+	}
+	if len(t.logs) == 0 {
+		return nil, ty.ErrSlashed
+	}
+	return t.receipt()
+}
+
+// offender 检查证据e, 返回投票的bls公钥绑定的地址
+func (t *slashTally) offender(e *ty.Pos33Evidence) (string, error) {
+	action := t.action
+	if e.Height >= action.height || action.height-e.Height > ty.Pos33SlashWindow {
+		return "", ty.ErrSlashEvidence
+	}
+	err := e.Check(action.api.GetConfig())
+	if err != nil {
+		return "", err
+	}
+	return action.getFromBls(e.A.Sig.Pubkey)
+}
+
+func (t *slashTally) slash(e *ty.Pos33Evidence) error {
+	action := t.action
+	owner, err := t.offender(e)
+	if err != nil {
+		return err
+	}
+	key := SlashKey(owner, e.Height, e.Round)
+	if t.done[string(key)] {
+		return ty.ErrSlashed
+	}
+	if _, err := action.db.Get(key); err == nil {
+		return ty.ErrSlashed
+	}
+
+	consignee, ok := t.consignees[owner]
+	if !ok {
+		consignee, err = action.getConsignee(owner)
+		if err != nil {
+			return err
+		}
+		t.consignees[owner] = consignee
+	}
+
+	mp := ty.GetPos33MineParam(action.api.GetConfig(), action.height)
+	bountyPersent := mp.SlashBountyPersent
+	// 举报自己不给奖励
+	if action.fromaddr == owner {
+		bountyPersent = 0
+	}
+	r := &ty.ReceiptPos33Slash{Offender: owner, Reporter: action.fromaddr, Height: e.Height, Round: e.Round, EvidenceHash: e.Hash}
+	for _, cr := range consignee.Consignors {
+		cut := cr.Amount * mp.SlashPersent / 100
+		if cut <= 0 {
+			continue
+		}
+		bounty := cut * bountyPersent / 100
+		err = t.take(cr.Address, cut-bounty, bounty)
+		if err != nil {
+			return err
+		}
+		cr.Amount -= cut
+		consignee.Amount -= cut
+		if t.consignors[cr.Address] == nil {
+			t.consignors[cr.Address] = make(map[string]int64)
+		}
+		t.consignors[cr.Address][owner] = cr.Amount
+		t.total += cut
+		r.Burned += cut - bounty
+		r.Bounty += bounty
+	}
+
+	t.done[string(key)] = true
+	t.kvs = append(t.kvs, &types.KeyValue{Key: key, Value: e.Hash})
+	t.logs = append(t.logs, &types.ReceiptLog{Ty: ty.TyLogPos33Slash, Log: types.Encode(r)})
+	tlog.Info("pos33 slash", "height", action.height, "offender", owner, "evidence height", e.Height, "round", e.Round, "burned", r.Burned, "bounty", r.Bounty)
+	return nil
+}
+
+// take 从委托人addr冻结的抵押中销毁burn, 奖励bounty给举报人
+func (t *slashTally) take(addr string, burn, bounty int64) error {
+	action := t.action
+	transfer := func(to string, amount int64) error {
+		if amount <= 0 {
+			return nil
+		}
+		var receipt *types.Receipt
+		var err error
+		if to == addr {
+			receipt, err = action.coinsAccount.ExecActive(addr, action.execaddr, amount)
+		} else {
+			receipt, err = action.coinsAccount.ExecTransferFrozen(addr, to, action.execaddr, amount)
+		}
+		if err != nil {
+			tlog.Error("pos33 slash transfer error", "err", err, "from", addr, "to", to, "amount", amount)
+			return err
+		}
+		t.kvs = append(t.kvs, receipt.KV...)
+		t.logs = append(t.logs, receipt.Logs...)
+		return nil
+	}
+	err := transfer(SlashBurnAddr(), burn)
+	if err != nil {
+		return err
+	}
+	return transfer(action.fromaddr, bounty)
+}
+
+// receipt 写入处罚后的委托, 每个地址只写一次
+func (t *slashTally) receipt() (*types.Receipt, error) {
+	action := t.action
+	kvs := t.kvs
+	var owners []string
+	for owner := range t.consignees {
+		owners = append(owners, owner)
+	}
+	sort.Strings(owners)
+	for _, owner := range owners {
+		kvs = append(kvs, action.updateConsignee(t.consignees[owner])...)
+	}
+
+	var addrs []string
+	for addr := range t.consignors {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+	for _, addr := range addrs {
+		consignor, err := getConsignor(action.db, addr)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range consignor.Consignees {
+			if amount, ok := t.consignors[addr][e.Address]; ok {
+				e.Amount = amount
+			}
+		}
+		kvs = append(kvs, &types.KeyValue{Key: ConsignorKey(addr), Value: types.Encode(consignor)})
+	}
+	if t.total > 0 {
+		kvs = append(kvs, action.updateAllAmount(-t.total))
+	}
+	return &types.Receipt{KV: kvs, Logs: t.logs, Ty: types.ExecOk}, nil
+}
@@ -0,0 +1,265 @@
+package executor
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// 一次最多返回多少个出块记录
+const maxMakerBlocks = 1000
+
+// subConfig exec.sub.pos33的配置
+type subConfig struct {
+	// if true, 执行区块时在localdb记录每个高度的抽签统计, 供浏览器和分析工具查询.
+	// 只记录打开以后执行的区块, 以前的高度需要重新同步
+	EnableSortIndex bool `json:"enableSortIndex,omitempty"`
+}
+
+var execSubCfg subConfig
+
+func sortRecordPrefix() []byte {
+	return []byte("LODB-pos33-sortrec-")
+}
+
+// SortRecordKey 抽签统计索引里height高度的记录
+func SortRecordKey(height int64) []byte {
+	return []byte(fmt.Sprintf("%s%012d", sortRecordPrefix(), height))
+}
+
+func makerBlockPrefix(addr string) []byte {
+	return []byte("LODB-pos33-sortmaker-" + string(address.FormatAddrKey(addr)) + "-")
+}
+
+// MakerBlockKey 抽签统计索引里addr在height高度出的区块
+func MakerBlockKey(addr string, height int64) []byte {
+	return []byte(fmt.Sprintf("%s%012d", makerBlockPrefix(addr), height))
+}
+
+func getSortRecord(ldb dbm.KVDB, height int64) (*ty.Pos33SortRecord, error) {
+	val, err := ldb.Get(SortRecordKey(height))
+	if err != nil {
+		return nil, err
+	}
+	r := new(ty.Pos33SortRecord)
+	err = types.Decode(val, r)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// sortRecordBefore 返回高度不超过height的最后一个记录, 没有时返回nil
+func sortRecordBefore(ldb dbm.KVDB, height int64) *ty.Pos33SortRecord {
+	if height < 0 {
+		return nil
+	}
+	vals, err := ldb.List(sortRecordPrefix(), SortRecordKey(height+1), 1, dbm.ListDESC)
+	if err != nil || len(vals) == 0 {
+		return nil
+	}
+	r := new(ty.Pos33SortRecord)
+	if types.Decode(vals[0], r) != nil {
+		return nil
+	}
+	return r
+}
+
+// firstSortRecord 开始索引的第一个记录
+func firstSortRecord(ldb dbm.KVDB) *ty.Pos33SortRecord {
+	vals, err := ldb.List(sortRecordPrefix(), nil, 1, dbm.ListASC)
+	if err != nil || len(vals) == 0 {
+		return nil
+	}
+	r := new(ty.Pos33SortRecord)
+	if types.Decode(vals[0], r) != nil {
+		return nil
+	}
+	return r
+}
+
+// allCount 执行这个区块以后的全网票数, 和共识查询的一样
+func (t *Pos33Ticket) allCount() (int64, error) {
+	cfg := t.GetAPI().GetConfig()
+	if !cfg.IsDappFork(t.GetHeight(), ty.Pos33TicketX, "UseEntrust") {
+		return 0, nil
+	}
+	amount, err := getAllAmount(t.GetStateDB())
+	if err == types.ErrNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	price, err := ticketPrice(t.GetStateDB(), cfg, t.GetHeight())
+	if err != nil {
+		return 0, err
+	}
+	return amount / price, nil
+}
+
+func (t *Pos33Ticket) chainParam(height int64) *ty.Pos33ChainParam {
+	if !t.GetAPI().GetConfig().IsDappFork(height, ty.Pos33TicketX, "ForkChainParam") {
+		return ty.DefaultPos33ChainParam()
+	}
+	ps, err := getChainParams(t.GetStateDB())
+	if err != nil {
+		return ty.DefaultPos33ChainParam()
+	}
+	return ps.At(height)
+}
+
+// sortRecord 这个区块的抽签统计, 投票人的地址从bls公钥的绑定记录查询
+func (t *Pos33Ticket) sortRecord(miner *ty.Pos33MinerMsg, tx *types.Transaction) (*ty.Pos33SortRecord, error) {
+	action := NewAction(t, tx)
+	m := types.Clone(miner).(*ty.Pos33MinerMsg)
+	err := action.expandAggVote(m)
+	if err != nil {
+		return nil, err
+	}
+	all, err := t.allCount()
+	if err != nil {
+		return nil, err
+	}
+	height := t.GetHeight()
+	r := &ty.Pos33SortRecord{
+		Height:    height,
+		Round:     m.GetSort().GetProof().GetInput().GetRound(),
+		Maker:     action.fromaddr,
+		Votes:     int64(len(m.BlsPkList)),
+		AllCount:  all,
+		BlockTime: t.GetBlockTime(),
+	}
+	mp := make(map[string]int64)
+	for _, pk := range m.BlsPkList {
+		addr, err := action.getFromBls(pk)
+		if err != nil {
+			return nil, err
+		}
+		mp[addr]++
+	}
+	for addr, n := range mp {
+		r.Voters = append(r.Voters, &ty.Pos33SortWinner{Addr: addr, Count: n})
+	}
+	sort.Slice(r.Voters, func(i, j int) bool { return r.Voters[i].Addr < r.Voters[j].Addr })
+
+	// 快照高度的票数用索引里的记录, 没有记录时和共识一样使用当前的票数
+	param := t.chainParam(height)
+	count := r.AllCount
+	if sr, err := getSortRecord(t.GetLocalDB(), height-int64(param.SortBlocks)); err == nil && sr.AllCount > 0 {
+		count = sr.AllCount
+	}
+	if count > 0 {
+		r.Diff = param.Diff(int(count))
+	}
+	r.Accumulate(sortRecordBefore(t.GetLocalDB(), height-1))
+	return r, nil
+}
+
+// sortIndexLocal 打开sortIndex时记录这个区块的抽签统计. 索引出错不影响执行区块
+func (t *Pos33Ticket) sortIndexLocal(miner *ty.Pos33MinerMsg, tx *types.Transaction) []*types.KeyValue {
+	if !execSubCfg.EnableSortIndex {
+		return nil
+	}
+	r, err := t.sortRecord(miner, tx)
+	if err != nil {
+		tlog.Error("sort index error", "err", err, "height", t.GetHeight())
+		return nil
+	}
+	mb := &ty.Pos33MakerBlock{Height: r.Height, Round: r.Round, Votes: r.Votes}
+	return []*types.KeyValue{
+		{Key: SortRecordKey(r.Height), Value: types.Encode(r)},
+		{Key: MakerBlockKey(r.Maker, r.Height), Value: types.Encode(mb)},
+	}
+}
+
+// sortIndexDelLocal 回滚区块时删除这个高度的抽签统计
+func (t *Pos33Ticket) sortIndexDelLocal(tx *types.Transaction) []*types.KeyValue {
+	if !execSubCfg.EnableSortIndex {
+		return nil
+	}
+	return []*types.KeyValue{
+		{Key: SortRecordKey(t.GetHeight())},
+		{Key: MakerBlockKey(tx.From(), t.GetHeight())},
+	}
+}
+
+// Query_Pos33SortRecord query the sortition record of a height
+func (ticket *Pos33Ticket) Query_Pos33SortRecord(param *types.ReqInt) (types.Message, error) {
+	if !execSubCfg.EnableSortIndex {
+		return nil, fmt.Errorf("%w: exec.sub.pos33.enableSortIndex is false", ty.ErrSortIndex)
+	}
+	return getSortRecord(ticket.GetLocalDB(), param.Height)
+}
+
+// Query_Pos33MakerBlocks query the blocks made by an address in a range of heights
+func (ticket *Pos33Ticket) Query_Pos33MakerBlocks(param *ty.ReqPos33MakerBlocks) (types.Message, error) {
+	if !execSubCfg.EnableSortIndex {
+		return nil, fmt.Errorf("%w: exec.sub.pos33.enableSortIndex is false", ty.ErrSortIndex)
+	}
+	if param.Addr == "" || param.Start < 0 || (param.End > 0 && param.End < param.Start) {
+		return nil, types.ErrInvalidParam
+	}
+	count := param.Count
+	if count <= 0 || count > maxMakerBlocks {
+		count = maxMakerBlocks
+	}
+	var key []byte
+	if param.Start > 0 {
+		key = MakerBlockKey(param.Addr, param.Start-1)
+	}
+	// 多取一个判断还有没有更多
+	vals, err := ticket.GetLocalDB().List(makerBlockPrefix(param.Addr), key, count+1, dbm.ListASC)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	r := &ty.Pos33MakerBlocks{Addr: param.Addr}
+	for _, val := range vals {
+		mb := new(ty.Pos33MakerBlock)
+		err = types.Decode(val, mb)
+		if err != nil {
+			return nil, err
+		}
+		if param.End > 0 && mb.Height > param.End {
+			break
+		}
+		if len(r.Blocks) == int(count) {
+			r.Next = mb.Height
+			break
+		}
+		r.Blocks = append(r.Blocks, mb)
+	}
+	return r, nil
+}
+
+// Query_Pos33SortStats query the average committee size and the round distribution in a range of heights
+func (ticket *Pos33Ticket) Query_Pos33SortStats(param *ty.ReqPos33SortStats) (types.Message, error) {
+	if !execSubCfg.EnableSortIndex {
+		return nil, fmt.Errorf("%w: exec.sub.pos33.enableSortIndex is false", ty.ErrSortIndex)
+	}
+	ldb := ticket.GetLocalDB()
+	end := param.End
+	if end <= 0 {
+		end = ticket.GetHeight()
+	}
+	to := sortRecordBefore(ldb, end)
+	if to == nil {
+		return nil, fmt.Errorf("%w: no sort record before %d", ty.ErrSortIndex, end)
+	}
+	start := param.Start
+	if param.Last > 0 {
+		start = to.Height - param.Last + 1
+	}
+	if start > to.Height {
+		return nil, types.ErrInvalidParam
+	}
+	// 开始索引以前的高度没有记录, 从第一个记录开始
+	if first := firstSortRecord(ldb); first != nil && start < first.Height {
+		start = first.Height
+	}
+	return ty.SortStatsBetween(start, sortRecordBefore(ldb, start-1), to)
+}
@@ -28,6 +28,9 @@ var driverName = "pos33"
 
 // Init initial
 func Init(name string, cfg *types.Chain33Config, sub []byte) {
+	if sub != nil {
+		types.MustDecode(sub, &execSubCfg)
+	}
 	drivers.Register(cfg, GetName(), newPos33Ticket, cfg.GetDappFork(driverName, "Enable"))
 	InitExecType()
 }
@@ -80,6 +83,27 @@ func (t *Pos33Ticket) CheckTx(tx *types.Transaction, index int) error {
 		if action.Ty == ty.Pos33TicketActionMiner && action.GetMiner() != nil {
 			return ty.ErrMinerTx
 		}
+		// 无效的证据不进入mempool
+		if action.Ty == ty.Pos33ActionSlash {
+			for _, e := range action.GetSlash().GetEvidences() {
+				err = e.Check(t.GetAPI().GetConfig())
+				if err != nil {
+					return err
+				}
+			}
+		}
+		switch action.Ty {
+		case ty.Pos33ActionDelegate:
+			return action.GetDelegate().Check()
+		case ty.Pos33ActionUndelegate:
+			return action.GetUndelegate().Check()
+		case ty.Pos33ActionJail:
+			if len(action.GetJail().GetAddrs()) == 0 {
+				return ty.ErrJail
+			}
+		case ty.Pos33ActionKeyRotate:
+			return action.GetKeyRotate().Check()
+		}
 	}
 	return nil
 }
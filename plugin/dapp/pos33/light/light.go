@@ -0,0 +1,345 @@
+// Package light 轻客户端验证区块的最终性证明(Pos33FinalityProof).
+// 不依赖chain33节点, 只需要一个以前的可信区块头: 证明的快照高度的下一个区块(anchor).
+// ForkLightProof以后每个区块的miner交易里有以后高度的票数承诺(Pos33TicketCommitment)的hash,
+// 包括抽签的seed, 难度和验证者票数的merkle根, 全节点检查过和链上状态一致.
+// 验证通过的区块可以作为以后高度的anchor.
+//
+// 验证的内容和共识的区块检查一致: 区块头由出块人签名, 交易根包含miner交易, miner交易由出块人的共识公钥签名,
+// 出块人和委员会的抽签按承诺的票数和难度中签, 聚合签名由足够的委员会座位签名.
+// bls公钥和矿工地址的绑定是链上状态, 证明里的地址不可信, 必须设置Verifier.BlsOwner.
+// 换过共识公钥的抵押地址也是链上状态, 需要时设置Verifier.SortAddr
+package light
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/types"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+	"github.com/yccproject/ycc/plugin/dapp/pos33/verifier"
+)
+
+// DefaultBlockHashFork ycc主网的ForkBlockHash, 以后的区块hash包括交易数
+const DefaultBlockHashFork = 5000000
+
+// committeeTy 委员会抽签的类型, 和共识的Committee相同
+const committeeTy = 0
+
+// Verifier 最终性证明的验证参数
+type Verifier struct {
+	// Quorum 至少这么多个委员会座位投票, 0使用pt.Pos33MustVotes
+	Quorum int
+	// BlockHashFork 区块hash使用新算法的高度
+	BlockHashFork int64
+	// AddrFormatFork 从这个高度开始用AddrID格式从公钥推导地址, 和共识的ForkAddressFormat相同.
+	// 小于等于0时总是eth格式
+	AddrFormatFork int64
+	AddrID         int32
+	// VrfSuiteFork 从这个高度开始proof必须写明vrf算法, 和共识的ForkVrfSuite相同. 小于等于0时proof都不写
+	VrfSuiteFork int64
+	// SortAddr 抽签公钥对应的抵押地址, nil时按AddrFormatFork和AddrID从公钥推导
+	SortAddr func(pubkey []byte, height int64) string
+	// BlsOwner bls公钥绑定的矿工地址, 必须设置, 比如查询可信的节点或者验证状态证明
+	BlsOwner func(pk []byte) (string, error)
+}
+
+// NewVerifier ycc主网的参数, 使用前要设置BlsOwner
+func NewVerifier() *Verifier {
+	return &Verifier{BlockHashFork: DefaultBlockHashFork, AddrID: pt.EthAddrID}
+}
+
+func proofError(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", pt.ErrFinalityProof, fmt.Sprintf(format, args...))
+}
+
+// BlockHash 用区块头计算区块hash
+func (v *Verifier) BlockHash(h *types.Header) []byte {
+	b := &types.Block{
+		Version:    h.Version,
+		ParentHash: h.ParentHash,
+		TxHash:     h.TxHash,
+		StateHash:  h.StateHash,
+		Height:     h.Height,
+		BlockTime:  h.BlockTime,
+		Difficulty: h.Difficulty,
+		Txs:        make([]*types.Transaction, h.TxCount),
+	}
+	return b.HashByForkHeight(v.BlockHashFork)
+}
+
+// TxRoot miner交易按证明里的merkle路径计算出的交易根, miner交易总是第一个
+func TxRoot(p *pt.Pos33MinerTxProof) []byte {
+	leaf := p.MinerTx.Hash()
+	if p.FullHash {
+		leaf = p.MinerTx.FullHash()
+	}
+	root := merkle.GetMerkleRootFromBranch(p.TxBranch, leaf, 0)
+	if len(p.ChildBranch) > 0 {
+		root = merkle.GetMerkleRootFromBranch(p.ChildBranch, root, 0)
+	}
+	return root
+}
+
+func (v *Verifier) sortAddr(pubkey []byte, height int64) string {
+	if v.SortAddr != nil {
+		return v.SortAddr(pubkey, height)
+	}
+	if v.AddrFormatFork > 0 && height >= v.AddrFormatFork {
+		return address.PubKeyToAddr(v.AddrID, pubkey)
+	}
+	return address.PubKeyToAddr(pt.EthAddrID, pubkey)
+}
+
+// minerTx 检查区块头的hash, 交易根包含miner交易, 区块头和miner交易都由出块人签名, 返回区块hash和miner
+func (v *Verifier) minerTx(p *pt.Pos33MinerTxProof) ([]byte, *pt.Pos33MinerMsg, error) {
+	h := p.GetHeader()
+	if h == nil || p.MinerTx == nil {
+		return nil, nil, proofError("header or miner tx is nil")
+	}
+	hash := v.BlockHash(h)
+	if len(h.Hash) > 0 && !bytes.Equal(h.Hash, hash) {
+		return nil, nil, proofError("block hash NOT match header, height %d", h.Height)
+	}
+	if !bytes.Equal(TxRoot(p), h.TxHash) {
+		return nil, nil, proofError("miner tx NOT in block %d", h.Height)
+	}
+	m, err := minerMsg(p.MinerTx, h.Height)
+	if err != nil {
+		return nil, nil, err
+	}
+	sig := h.Signature
+	if sig == nil || !bytes.Equal(sig.Pubkey, m.Sort.Proof.Pubkey) {
+		return nil, nil, proofError("block %d NOT signed by the maker", h.Height)
+	}
+	// 出块人签的是区块hash, 和chain33检查区块签名一样
+	if !types.CheckSign(hash, "", sig, h.Height) {
+		return nil, nil, proofError("block %d signature error", h.Height)
+	}
+	return hash, m, nil
+}
+
+// tickets 检查票数承诺在anchor区块的miner交易里, anchor的区块hash是trusted
+func (v *Verifier) tickets(anchor *pt.Pos33MinerTxProof, c *pt.Pos33TicketCommitment, trusted []byte) error {
+	hash, am, err := v.minerTx(anchor)
+	if err != nil {
+		return fmt.Errorf("anchor: %w", err)
+	}
+	if !bytes.Equal(hash, trusted) {
+		return proofError("anchor block %d NOT trusted", anchor.Header.Height)
+	}
+	err = c.Check(c.GetHash())
+	if err != nil {
+		return err
+	}
+	if c.SnapshotHeight+1 != anchor.Header.Height {
+		return proofError("ticket snapshot %d NOT before anchor %d", c.SnapshotHeight, anchor.Header.Height)
+	}
+	for _, tc := range am.TicketCommits {
+		if bytes.Equal(tc, c.Hash) {
+			return nil
+		}
+	}
+	return proofError("ticket commitment NOT in anchor block %d", anchor.Header.Height)
+}
+
+// minerMsg 检查miner交易的签名, 返回miner
+func minerMsg(tx *types.Transaction, height int64) (*pt.Pos33MinerMsg, error) {
+	if tx == nil || tx.Signature == nil {
+		return nil, proofError("miner tx is nil")
+	}
+	var act pt.Pos33TicketAction
+	err := types.Decode(tx.Payload, &act)
+	if err != nil {
+		return nil, proofError("miner tx decode error: %v", err)
+	}
+	m := act.GetMiner()
+	if act.Ty != pt.Pos33TicketActionMiner || m == nil {
+		return nil, proofError("first tx is NOT miner tx")
+	}
+	s := m.Sort
+	if s == nil || s.Proof == nil || s.Proof.Input == nil || s.SortHash == nil {
+		return nil, proofError("miner sort is nil")
+	}
+	if !bytes.Equal(m.Hash, s.SortHash.Hash) {
+		return nil, proofError("miner hash NOT match sort hash")
+	}
+	if !bytes.Equal(tx.Signature.Pubkey, s.Proof.Pubkey) {
+		return nil, proofError("miner tx NOT signed by the maker")
+	}
+	if !tx.CheckSign(height) {
+		return nil, proofError("miner tx signature error")
+	}
+	return m, nil
+}
+
+// checkSort 按票数承诺验证一个委员会抽签, 返回抽签的地址
+func (v *Verifier) checkSort(c *pt.Pos33TicketCommitment, counts map[string]int64, round int32, s *pt.Pos33SortMsg) (string, error) {
+	if s == nil || s.Proof == nil || s.Proof.Input == nil || s.SortHash == nil {
+		return "", proofError("sort is nil")
+	}
+	in := s.Proof.Input
+	if in.Height != c.Height || in.Round != round || in.Ty != committeeTy || !bytes.Equal(in.Seed, c.Seed) {
+		return "", proofError("sort input NOT match, height %d, round %d", in.Height, in.Round)
+	}
+	subs := c.SubCommittees
+	if subs <= 0 {
+		subs = 1
+	}
+	if s.SortHash.Num < 0 || s.SortHash.Num >= subs {
+		return "", proofError("sort num %d out of %d", s.SortHash.Num, subs)
+	}
+	err := verifier.CheckSuite(s.Proof.VrfSuite, v.VrfSuiteFork > 0 && c.Height >= v.VrfSuiteFork)
+	if err != nil {
+		return "", err
+	}
+	addr := v.sortAddr(s.Proof.Pubkey, c.Height)
+	count, ok := counts[addr]
+	if !ok {
+		return "", proofError("ticket count of %s NOT in proof", addr)
+	}
+	if s.SortHash.Index < 0 || s.SortHash.Index >= count {
+		return "", proofError("sort index %d out of count %d", s.SortHash.Index, count)
+	}
+	input := types.Encode(&pt.VrfInput{Seed: c.Seed, Height: c.Height, Round: round, Ty: committeeTy})
+	err = verifier.VerifySort(s.Proof.Pubkey, input, s.Proof.VrfProof, s.Proof.VrfHash, s.SortHash.Hash, int(s.SortHash.Index), int(s.SortHash.Num), c.Diff(round))
+	if err != nil {
+		return "", err
+	}
+	return addr, nil
+}
+
+// counts 检查每个地址的票数都在票数承诺的merkle根下
+func counts(c *pt.Pos33TicketCommitment, bs []*pt.Pos33TicketBranch) (map[string]int64, error) {
+	mp := make(map[string]int64)
+	for _, b := range bs {
+		if b.GetLeaf() == nil {
+			return nil, proofError("ticket leaf is nil")
+		}
+		if _, ok := mp[b.Leaf.Addr]; ok {
+			return nil, proofError("duplicate ticket leaf %s", b.Leaf.Addr)
+		}
+		if !bytes.Equal(b.Root(), c.Root) {
+			return nil, proofError("ticket branch of %s NOT match root", b.Leaf.Addr)
+		}
+		mp[b.Leaf.Addr] = b.Leaf.Count
+	}
+	return mp, nil
+}
+
+// voters 返回投票的bls公钥和聚合签名, 必须和miner交易里的投票一致
+func voters(m *pt.Pos33MinerMsg, vs []*pt.Pos33FinalityVoter) ([][]byte, []byte, error) {
+	pks := make([][]byte, len(vs))
+	for i, x := range vs {
+		pks[i] = x.BlsPk
+	}
+	a := m.GetAgg()
+	if a == nil {
+		if len(pks) != len(m.BlsPkList) {
+			return nil, nil, proofError("%d voters, miner has %d", len(pks), len(m.BlsPkList))
+		}
+		for i, pk := range m.BlsPkList {
+			if !bytes.Equal(pk, pks[i]) {
+				return nil, nil, proofError("voter %d NOT match miner", i)
+			}
+		}
+		return pks, m.BlsSig, nil
+	}
+	// 位图里公钥的编号是链上状态, 聚合签名验证通过就说明是这些公钥签的名
+	if len(pks) != m.VoterCount() {
+		return nil, nil, proofError("%d voters, miner has %d", len(pks), m.VoterCount())
+	}
+	tail := pks[len(pks)-len(a.Pks):]
+	for i, pk := range a.Pks {
+		if !bytes.Equal(pk, tail[i]) {
+			return nil, nil, proofError("unindexed voter %d NOT match miner", i)
+		}
+	}
+	return pks, a.Sig, nil
+}
+
+// Verify 用以前的可信区块头的hash验证最终性证明, 通过时返回区块hash.
+// trusted是证明的anchor区块, 也就是快照高度的下一个区块的hash.
+// 共识第3轮以后不检查投票签名, 这里总是检查, 签名不对的区块不能证明
+func (v *Verifier) Verify(p *pt.Pos33FinalityProof, trusted []byte) ([]byte, error) {
+	if p == nil || p.Header == nil || p.Anchor == nil {
+		return nil, proofError("header or anchor is nil")
+	}
+	if v.BlsOwner == nil {
+		return nil, proofError("bls owner NOT set")
+	}
+	c := p.Tickets
+	err := v.tickets(p.Anchor, c, trusted)
+	if err != nil {
+		return nil, err
+	}
+	h := p.Header
+	if h.Height <= pt.Pos33SortBlocks || c.Height != h.Height {
+		return nil, proofError("ticket commitment height %d NOT match block %d", c.Height, h.Height)
+	}
+	hash, m, err := v.minerTx(&pt.Pos33MinerTxProof{Header: h, MinerTx: p.MinerTx, TxBranch: p.TxBranch, ChildBranch: p.ChildBranch, FullHash: p.FullHash})
+	if err != nil {
+		return nil, err
+	}
+	round := m.Sort.Proof.Input.Round
+
+	cs, err := counts(c, p.Counts)
+	if err != nil {
+		return nil, err
+	}
+	_, err = v.checkSort(c, cs, round, m.Sort)
+	if err != nil {
+		return nil, fmt.Errorf("maker sort: %w", err)
+	}
+	// 每个通过的委员会抽签是一个座位
+	seats := make(map[string]int)
+	done := make(map[string]bool)
+	for _, s := range p.Committee {
+		addr, err := v.checkSort(c, cs, round, s)
+		if err != nil {
+			return nil, fmt.Errorf("committee sort: %w", err)
+		}
+		if done[string(s.SortHash.Hash)] {
+			continue
+		}
+		done[string(s.SortHash.Hash)] = true
+		seats[addr]++
+	}
+
+	pks, sig, err := voters(m, p.Voters)
+	if err != nil {
+		return nil, err
+	}
+	// 一个地址的投票不超过它的座位数
+	votes := 0
+	used := make(map[string]int)
+	voted := make(map[string]bool)
+	for _, x := range p.Voters {
+		if voted[string(x.BlsPk)] {
+			return nil, proofError("duplicate voter")
+		}
+		voted[string(x.BlsPk)] = true
+		addr, err := v.BlsOwner(x.BlsPk)
+		if err != nil {
+			return nil, err
+		}
+		if used[addr] < seats[addr] {
+			used[addr]++
+			votes++
+		}
+	}
+	quorum := v.Quorum
+	if quorum <= 0 {
+		quorum = pt.Pos33MustVotes
+	}
+	if votes < quorum {
+		return nil, proofError("votes %d < quorum %d", votes, quorum)
+	}
+	am := &pt.Pos33MinerMsg{BlsPkList: pks, BlsSig: sig, Hash: m.Hash}
+	err = am.Verify()
+	if err != nil {
+		return nil, proofError("aggregate signature error: %v", err)
+	}
+	return hash, nil
+}
@@ -5,6 +5,8 @@
 package rpc
 
 import (
+	"encoding/json"
+
 	"github.com/33cn/chain33/common"
 	rpctypes "github.com/33cn/chain33/rpc/types"
 	"github.com/33cn/chain33/types"
@@ -50,6 +52,13 @@ func (g *channelClient) GetPos33Info(ctx context.Context, in *types.ReqNil) (*ty
 	cfg33 := g.GetConfig()
 	mp := ty.GetPos33MineParam(cfg33, height)
 	tprice := mp.GetTicketPrice()
+	if cfg33.IsDappFork(height, ty.Pos33TicketX, "ForkTicketPrice") {
+		p, err := g.GetTicketPrice(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		tprice = p.Current.Price
+	}
 
 	useEntrust := cfg33.IsDappFork(height, ty.Pos33TicketX, "UseEntrust")
 	if useEntrust {
@@ -205,6 +214,211 @@ func (c *Jrpc) SetPos33Entrust(in *ty.Pos33Entrust, result *interface{}) error {
 	return nil
 }
 
+// CreateDelegate 创建委托交易, 由委托人签名发送
+func (g *channelClient) CreateDelegate(ctx context.Context, in *ty.Pos33Delegate) (*ty.ReplyTxHex, error) {
+	cfg := g.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(ty.Pos33TicketX), "Delegate", in)
+	if err != nil {
+		return nil, err
+	}
+	return &ty.ReplyTxHex{TxHex: common.ToHex(data)}, nil
+}
+
+// CreateDelegate 创建委托交易, 由委托人签名发送
+func (c *Jrpc) CreateDelegate(in *ty.Pos33Delegate, result *interface{}) error {
+	r, err := c.cli.CreateDelegate(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = r
+	return nil
+}
+
+// CreateUndelegate 创建取回委托的交易, 由委托人签名发送
+func (g *channelClient) CreateUndelegate(ctx context.Context, in *ty.Pos33Undelegate) (*ty.ReplyTxHex, error) {
+	cfg := g.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(ty.Pos33TicketX), "Undelegate", in)
+	if err != nil {
+		return nil, err
+	}
+	return &ty.ReplyTxHex{TxHex: common.ToHex(data)}, nil
+}
+
+// CreateUndelegate 创建取回委托的交易, 由委托人签名发送
+func (c *Jrpc) CreateUndelegate(in *ty.Pos33Undelegate, result *interface{}) error {
+	r, err := c.cli.CreateUndelegate(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = r
+	return nil
+}
+
+// GetOperator 查询矿工的委托情况和票数
+func (g *channelClient) GetOperator(ctx context.Context, in *types.ReqAddr) (*ty.Pos33OperatorInfo, error) {
+	msg, err := g.Query(ty.Pos33TicketX, "Pos33Operator", in)
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*ty.Pos33OperatorInfo), nil
+}
+
+// GetOperator 查询矿工的委托情况和票数
+func (c *Jrpc) GetOperator(in *types.ReqAddr, result *interface{}) error {
+	r, err := c.cli.GetOperator(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = r
+	return nil
+}
+
+// CreateJail 创建关押不在线矿工的交易, 任何人都可以发送
+func (g *channelClient) CreateJail(ctx context.Context, in *ty.Pos33Jail) (*ty.ReplyTxHex, error) {
+	cfg := g.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(ty.Pos33TicketX), "Jail", in)
+	if err != nil {
+		return nil, err
+	}
+	return &ty.ReplyTxHex{TxHex: common.ToHex(data)}, nil
+}
+
+// CreateJail 创建关押不在线矿工的交易, 任何人都可以发送
+func (c *Jrpc) CreateJail(in *ty.Pos33Jail, result *interface{}) error {
+	r, err := c.cli.CreateJail(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = r
+	return nil
+}
+
+// CreateUnjail 创建解除关押的交易, 由被关押的矿工签名发送
+func (g *channelClient) CreateUnjail(ctx context.Context, in *ty.Pos33Unjail) (*ty.ReplyTxHex, error) {
+	cfg := g.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(ty.Pos33TicketX), "Unjail", in)
+	if err != nil {
+		return nil, err
+	}
+	return &ty.ReplyTxHex{TxHex: common.ToHex(data)}, nil
+}
+
+// CreateUnjail 创建解除关押的交易, 由被关押的矿工签名发送
+func (c *Jrpc) CreateUnjail(in *ty.Pos33Unjail, result *interface{}) error {
+	r, err := c.cli.CreateUnjail(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = r
+	return nil
+}
+
+// GetLiveness 查询矿工的参与记录和关押状态
+func (g *channelClient) GetLiveness(ctx context.Context, in *types.ReqAddr) (*ty.Pos33Liveness, error) {
+	msg, err := g.Query(ty.Pos33TicketX, "Pos33Liveness", in)
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*ty.Pos33Liveness), nil
+}
+
+// GetLiveness 查询矿工的参与记录和关押状态
+func (c *Jrpc) GetLiveness(in *types.ReqAddr, result *interface{}) error {
+	r, err := c.cli.GetLiveness(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = r
+	return nil
+}
+
+// CreateKeyRotate 创建换共识公钥的交易, in已经用新的私钥签名, 由staker签名发送
+func (g *channelClient) CreateKeyRotate(ctx context.Context, in *ty.Pos33KeyRotate) (*ty.ReplyTxHex, error) {
+	err := in.Check()
+	if err != nil {
+		return nil, err
+	}
+	cfg := g.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(ty.Pos33TicketX), "KeyRotate", in)
+	if err != nil {
+		return nil, err
+	}
+	return &ty.ReplyTxHex{TxHex: common.ToHex(data)}, nil
+}
+
+// CreateKeyRotate 创建换共识公钥的交易, in已经用新的私钥签名, 由staker签名发送
+func (c *Jrpc) CreateKeyRotate(in *ty.Pos33KeyRotate, result *interface{}) error {
+	r, err := c.cli.CreateKeyRotate(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = r
+	return nil
+}
+
+// GetKeyOwner 查询共识公钥绑定的staker和staker换公钥的记录
+func (g *channelClient) GetKeyOwner(ctx context.Context, in *ty.ReqPos33KeyOwner) (*ty.Pos33KeyOwner, error) {
+	msg, err := g.Query(ty.Pos33TicketX, "Pos33KeyOwner", in)
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*ty.Pos33KeyOwner), nil
+}
+
+// GetKeyOwner 查询共识公钥绑定的staker和staker换公钥的记录
+func (c *Jrpc) GetKeyOwner(in *ty.ReqPos33KeyOwner, result *interface{}) error {
+	r, err := c.cli.GetKeyOwner(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = r
+	return nil
+}
+
+// SetChainParam 创建设置共识参数的交易, 只有manage的超级管理员可以发送
+func (g *channelClient) SetChainParam(ctx context.Context, in *ty.Pos33ChainParam) (*ty.ReplyTxHex, error) {
+	cfg := g.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(ty.Pos33TicketX), "ChainParam", in)
+	if err != nil {
+		return nil, err
+	}
+	return &ty.ReplyTxHex{TxHex: common.ToHex(data)}, nil
+}
+
+// SetChainParam 创建设置共识参数的交易, 只有manage的超级管理员可以发送
+func (c *Jrpc) SetChainParam(in *ty.Pos33ChainParam, result *interface{}) error {
+	r, err := c.cli.SetChainParam(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = r
+	return nil
+}
+
+// GetChainParams 查询按生效高度排列的共识参数
+func (g *channelClient) GetChainParams(ctx context.Context, in *types.ReqNil) (*ty.Pos33ChainParams, error) {
+	msg, err := g.Query(ty.Pos33TicketX, "Pos33ChainParams", in)
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*ty.Pos33ChainParams), nil
+}
+
+// GetChainParams 查询按生效高度排列的共识参数
+func (c *Jrpc) GetChainParams(in *types.ReqNil, result *interface{}) error {
+	r, err := c.cli.GetChainParams(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	var jsonmsg json.RawMessage
+	jsonmsg, err = types.PBToJSON(r)
+	if err != nil {
+		return err
+	}
+	*result = jsonmsg
+	return nil
+}
+
 func (g *channelClient) GetMinerList(ctx context.Context, in *types.ReqNil) (*types.ReplyStrings, error) {
 	data, err := g.QueryConsensusFunc(ty.Pos33TicketX, "GetMinerList", in)
 	if err != nil {
@@ -221,3 +435,529 @@ func (c *Jrpc) GetMinerList(in *types.ReqNil, result *interface{}) error {
 	*result = r.GetDatas()
 	return nil
 }
+
+// GetValidatorSet 获取height高度抽签使用的验证者集合
+func (g *channelClient) GetValidatorSet(ctx context.Context, in *types.ReqInt) (*ty.Pos33ValidatorSet, error) {
+	data, err := g.QueryConsensusFunc(ty.Pos33TicketX, "GetValidatorSet", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33ValidatorSet), nil
+}
+
+// GetValidatorSet 获取height高度抽签使用的验证者集合
+func (c *Jrpc) GetValidatorSet(in *types.ReqInt, result *interface{}) error {
+	r, err := c.cli.GetValidatorSet(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	var jsonmsg json.RawMessage
+	jsonmsg, err = types.PBToJSON(r)
+	if err != nil {
+		return err
+	}
+	*result = jsonmsg
+	return nil
+}
+
+// GetCommittee 获取height高度确定的委员会
+func (g *channelClient) GetCommittee(ctx context.Context, in *types.ReqInt) (*ty.Pos33CommitteeRecord, error) {
+	data, err := g.QueryConsensusFunc(ty.Pos33TicketX, "GetCommittee", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33CommitteeRecord), nil
+}
+
+// GetCommittee 获取height高度确定的委员会
+func (c *Jrpc) GetCommittee(in *types.ReqInt, result *interface{}) error {
+	r, err := c.cli.GetCommittee(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	var jsonmsg json.RawMessage
+	jsonmsg, err = types.PBToJSON(r)
+	if err != nil {
+		return err
+	}
+	*result = jsonmsg
+	return nil
+}
+
+// GetCommitteeSizes 获取height高度每一轮的委员会大小
+func (g *channelClient) GetCommitteeSizes(ctx context.Context, in *types.ReqInt) (*ty.Pos33CommitteeSizes, error) {
+	data, err := g.QueryConsensusFunc(ty.Pos33TicketX, "GetCommitteeSizes", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33CommitteeSizes), nil
+}
+
+// GetCommitteeSizes 获取height高度每一轮的委员会大小
+func (c *Jrpc) GetCommitteeSizes(in *types.ReqInt, result *interface{}) error {
+	r, err := c.cli.GetCommitteeSizes(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = r
+	return nil
+}
+
+// GetRetentionStats 获取委员会记录的保留策略和清理统计
+func (g *channelClient) GetRetentionStats(ctx context.Context, in *types.ReqNil) (*ty.Pos33RetentionStats, error) {
+	data, err := g.QueryConsensusFunc(ty.Pos33TicketX, "GetRetentionStats", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33RetentionStats), nil
+}
+
+// GetRetentionStats 获取委员会记录的保留策略和清理统计
+func (c *Jrpc) GetRetentionStats(in *types.ReqNil, result *interface{}) error {
+	r, err := c.cli.GetRetentionStats(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = r
+	return nil
+}
+
+// GetCommitteeDigest 获取height高度区块的委员会摘要, 用于配置信任的检查点
+func (g *channelClient) GetCommitteeDigest(ctx context.Context, in *types.ReqInt) (*types.ReplyString, error) {
+	data, err := g.QueryConsensusFunc(ty.Pos33TicketX, "GetCommitteeDigest", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*types.ReplyString), nil
+}
+
+// GetCommitteeDigest 获取height高度区块的委员会摘要, 用于配置信任的检查点
+func (c *Jrpc) GetCommitteeDigest(in *types.ReqInt, result *interface{}) error {
+	r, err := c.cli.GetCommitteeDigest(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = r
+	return nil
+}
+
+// GetConfigManifest 获取共识参数清单, 配置相同的节点清单的hash相同
+func (g *channelClient) GetConfigManifest(ctx context.Context, in *ty.ReqPos33Manifest) (*ty.Pos33ConfigManifest, error) {
+	data, err := g.QueryConsensusFunc(ty.Pos33TicketX, "GetConfigManifest", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33ConfigManifest), nil
+}
+
+// GetConfigManifest 获取共识参数清单, 配置相同的节点清单的hash相同
+func (c *Jrpc) GetConfigManifest(in *ty.ReqPos33Manifest, result *interface{}) error {
+	r, err := c.cli.GetConfigManifest(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	var jsonmsg json.RawMessage
+	jsonmsg, err = types.PBToJSON(r)
+	if err != nil {
+		return err
+	}
+	*result = jsonmsg
+	return nil
+}
+
+// GetBlockCommittee 获取height高度区块的委员会, 和共识接受区块时一致
+func (g *channelClient) GetBlockCommittee(ctx context.Context, in *types.ReqInt) (*ty.Pos33BlockCommittee, error) {
+	data, err := g.QueryConsensusFunc(ty.Pos33TicketX, "GetBlockCommittee", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33BlockCommittee), nil
+}
+
+// GetBlockCommittee 获取height高度区块的委员会, 和共识接受区块时一致
+func (c *Jrpc) GetBlockCommittee(in *types.ReqInt, result *interface{}) error {
+	r, err := c.cli.GetBlockCommittee(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	var jsonmsg json.RawMessage
+	jsonmsg, err = types.PBToJSON(r)
+	if err != nil {
+		return err
+	}
+	*result = jsonmsg
+	return nil
+}
+
+func (g *channelClient) GetCurrentRound(ctx context.Context, in *types.ReqNil) (*ty.Pos33CurrentRound, error) {
+	data, err := g.QueryConsensusFunc(ty.Pos33TicketX, "GetCurrentRound", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33CurrentRound), nil
+}
+
+// GetCurrentRound 获取节点正在处理的高度和轮次
+func (c *Jrpc) GetCurrentRound(in *types.ReqNil, result *interface{}) error {
+	r, err := c.cli.GetCurrentRound(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = r
+	return nil
+}
+
+func (g *channelClient) GetGrindingScores(ctx context.Context, in *ty.ReqPos33Grinding) (*ty.Pos33GrindingReport, error) {
+	data, err := g.QueryConsensusFunc(ty.Pos33TicketX, "GetGrindingScores", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33GrindingReport), nil
+}
+
+// GetGrindingScores 分析一段高度内抽签结果的自相关, 获取每个地址的怀疑分数
+func (c *Jrpc) GetGrindingScores(in *ty.ReqPos33Grinding, result *interface{}) error {
+	r, err := c.cli.GetGrindingScores(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = r
+	return nil
+}
+
+func (g *channelClient) GetBlockDiff(ctx context.Context, in *types.ReqInt) (*ty.Pos33BlockDiff, error) {
+	data, err := g.QueryConsensusFunc(ty.Pos33TicketX, "GetBlockDiff", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33BlockDiff), nil
+}
+
+// GetBlockDiff 获取height高度的区块确定时那一轮使用的diff
+func (c *Jrpc) GetBlockDiff(in *types.ReqInt, result *interface{}) error {
+	r, err := c.cli.GetBlockDiff(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = r
+	return nil
+}
+
+func (g *channelClient) GetSortitionEvents(ctx context.Context, in *ty.ReqPos33SortitionEvents) (*ty.Pos33SortitionEvents, error) {
+	data, err := g.QueryConsensusFunc(ty.Pos33TicketX, "GetSortitionEvents", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33SortitionEvents), nil
+}
+
+// GetSortitionEvents 获取seq从fromSeq开始的共识事件
+func (c *Jrpc) GetSortitionEvents(in *ty.ReqPos33SortitionEvents, result *interface{}) error {
+	r, err := c.cli.GetSortitionEvents(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = r
+	return nil
+}
+
+func (g *channelClient) GetStakingStats(ctx context.Context, in *types.ReqNil) (*ty.Pos33StakingStats, error) {
+	data, err := g.QueryConsensusFunc(ty.Pos33TicketX, "GetStakingStats", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33StakingStats), nil
+}
+
+// GetStakingStats 获取全网抵押统计
+func (c *Jrpc) GetStakingStats(in *types.ReqNil, result *interface{}) error {
+	r, err := c.cli.GetStakingStats(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = r
+	return nil
+}
+
+func (g *channelClient) GetReadiness(ctx context.Context, in *types.ReqNil) (*ty.Pos33Readiness, error) {
+	data, err := g.QueryConsensusFunc(ty.Pos33TicketX, "GetReadiness", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33Readiness), nil
+}
+
+// GetReadiness 获取节点是否可以参与共识
+func (c *Jrpc) GetReadiness(in *types.ReqNil, result *interface{}) error {
+	r, err := c.cli.GetReadiness(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = r
+	return nil
+}
+
+func (g *channelClient) GetSeatsStats(ctx context.Context, in *ty.ReqPos33Seats) (*ty.Pos33SeatsStats, error) {
+	data, err := g.QueryConsensusFunc(ty.Pos33TicketX, "GetSeatsStats", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33SeatsStats), nil
+}
+
+// GetSeatsStats 获取count张票在diff下每个区块中签数的均值和标准差
+func (c *Jrpc) GetSeatsStats(in *ty.ReqPos33Seats, result *interface{}) error {
+	r, err := c.cli.GetSeatsStats(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = r
+	return nil
+}
+
+func (g *channelClient) GetRewards(ctx context.Context, in *ty.ReqPos33Rewards) (*ty.Pos33Rewards, error) {
+	data, err := g.QueryConsensusFunc(ty.Pos33TicketX, "GetRewards", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33Rewards), nil
+}
+
+// GetRewards 获取地址在一段高度内得到的奖励, 范围太大时从Next继续查询
+func (c *Jrpc) GetRewards(in *ty.ReqPos33Rewards, result *interface{}) error {
+	r, err := c.cli.GetRewards(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = r
+	return nil
+}
+
+func (g *channelClient) GetConsensusSnapshot(ctx context.Context, in *types.ReqNil) (*ty.Pos33ConsensusSnapshot, error) {
+	data, err := g.QueryConsensusFunc(ty.Pos33TicketX, "GetConsensusSnapshot", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33ConsensusSnapshot), nil
+}
+
+// GetConsensusSnapshot 导出共识模块缓存的快照, 其他节点可以通过snapshotFile配置导入
+func (c *Jrpc) GetConsensusSnapshot(in *types.ReqNil, result *interface{}) error {
+	r, err := c.cli.GetConsensusSnapshot(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	var jsonmsg json.RawMessage
+	jsonmsg, err = types.PBToJSON(r)
+	if err != nil {
+		return err
+	}
+	*result = jsonmsg
+	return nil
+}
+
+// ReplayAudit 用审计日志里height高度记录的输入重新验证抽签, 返回和记录不一致的结果
+func (g *channelClient) ReplayAudit(ctx context.Context, in *types.ReqInt) (*ty.Pos33AuditReplay, error) {
+	data, err := g.QueryConsensusFunc(ty.Pos33TicketX, "ReplayAudit", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33AuditReplay), nil
+}
+
+// ReplayAudit 用审计日志里height高度记录的输入重新验证抽签, 返回和记录不一致的结果
+func (c *Jrpc) ReplayAudit(in *types.ReqInt, result *interface{}) error {
+	r, err := c.cli.ReplayAudit(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	var jsonmsg json.RawMessage
+	jsonmsg, err = types.PBToJSON(r)
+	if err != nil {
+		return err
+	}
+	*result = jsonmsg
+	return nil
+}
+
+func (g *channelClient) GetCheckpoint(ctx context.Context, in *ty.ReqPos33Checkpoint) (*ty.Pos33Checkpoint, error) {
+	data, err := g.QueryConsensusFunc(ty.Pos33TicketX, "GetCheckpoint", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33Checkpoint), nil
+}
+
+// GetCheckpoint 导出height高度的快速同步检查点, 其他节点可以通过checkpointFile配置或者ImportCheckpoint导入
+func (c *Jrpc) GetCheckpoint(in *ty.ReqPos33Checkpoint, result *interface{}) error {
+	r, err := c.cli.GetCheckpoint(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	var jsonmsg json.RawMessage
+	jsonmsg, err = types.PBToJSON(r)
+	if err != nil {
+		return err
+	}
+	*result = jsonmsg
+	return nil
+}
+
+func (g *channelClient) ImportCheckpoint(ctx context.Context, in *ty.Pos33Checkpoint) (*types.Reply, error) {
+	data, err := g.QueryConsensusFunc(ty.Pos33TicketX, "ImportCheckpoint", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*types.Reply), nil
+}
+
+// ImportCheckpoint 导入快速同步检查点, 检查点以下的区块不验证抽签和投票
+func (c *Jrpc) ImportCheckpoint(in *ty.Pos33Checkpoint, result *interface{}) error {
+	r, err := c.cli.ImportCheckpoint(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = r
+	return nil
+}
+
+func (g *channelClient) CommitteeAt(ctx context.Context, in *types.ReqInt) (*ty.Pos33CommitteeAt, error) {
+	data, err := g.QueryConsensusFunc(ty.Pos33TicketX, "CommitteeAt", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33CommitteeAt), nil
+}
+
+// CommitteeAt 查询height高度的委员会: seed, diff, 每个抽签的地址, 序号, hash和投票情况
+func (c *Jrpc) CommitteeAt(in *types.ReqInt, result *interface{}) error {
+	r, err := c.cli.CommitteeAt(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	var jsonmsg json.RawMessage
+	jsonmsg, err = types.PBToJSON(r)
+	if err != nil {
+		return err
+	}
+	*result = jsonmsg
+	return nil
+}
+
+func (g *channelClient) GetFinalityProof(ctx context.Context, in *types.ReqInt) (*ty.Pos33FinalityProof, error) {
+	data, err := g.QueryConsensusFunc(ty.Pos33TicketX, "GetFinalityProof", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33FinalityProof), nil
+}
+
+// GetFinalityProof 获取height高度区块的最终性证明, 轻客户端用light包验证
+func (c *Jrpc) GetFinalityProof(in *types.ReqInt, result *interface{}) error {
+	r, err := c.cli.GetFinalityProof(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	var jsonmsg json.RawMessage
+	jsonmsg, err = types.PBToJSON(r)
+	if err != nil {
+		return err
+	}
+	*result = jsonmsg
+	return nil
+}
+
+// GetPeerScores 获取转发共识消息的节点的计分和禁止情况
+func (g *channelClient) GetPeerScores(ctx context.Context, in *types.ReqNil) (*ty.Pos33PeerScores, error) {
+	data, err := g.QueryConsensusFunc(ty.Pos33TicketX, "GetPeerScores", in)
+	if err != nil {
+		return nil, err
+	}
+	return data.(*ty.Pos33PeerScores), nil
+}
+
+// GetPeerScores 获取转发共识消息的节点的计分和禁止情况
+func (c *Jrpc) GetPeerScores(in *types.ReqNil, result *interface{}) error {
+	r, err := c.cli.GetPeerScores(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = r
+	return nil
+}
+
+// GetSortRecord 获取抽签统计索引里height高度的记录
+func (g *channelClient) GetSortRecord(ctx context.Context, in *types.ReqInt) (*ty.Pos33SortRecord, error) {
+	msg, err := g.Query(ty.Pos33TicketX, "Pos33SortRecord", in)
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*ty.Pos33SortRecord), nil
+}
+
+// GetSortRecord 获取抽签统计索引里height高度的记录
+func (c *Jrpc) GetSortRecord(in *types.ReqInt, result *interface{}) error {
+	r, err := c.cli.GetSortRecord(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = r
+	return nil
+}
+
+// GetMakerBlocks 获取地址在一段高度内出的区块
+func (g *channelClient) GetMakerBlocks(ctx context.Context, in *ty.ReqPos33MakerBlocks) (*ty.Pos33MakerBlocks, error) {
+	msg, err := g.Query(ty.Pos33TicketX, "Pos33MakerBlocks", in)
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*ty.Pos33MakerBlocks), nil
+}
+
+// GetMakerBlocks 获取地址在一段高度内出的区块
+func (c *Jrpc) GetMakerBlocks(in *ty.ReqPos33MakerBlocks, result *interface{}) error {
+	r, err := c.cli.GetMakerBlocks(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = r
+	return nil
+}
+
+// GetSortStats 获取一段高度内的平均委员会大小和出块轮次的分布
+func (g *channelClient) GetSortStats(ctx context.Context, in *ty.ReqPos33SortStats) (*ty.Pos33SortStats, error) {
+	msg, err := g.Query(ty.Pos33TicketX, "Pos33SortStats", in)
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*ty.Pos33SortStats), nil
+}
+
+// GetSortStats 获取一段高度内的平均委员会大小和出块轮次的分布
+func (c *Jrpc) GetSortStats(in *ty.ReqPos33SortStats, result *interface{}) error {
+	r, err := c.cli.GetSortStats(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = r
+	return nil
+}
+
+// GetTicketPrice 获取当前的票价, 和按现在的抵押计算的下一个周期的票价
+func (g *channelClient) GetTicketPrice(ctx context.Context, in *types.ReqNil) (*ty.Pos33TicketPriceInfo, error) {
+	msg, err := g.Query(ty.Pos33TicketX, "Pos33TicketPrice", in)
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*ty.Pos33TicketPriceInfo), nil
+}
+
+// GetTicketPrice 获取当前的票价, 和按现在的抵押计算的下一个周期的票价
+func (c *Jrpc) GetTicketPrice(in *types.ReqNil, result *interface{}) error {
+	r, err := c.cli.GetTicketPrice(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = r
+	return nil
+}
@@ -0,0 +1,68 @@
+package rpc
+
+import (
+	"time"
+
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// eventPollInterval 没有新事件时, 多久再查询一次共识模块
+var eventPollInterval = time.Millisecond * 200
+
+// StreamSortitionEvents 推送共识事件, Types不为空时只推送这些类型.
+// 共识模块只保存最近的事件, 按seq轮询, 消费太慢时旧的事件会被覆盖,
+// 发送一个Pos33EventDropped事件告诉消费者丢了多少, 不会阻塞共识
+func (g *Grpc) StreamSortitionEvents(in *ty.ReqPos33SortitionEvents, stream ty.Pos33_StreamSortitionEventsServer) error {
+	ctx := stream.Context()
+	from := in.FromSeq
+	for ctx.Err() == nil {
+		r, err := g.GetSortitionEvents(ctx, &ty.ReqPos33SortitionEvents{FromSeq: from, Count: in.Count, Types: in.Types})
+		if err != nil {
+			return err
+		}
+		if r.Dropped > 0 {
+			err = stream.Send(&ty.Pos33SortitionEvent{Seq: from, Ty: ty.Pos33EventDropped, Count: int32(r.Dropped)})
+			if err != nil {
+				return err
+			}
+		}
+		for _, e := range r.Events {
+			err = stream.Send(e)
+			if err != nil {
+				return err
+			}
+		}
+		// 被过滤掉的事件也会推进NextSeq, 没有推进时才等待
+		if r.NextSeq > from {
+			from = r.NextSeq
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(eventPollInterval):
+		}
+	}
+	return nil
+}
+
+// StreamRewards 分段推送[start, end]高度的奖励, 每段最多是共识模块一次查询的范围
+func (g *Grpc) StreamRewards(in *ty.ReqPos33Rewards, stream ty.Pos33_StreamRewardsServer) error {
+	ctx := stream.Context()
+	req := &ty.ReqPos33Rewards{Addr: in.Addr, Start: in.Start, End: in.End}
+	for ctx.Err() == nil {
+		r, err := g.GetRewards(ctx, req)
+		if err != nil {
+			return err
+		}
+		err = stream.Send(r)
+		if err != nil {
+			return err
+		}
+		if r.Next == 0 {
+			return nil
+		}
+		req.Start = r.Next
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/33cn/chain33/client/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	ty "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// mockEventStream 模拟grpc客户端, 收到n个事件后断开
+type mockEventStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	n      int
+	events []*ty.Pos33SortitionEvent
+}
+
+func (s *mockEventStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockEventStream) Send(e *ty.Pos33SortitionEvent) error {
+	s.events = append(s.events, e)
+	if len(s.events) == s.n {
+		s.cancel()
+	}
+	return nil
+}
+
+func fromSeq(seq int64) interface{} {
+	return mock.MatchedBy(func(in *ty.ReqPos33SortitionEvents) bool { return in.FromSeq == seq })
+}
+
+func TestStreamSortitionEvents(t *testing.T) {
+	eventPollInterval = time.Millisecond
+	api := new(mocks.QueueProtocolAPI)
+	g := &Grpc{channelClient: newGrpc(api)}
+
+	// 客户端从0开始, 但是0到4已经被覆盖了
+	api.On("QueryConsensusFunc", ty.Pos33TicketX, "GetSortitionEvents", fromSeq(0)).Return(&ty.Pos33SortitionEvents{
+		Events:  []*ty.Pos33SortitionEvent{{Seq: 5, Ty: ty.Pos33EventWonSeats, Count: 3}, {Seq: 6, Ty: ty.Pos33EventCommittee, Count: 75}},
+		NextSeq: 7,
+		Dropped: 5,
+	}, nil).Once()
+	api.On("QueryConsensusFunc", ty.Pos33TicketX, "GetSortitionEvents", fromSeq(7)).Return(&ty.Pos33SortitionEvents{NextSeq: 7}, nil).Once()
+	api.On("QueryConsensusFunc", ty.Pos33TicketX, "GetSortitionEvents", fromSeq(7)).Return(&ty.Pos33SortitionEvents{
+		Events:  []*ty.Pos33SortitionEvent{{Seq: 7, Ty: ty.Pos33EventVerifyFailed, Err: "sort hash NOT win"}},
+		NextSeq: 8,
+	}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &mockEventStream{ctx: ctx, cancel: cancel, n: 4}
+	err := g.StreamSortitionEvents(&ty.ReqPos33SortitionEvents{}, s)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(s.events))
+	assert.Equal(t, int32(ty.Pos33EventDropped), s.events[0].Ty)
+	assert.Equal(t, int32(5), s.events[0].Count)
+	assert.Equal(t, int64(5), s.events[1].Seq)
+	assert.Equal(t, int64(6), s.events[2].Seq)
+	assert.Equal(t, int32(ty.Pos33EventVerifyFailed), s.events[3].Ty)
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+)
+
+// Pos33BlsPubkeysFunc 按编号查询链上登记的bls公钥, 返回的公钥和indices一一对应
+type Pos33BlsPubkeysFunc func(indices []int64) ([][]byte, error)
+
+// NewPos33AggVote 用投票公钥的编号生成位图, pks是还没有编号的投票公钥
+func NewPos33AggVote(indices []int64, pks [][]byte, sig []byte) *Pos33AggVote {
+	var max int64 = -1
+	for _, i := range indices {
+		if i > max {
+			max = i
+		}
+	}
+	bitmap := make([]byte, (max+8)/8)
+	for _, i := range indices {
+		bitmap[i/8] |= 1 << uint(i%8)
+	}
+	return &Pos33AggVote{Bitmap: bitmap, Pks: pks, Sig: sig}
+}
+
+// Indices 返回位图里投票的编号, 从小到大
+func (a *Pos33AggVote) Indices() []int64 {
+	var is []int64
+	for i, b := range a.GetBitmap() {
+		for j := 0; j < 8; j++ {
+			if b&(1<<uint(j)) != 0 {
+				is = append(is, int64(i*8+j))
+			}
+		}
+	}
+	return is
+}
+
+// Expand 用lookup把Agg展开成BlsPkList和BlsSig, 位图里的公钥按编号排在Agg.Pks前面.
+// 没有Agg时不变, 展开以后的检查和奖励和以前的区块一样
+func (m *Pos33MinerMsg) Expand(lookup Pos33BlsPubkeysFunc) error {
+	a := m.GetAgg()
+	if a == nil {
+		return nil
+	}
+	if len(m.BlsPkList) > 0 || len(m.BlsSig) > 0 {
+		return fmt.Errorf("%w: both agg vote and bls pk list", ErrAggVote)
+	}
+	var pks [][]byte
+	if is := a.Indices(); len(is) > 0 {
+		var err error
+		pks, err = lookup(is)
+		if err != nil {
+			return err
+		}
+		if len(pks) != len(is) {
+			return fmt.Errorf("%w: %d pubkeys for %d indices", ErrAggVote, len(pks), len(is))
+		}
+	}
+	m.BlsPkList = append(pks, a.Pks...)
+	m.BlsSig = a.Sig
+	return nil
+}
+
+// VoterCount 投票的数量, 不需要展开Agg
+func (m *Pos33MinerMsg) VoterCount() int {
+	if a := m.GetAgg(); a != nil {
+		return len(a.Indices()) + len(a.Pks)
+	}
+	return len(m.BlsPkList)
+}
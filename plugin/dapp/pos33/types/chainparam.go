@@ -0,0 +1,90 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	// Pos33ChainParamLead 参数至少在交易高度以后多少个区块生效, 所有节点在抽签之前都已经执行了这个交易
+	Pos33ChainParamLead = 2 * Pos33SortBlocks
+	// Pos33MinSortBlocks sortBlocks的下限, 在Pos33SortBlocks/2高度以前投票选委员会时抽签要已经广播
+	Pos33MinSortBlocks = Pos33SortBlocks/2 + 1
+	// Pos33MaxCommitteeSize 委员会大小的上限
+	Pos33MaxCommitteeSize = 1000
+	// Pos33MaxDiffPersent 抽签难度百分比的上限
+	Pos33MaxDiffPersent = 1000
+)
+
+// DefaultPos33ChainParam 没有治理交易时使用的参数
+func DefaultPos33ChainParam() *Pos33ChainParam {
+	return &Pos33ChainParam{
+		CommitteeSize: Pos33CommitteeSize,
+		DiffPersent:   100,
+		SortBlocks:    Pos33SortBlocks,
+	}
+}
+
+// Check 检查参数的范围, 为0的参数沿用之前的值.
+// sortBlocks不能超过Pos33SortBlocks, 各种缓存保留的高度是按Pos33SortBlocks计算的,
+// 也不能小于Pos33MinSortBlocks
+func (p *Pos33ChainParam) Check() error {
+	if p.CommitteeSize < 0 || p.CommitteeSize > Pos33MaxCommitteeSize {
+		return fmt.Errorf("%w: committeeSize %d", ErrChainParam, p.CommitteeSize)
+	}
+	if p.DiffPersent < 0 || p.DiffPersent > Pos33MaxDiffPersent {
+		return fmt.Errorf("%w: diffPersent %d", ErrChainParam, p.DiffPersent)
+	}
+	if p.SortBlocks != 0 && (p.SortBlocks < Pos33MinSortBlocks || p.SortBlocks > Pos33SortBlocks) {
+		return fmt.Errorf("%w: sortBlocks %d", ErrChainParam, p.SortBlocks)
+	}
+	if p.CommitteeSize == 0 && p.DiffPersent == 0 && p.SortBlocks == 0 {
+		return fmt.Errorf("%w: nothing changed", ErrChainParam)
+	}
+	return nil
+}
+
+// Diff 全网票数为allCount时的抽签难度. DiffPersent是100时和以前一样是CommitteeSize/allCount,
+// 先乘再除的结果可能差1ulp, 会改变以前的区块的中签结果
+func (p *Pos33ChainParam) Diff(allCount int) float64 {
+	if p.DiffPersent == 100 {
+		return float64(p.CommitteeSize) / float64(allCount)
+	}
+	return float64(p.CommitteeSize) / float64(allCount) * float64(p.DiffPersent) / 100
+}
+
+// At 返回height高度生效的参数
+func (ps *Pos33ChainParams) At(height int64) *Pos33ChainParam {
+	params := ps.GetParams()
+	i := sort.Search(len(params), func(i int) bool { return params[i].Height > height })
+	if i == 0 {
+		return DefaultPos33ChainParam()
+	}
+	return params[i-1]
+}
+
+// Add 增加从p.Height开始生效的参数, 为0的参数用之前的值补全.
+// 生效高度必须比已有的都高, 已经排好的参数不能修改
+func (ps *Pos33ChainParams) Add(p *Pos33ChainParam) (*Pos33ChainParam, error) {
+	if n := len(ps.Params); n > 0 && p.Height <= ps.Params[n-1].Height {
+		return nil, fmt.Errorf("%w: height %d <= last param height %d", ErrChainParam, p.Height, ps.Params[n-1].Height)
+	}
+	last := ps.At(p.Height)
+	np := &Pos33ChainParam{
+		Height:        p.Height,
+		CommitteeSize: last.CommitteeSize,
+		DiffPersent:   last.DiffPersent,
+		SortBlocks:    last.SortBlocks,
+	}
+	if p.CommitteeSize > 0 {
+		np.CommitteeSize = p.CommitteeSize
+	}
+	if p.DiffPersent > 0 {
+		np.DiffPersent = p.DiffPersent
+	}
+	if p.SortBlocks > 0 {
+		np.SortBlocks = p.SortBlocks
+	}
+	ps.Params = append(ps.Params, np)
+	return np, nil
+}
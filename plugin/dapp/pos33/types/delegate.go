@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Check 检查委托的参数
+func (d *Pos33Delegate) Check() error {
+	if d == nil || d.Operator == "" {
+		return fmt.Errorf("%w: operator is empty", ErrDelegate)
+	}
+	if d.Amount <= 0 {
+		return fmt.Errorf("%w: amount %d", ErrDelegate, d.Amount)
+	}
+	return nil
+}
+
+// Check 检查取回委托的参数
+func (u *Pos33Undelegate) Check() error {
+	if u == nil || u.Operator == "" {
+		return fmt.Errorf("%w: operator is empty", ErrDelegate)
+	}
+	if u.Amount <= 0 {
+		return fmt.Errorf("%w: amount %d", ErrDelegate, u.Amount)
+	}
+	return nil
+}
+
+// DelegatorShare 按委托数量分配奖励, 返回reward*amount/total.
+// ForkDelegate以前按票数(amount/ticketPrice)分配, 不足一张票的委托分不到奖励
+func DelegatorShare(reward, amount, total int64) int64 {
+	if reward <= 0 || amount <= 0 || total <= 0 {
+		return 0
+	}
+	r := new(big.Int).Mul(big.NewInt(reward), big.NewInt(amount))
+	return r.Quo(r, big.NewInt(total)).Int64()
+}
@@ -29,4 +29,28 @@ var (
 	ErrNoVrf = errors.New("ErrNoVrf")
 	// ErrVrfVerify err type
 	ErrVrfVerify = errors.New("ErrVrfVerify")
+	// ErrVrfProofSize err type
+	ErrVrfProofSize = errors.New("ErrVrfProofSize")
+	// ErrSlashEvidence err type
+	ErrSlashEvidence = errors.New("ErrSlashEvidence")
+	// ErrSlashed err type
+	ErrSlashed = errors.New("ErrSlashed")
+	// ErrChainParam err type
+	ErrChainParam = errors.New("ErrChainParam")
+	// ErrAggVote err type
+	ErrAggVote = errors.New("ErrAggVote")
+	// ErrDelegate err type
+	ErrDelegate = errors.New("ErrDelegate")
+	// ErrJail err type
+	ErrJail = errors.New("ErrJail")
+	// ErrKeyRotate err type
+	ErrKeyRotate = errors.New("ErrKeyRotate")
+	// ErrFinalityProof err type
+	ErrFinalityProof = errors.New("ErrFinalityProof")
+	// ErrMinerReward err type
+	ErrMinerReward = errors.New("ErrMinerReward")
+	// ErrSortIndex err type
+	ErrSortIndex = errors.New("ErrSortIndex")
+	// ErrTicketPrice err type
+	ErrTicketPrice = errors.New("ErrTicketPrice")
 )
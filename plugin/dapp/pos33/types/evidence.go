@@ -0,0 +1,116 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/yccproject/ycc/plugin/dapp/pos33/verifier"
+)
+
+// Pos33SlashWindow 作恶发生后这么多个区块内可以处理证据
+const Pos33SlashWindow = 128
+
+// NewPos33Evidence 用同一个抽签的两个冲突投票创建证据: 高度和轮次相同, 投票的Hash不同.
+// a和b按投票的Hash排序, 不管先收到哪个, 每个节点得到的证据都一样.
+// makers是sort hash到抽签的map, 从里面找到两个投票的出块抽签, 找不到的为空
+func NewPos33Evidence(offender string, a, b *Pos33VoteMsg, makers map[string]*Pos33SortMsg) (*Pos33Evidence, error) {
+	if a.GetSort().GetSortHash() == nil || a.Sort.GetProof().GetInput() == nil ||
+		b.GetSort().GetSortHash() == nil || b.Sort.GetProof().GetInput() == nil {
+		return nil, errors.New("evidence vote sort is nil")
+	}
+	if !bytes.Equal(a.Sort.SortHash.Hash, b.Sort.SortHash.Hash) {
+		return nil, errors.New("evidence votes NOT from the same sort")
+	}
+	ia, ib := a.Sort.Proof.Input, b.Sort.Proof.Input
+	if ia.Height != ib.Height || ia.Round != ib.Round || a.Round != b.Round {
+		return nil, errors.New("evidence votes height or round NOT same")
+	}
+	c := bytes.Compare(a.Hash, b.Hash)
+	if c == 0 {
+		return nil, errors.New("evidence votes NOT conflict")
+	}
+	if c > 0 {
+		a, b = b, a
+	}
+	e := &Pos33Evidence{Height: ia.Height, Round: a.Round, Offender: offender, A: a, B: b,
+		MakerA: makers[string(a.Hash)], MakerB: makers[string(b.Hash)]}
+	e.Hash = e.CalcHash()
+	return e, nil
+}
+
+// CalcHash 计算证据的hash, 不包括Hash字段本身
+func (m *Pos33Evidence) CalcHash() []byte {
+	h := m.Hash
+	m.Hash = nil
+	b := crypto.Sha256(types.Encode(m))
+	m.Hash = h
+	return b
+}
+
+// Check 无状态地检查证据可以处罚, cfg只用来确定分叉: 两个投票是同一个bls公钥对同一个抽签签的,
+// 投的是同一个高度和轮次的两个不同的出块抽签. 投票的bls公钥属于谁由调用者检查
+func (m *Pos33Evidence) Check(cfg *types.Chain33Config) error {
+	a, b := m.A, m.B
+	if a.GetSig() == nil || b.GetSig() == nil || m.MakerA == nil || m.MakerB == nil {
+		return ErrSlashEvidence
+	}
+	if !bytes.Equal(m.Hash, m.CalcHash()) {
+		return ErrSlashEvidence
+	}
+	e, err := NewPos33Evidence(m.Offender, a, b, nil)
+	if err != nil || !bytes.Equal(e.A.Hash, a.Hash) {
+		return ErrSlashEvidence
+	}
+	if e.Height != m.Height || e.Round != m.Round || !bytes.Equal(a.Sig.Pubkey, b.Sig.Pubkey) {
+		return ErrSlashEvidence
+	}
+	if !a.Verify() || !b.Verify() {
+		return ErrSlashEvidence
+	}
+	for i, mk := range []*Pos33SortMsg{m.MakerA, m.MakerB} {
+		err = m.checkMaker(cfg, mk, [][]byte{a.Hash, b.Hash}[i])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkMaker 检查出块抽签的hash是hash, 并且是证据的高度和轮次的vrf算出来的
+func (m *Pos33Evidence) checkMaker(cfg *types.Chain33Config, mk *Pos33SortMsg, hash []byte) error {
+	sh, proof := mk.GetSortHash(), mk.GetProof()
+	if sh == nil || proof.GetInput() == nil || !bytes.Equal(sh.Hash, hash) {
+		return ErrSlashEvidence
+	}
+	in := proof.Input
+	tagged := cfg.IsDappFork(in.Height, Pos33TicketX, "ForkVrfSuite")
+	if in.Height != m.Height || in.Round != m.Round || verifier.CheckSuite(proof.VrfSuite, tagged) != nil {
+		return ErrSlashEvidence
+	}
+	err := verifier.VerifyVrf(proof.Pubkey, types.Encode(in), proof.VrfProof, proof.VrfHash)
+	if err != nil {
+		return ErrSlashEvidence
+	}
+	if !bytes.Equal(verifier.SortHash(proof.VrfHash, int(sh.Index), int(sh.Num)), hash) {
+		return ErrSlashEvidence
+	}
+	return nil
+}
+
+// Evidences 按高度, 作恶的地址, hash排序, 所有节点打包证据的顺序一致
+type Evidences []*Pos33Evidence
+
+func (m Evidences) Len() int { return len(m) }
+func (m Evidences) Less(i, j int) bool {
+	a, b := m[i], m[j]
+	if a.Height != b.Height {
+		return a.Height < b.Height
+	}
+	if a.Offender != b.Offender {
+		return a.Offender < b.Offender
+	}
+	return bytes.Compare(a.Hash, b.Hash) < 0
+}
+func (m Evidences) Swap(i, j int) { m[i], m[j] = m[j], m[i] }
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/types"
+)
+
+// Pos33TicketLeafHash 票数承诺的叶子
+func Pos33TicketLeafHash(v *Pos33Validator) []byte {
+	return crypto.Sha256(types.Encode(v))
+}
+
+func (m *Pos33ValidatorSet) leafHashes() [][]byte {
+	hs := make([][]byte, len(m.Validators))
+	for i, v := range m.Validators {
+		hs[i] = Pos33TicketLeafHash(v)
+	}
+	return hs
+}
+
+// TicketRoot 按地址排序的验证者的merkle根, 没有验证者时为nil
+func (m *Pos33ValidatorSet) TicketRoot() []byte {
+	if len(m.Validators) == 0 {
+		return nil
+	}
+	return merkle.GetMerkleRoot(m.leafHashes())
+}
+
+// TicketBranch 返回addr的票数和到TicketRoot的merkle路径
+func (m *Pos33ValidatorSet) TicketBranch(addr string) (*Pos33TicketBranch, error) {
+	for i, v := range m.Validators {
+		if v.Addr == addr {
+			return &Pos33TicketBranch{Leaf: v, Index: int32(i), Branch: merkle.GetMerkleBranch(m.leafHashes(), uint32(i))}, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s NOT in validator set, height %d", ErrFinalityProof, addr, m.Height)
+}
+
+// Root 用merkle路径计算出的根
+func (b *Pos33TicketBranch) Root() []byte {
+	return merkle.GetMerkleRootFromBranch(b.Branch, Pos33TicketLeafHash(b.Leaf), uint32(b.Index))
+}
+
+// NewPos33TicketCommitment 用快照高度的验证者集合生成票数承诺
+func NewPos33TicketCommitment(vs *Pos33ValidatorSet, seed []byte, diffs []float64, subCommittees int32) *Pos33TicketCommitment {
+	c := &Pos33TicketCommitment{
+		Height:         vs.Height,
+		SnapshotHeight: vs.SnapshotHeight,
+		AllCount:       vs.AllCount,
+		Seed:           seed,
+		Diffs:          diffs,
+		SubCommittees:  subCommittees,
+		Root:           vs.TicketRoot(),
+	}
+	c.Hash = c.CalcHash()
+	return c
+}
+
+// CalcHash 计算票数承诺的hash, 不包括Hash字段本身
+func (m *Pos33TicketCommitment) CalcHash() []byte {
+	h := m.Hash
+	m.Hash = nil
+	b := crypto.Sha256(types.Encode(m))
+	m.Hash = h
+	return b
+}
+
+// Diff round轮委员会的难度
+func (m *Pos33TicketCommitment) Diff(round int32) float64 {
+	if len(m.Diffs) == 0 || round < 0 {
+		return 0
+	}
+	if int(round) >= len(m.Diffs) {
+		return m.Diffs[len(m.Diffs)-1]
+	}
+	return m.Diffs[round]
+}
+
+// Check 检查票数承诺的hash是trusted
+func (m *Pos33TicketCommitment) Check(trusted []byte) error {
+	if m == nil {
+		return fmt.Errorf("%w: ticket commitment is nil", ErrFinalityProof)
+	}
+	if !bytes.Equal(m.Hash, m.CalcHash()) {
+		return fmt.Errorf("%w: ticket commitment hash error", ErrFinalityProof)
+	}
+	if !bytes.Equal(m.Hash, trusted) {
+		return fmt.Errorf("%w: ticket commitment NOT trusted, height %d", ErrFinalityProof, m.Height)
+	}
+	return nil
+}
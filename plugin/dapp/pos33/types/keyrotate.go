@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+)
+
+// Pos33KeyRotateDelay 新的共识公钥至少这么多个高度以后生效.
+// 抽签在出块前Pos33SortBlocks个高度开始, 生效以前所有节点都已经执行了换公钥的交易
+const Pos33KeyRotateDelay = Pos33SortBlocks * 2
+
+func (k *Pos33KeyRotate) signHash() []byte {
+	return crypto.Sha256(types.Encode(&Pos33KeyRotate{Staker: k.Staker, Height: k.Height}))
+}
+
+// Sign 用新的共识私钥签名
+func (k *Pos33KeyRotate) Sign(priv crypto.PrivKey) {
+	sig := priv.Sign(k.signHash())
+	k.Sig = &types.Signature{Ty: types.SECP256K1, Pubkey: priv.PubKey().Bytes(), Signature: sig.Bytes()}
+}
+
+// Check 检查换公钥的参数和新公钥的签名
+func (k *Pos33KeyRotate) Check() error {
+	if k == nil || k.Staker == "" {
+		return fmt.Errorf("%w: staker is empty", ErrKeyRotate)
+	}
+	if k.Sig == nil || len(k.Sig.Pubkey) == 0 {
+		return fmt.Errorf("%w: new pubkey is empty", ErrKeyRotate)
+	}
+	if !types.CheckSign(k.signHash(), "", k.Sig, k.Height) {
+		return fmt.Errorf("%w: signature of new pubkey error", ErrKeyRotate)
+	}
+	return nil
+}
+
+// Owner height高度这个公钥绑定的staker, 不在有效期内返回""
+func (k *Pos33ConsensusKey) Owner(height int64) string {
+	if k == nil || height < k.Height || (k.Revoke > 0 && height >= k.Revoke) {
+		return ""
+	}
+	return k.Staker
+}
+
+// Owner height高度公钥抽签时使用的抵押地址, addr是公钥推导出的地址.
+// 绑定有效时是绑定的staker, 否则是addr自己, addr已经换了公钥时返回""
+func (o *Pos33KeyOwner) Owner(addr string, height int64) string {
+	if o == nil {
+		return addr
+	}
+	if s := o.Key.Owner(height); s != "" {
+		return s
+	}
+	if o.Staker != nil && o.Staker.SelfRevoke > 0 && height >= o.Staker.SelfRevoke {
+		return ""
+	}
+	return addr
+}
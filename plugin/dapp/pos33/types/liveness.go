@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Pos33JailMinSeats 没有参与的高度里期望的投票数至少这么多才能关押.
+// 投票数近似泊松分布, 期望10次一次都没有投票的概率小于万分之一, 票数少的矿工不会因为没有中签被关押
+const Pos33JailMinSeats = 10
+
+// Missed 到height高度为止连续没有参与的高度数
+func (l *Pos33Liveness) Missed(height int64) int64 {
+	if height <= l.LastActive {
+		return 0
+	}
+	return height - l.LastActive
+}
+
+// Jailable 检查height高度能不能关押. amount是这个矿工的抵押, allAmount是全网的抵押
+func (l *Pos33Liveness) Jailable(height, jailBlocks, amount, allAmount int64) error {
+	if jailBlocks <= 0 {
+		return fmt.Errorf("%w: jail NOT enabled", ErrJail)
+	}
+	if l.Jailed {
+		return fmt.Errorf("%w: %s already jailed at %d", ErrJail, l.Address, l.JailHeight)
+	}
+	missed := l.Missed(height)
+	if missed < jailBlocks {
+		return fmt.Errorf("%w: %s missed %d < %d blocks", ErrJail, l.Address, missed, jailBlocks)
+	}
+	if amount <= 0 || allAmount <= 0 {
+		return fmt.Errorf("%w: %s has no stake", ErrJail, l.Address)
+	}
+	// 期望的投票数 missed * Pos33VoterSize * amount / allAmount
+	seats := new(big.Int).Mul(big.NewInt(missed*Pos33VoterSize), big.NewInt(amount))
+	seats.Quo(seats, big.NewInt(allAmount))
+	if seats.Cmp(big.NewInt(Pos33JailMinSeats)) < 0 {
+		return fmt.Errorf("%w: %s expected seats %s < %d", ErrJail, l.Address, seats, Pos33JailMinSeats)
+	}
+	return nil
+}
@@ -218,6 +218,13 @@ type Pos33TicketAction struct {
 	//	*Pos33TicketAction_BlsBind
 	//	*Pos33TicketAction_FeeRate
 	//	*Pos33TicketAction_Withdraw
+	//	*Pos33TicketAction_Slash
+	//	*Pos33TicketAction_ChainParam
+	//	*Pos33TicketAction_Delegate
+	//	*Pos33TicketAction_Undelegate
+	//	*Pos33TicketAction_Jail
+	//	*Pos33TicketAction_Unjail
+	//	*Pos33TicketAction_KeyRotate
 	Value isPos33TicketAction_Value `protobuf_oneof:"value"`
 	Ty    int32                     `protobuf:"varint,10,opt,name=ty,proto3" json:"ty,omitempty"`
 }
@@ -331,6 +338,55 @@ func (x *Pos33TicketAction) GetWithdraw() *Pos33WithdrawReward {
 	return nil
 }
 
+func (x *Pos33TicketAction) GetSlash() *Pos33Slash {
+	if x, ok := x.GetValue().(*Pos33TicketAction_Slash); ok {
+		return x.Slash
+	}
+	return nil
+}
+
+func (x *Pos33TicketAction) GetChainParam() *Pos33ChainParam {
+	if x, ok := x.GetValue().(*Pos33TicketAction_ChainParam); ok {
+		return x.ChainParam
+	}
+	return nil
+}
+
+func (x *Pos33TicketAction) GetDelegate() *Pos33Delegate {
+	if x, ok := x.GetValue().(*Pos33TicketAction_Delegate); ok {
+		return x.Delegate
+	}
+	return nil
+}
+
+func (x *Pos33TicketAction) GetUndelegate() *Pos33Undelegate {
+	if x, ok := x.GetValue().(*Pos33TicketAction_Undelegate); ok {
+		return x.Undelegate
+	}
+	return nil
+}
+
+func (x *Pos33TicketAction) GetJail() *Pos33Jail {
+	if x, ok := x.GetValue().(*Pos33TicketAction_Jail); ok {
+		return x.Jail
+	}
+	return nil
+}
+
+func (x *Pos33TicketAction) GetUnjail() *Pos33Unjail {
+	if x, ok := x.GetValue().(*Pos33TicketAction_Unjail); ok {
+		return x.Unjail
+	}
+	return nil
+}
+
+func (x *Pos33TicketAction) GetKeyRotate() *Pos33KeyRotate {
+	if x, ok := x.GetValue().(*Pos33TicketAction_KeyRotate); ok {
+		return x.KeyRotate
+	}
+	return nil
+}
+
 func (x *Pos33TicketAction) GetTy() int32 {
 	if x != nil {
 		return x.Ty
@@ -382,6 +438,34 @@ type Pos33TicketAction_Withdraw struct {
 	Withdraw *Pos33WithdrawReward `protobuf:"bytes,11,opt,name=withdraw,proto3,oneof"`
 }
 
+type Pos33TicketAction_Slash struct {
+	Slash *Pos33Slash `protobuf:"bytes,13,opt,name=slash,proto3,oneof"`
+}
+
+type Pos33TicketAction_ChainParam struct {
+	ChainParam *Pos33ChainParam `protobuf:"bytes,14,opt,name=chainParam,proto3,oneof"`
+}
+
+type Pos33TicketAction_Delegate struct {
+	Delegate *Pos33Delegate `protobuf:"bytes,15,opt,name=delegate,proto3,oneof"`
+}
+
+type Pos33TicketAction_Undelegate struct {
+	Undelegate *Pos33Undelegate `protobuf:"bytes,16,opt,name=undelegate,proto3,oneof"`
+}
+
+type Pos33TicketAction_Jail struct {
+	Jail *Pos33Jail `protobuf:"bytes,17,opt,name=jail,proto3,oneof"`
+}
+
+type Pos33TicketAction_Unjail struct {
+	Unjail *Pos33Unjail `protobuf:"bytes,18,opt,name=unjail,proto3,oneof"`
+}
+
+type Pos33TicketAction_KeyRotate struct {
+	KeyRotate *Pos33KeyRotate `protobuf:"bytes,19,opt,name=keyRotate,proto3,oneof"`
+}
+
 func (*Pos33TicketAction_Topen) isPos33TicketAction_Value() {}
 
 func (*Pos33TicketAction_Genesis) isPos33TicketAction_Value() {}
@@ -402,6 +486,20 @@ func (*Pos33TicketAction_FeeRate) isPos33TicketAction_Value() {}
 
 func (*Pos33TicketAction_Withdraw) isPos33TicketAction_Value() {}
 
+func (*Pos33TicketAction_Slash) isPos33TicketAction_Value() {}
+
+func (*Pos33TicketAction_ChainParam) isPos33TicketAction_Value() {}
+
+func (*Pos33TicketAction_Delegate) isPos33TicketAction_Value() {}
+
+func (*Pos33TicketAction_Undelegate) isPos33TicketAction_Value() {}
+
+func (*Pos33TicketAction_Jail) isPos33TicketAction_Value() {}
+
+func (*Pos33TicketAction_Unjail) isPos33TicketAction_Value() {}
+
+func (*Pos33TicketAction_KeyRotate) isPos33TicketAction_Value() {}
+
 type Pos33Msg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -610,6 +708,8 @@ type HashProof struct {
 	VrfHash  []byte `protobuf:"bytes,5,opt,name=vrf_hash,json=vrfHash,proto3" json:"vrf_hash,omitempty"`
 	VrfProof []byte `protobuf:"bytes,6,opt,name=vrf_proof,json=vrfProof,proto3" json:"vrf_proof,omitempty"`
 	Pubkey   []byte `protobuf:"bytes,7,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// vrf算法标识, 空表示分叉前的secp256k1-SHA256
+	VrfSuite string `protobuf:"bytes,8,opt,name=vrf_suite,json=vrfSuite,proto3" json:"vrf_suite,omitempty"`
 }
 
 func (x *HashProof) Reset() {
@@ -672,6 +772,13 @@ func (x *HashProof) GetPubkey() []byte {
 	return nil
 }
 
+func (x *HashProof) GetVrfSuite() string {
+	if x != nil {
+		return x.VrfSuite
+	}
+	return ""
+}
+
 type Pos33SortMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1270,16 +1377,19 @@ func (x *Pos33SortMap) GetSortMap() map[string]*Pos33SortMsg {
 	return nil
 }
 
-type Pos33Votes struct {
+// 没有中签的证明, 包含vrf证明和抽签时的票数
+type Pos33NoSeats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Vs []*Pos33VoteMsg `protobuf:"bytes,1,rep,name=vs,proto3" json:"vs,omitempty"`
+	Proof *HashProof       `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	Count int64            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Sig   *types.Signature `protobuf:"bytes,3,opt,name=sig,proto3" json:"sig,omitempty"`
 }
 
-func (x *Pos33Votes) Reset() {
-	*x = Pos33Votes{}
+func (x *Pos33NoSeats) Reset() {
+	*x = Pos33NoSeats{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1287,13 +1397,13 @@ func (x *Pos33Votes) Reset() {
 	}
 }
 
-func (x *Pos33Votes) String() string {
+func (x *Pos33NoSeats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33Votes) ProtoMessage() {}
+func (*Pos33NoSeats) ProtoMessage() {}
 
-func (x *Pos33Votes) ProtoReflect() protoreflect.Message {
+func (x *Pos33NoSeats) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1305,28 +1415,44 @@ func (x *Pos33Votes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33Votes.ProtoReflect.Descriptor instead.
-func (*Pos33Votes) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33NoSeats.ProtoReflect.Descriptor instead.
+func (*Pos33NoSeats) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Pos33Votes) GetVs() []*Pos33VoteMsg {
+func (x *Pos33NoSeats) GetProof() *HashProof {
 	if x != nil {
-		return x.Vs
+		return x.Proof
 	}
 	return nil
 }
 
-type Pos33MakerVotes struct {
+func (x *Pos33NoSeats) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Pos33NoSeats) GetSig() *types.Signature {
+	if x != nil {
+		return x.Sig
+	}
+	return nil
+}
+
+// 抽签快照时的一个验证者和票数
+type Pos33Validator struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Mvs []*Pos33Votes `protobuf:"bytes,1,rep,name=mvs,proto3" json:"mvs,omitempty"`
+	Addr  string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *Pos33MakerVotes) Reset() {
-	*x = Pos33MakerVotes{}
+func (x *Pos33Validator) Reset() {
+	*x = Pos33Validator{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1334,13 +1460,13 @@ func (x *Pos33MakerVotes) Reset() {
 	}
 }
 
-func (x *Pos33MakerVotes) String() string {
+func (x *Pos33Validator) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33MakerVotes) ProtoMessage() {}
+func (*Pos33Validator) ProtoMessage() {}
 
-func (x *Pos33MakerVotes) ProtoReflect() protoreflect.Message {
+func (x *Pos33Validator) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1352,30 +1478,40 @@ func (x *Pos33MakerVotes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33MakerVotes.ProtoReflect.Descriptor instead.
-func (*Pos33MakerVotes) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33Validator.ProtoReflect.Descriptor instead.
+func (*Pos33Validator) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Pos33MakerVotes) GetMvs() []*Pos33Votes {
+func (x *Pos33Validator) GetAddr() string {
 	if x != nil {
-		return x.Mvs
+		return x.Addr
 	}
-	return nil
+	return ""
 }
 
-type Pos33TicketMiner struct {
+func (x *Pos33Validator) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// height高度抽签使用的验证者集合, 按地址排序, hash用于比较
+type Pos33ValidatorSet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sort      *Pos33SortMsg   `protobuf:"bytes,1,opt,name=sort,proto3" json:"sort,omitempty"`
-	Vs        []*Pos33VoteMsg `protobuf:"bytes,2,rep,name=vs,proto3" json:"vs,omitempty"`
-	BlockTime int64           `protobuf:"varint,4,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
+	Height         int64             `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	SnapshotHeight int64             `protobuf:"varint,2,opt,name=snapshotHeight,proto3" json:"snapshotHeight,omitempty"`
+	AllCount       int64             `protobuf:"varint,3,opt,name=allCount,proto3" json:"allCount,omitempty"`
+	Validators     []*Pos33Validator `protobuf:"bytes,4,rep,name=validators,proto3" json:"validators,omitempty"`
+	Hash           []byte            `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (x *Pos33TicketMiner) Reset() {
-	*x = Pos33TicketMiner{}
+func (x *Pos33ValidatorSet) Reset() {
+	*x = Pos33ValidatorSet{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1383,13 +1519,13 @@ func (x *Pos33TicketMiner) Reset() {
 	}
 }
 
-func (x *Pos33TicketMiner) String() string {
+func (x *Pos33ValidatorSet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33TicketMiner) ProtoMessage() {}
+func (*Pos33ValidatorSet) ProtoMessage() {}
 
-func (x *Pos33TicketMiner) ProtoReflect() protoreflect.Message {
+func (x *Pos33ValidatorSet) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1401,46 +1537,59 @@ func (x *Pos33TicketMiner) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33TicketMiner.ProtoReflect.Descriptor instead.
-func (*Pos33TicketMiner) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33ValidatorSet.ProtoReflect.Descriptor instead.
+func (*Pos33ValidatorSet) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Pos33TicketMiner) GetSort() *Pos33SortMsg {
+func (x *Pos33ValidatorSet) GetHeight() int64 {
 	if x != nil {
-		return x.Sort
+		return x.Height
 	}
-	return nil
+	return 0
 }
 
-func (x *Pos33TicketMiner) GetVs() []*Pos33VoteMsg {
+func (x *Pos33ValidatorSet) GetSnapshotHeight() int64 {
 	if x != nil {
-		return x.Vs
+		return x.SnapshotHeight
 	}
-	return nil
+	return 0
 }
 
-func (x *Pos33TicketMiner) GetBlockTime() int64 {
+func (x *Pos33ValidatorSet) GetAllCount() int64 {
 	if x != nil {
-		return x.BlockTime
+		return x.AllCount
 	}
 	return 0
 }
 
-type Pos33MinerMsg struct {
+func (x *Pos33ValidatorSet) GetValidators() []*Pos33Validator {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+func (x *Pos33ValidatorSet) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+// height高度round轮最终确定的委员会
+type Pos33CommitteeRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BlsPkList [][]byte      `protobuf:"bytes,1,rep,name=BlsPkList,proto3" json:"BlsPkList,omitempty"`
-	BlsSig    []byte        `protobuf:"bytes,2,opt,name=BlsSig,proto3" json:"BlsSig,omitempty"`
-	Hash      []byte        `protobuf:"bytes,3,opt,name=Hash,proto3" json:"Hash,omitempty"`
-	Sort      *Pos33SortMsg `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"`
-	BlockTime int64         `protobuf:"varint,4,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
+	Height int64           `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round  int32           `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Comm   []*Pos33SortMsg `protobuf:"bytes,3,rep,name=comm,proto3" json:"comm,omitempty"`
 }
 
-func (x *Pos33MinerMsg) Reset() {
-	*x = Pos33MinerMsg{}
+func (x *Pos33CommitteeRecord) Reset() {
+	*x = Pos33CommitteeRecord{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1448,13 +1597,13 @@ func (x *Pos33MinerMsg) Reset() {
 	}
 }
 
-func (x *Pos33MinerMsg) String() string {
+func (x *Pos33CommitteeRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33MinerMsg) ProtoMessage() {}
+func (*Pos33CommitteeRecord) ProtoMessage() {}
 
-func (x *Pos33MinerMsg) ProtoReflect() protoreflect.Message {
+func (x *Pos33CommitteeRecord) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1466,57 +1615,42 @@ func (x *Pos33MinerMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33MinerMsg.ProtoReflect.Descriptor instead.
-func (*Pos33MinerMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33CommitteeRecord.ProtoReflect.Descriptor instead.
+func (*Pos33CommitteeRecord) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Pos33MinerMsg) GetBlsPkList() [][]byte {
-	if x != nil {
-		return x.BlsPkList
-	}
-	return nil
-}
-
-func (x *Pos33MinerMsg) GetBlsSig() []byte {
+func (x *Pos33CommitteeRecord) GetHeight() int64 {
 	if x != nil {
-		return x.BlsSig
+		return x.Height
 	}
-	return nil
+	return 0
 }
 
-func (x *Pos33MinerMsg) GetHash() []byte {
+func (x *Pos33CommitteeRecord) GetRound() int32 {
 	if x != nil {
-		return x.Hash
+		return x.Round
 	}
-	return nil
+	return 0
 }
 
-func (x *Pos33MinerMsg) GetSort() *Pos33SortMsg {
+func (x *Pos33CommitteeRecord) GetComm() []*Pos33SortMsg {
 	if x != nil {
-		return x.Sort
+		return x.Comm
 	}
 	return nil
 }
 
-func (x *Pos33MinerMsg) GetBlockTime() int64 {
-	if x != nil {
-		return x.BlockTime
-	}
-	return 0
-}
-
-type Pos33MinerFlag struct {
+type Pos33CommitteeStore struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Flag    int32 `protobuf:"varint,1,opt,name=flag,proto3" json:"flag,omitempty"`
-	Reserve int64 `protobuf:"varint,2,opt,name=reserve,proto3" json:"reserve,omitempty"`
+	Records []*Pos33CommitteeRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
 }
 
-func (x *Pos33MinerFlag) Reset() {
-	*x = Pos33MinerFlag{}
+func (x *Pos33CommitteeStore) Reset() {
+	*x = Pos33CommitteeStore{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1524,13 +1658,13 @@ func (x *Pos33MinerFlag) Reset() {
 	}
 }
 
-func (x *Pos33MinerFlag) String() string {
+func (x *Pos33CommitteeStore) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33MinerFlag) ProtoMessage() {}
+func (*Pos33CommitteeStore) ProtoMessage() {}
 
-func (x *Pos33MinerFlag) ProtoReflect() protoreflect.Message {
+func (x *Pos33CommitteeStore) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1542,35 +1676,29 @@ func (x *Pos33MinerFlag) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33MinerFlag.ProtoReflect.Descriptor instead.
-func (*Pos33MinerFlag) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33CommitteeStore.ProtoReflect.Descriptor instead.
+func (*Pos33CommitteeStore) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *Pos33MinerFlag) GetFlag() int32 {
-	if x != nil {
-		return x.Flag
-	}
-	return 0
-}
-
-func (x *Pos33MinerFlag) GetReserve() int64 {
+func (x *Pos33CommitteeStore) GetRecords() []*Pos33CommitteeRecord {
 	if x != nil {
-		return x.Reserve
+		return x.Records
 	}
-	return 0
+	return nil
 }
 
-type Pos33PrivMsg struct {
+type Pos33RoundSize struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Priv []byte `protobuf:"bytes,1,opt,name=priv,proto3" json:"priv,omitempty"`
+	Round int32 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Size  int32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (x *Pos33PrivMsg) Reset() {
-	*x = Pos33PrivMsg{}
+func (x *Pos33RoundSize) Reset() {
+	*x = Pos33RoundSize{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1578,13 +1706,13 @@ func (x *Pos33PrivMsg) Reset() {
 	}
 }
 
-func (x *Pos33PrivMsg) String() string {
+func (x *Pos33RoundSize) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33PrivMsg) ProtoMessage() {}
+func (*Pos33RoundSize) ProtoMessage() {}
 
-func (x *Pos33PrivMsg) ProtoReflect() protoreflect.Message {
+func (x *Pos33RoundSize) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1596,29 +1724,38 @@ func (x *Pos33PrivMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33PrivMsg.ProtoReflect.Descriptor instead.
-func (*Pos33PrivMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33RoundSize.ProtoReflect.Descriptor instead.
+func (*Pos33RoundSize) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *Pos33PrivMsg) GetPriv() []byte {
+func (x *Pos33RoundSize) GetRound() int32 {
 	if x != nil {
-		return x.Priv
+		return x.Round
 	}
-	return nil
+	return 0
 }
 
-type Pos33TicketBind struct {
+func (x *Pos33RoundSize) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// height高度每一轮的委员会大小, rounds是总轮数, sizes最多返回16轮
+type Pos33CommitteeSizes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MinerAddress  string `protobuf:"bytes,1,opt,name=minerAddress,proto3" json:"minerAddress,omitempty"`
-	ReturnAddress string `protobuf:"bytes,2,opt,name=returnAddress,proto3" json:"returnAddress,omitempty"`
+	Height int64             `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Rounds int32             `protobuf:"varint,2,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	Sizes  []*Pos33RoundSize `protobuf:"bytes,3,rep,name=sizes,proto3" json:"sizes,omitempty"`
 }
 
-func (x *Pos33TicketBind) Reset() {
-	*x = Pos33TicketBind{}
+func (x *Pos33CommitteeSizes) Reset() {
+	*x = Pos33CommitteeSizes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1626,13 +1763,13 @@ func (x *Pos33TicketBind) Reset() {
 	}
 }
 
-func (x *Pos33TicketBind) String() string {
+func (x *Pos33CommitteeSizes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33TicketBind) ProtoMessage() {}
+func (*Pos33CommitteeSizes) ProtoMessage() {}
 
-func (x *Pos33TicketBind) ProtoReflect() protoreflect.Message {
+func (x *Pos33CommitteeSizes) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1644,44 +1781,46 @@ func (x *Pos33TicketBind) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33TicketBind.ProtoReflect.Descriptor instead.
-func (*Pos33TicketBind) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33CommitteeSizes.ProtoReflect.Descriptor instead.
+func (*Pos33CommitteeSizes) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *Pos33TicketBind) GetMinerAddress() string {
+func (x *Pos33CommitteeSizes) GetHeight() int64 {
 	if x != nil {
-		return x.MinerAddress
+		return x.Height
 	}
-	return ""
+	return 0
 }
 
-func (x *Pos33TicketBind) GetReturnAddress() string {
+func (x *Pos33CommitteeSizes) GetRounds() int32 {
 	if x != nil {
-		return x.ReturnAddress
+		return x.Rounds
 	}
-	return ""
+	return 0
 }
 
-type Pos33TicketOpen struct {
+func (x *Pos33CommitteeSizes) GetSizes() []*Pos33RoundSize {
+	if x != nil {
+		return x.Sizes
+	}
+	return nil
+}
+
+// 区块产生时使用的diff
+type Pos33BlockDiff struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//用户挖矿的ticket 地址
-	MinerAddress string `protobuf:"bytes,1,opt,name=minerAddress,proto3" json:"minerAddress,omitempty"`
-	//币实际存储的地址
-	ReturnAddress string `protobuf:"bytes,3,opt,name=returnAddress,proto3" json:"returnAddress,omitempty"`
-	//聚合签名的地址
-	BlsAddress string `protobuf:"bytes,6,opt,name=blsAddress,proto3" json:"blsAddress,omitempty"`
-	//购买ticket的数目
-	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	//随机种子
-	RandSeed int64 `protobuf:"varint,4,opt,name=randSeed,proto3" json:"randSeed,omitempty"`
+	Height   int64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round    int32   `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	AllCount int64   `protobuf:"varint,3,opt,name=allCount,proto3" json:"allCount,omitempty"`
+	Diff     float64 `protobuf:"fixed64,4,opt,name=diff,proto3" json:"diff,omitempty"`
 }
 
-func (x *Pos33TicketOpen) Reset() {
-	*x = Pos33TicketOpen{}
+func (x *Pos33BlockDiff) Reset() {
+	*x = Pos33BlockDiff{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1689,13 +1828,13 @@ func (x *Pos33TicketOpen) Reset() {
 	}
 }
 
-func (x *Pos33TicketOpen) String() string {
+func (x *Pos33BlockDiff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33TicketOpen) ProtoMessage() {}
+func (*Pos33BlockDiff) ProtoMessage() {}
 
-func (x *Pos33TicketOpen) ProtoReflect() protoreflect.Message {
+func (x *Pos33BlockDiff) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1707,59 +1846,50 @@ func (x *Pos33TicketOpen) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33TicketOpen.ProtoReflect.Descriptor instead.
-func (*Pos33TicketOpen) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33BlockDiff.ProtoReflect.Descriptor instead.
+func (*Pos33BlockDiff) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *Pos33TicketOpen) GetMinerAddress() string {
+func (x *Pos33BlockDiff) GetHeight() int64 {
 	if x != nil {
-		return x.MinerAddress
-	}
-	return ""
-}
-
-func (x *Pos33TicketOpen) GetReturnAddress() string {
-	if x != nil {
-		return x.ReturnAddress
+		return x.Height
 	}
-	return ""
+	return 0
 }
 
-func (x *Pos33TicketOpen) GetBlsAddress() string {
+func (x *Pos33BlockDiff) GetRound() int32 {
 	if x != nil {
-		return x.BlsAddress
+		return x.Round
 	}
-	return ""
+	return 0
 }
 
-func (x *Pos33TicketOpen) GetCount() int32 {
+func (x *Pos33BlockDiff) GetAllCount() int64 {
 	if x != nil {
-		return x.Count
+		return x.AllCount
 	}
 	return 0
 }
 
-func (x *Pos33TicketOpen) GetRandSeed() int64 {
+func (x *Pos33BlockDiff) GetDiff() float64 {
 	if x != nil {
-		return x.RandSeed
+		return x.Diff
 	}
 	return 0
 }
 
-type Pos33TicketGenesis struct {
+type ReqPos33Seats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MinerAddress  string `protobuf:"bytes,1,opt,name=minerAddress,proto3" json:"minerAddress,omitempty"`
-	ReturnAddress string `protobuf:"bytes,2,opt,name=returnAddress,proto3" json:"returnAddress,omitempty"`
-	BlsAddress    string `protobuf:"bytes,6,opt,name=blsAddress,proto3" json:"blsAddress,omitempty"`
-	Count         int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Count int64   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Diff  float64 `protobuf:"fixed64,2,opt,name=diff,proto3" json:"diff,omitempty"`
 }
 
-func (x *Pos33TicketGenesis) Reset() {
-	*x = Pos33TicketGenesis{}
+func (x *ReqPos33Seats) Reset() {
+	*x = ReqPos33Seats{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1767,13 +1897,13 @@ func (x *Pos33TicketGenesis) Reset() {
 	}
 }
 
-func (x *Pos33TicketGenesis) String() string {
+func (x *ReqPos33Seats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33TicketGenesis) ProtoMessage() {}
+func (*ReqPos33Seats) ProtoMessage() {}
 
-func (x *Pos33TicketGenesis) ProtoReflect() protoreflect.Message {
+func (x *ReqPos33Seats) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1785,51 +1915,40 @@ func (x *Pos33TicketGenesis) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33TicketGenesis.ProtoReflect.Descriptor instead.
-func (*Pos33TicketGenesis) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReqPos33Seats.ProtoReflect.Descriptor instead.
+func (*ReqPos33Seats) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *Pos33TicketGenesis) GetMinerAddress() string {
-	if x != nil {
-		return x.MinerAddress
-	}
-	return ""
-}
-
-func (x *Pos33TicketGenesis) GetReturnAddress() string {
-	if x != nil {
-		return x.ReturnAddress
-	}
-	return ""
-}
-
-func (x *Pos33TicketGenesis) GetBlsAddress() string {
+func (x *ReqPos33Seats) GetCount() int64 {
 	if x != nil {
-		return x.BlsAddress
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-func (x *Pos33TicketGenesis) GetCount() int32 {
+func (x *ReqPos33Seats) GetDiff() float64 {
 	if x != nil {
-		return x.Count
+		return x.Diff
 	}
 	return 0
 }
 
-type Pos33TicketClose struct {
+// count张票在diff下每个区块中签数的均值和标准差
+type Pos33SeatsStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// repeated string ticketId = 1;
-	MinerAddress string `protobuf:"bytes,2,opt,name=minerAddress,proto3" json:"minerAddress,omitempty"`
-	Count        int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Count    int64   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Diff     float64 `protobuf:"fixed64,2,opt,name=diff,proto3" json:"diff,omitempty"`
+	Mean     float64 `protobuf:"fixed64,3,opt,name=mean,proto3" json:"mean,omitempty"`
+	Variance float64 `protobuf:"fixed64,4,opt,name=variance,proto3" json:"variance,omitempty"`
+	Stddev   float64 `protobuf:"fixed64,5,opt,name=stddev,proto3" json:"stddev,omitempty"`
 }
 
-func (x *Pos33TicketClose) Reset() {
-	*x = Pos33TicketClose{}
+func (x *Pos33SeatsStats) Reset() {
+	*x = Pos33SeatsStats{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1837,13 +1956,13 @@ func (x *Pos33TicketClose) Reset() {
 	}
 }
 
-func (x *Pos33TicketClose) String() string {
+func (x *Pos33SeatsStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33TicketClose) ProtoMessage() {}
+func (*Pos33SeatsStats) ProtoMessage() {}
 
-func (x *Pos33TicketClose) ProtoReflect() protoreflect.Message {
+func (x *Pos33SeatsStats) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1855,36 +1974,58 @@ func (x *Pos33TicketClose) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33TicketClose.ProtoReflect.Descriptor instead.
-func (*Pos33TicketClose) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33SeatsStats.ProtoReflect.Descriptor instead.
+func (*Pos33SeatsStats) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *Pos33TicketClose) GetMinerAddress() string {
+func (x *Pos33SeatsStats) GetCount() int64 {
 	if x != nil {
-		return x.MinerAddress
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-func (x *Pos33TicketClose) GetCount() int32 {
+func (x *Pos33SeatsStats) GetDiff() float64 {
 	if x != nil {
-		return x.Count
+		return x.Diff
 	}
 	return 0
 }
 
-type Pos33TicketReward struct {
+func (x *Pos33SeatsStats) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *Pos33SeatsStats) GetVariance() float64 {
+	if x != nil {
+		return x.Variance
+	}
+	return 0
+}
+
+func (x *Pos33SeatsStats) GetStddev() float64 {
+	if x != nil {
+		return x.Stddev
+	}
+	return 0
+}
+
+type ReqPos33Rewards struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Addr   string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
-	Height int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Addr  string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Start int64  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   int64  `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
 }
 
-func (x *Pos33TicketReward) Reset() {
-	*x = Pos33TicketReward{}
+func (x *ReqPos33Rewards) Reset() {
+	*x = ReqPos33Rewards{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1892,13 +2033,13 @@ func (x *Pos33TicketReward) Reset() {
 	}
 }
 
-func (x *Pos33TicketReward) String() string {
+func (x *ReqPos33Rewards) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33TicketReward) ProtoMessage() {}
+func (*ReqPos33Rewards) ProtoMessage() {}
 
-func (x *Pos33TicketReward) ProtoReflect() protoreflect.Message {
+func (x *ReqPos33Rewards) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1910,36 +2051,53 @@ func (x *Pos33TicketReward) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33TicketReward.ProtoReflect.Descriptor instead.
-func (*Pos33TicketReward) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReqPos33Rewards.ProtoReflect.Descriptor instead.
+func (*ReqPos33Rewards) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *Pos33TicketReward) GetAddr() string {
+func (x *ReqPos33Rewards) GetAddr() string {
 	if x != nil {
 		return x.Addr
 	}
 	return ""
 }
 
-func (x *Pos33TicketReward) GetHeight() int64 {
+func (x *ReqPos33Rewards) GetStart() int64 {
 	if x != nil {
-		return x.Height
+		return x.Start
 	}
 	return 0
 }
 
-type Pos33TicketList struct {
+func (x *ReqPos33Rewards) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+// 奖励参数相同的一段高度内的奖励
+type Pos33RewardSegment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Addr   string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
-	Status int32  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
-}
-
-func (x *Pos33TicketList) Reset() {
-	*x = Pos33TicketList{}
+	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   int64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	// 每个投票的奖励
+	VoteReward int64 `protobuf:"varint,3,opt,name=voteReward,proto3" json:"voteReward,omitempty"`
+	// 出块时每个投票的奖励, ForkRewardV2以后是出块人的固定奖励
+	MineReward int64 `protobuf:"varint,4,opt,name=mineReward,proto3" json:"mineReward,omitempty"`
+	Seats      int64 `protobuf:"varint,5,opt,name=seats,proto3" json:"seats,omitempty"`
+	Blocks     int64 `protobuf:"varint,6,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// 自己出的区块的投票数
+	BlockVotes int64 `protobuf:"varint,7,opt,name=blockVotes,proto3" json:"blockVotes,omitempty"`
+	Reward     int64 `protobuf:"varint,8,opt,name=reward,proto3" json:"reward,omitempty"`
+}
+
+func (x *Pos33RewardSegment) Reset() {
+	*x = Pos33RewardSegment{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1947,13 +2105,13 @@ func (x *Pos33TicketList) Reset() {
 	}
 }
 
-func (x *Pos33TicketList) String() string {
+func (x *Pos33RewardSegment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33TicketList) ProtoMessage() {}
+func (*Pos33RewardSegment) ProtoMessage() {}
 
-func (x *Pos33TicketList) ProtoReflect() protoreflect.Message {
+func (x *Pos33RewardSegment) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1965,36 +2123,87 @@ func (x *Pos33TicketList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33TicketList.ProtoReflect.Descriptor instead.
-func (*Pos33TicketList) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33RewardSegment.ProtoReflect.Descriptor instead.
+func (*Pos33RewardSegment) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *Pos33TicketList) GetAddr() string {
+func (x *Pos33RewardSegment) GetStart() int64 {
 	if x != nil {
-		return x.Addr
+		return x.Start
 	}
-	return ""
+	return 0
 }
 
-func (x *Pos33TicketList) GetStatus() int32 {
+func (x *Pos33RewardSegment) GetEnd() int64 {
 	if x != nil {
-		return x.Status
+		return x.End
 	}
 	return 0
 }
 
-type ReplyPos33TicketReward struct {
+func (x *Pos33RewardSegment) GetVoteReward() int64 {
+	if x != nil {
+		return x.VoteReward
+	}
+	return 0
+}
+
+func (x *Pos33RewardSegment) GetMineReward() int64 {
+	if x != nil {
+		return x.MineReward
+	}
+	return 0
+}
+
+func (x *Pos33RewardSegment) GetSeats() int64 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *Pos33RewardSegment) GetBlocks() int64 {
+	if x != nil {
+		return x.Blocks
+	}
+	return 0
+}
+
+func (x *Pos33RewardSegment) GetBlockVotes() int64 {
+	if x != nil {
+		return x.BlockVotes
+	}
+	return 0
+}
+
+func (x *Pos33RewardSegment) GetReward() int64 {
+	if x != nil {
+		return x.Reward
+	}
+	return 0
+}
+
+// addr在[start, end]高度的奖励, 一次最多查询的高度有限制, next不为0时从next继续查询
+type Pos33Rewards struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VoterReward int64 `protobuf:"varint,1,opt,name=voter_reward,json=voterReward,proto3" json:"voter_reward,omitempty"`
-	MinerReward int64 `protobuf:"varint,2,opt,name=miner_reward,json=minerReward,proto3" json:"miner_reward,omitempty"`
+	Addr       string                `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Start      int64                 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End        int64                 `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	Next       int64                 `protobuf:"varint,4,opt,name=next,proto3" json:"next,omitempty"`
+	Seats      int64                 `protobuf:"varint,5,opt,name=seats,proto3" json:"seats,omitempty"`
+	Blocks     int64                 `protobuf:"varint,6,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	VoteReward int64                 `protobuf:"varint,7,opt,name=voteReward,proto3" json:"voteReward,omitempty"`
+	MineReward int64                 `protobuf:"varint,8,opt,name=mineReward,proto3" json:"mineReward,omitempty"`
+	Total      int64                 `protobuf:"varint,9,opt,name=total,proto3" json:"total,omitempty"`
+	Segments   []*Pos33RewardSegment `protobuf:"bytes,10,rep,name=segments,proto3" json:"segments,omitempty"`
 }
 
-func (x *ReplyPos33TicketReward) Reset() {
-	*x = ReplyPos33TicketReward{}
+func (x *Pos33Rewards) Reset() {
+	*x = Pos33Rewards{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2002,13 +2211,13 @@ func (x *ReplyPos33TicketReward) Reset() {
 	}
 }
 
-func (x *ReplyPos33TicketReward) String() string {
+func (x *Pos33Rewards) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplyPos33TicketReward) ProtoMessage() {}
+func (*Pos33Rewards) ProtoMessage() {}
 
-func (x *ReplyPos33TicketReward) ProtoReflect() protoreflect.Message {
+func (x *Pos33Rewards) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2020,106 +2229,116 @@ func (x *ReplyPos33TicketReward) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReplyPos33TicketReward.ProtoReflect.Descriptor instead.
-func (*ReplyPos33TicketReward) Descriptor() ([]byte, []int) {
+// Deprecated: Use Pos33Rewards.ProtoReflect.Descriptor instead.
+func (*Pos33Rewards) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ReplyPos33TicketReward) GetVoterReward() int64 {
+func (x *Pos33Rewards) GetAddr() string {
 	if x != nil {
-		return x.VoterReward
+		return x.Addr
 	}
-	return 0
+	return ""
 }
 
-func (x *ReplyPos33TicketReward) GetMinerReward() int64 {
+func (x *Pos33Rewards) GetStart() int64 {
 	if x != nil {
-		return x.MinerReward
+		return x.Start
 	}
 	return 0
 }
 
-type ReplyWalletPos33Count struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Privkey []byte `protobuf:"bytes,2,opt,name=privkey,proto3" json:"privkey,omitempty"`
-	Count   int64  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+func (x *Pos33Rewards) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
 }
 
-func (x *ReplyWalletPos33Count) Reset() {
-	*x = ReplyWalletPos33Count{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pos33_proto_msgTypes[29]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Pos33Rewards) GetNext() int64 {
+	if x != nil {
+		return x.Next
 	}
+	return 0
 }
 
-func (x *ReplyWalletPos33Count) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Pos33Rewards) GetSeats() int64 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
 }
 
-func (*ReplyWalletPos33Count) ProtoMessage() {}
-
-func (x *ReplyWalletPos33Count) ProtoReflect() protoreflect.Message {
-	mi := &file_pos33_proto_msgTypes[29]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Pos33Rewards) GetBlocks() int64 {
+	if x != nil {
+		return x.Blocks
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ReplyWalletPos33Count.ProtoReflect.Descriptor instead.
-func (*ReplyWalletPos33Count) Descriptor() ([]byte, []int) {
-	return file_pos33_proto_rawDescGZIP(), []int{29}
+func (x *Pos33Rewards) GetVoteReward() int64 {
+	if x != nil {
+		return x.VoteReward
+	}
+	return 0
 }
 
-func (x *ReplyWalletPos33Count) GetPrivkey() []byte {
+func (x *Pos33Rewards) GetMineReward() int64 {
 	if x != nil {
-		return x.Privkey
+		return x.MineReward
 	}
-	return nil
+	return 0
 }
 
-func (x *ReplyWalletPos33Count) GetCount() int64 {
+func (x *Pos33Rewards) GetTotal() int64 {
 	if x != nil {
-		return x.Count
+		return x.Total
 	}
 	return 0
 }
 
-type ReceiptPos33Deposit struct {
+func (x *Pos33Rewards) GetSegments() []*Pos33RewardSegment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+// 委员会记录的保留策略和清理统计
+type Pos33RetentionStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Addr  string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
-	Count int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Blocks        int64 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`       // 保留最近多少个高度
+	MinBlocks     int64 `protobuf:"varint,2,opt,name=minBlocks,proto3" json:"minBlocks,omitempty"` // 最少保留的高度数, 由惩罚窗口决定
+	MaxBytes      int64 `protobuf:"varint,3,opt,name=maxBytes,proto3" json:"maxBytes,omitempty"`   // 最多占用的字节数, 0不限制
+	Heights       int64 `protobuf:"varint,4,opt,name=heights,proto3" json:"heights,omitempty"`
+	Records       int64 `protobuf:"varint,5,opt,name=records,proto3" json:"records,omitempty"`
+	Bytes         int64 `protobuf:"varint,6,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Lowest        int64 `protobuf:"varint,7,opt,name=lowest,proto3" json:"lowest,omitempty"`
+	Highest       int64 `protobuf:"varint,8,opt,name=highest,proto3" json:"highest,omitempty"`
+	PrunedHeights int64 `protobuf:"varint,9,opt,name=prunedHeights,proto3" json:"prunedHeights,omitempty"`
+	PrunedBytes   int64 `protobuf:"varint,10,opt,name=prunedBytes,proto3" json:"prunedBytes,omitempty"`
 }
 
-func (x *ReceiptPos33Deposit) Reset() {
-	*x = ReceiptPos33Deposit{}
+func (x *Pos33RetentionStats) Reset() {
+	*x = Pos33RetentionStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pos33_proto_msgTypes[30]
+		mi := &file_pos33_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReceiptPos33Deposit) String() string {
+func (x *Pos33RetentionStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReceiptPos33Deposit) ProtoMessage() {}
+func (*Pos33RetentionStats) ProtoMessage() {}
 
-func (x *ReceiptPos33Deposit) ProtoReflect() protoreflect.Message {
-	mi := &file_pos33_proto_msgTypes[30]
+func (x *Pos33RetentionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2130,107 +2349,107 @@ func (x *ReceiptPos33Deposit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReceiptPos33Deposit.ProtoReflect.Descriptor instead.
-func (*ReceiptPos33Deposit) Descriptor() ([]byte, []int) {
-	return file_pos33_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use Pos33RetentionStats.ProtoReflect.Descriptor instead.
+func (*Pos33RetentionStats) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ReceiptPos33Deposit) GetAddr() string {
+func (x *Pos33RetentionStats) GetBlocks() int64 {
 	if x != nil {
-		return x.Addr
+		return x.Blocks
 	}
-	return ""
+	return 0
 }
 
-func (x *ReceiptPos33Deposit) GetCount() int64 {
+func (x *Pos33RetentionStats) GetMinBlocks() int64 {
 	if x != nil {
-		return x.Count
+		return x.MinBlocks
 	}
 	return 0
 }
 
-type ReceiptPos33Miner struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Pos33RetentionStats) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
 
-	Addr   string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
-	Reward int64  `protobuf:"varint,3,opt,name=reward,proto3" json:"reward,omitempty"`
+func (x *Pos33RetentionStats) GetHeights() int64 {
+	if x != nil {
+		return x.Heights
+	}
+	return 0
 }
 
-func (x *ReceiptPos33Miner) Reset() {
-	*x = ReceiptPos33Miner{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pos33_proto_msgTypes[31]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Pos33RetentionStats) GetRecords() int64 {
+	if x != nil {
+		return x.Records
 	}
+	return 0
 }
 
-func (x *ReceiptPos33Miner) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Pos33RetentionStats) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
 }
 
-func (*ReceiptPos33Miner) ProtoMessage() {}
-
-func (x *ReceiptPos33Miner) ProtoReflect() protoreflect.Message {
-	mi := &file_pos33_proto_msgTypes[31]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Pos33RetentionStats) GetLowest() int64 {
+	if x != nil {
+		return x.Lowest
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ReceiptPos33Miner.ProtoReflect.Descriptor instead.
-func (*ReceiptPos33Miner) Descriptor() ([]byte, []int) {
-	return file_pos33_proto_rawDescGZIP(), []int{31}
+func (x *Pos33RetentionStats) GetHighest() int64 {
+	if x != nil {
+		return x.Highest
+	}
+	return 0
 }
 
-func (x *ReceiptPos33Miner) GetAddr() string {
+func (x *Pos33RetentionStats) GetPrunedHeights() int64 {
 	if x != nil {
-		return x.Addr
+		return x.PrunedHeights
 	}
-	return ""
+	return 0
 }
 
-func (x *ReceiptPos33Miner) GetReward() int64 {
+func (x *Pos33RetentionStats) GetPrunedBytes() int64 {
 	if x != nil {
-		return x.Reward
+		return x.PrunedBytes
 	}
 	return 0
 }
 
-type ReceiptPos33TicketBind struct {
+type ReqPos33Manifest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OldMinerAddress string `protobuf:"bytes,1,opt,name=oldMinerAddress,proto3" json:"oldMinerAddress,omitempty"`
-	NewMinerAddress string `protobuf:"bytes,2,opt,name=newMinerAddress,proto3" json:"newMinerAddress,omitempty"`
-	ReturnAddress   string `protobuf:"bytes,3,opt,name=returnAddress,proto3" json:"returnAddress,omitempty"`
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Sign   bool  `protobuf:"varint,2,opt,name=sign,proto3" json:"sign,omitempty"` // 用节点的挖矿私钥签名
 }
 
-func (x *ReceiptPos33TicketBind) Reset() {
-	*x = ReceiptPos33TicketBind{}
+func (x *ReqPos33Manifest) Reset() {
+	*x = ReqPos33Manifest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pos33_proto_msgTypes[32]
+		mi := &file_pos33_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReceiptPos33TicketBind) String() string {
+func (x *ReqPos33Manifest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReceiptPos33TicketBind) ProtoMessage() {}
+func (*ReqPos33Manifest) ProtoMessage() {}
 
-func (x *ReceiptPos33TicketBind) ProtoReflect() protoreflect.Message {
-	mi := &file_pos33_proto_msgTypes[32]
+func (x *ReqPos33Manifest) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2241,58 +2460,51 @@ func (x *ReceiptPos33TicketBind) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReceiptPos33TicketBind.ProtoReflect.Descriptor instead.
-func (*ReceiptPos33TicketBind) Descriptor() ([]byte, []int) {
-	return file_pos33_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *ReceiptPos33TicketBind) GetOldMinerAddress() string {
-	if x != nil {
-		return x.OldMinerAddress
-	}
-	return ""
+// Deprecated: Use ReqPos33Manifest.ProtoReflect.Descriptor instead.
+func (*ReqPos33Manifest) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ReceiptPos33TicketBind) GetNewMinerAddress() string {
+func (x *ReqPos33Manifest) GetHeight() int64 {
 	if x != nil {
-		return x.NewMinerAddress
+		return x.Height
 	}
-	return ""
+	return 0
 }
 
-func (x *ReceiptPos33TicketBind) GetReturnAddress() string {
+func (x *ReqPos33Manifest) GetSign() bool {
 	if x != nil {
-		return x.ReturnAddress
+		return x.Sign
 	}
-	return ""
+	return false
 }
 
-type Consignee struct {
+type Pos33ManifestEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Amount  int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *Consignee) Reset() {
-	*x = Consignee{}
+func (x *Pos33ManifestEntry) Reset() {
+	*x = Pos33ManifestEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pos33_proto_msgTypes[33]
+		mi := &file_pos33_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Consignee) String() string {
+func (x *Pos33ManifestEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Consignee) ProtoMessage() {}
+func (*Pos33ManifestEntry) ProtoMessage() {}
 
-func (x *Consignee) ProtoReflect() protoreflect.Message {
-	mi := &file_pos33_proto_msgTypes[33]
+func (x *Pos33ManifestEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2303,53 +2515,55 @@ func (x *Consignee) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Consignee.ProtoReflect.Descriptor instead.
-func (*Consignee) Descriptor() ([]byte, []int) {
-	return file_pos33_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use Pos33ManifestEntry.ProtoReflect.Descriptor instead.
+func (*Pos33ManifestEntry) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *Consignee) GetAddress() string {
+func (x *Pos33ManifestEntry) GetKey() string {
 	if x != nil {
-		return x.Address
+		return x.Key
 	}
 	return ""
 }
 
-func (x *Consignee) GetAmount() int64 {
+func (x *Pos33ManifestEntry) GetValue() string {
 	if x != nil {
-		return x.Amount
+		return x.Value
 	}
-	return 0
+	return ""
 }
 
-type Consignor struct {
+// 共识参数清单, entries按key排序, hash是height和entries编码后的sha256
+type Pos33ConfigManifest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address      string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Amount       int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`                                 // 委托数量
-	Reward       int64  `protobuf:"varint,3,opt,name=reward,proto3" json:"reward,omitempty"`                                 // 获取奖励的总额
-	RemainReward int64  `protobuf:"varint,4,opt,name=remain_reward,json=remainReward,proto3" json:"remain_reward,omitempty"` // 返给用户后的剩余奖励
+	Height    int64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Entries   []*Pos33ManifestEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	Hash      []byte                `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	Pubkey    []byte                `protobuf:"bytes,4,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Signature []byte                `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *Consignor) Reset() {
-	*x = Consignor{}
+func (x *Pos33ConfigManifest) Reset() {
+	*x = Pos33ConfigManifest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pos33_proto_msgTypes[34]
+		mi := &file_pos33_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Consignor) String() string {
+func (x *Pos33ConfigManifest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Consignor) ProtoMessage() {}
+func (*Pos33ConfigManifest) ProtoMessage() {}
 
-func (x *Consignor) ProtoReflect() protoreflect.Message {
-	mi := &file_pos33_proto_msgTypes[34]
+func (x *Pos33ConfigManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2360,66 +2574,73 @@ func (x *Consignor) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Consignor.ProtoReflect.Descriptor instead.
-func (*Consignor) Descriptor() ([]byte, []int) {
-	return file_pos33_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use Pos33ConfigManifest.ProtoReflect.Descriptor instead.
+func (*Pos33ConfigManifest) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *Consignor) GetAddress() string {
+func (x *Pos33ConfigManifest) GetHeight() int64 {
 	if x != nil {
-		return x.Address
+		return x.Height
 	}
-	return ""
+	return 0
 }
 
-func (x *Consignor) GetAmount() int64 {
+func (x *Pos33ConfigManifest) GetEntries() []*Pos33ManifestEntry {
 	if x != nil {
-		return x.Amount
+		return x.Entries
 	}
-	return 0
+	return nil
 }
 
-func (x *Consignor) GetReward() int64 {
+func (x *Pos33ConfigManifest) GetHash() []byte {
 	if x != nil {
-		return x.Reward
+		return x.Hash
 	}
-	return 0
+	return nil
 }
 
-func (x *Consignor) GetRemainReward() int64 {
+func (x *Pos33ConfigManifest) GetPubkey() []byte {
 	if x != nil {
-		return x.RemainReward
+		return x.Pubkey
 	}
-	return 0
+	return nil
 }
 
-// 委托人
-type Pos33Consignor struct {
+func (x *Pos33ConfigManifest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type Pos33CommitteeMember struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address    string       `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Consignees []*Consignee `protobuf:"bytes,3,rep,name=consignees,proto3" json:"consignees,omitempty"` // 受托人列表
+	Addr   string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Seats  int64    `protobuf:"varint,2,opt,name=seats,proto3" json:"seats,omitempty"`
+	BlsPks [][]byte `protobuf:"bytes,3,rep,name=blsPks,proto3" json:"blsPks,omitempty"`
 }
 
-func (x *Pos33Consignor) Reset() {
-	*x = Pos33Consignor{}
+func (x *Pos33CommitteeMember) Reset() {
+	*x = Pos33CommitteeMember{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pos33_proto_msgTypes[35]
+		mi := &file_pos33_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Pos33Consignor) String() string {
+func (x *Pos33CommitteeMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33Consignor) ProtoMessage() {}
+func (*Pos33CommitteeMember) ProtoMessage() {}
 
-func (x *Pos33Consignor) ProtoReflect() protoreflect.Message {
-	mi := &file_pos33_proto_msgTypes[35]
+func (x *Pos33CommitteeMember) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2430,56 +2651,63 @@ func (x *Pos33Consignor) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33Consignor.ProtoReflect.Descriptor instead.
-func (*Pos33Consignor) Descriptor() ([]byte, []int) {
-	return file_pos33_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use Pos33CommitteeMember.ProtoReflect.Descriptor instead.
+func (*Pos33CommitteeMember) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *Pos33Consignor) GetAddress() string {
+func (x *Pos33CommitteeMember) GetAddr() string {
 	if x != nil {
-		return x.Address
+		return x.Addr
 	}
 	return ""
 }
 
-func (x *Pos33Consignor) GetConsignees() []*Consignee {
+func (x *Pos33CommitteeMember) GetSeats() int64 {
 	if x != nil {
-		return x.Consignees
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *Pos33CommitteeMember) GetBlsPks() [][]byte {
+	if x != nil {
+		return x.BlsPks
 	}
 	return nil
 }
 
-// 受托人
-type Pos33Consignee struct {
+// 从区块和当时的状态重建的委员会, members按地址排序
+type Pos33BlockCommittee struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address         string       `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Amount          int64        `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	FeeReward       int64        `protobuf:"varint,3,opt,name=fee_reward,json=feeReward,proto3" json:"fee_reward,omitempty"`
-	RemainFeeReward int64        `protobuf:"varint,4,opt,name=remain_fee_reward,json=remainFeeReward,proto3" json:"remain_fee_reward,omitempty"` // 返给后的剩余奖励
-	FeePersent      int64        `protobuf:"varint,5,opt,name=fee_persent,json=feePersent,proto3" json:"fee_persent,omitempty"`
-	Consignors      []*Consignor `protobuf:"bytes,6,rep,name=consignors,proto3" json:"consignors,omitempty"` // 委托人列表
+	Height    int64                   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round     int32                   `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Maker     string                  `protobuf:"bytes,3,opt,name=maker,proto3" json:"maker,omitempty"`
+	MakerSort *Pos33SortMsg           `protobuf:"bytes,4,opt,name=makerSort,proto3" json:"makerSort,omitempty"`
+	Seats     int64                   `protobuf:"varint,5,opt,name=seats,proto3" json:"seats,omitempty"`
+	Members   []*Pos33CommitteeMember `protobuf:"bytes,6,rep,name=members,proto3" json:"members,omitempty"`
 }
 
-func (x *Pos33Consignee) Reset() {
-	*x = Pos33Consignee{}
+func (x *Pos33BlockCommittee) Reset() {
+	*x = Pos33BlockCommittee{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pos33_proto_msgTypes[36]
+		mi := &file_pos33_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Pos33Consignee) String() string {
+func (x *Pos33BlockCommittee) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33Consignee) ProtoMessage() {}
+func (*Pos33BlockCommittee) ProtoMessage() {}
 
-func (x *Pos33Consignee) ProtoReflect() protoreflect.Message {
-	mi := &file_pos33_proto_msgTypes[36]
+func (x *Pos33BlockCommittee) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2490,80 +2718,81 @@ func (x *Pos33Consignee) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33Consignee.ProtoReflect.Descriptor instead.
-func (*Pos33Consignee) Descriptor() ([]byte, []int) {
-	return file_pos33_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use Pos33BlockCommittee.ProtoReflect.Descriptor instead.
+func (*Pos33BlockCommittee) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *Pos33Consignee) GetAddress() string {
+func (x *Pos33BlockCommittee) GetHeight() int64 {
 	if x != nil {
-		return x.Address
+		return x.Height
 	}
-	return ""
+	return 0
 }
 
-func (x *Pos33Consignee) GetAmount() int64 {
+func (x *Pos33BlockCommittee) GetRound() int32 {
 	if x != nil {
-		return x.Amount
+		return x.Round
 	}
 	return 0
 }
 
-func (x *Pos33Consignee) GetFeeReward() int64 {
+func (x *Pos33BlockCommittee) GetMaker() string {
 	if x != nil {
-		return x.FeeReward
+		return x.Maker
 	}
-	return 0
+	return ""
 }
 
-func (x *Pos33Consignee) GetRemainFeeReward() int64 {
+func (x *Pos33BlockCommittee) GetMakerSort() *Pos33SortMsg {
 	if x != nil {
-		return x.RemainFeeReward
+		return x.MakerSort
 	}
-	return 0
+	return nil
 }
 
-func (x *Pos33Consignee) GetFeePersent() int64 {
+func (x *Pos33BlockCommittee) GetSeats() int64 {
 	if x != nil {
-		return x.FeePersent
+		return x.Seats
 	}
 	return 0
 }
 
-func (x *Pos33Consignee) GetConsignors() []*Consignor {
+func (x *Pos33BlockCommittee) GetMembers() []*Pos33CommitteeMember {
 	if x != nil {
-		return x.Consignors
+		return x.Members
 	}
 	return nil
 }
 
-type Pos33Entrust struct {
+// 节点正在处理的高度和轮次, timeInRound是在这一轮的毫秒数
+type Pos33CurrentRound struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Consignee string `protobuf:"bytes,1,opt,name=consignee,proto3" json:"consignee,omitempty"`
-	Consignor string `protobuf:"bytes,2,opt,name=consignor,proto3" json:"consignor,omitempty"`
-	Amount    int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Height      int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round       int32 `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	TimeInRound int64 `protobuf:"varint,3,opt,name=timeInRound,proto3" json:"timeInRound,omitempty"`
 }
 
-func (x *Pos33Entrust) Reset() {
-	*x = Pos33Entrust{}
+func (x *Pos33CurrentRound) Reset() {
+	*x = Pos33CurrentRound{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pos33_proto_msgTypes[37]
+		mi := &file_pos33_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Pos33Entrust) String() string {
+func (x *Pos33CurrentRound) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33Entrust) ProtoMessage() {}
+func (*Pos33CurrentRound) ProtoMessage() {}
 
-func (x *Pos33Entrust) ProtoReflect() protoreflect.Message {
-	mi := &file_pos33_proto_msgTypes[37]
+func (x *Pos33CurrentRound) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2574,57 +2803,60 @@ func (x *Pos33Entrust) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33Entrust.ProtoReflect.Descriptor instead.
-func (*Pos33Entrust) Descriptor() ([]byte, []int) {
-	return file_pos33_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use Pos33CurrentRound.ProtoReflect.Descriptor instead.
+func (*Pos33CurrentRound) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *Pos33Entrust) GetConsignee() string {
+func (x *Pos33CurrentRound) GetHeight() int64 {
 	if x != nil {
-		return x.Consignee
+		return x.Height
 	}
-	return ""
+	return 0
 }
 
-func (x *Pos33Entrust) GetConsignor() string {
+func (x *Pos33CurrentRound) GetRound() int32 {
 	if x != nil {
-		return x.Consignor
+		return x.Round
 	}
-	return ""
+	return 0
 }
 
-func (x *Pos33Entrust) GetAmount() int64 {
+func (x *Pos33CurrentRound) GetTimeInRound() int64 {
 	if x != nil {
-		return x.Amount
+		return x.TimeInRound
 	}
 	return 0
 }
 
-type Pos33Migrate struct {
+// 分析[start, end]高度的抽签自相关, lag是出块影响seed的高度差, 为0时是Pos33SortBlocks
+type ReqPos33Grinding struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Miner string `protobuf:"bytes,1,opt,name=miner,proto3" json:"miner,omitempty"`
+	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   int64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Lag   int64 `protobuf:"varint,3,opt,name=lag,proto3" json:"lag,omitempty"`
 }
 
-func (x *Pos33Migrate) Reset() {
-	*x = Pos33Migrate{}
+func (x *ReqPos33Grinding) Reset() {
+	*x = ReqPos33Grinding{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pos33_proto_msgTypes[38]
+		mi := &file_pos33_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Pos33Migrate) String() string {
+func (x *ReqPos33Grinding) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33Migrate) ProtoMessage() {}
+func (*ReqPos33Grinding) ProtoMessage() {}
 
-func (x *Pos33Migrate) ProtoReflect() protoreflect.Message {
-	mi := &file_pos33_proto_msgTypes[38]
+func (x *ReqPos33Grinding) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2635,44 +2867,66 @@ func (x *Pos33Migrate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33Migrate.ProtoReflect.Descriptor instead.
-func (*Pos33Migrate) Descriptor() ([]byte, []int) {
-	return file_pos33_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use ReqPos33Grinding.ProtoReflect.Descriptor instead.
+func (*ReqPos33Grinding) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *Pos33Migrate) GetMiner() string {
+func (x *ReqPos33Grinding) GetStart() int64 {
 	if x != nil {
-		return x.Miner
+		return x.Start
 	}
-	return ""
+	return 0
 }
 
-type Pos33BlsBind struct {
+func (x *ReqPos33Grinding) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *ReqPos33Grinding) GetLag() int64 {
+	if x != nil {
+		return x.Lag
+	}
+	return 0
+}
+
+// 一个地址出块以后lag个高度的抽签结果, 和整个范围的比例比较
+type Pos33GrindingScore struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BlsAddr   string `protobuf:"bytes,1,opt,name=BlsAddr,proto3" json:"BlsAddr,omitempty"`
-	MinerAddr string `protobuf:"bytes,2,opt,name=MinerAddr,proto3" json:"MinerAddr,omitempty"`
+	Addr          string  `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Produced      int64   `protobuf:"varint,2,opt,name=produced,proto3" json:"produced,omitempty"`
+	FollowWins    int64   `protobuf:"varint,3,opt,name=followWins,proto3" json:"followWins,omitempty"`
+	ExpectedWins  float64 `protobuf:"fixed64,4,opt,name=expectedWins,proto3" json:"expectedWins,omitempty"`
+	MakerZ        float64 `protobuf:"fixed64,5,opt,name=makerZ,proto3" json:"makerZ,omitempty"`
+	FollowSeats   int64   `protobuf:"varint,6,opt,name=followSeats,proto3" json:"followSeats,omitempty"`
+	ExpectedSeats float64 `protobuf:"fixed64,7,opt,name=expectedSeats,proto3" json:"expectedSeats,omitempty"`
+	SeatZ         float64 `protobuf:"fixed64,8,opt,name=seatZ,proto3" json:"seatZ,omitempty"`
+	Score         float64 `protobuf:"fixed64,9,opt,name=score,proto3" json:"score,omitempty"`
 }
 
-func (x *Pos33BlsBind) Reset() {
-	*x = Pos33BlsBind{}
+func (x *Pos33GrindingScore) Reset() {
+	*x = Pos33GrindingScore{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pos33_proto_msgTypes[39]
+		mi := &file_pos33_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Pos33BlsBind) String() string {
+func (x *Pos33GrindingScore) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33BlsBind) ProtoMessage() {}
+func (*Pos33GrindingScore) ProtoMessage() {}
 
-func (x *Pos33BlsBind) ProtoReflect() protoreflect.Message {
-	mi := &file_pos33_proto_msgTypes[39]
+func (x *Pos33GrindingScore) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2683,53 +2937,103 @@ func (x *Pos33BlsBind) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33BlsBind.ProtoReflect.Descriptor instead.
-func (*Pos33BlsBind) Descriptor() ([]byte, []int) {
-	return file_pos33_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use Pos33GrindingScore.ProtoReflect.Descriptor instead.
+func (*Pos33GrindingScore) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *Pos33BlsBind) GetBlsAddr() string {
+func (x *Pos33GrindingScore) GetAddr() string {
 	if x != nil {
-		return x.BlsAddr
+		return x.Addr
 	}
 	return ""
 }
 
-func (x *Pos33BlsBind) GetMinerAddr() string {
+func (x *Pos33GrindingScore) GetProduced() int64 {
 	if x != nil {
-		return x.MinerAddr
+		return x.Produced
 	}
-	return ""
+	return 0
 }
 
-type ReqBindPos33Miner struct {
+func (x *Pos33GrindingScore) GetFollowWins() int64 {
+	if x != nil {
+		return x.FollowWins
+	}
+	return 0
+}
+
+func (x *Pos33GrindingScore) GetExpectedWins() float64 {
+	if x != nil {
+		return x.ExpectedWins
+	}
+	return 0
+}
+
+func (x *Pos33GrindingScore) GetMakerZ() float64 {
+	if x != nil {
+		return x.MakerZ
+	}
+	return 0
+}
+
+func (x *Pos33GrindingScore) GetFollowSeats() int64 {
+	if x != nil {
+		return x.FollowSeats
+	}
+	return 0
+}
+
+func (x *Pos33GrindingScore) GetExpectedSeats() float64 {
+	if x != nil {
+		return x.ExpectedSeats
+	}
+	return 0
+}
+
+func (x *Pos33GrindingScore) GetSeatZ() float64 {
+	if x != nil {
+		return x.SeatZ
+	}
+	return 0
+}
+
+func (x *Pos33GrindingScore) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// 按score从大到小排序
+type Pos33GrindingReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BindAddr     string `protobuf:"bytes,1,opt,name=bindAddr,proto3" json:"bindAddr,omitempty"`
-	OriginAddr   string `protobuf:"bytes,2,opt,name=originAddr,proto3" json:"originAddr,omitempty"`
-	Amount       int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	CheckBalance bool   `protobuf:"varint,4,opt,name=checkBalance,proto3" json:"checkBalance,omitempty"`
+	Start  int64                 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End    int64                 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Lag    int64                 `protobuf:"varint,3,opt,name=lag,proto3" json:"lag,omitempty"`
+	Scores []*Pos33GrindingScore `protobuf:"bytes,4,rep,name=scores,proto3" json:"scores,omitempty"`
 }
 
-func (x *ReqBindPos33Miner) Reset() {
-	*x = ReqBindPos33Miner{}
+func (x *Pos33GrindingReport) Reset() {
+	*x = Pos33GrindingReport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pos33_proto_msgTypes[40]
+		mi := &file_pos33_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReqBindPos33Miner) String() string {
+func (x *Pos33GrindingReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReqBindPos33Miner) ProtoMessage() {}
+func (*Pos33GrindingReport) ProtoMessage() {}
 
-func (x *ReqBindPos33Miner) ProtoReflect() protoreflect.Message {
-	mi := &file_pos33_proto_msgTypes[40]
+func (x *Pos33GrindingReport) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2740,66 +3044,106 @@ func (x *ReqBindPos33Miner) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReqBindPos33Miner.ProtoReflect.Descriptor instead.
-func (*ReqBindPos33Miner) Descriptor() ([]byte, []int) {
-	return file_pos33_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use Pos33GrindingReport.ProtoReflect.Descriptor instead.
+func (*Pos33GrindingReport) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ReqBindPos33Miner) GetBindAddr() string {
+func (x *Pos33GrindingReport) GetStart() int64 {
 	if x != nil {
-		return x.BindAddr
+		return x.Start
 	}
-	return ""
+	return 0
 }
 
-func (x *ReqBindPos33Miner) GetOriginAddr() string {
+func (x *Pos33GrindingReport) GetEnd() int64 {
 	if x != nil {
-		return x.OriginAddr
+		return x.End
 	}
-	return ""
+	return 0
 }
 
-func (x *ReqBindPos33Miner) GetAmount() int64 {
+func (x *Pos33GrindingReport) GetLag() int64 {
 	if x != nil {
-		return x.Amount
+		return x.Lag
 	}
 	return 0
 }
 
-func (x *ReqBindPos33Miner) GetCheckBalance() bool {
+func (x *Pos33GrindingReport) GetScores() []*Pos33GrindingScore {
 	if x != nil {
-		return x.CheckBalance
+		return x.Scores
 	}
-	return false
+	return nil
 }
 
-type Pos33WithdrawReward struct {
+// 远程签名服务, 共识私钥不进入节点进程
+type ReqPos33SignerInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	Consignee string `protobuf:"bytes,1,opt,name=consignee,proto3" json:"consignee,omitempty"`
-	Consignor string `protobuf:"bytes,2,opt,name=consignor,proto3" json:"consignor,omitempty"`
-	Amount    int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+func (x *ReqPos33SignerInfo) Reset() {
+	*x = ReqPos33SignerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *Pos33WithdrawReward) Reset() {
-	*x = Pos33WithdrawReward{}
+func (x *ReqPos33SignerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33SignerInfo) ProtoMessage() {}
+
+func (x *ReqPos33SignerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33SignerInfo.ProtoReflect.Descriptor instead.
+func (*ReqPos33SignerInfo) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{39}
+}
+
+type Pos33SignerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// secp256k1公钥
+	Pubkey []byte `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// 投票使用的bls公钥
+	BlsPubkey []byte `protobuf:"bytes,2,opt,name=blsPubkey,proto3" json:"blsPubkey,omitempty"`
+}
+
+func (x *Pos33SignerInfo) Reset() {
+	*x = Pos33SignerInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pos33_proto_msgTypes[41]
+		mi := &file_pos33_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Pos33WithdrawReward) String() string {
+func (x *Pos33SignerInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33WithdrawReward) ProtoMessage() {}
+func (*Pos33SignerInfo) ProtoMessage() {}
 
-func (x *Pos33WithdrawReward) ProtoReflect() protoreflect.Message {
-	mi := &file_pos33_proto_msgTypes[41]
+func (x *Pos33SignerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2810,43 +3154,92 @@ func (x *Pos33WithdrawReward) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33WithdrawReward.ProtoReflect.Descriptor instead.
-func (*Pos33WithdrawReward) Descriptor() ([]byte, []int) {
-	return file_pos33_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use Pos33SignerInfo.ProtoReflect.Descriptor instead.
+func (*Pos33SignerInfo) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *Pos33WithdrawReward) GetConsignee() string {
+func (x *Pos33SignerInfo) GetPubkey() []byte {
 	if x != nil {
-		return x.Consignee
+		return x.Pubkey
 	}
-	return ""
+	return nil
 }
 
-func (x *Pos33WithdrawReward) GetConsignor() string {
+func (x *Pos33SignerInfo) GetBlsPubkey() []byte {
 	if x != nil {
-		return x.Consignor
+		return x.BlsPubkey
+	}
+	return nil
+}
+
+type ReqPos33SignVrf struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Suite string `protobuf:"bytes,1,opt,name=suite,proto3" json:"suite,omitempty"`
+	// 编码以后的VrfInput
+	Input []byte `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+}
+
+func (x *ReqPos33SignVrf) Reset() {
+	*x = ReqPos33SignVrf{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33SignVrf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33SignVrf) ProtoMessage() {}
+
+func (x *ReqPos33SignVrf) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33SignVrf.ProtoReflect.Descriptor instead.
+func (*ReqPos33SignVrf) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ReqPos33SignVrf) GetSuite() string {
+	if x != nil {
+		return x.Suite
 	}
 	return ""
 }
 
-func (x *Pos33WithdrawReward) GetAmount() int64 {
+func (x *ReqPos33SignVrf) GetInput() []byte {
 	if x != nil {
-		return x.Amount
+		return x.Input
 	}
-	return 0
+	return nil
 }
 
-type Pos33MinerFeeRate struct {
+type ReplyPos33SignVrf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MinerAddr      string `protobuf:"bytes,1,opt,name=miner_addr,json=minerAddr,proto3" json:"miner_addr,omitempty"`
-	FeeRatePersent int32  `protobuf:"varint,2,opt,name=fee_rate_persent,json=feeRatePersent,proto3" json:"fee_rate_persent,omitempty"`
+	Hash  []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
 }
 
-func (x *Pos33MinerFeeRate) Reset() {
-	*x = Pos33MinerFeeRate{}
+func (x *ReplyPos33SignVrf) Reset() {
+	*x = ReplyPos33SignVrf{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2854,13 +3247,13 @@ func (x *Pos33MinerFeeRate) Reset() {
 	}
 }
 
-func (x *Pos33MinerFeeRate) String() string {
+func (x *ReplyPos33SignVrf) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pos33MinerFeeRate) ProtoMessage() {}
+func (*ReplyPos33SignVrf) ProtoMessage() {}
 
-func (x *Pos33MinerFeeRate) ProtoReflect() protoreflect.Message {
+func (x *ReplyPos33SignVrf) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2872,36 +3265,39 @@ func (x *Pos33MinerFeeRate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pos33MinerFeeRate.ProtoReflect.Descriptor instead.
-func (*Pos33MinerFeeRate) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReplyPos33SignVrf.ProtoReflect.Descriptor instead.
+func (*ReplyPos33SignVrf) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *Pos33MinerFeeRate) GetMinerAddr() string {
+func (x *ReplyPos33SignVrf) GetHash() []byte {
 	if x != nil {
-		return x.MinerAddr
+		return x.Hash
 	}
-	return ""
+	return nil
 }
 
-func (x *Pos33MinerFeeRate) GetFeeRatePersent() int32 {
+func (x *ReplyPos33SignVrf) GetProof() []byte {
 	if x != nil {
-		return x.FeeRatePersent
+		return x.Proof
 	}
-	return 0
+	return nil
 }
 
-// message ReplyBindPos33Miner { string txHex = 1; }
-type ReplyTxHex struct {
+// 签名服务对同一个kind, height, round只签一个msg, 防止双签
+type ReqPos33Sign struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TxHex string `protobuf:"bytes,1,opt,name=txHex,proto3" json:"txHex,omitempty"`
+	Kind   string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Height int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Round  int32  `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	Msg    []byte `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
 }
 
-func (x *ReplyTxHex) Reset() {
-	*x = ReplyTxHex{}
+func (x *ReqPos33Sign) Reset() {
+	*x = ReqPos33Sign{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2909,13 +3305,13 @@ func (x *ReplyTxHex) Reset() {
 	}
 }
 
-func (x *ReplyTxHex) String() string {
+func (x *ReqPos33Sign) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplyTxHex) ProtoMessage() {}
+func (*ReqPos33Sign) ProtoMessage() {}
 
-func (x *ReplyTxHex) ProtoReflect() protoreflect.Message {
+func (x *ReqPos33Sign) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2927,29 +3323,50 @@ func (x *ReplyTxHex) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReplyTxHex.ProtoReflect.Descriptor instead.
-func (*ReplyTxHex) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReqPos33Sign.ProtoReflect.Descriptor instead.
+func (*ReqPos33Sign) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *ReplyTxHex) GetTxHex() string {
+func (x *ReqPos33Sign) GetKind() string {
 	if x != nil {
-		return x.TxHex
+		return x.Kind
 	}
 	return ""
 }
 
-type ReplyPos33Info struct {
+func (x *ReqPos33Sign) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ReqPos33Sign) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *ReqPos33Sign) GetMsg() []byte {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+type ReplyPos33Sign struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Price    int64 `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
-	AllCount int64 `protobuf:"varint,2,opt,name=all_count,json=allCount,proto3" json:"all_count,omitempty"`
+	Signature []byte `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	Pubkey    []byte `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
 }
 
-func (x *ReplyPos33Info) Reset() {
-	*x = ReplyPos33Info{}
+func (x *ReplyPos33Sign) Reset() {
+	*x = ReplyPos33Sign{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pos33_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2957,13 +3374,13 @@ func (x *ReplyPos33Info) Reset() {
 	}
 }
 
-func (x *ReplyPos33Info) String() string {
+func (x *ReplyPos33Sign) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplyPos33Info) ProtoMessage() {}
+func (*ReplyPos33Sign) ProtoMessage() {}
 
-func (x *ReplyPos33Info) ProtoReflect() protoreflect.Message {
+func (x *ReplyPos33Sign) ProtoReflect() protoreflect.Message {
 	mi := &file_pos33_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2975,476 +3392,8577 @@ func (x *ReplyPos33Info) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReplyPos33Info.ProtoReflect.Descriptor instead.
-func (*ReplyPos33Info) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReplyPos33Sign.ProtoReflect.Descriptor instead.
+func (*ReplyPos33Sign) Descriptor() ([]byte, []int) {
 	return file_pos33_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ReplyPos33Info) GetPrice() int64 {
+func (x *ReplyPos33Sign) GetSignature() []byte {
 	if x != nil {
-		return x.Price
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *ReplyPos33Sign) GetPubkey() []byte {
+	if x != nil {
+		return x.Pubkey
+	}
+	return nil
+}
+
+// 抽签和委员会事件, 推送给外部的监控服务
+type Pos33SortitionEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq    int64  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Ty     int32  `protobuf:"varint,2,opt,name=ty,proto3" json:"ty,omitempty"`
+	Height int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Round  int32  `protobuf:"varint,4,opt,name=round,proto3" json:"round,omitempty"`
+	Addr   string `protobuf:"bytes,5,opt,name=addr,proto3" json:"addr,omitempty"`
+	// 中签的票数, 委员会的大小, 或者丢弃的事件数
+	Count int32  `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	Err   string `protobuf:"bytes,7,opt,name=err,proto3" json:"err,omitempty"`
+	Time  int64  `protobuf:"varint,8,opt,name=time,proto3" json:"time,omitempty"`
+	// Pos33EventDiff的难度
+	Diff float64 `protobuf:"fixed64,9,opt,name=diff,proto3" json:"diff,omitempty"`
+}
+
+func (x *Pos33SortitionEvent) Reset() {
+	*x = Pos33SortitionEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33SortitionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33SortitionEvent) ProtoMessage() {}
+
+func (x *Pos33SortitionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33SortitionEvent.ProtoReflect.Descriptor instead.
+func (*Pos33SortitionEvent) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Pos33SortitionEvent) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
 	}
 	return 0
 }
 
-func (x *ReplyPos33Info) GetAllCount() int64 {
+func (x *Pos33SortitionEvent) GetTy() int32 {
 	if x != nil {
-		return x.AllCount
+		return x.Ty
 	}
 	return 0
 }
 
-var File_pos33_proto protoreflect.FileDescriptor
+func (x *Pos33SortitionEvent) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
 
-var file_pos33_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x33, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x1a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa1, 0x02, 0x0a, 0x0b, 0x50, 0x6f,
-	0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x63,
-	0x6b, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x63,
-	0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a,
-	0x09, 0x69, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x69, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6f,
-	0x70, 0x65, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x6f, 0x70, 0x65, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63,
-	0x6c, 0x6f, 0x73, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a,
-	0x0a, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x22, 0x0a,
-	0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0xa7, 0x04,
-	0x0a, 0x11, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33,
-	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x48, 0x00, 0x52, 0x05, 0x74, 0x6f,
-	0x70, 0x65, 0x6e, 0x12, 0x35, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73,
-	0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x48,
-	0x00, 0x52, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x63,
-	0x6c, 0x6f, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6c,
-	0x6f, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x74, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x2e, 0x0a,
-	0x05, 0x74, 0x62, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
-	0x42, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x05, 0x74, 0x62, 0x69, 0x6e, 0x64, 0x12, 0x2c, 0x0a,
-	0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x4d,
-	0x73, 0x67, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x07, 0x65,
-	0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x45, 0x6e, 0x74, 0x72, 0x75, 0x73,
-	0x74, 0x48, 0x00, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x07,
-	0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x67, 0x72, 0x61,
-	0x74, 0x65, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a,
-	0x07, 0x62, 0x6c, 0x73, 0x42, 0x69, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x42, 0x6c, 0x73, 0x42,
-	0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x07, 0x62, 0x6c, 0x73, 0x42, 0x69, 0x6e, 0x64, 0x12, 0x34,
-	0x0a, 0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e,
-	0x65, 0x72, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x07, 0x66, 0x65, 0x65,
-	0x52, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50,
-	0x6f, 0x73, 0x33, 0x33, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x48, 0x00, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x0e,
-	0x0a, 0x02, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x42, 0x07,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x77, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x33, 0x33,
-	0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33,
-	0x33, 0x4d, 0x73, 0x67, 0x2e, 0x54, 0x79, 0x52, 0x02, 0x74, 0x79, 0x22, 0x33, 0x0a, 0x02, 0x54,
-	0x79, 0x12, 0x06, 0x0a, 0x02, 0x4d, 0x56, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x42, 0x56, 0x10,
-	0x01, 0x12, 0x05, 0x0a, 0x01, 0x42, 0x10, 0x02, 0x12, 0x06, 0x0a, 0x02, 0x4d, 0x53, 0x10, 0x03,
-	0x12, 0x06, 0x0a, 0x02, 0x56, 0x53, 0x10, 0x04, 0x12, 0x06, 0x0a, 0x02, 0x43, 0x56, 0x10, 0x05,
-	0x22, 0x5a, 0x0a, 0x08, 0x53, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x5c, 0x0a, 0x08,
-	0x56, 0x72, 0x66, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x09, 0x48,
-	0x61, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x56, 0x72, 0x66, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12,
-	0x19, 0x0a, 0x08, 0x76, 0x72, 0x66, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x76, 0x72, 0x66, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x72,
-	0x66, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x76,
-	0x72, 0x66, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65,
-	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x22,
-	0x64, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x73, 0x67, 0x12,
-	0x2c, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x48,
-	0x61, 0x73, 0x68, 0x52, 0x08, 0x73, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a,
-	0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x05,
-	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x37, 0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f,
-	0x72, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33,
-	0x53, 0x6f, 0x72, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x05, 0x73, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x42,
-	0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x6f, 0x74, 0x65, 0x53, 0x6f, 0x72, 0x74, 0x73,
-	0x12, 0x30, 0x0a, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73,
-	0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x53, 0x6f, 0x72,
-	0x74, 0x73, 0x22, 0x4b, 0x0a, 0x0b, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4f, 0x6e, 0x6c, 0x69, 0x6e,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x03, 0x53,
-	0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x03, 0x53, 0x69, 0x67, 0x22,
-	0x3d, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x73, 0x67,
-	0x12, 0x1a, 0x0a, 0x01, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x01, 0x62, 0x12, 0x10, 0x0a, 0x03,
-	0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x69, 0x64, 0x22, 0x51,
-	0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x73, 0x67, 0x32,
-	0x12, 0x1a, 0x0a, 0x01, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x01, 0x62, 0x12, 0x23, 0x0a, 0x02,
-	0x76, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x6f, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x02, 0x76,
-	0x73, 0x22, 0x85, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x6f, 0x74, 0x65, 0x4d,
-	0x73, 0x67, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f,
-	0x72, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68,
-	0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12,
-	0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x03, 0x73, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x52, 0x03, 0x73, 0x69, 0x67, 0x22, 0xab, 0x01, 0x0a, 0x0f, 0x50, 0x6f,
-	0x73, 0x33, 0x33, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a,
-	0x05, 0x6d, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61,
-	0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c,
-	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0xb5, 0x01, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x33,
-	0x33, 0x53, 0x6f, 0x72, 0x74, 0x73, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x6d, 0x79,
-	0x5f, 0x73, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x73,
-	0x67, 0x52, 0x07, 0x6d, 0x79, 0x53, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65,
-	0x6c, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c,
-	0x52, 0x0b, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x16, 0x0a,
-	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x03, 0x73,
-	0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x03, 0x73, 0x69, 0x67, 0x22,
-	0x9c, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70,
-	0x12, 0x3b, 0x0a, 0x08, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33,
-	0x53, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x1a, 0x4f, 0x0a,
-	0x0c, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74,
-	0x4d, 0x73, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x31,
-	0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x02,
-	0x76, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x6f, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x02, 0x76,
-	0x73, 0x22, 0x36, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x61, 0x6b, 0x65, 0x72, 0x56,
-	0x6f, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x03, 0x6d, 0x76, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56,
-	0x6f, 0x74, 0x65, 0x73, 0x52, 0x03, 0x6d, 0x76, 0x73, 0x22, 0x7e, 0x0a, 0x10, 0x50, 0x6f, 0x73,
-	0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x27, 0x0a,
-	0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x73, 0x67,
-	0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x23, 0x0a, 0x02, 0x76, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33,
-	0x56, 0x6f, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x02, 0x76, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x0d, 0x50, 0x6f,
-	0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x42,
-	0x6c, 0x73, 0x50, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09,
-	0x42, 0x6c, 0x73, 0x50, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x42, 0x6c, 0x73,
-	0x53, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x42, 0x6c, 0x73, 0x53, 0x69,
-	0x67, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x48, 0x61, 0x73, 0x68, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33,
-	0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x3e, 0x0a, 0x0e,
-	0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x12,
-	0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x66, 0x6c,
-	0x61, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x22, 0x22, 0x0a, 0x0c,
-	0x50, 0x6f, 0x73, 0x33, 0x33, 0x50, 0x72, 0x69, 0x76, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04,
-	0x70, 0x72, 0x69, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x70, 0x72, 0x69, 0x76,
-	0x22, 0x5b, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x42,
-	0x69, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72,
-	0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xad, 0x01,
-	0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x70, 0x65,
-	0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x62,
-	0x6c, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x62, 0x6c, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x61, 0x6e, 0x64, 0x53, 0x65, 0x65, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x61, 0x6e, 0x64, 0x53, 0x65, 0x65, 0x64, 0x22, 0x94, 0x01,
-	0x0a, 0x12, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x47, 0x65, 0x6e,
-	0x65, 0x73, 0x69, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x65,
-	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e,
-	0x0a, 0x0a, 0x62, 0x6c, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4c, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63,
-	0x6b, 0x65, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x65,
-	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x3f, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65,
-	0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x22, 0x3d, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x5e, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x33, 0x33,
-	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c,
-	0x76, 0x6f, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0b, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12,
-	0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x22, 0x47, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70,
-	0x72, 0x69, 0x76, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x72,
-	0x69, 0x76, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3f, 0x0a, 0x13, 0x52,
-	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x44, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3f, 0x0a, 0x11,
-	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e, 0x65,
-	0x72, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0x92, 0x01,
-	0x0a, 0x16, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69,
-	0x63, 0x6b, 0x65, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x6f, 0x6c, 0x64, 0x4d,
-	0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x6f, 0x6c, 0x64, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x65, 0x77,
-	0x4d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d,
-	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x22, 0x3d, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x22, 0x7a, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x61,
-	0x69, 0x6e, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0c, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0x5c, 0x0a,
-	0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x52,
-	0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x73, 0x22, 0xe0, 0x01, 0x0a, 0x0e,
-	0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12,
-	0x2a, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x61,
-	0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66,
-	0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x0a,
-	0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e,
-	0x6f, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x73, 0x22, 0x62,
-	0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x45, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x12, 0x1c, 0x0a, 0x09,
-	0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x24, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x67, 0x72, 0x61,
-	0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x22, 0x46, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x33,
-	0x33, 0x42, 0x6c, 0x73, 0x42, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x6c, 0x73, 0x41,
-	0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x42, 0x6c, 0x73, 0x41, 0x64,
-	0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
-	0x22, 0x8b, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x42, 0x69, 0x6e, 0x64, 0x50, 0x6f, 0x73, 0x33,
-	0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x6e, 0x64, 0x41, 0x64,
-	0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x69, 0x6e, 0x64, 0x41, 0x64,
-	0x64, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x41, 0x64, 0x64, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x41, 0x64,
-	0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x68,
-	0x65, 0x63, 0x6b, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x69,
-	0x0a, 0x13, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67,
-	0x6e, 0x65, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f,
-	0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5c, 0x0a, 0x11, 0x50, 0x6f, 0x73,
-	0x33, 0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x28, 0x0a,
-	0x10, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x65, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65,
-	0x50, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x74, 0x22, 0x22, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x54, 0x78, 0x48, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0x43, 0x0a, 0x0e, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a,
-	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x32, 0x44, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x33, 0x33, 0x12, 0x3b, 0x0a, 0x0f, 0x53, 0x65, 0x74,
-	0x50, 0x6f, 0x73, 0x33, 0x33, 0x45, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x12, 0x13, 0x2e, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x45, 0x6e, 0x74, 0x72, 0x75, 0x73,
-	0x74, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54,
-	0x78, 0x48, 0x65, 0x78, 0x22, 0x00, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *Pos33SortitionEvent) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
 }
 
-var (
-	file_pos33_proto_rawDescOnce sync.Once
-	file_pos33_proto_rawDescData = file_pos33_proto_rawDesc
-)
+func (x *Pos33SortitionEvent) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
 
-func file_pos33_proto_rawDescGZIP() []byte {
-	file_pos33_proto_rawDescOnce.Do(func() {
-		file_pos33_proto_rawDescData = protoimpl.X.CompressGZIP(file_pos33_proto_rawDescData)
-	})
-	return file_pos33_proto_rawDescData
+func (x *Pos33SortitionEvent) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
 }
 
-var file_pos33_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pos33_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
-var file_pos33_proto_goTypes = []interface{}{
-	(Pos33Msg_Ty)(0),               // 0: types.Pos33Msg.Ty
-	(*Pos33Ticket)(nil),            // 1: types.Pos33Ticket
-	(*Pos33TicketAction)(nil),      // 2: types.Pos33TicketAction
-	(*Pos33Msg)(nil),               // 3: types.Pos33Msg
-	(*SortHash)(nil),               // 4: types.SortHash
-	(*VrfInput)(nil),               // 5: types.VrfInput
-	(*HashProof)(nil),              // 6: types.HashProof
-	(*Pos33SortMsg)(nil),           // 7: types.Pos33SortMsg
-	(*Pos33Sorts)(nil),             // 8: types.Pos33Sorts
-	(*Pos33VoteSorts)(nil),         // 9: types.Pos33VoteSorts
-	(*Pos33Online)(nil),            // 10: types.Pos33Online
-	(*Pos33BlockMsg)(nil),          // 11: types.Pos33BlockMsg
-	(*Pos33BlockMsg2)(nil),         // 12: types.Pos33BlockMsg2
-	(*Pos33VoteMsg)(nil),           // 13: types.Pos33VoteMsg
-	(*Pos33DepositMsg)(nil),        // 14: types.Pos33DepositMsg
-	(*Pos33SortsVote)(nil),         // 15: types.Pos33SortsVote
-	(*Pos33SortMap)(nil),           // 16: types.Pos33SortMap
-	(*Pos33Votes)(nil),             // 17: types.Pos33Votes
-	(*Pos33MakerVotes)(nil),        // 18: types.Pos33MakerVotes
-	(*Pos33TicketMiner)(nil),       // 19: types.Pos33TicketMiner
-	(*Pos33MinerMsg)(nil),          // 20: types.Pos33MinerMsg
-	(*Pos33MinerFlag)(nil),         // 21: types.Pos33MinerFlag
-	(*Pos33PrivMsg)(nil),           // 22: types.Pos33PrivMsg
-	(*Pos33TicketBind)(nil),        // 23: types.Pos33TicketBind
-	(*Pos33TicketOpen)(nil),        // 24: types.Pos33TicketOpen
-	(*Pos33TicketGenesis)(nil),     // 25: types.Pos33TicketGenesis
-	(*Pos33TicketClose)(nil),       // 26: types.Pos33TicketClose
-	(*Pos33TicketReward)(nil),      // 27: types.Pos33TicketReward
-	(*Pos33TicketList)(nil),        // 28: types.Pos33TicketList
-	(*ReplyPos33TicketReward)(nil), // 29: types.ReplyPos33TicketReward
-	(*ReplyWalletPos33Count)(nil),  // 30: types.ReplyWalletPos33Count
-	(*ReceiptPos33Deposit)(nil),    // 31: types.ReceiptPos33Deposit
-	(*ReceiptPos33Miner)(nil),      // 32: types.ReceiptPos33Miner
-	(*ReceiptPos33TicketBind)(nil), // 33: types.ReceiptPos33TicketBind
-	(*Consignee)(nil),              // 34: types.Consignee
-	(*Consignor)(nil),              // 35: types.Consignor
-	(*Pos33Consignor)(nil),         // 36: types.Pos33Consignor
-	(*Pos33Consignee)(nil),         // 37: types.Pos33Consignee
-	(*Pos33Entrust)(nil),           // 38: types.Pos33Entrust
-	(*Pos33Migrate)(nil),           // 39: types.Pos33Migrate
-	(*Pos33BlsBind)(nil),           // 40: types.Pos33BlsBind
-	(*ReqBindPos33Miner)(nil),      // 41: types.ReqBindPos33Miner
-	(*Pos33WithdrawReward)(nil),    // 42: types.Pos33WithdrawReward
-	(*Pos33MinerFeeRate)(nil),      // 43: types.Pos33MinerFeeRate
-	(*ReplyTxHex)(nil),             // 44: types.ReplyTxHex
-	(*ReplyPos33Info)(nil),         // 45: types.ReplyPos33Info
-	nil,                            // 46: types.Pos33SortMap.SortMapEntry
-	(*types.Signature)(nil),        // 47: types.Signature
-	(*types.Block)(nil),            // 48: types.Block
+func (x *Pos33SortitionEvent) GetErr() string {
+	if x != nil {
+		return x.Err
+	}
+	return ""
 }
-var file_pos33_proto_depIdxs = []int32{
-	24, // 0: types.Pos33TicketAction.topen:type_name -> types.Pos33TicketOpen
-	25, // 1: types.Pos33TicketAction.genesis:type_name -> types.Pos33TicketGenesis
-	26, // 2: types.Pos33TicketAction.tclose:type_name -> types.Pos33TicketClose
-	23, // 3: types.Pos33TicketAction.tbind:type_name -> types.Pos33TicketBind
-	20, // 4: types.Pos33TicketAction.miner:type_name -> types.Pos33MinerMsg
-	38, // 5: types.Pos33TicketAction.entrust:type_name -> types.Pos33Entrust
-	39, // 6: types.Pos33TicketAction.migrate:type_name -> types.Pos33Migrate
-	40, // 7: types.Pos33TicketAction.blsBind:type_name -> types.Pos33BlsBind
-	43, // 8: types.Pos33TicketAction.feeRate:type_name -> types.Pos33MinerFeeRate
-	42, // 9: types.Pos33TicketAction.withdraw:type_name -> types.Pos33WithdrawReward
-	0,  // 10: types.Pos33Msg.ty:type_name -> types.Pos33Msg.Ty
-	5,  // 11: types.HashProof.input:type_name -> types.VrfInput
-	4,  // 12: types.Pos33SortMsg.sort_hash:type_name -> types.SortHash
-	6,  // 13: types.Pos33SortMsg.proof:type_name -> types.HashProof
-	7,  // 14: types.Pos33Sorts.sorts:type_name -> types.Pos33SortMsg
-	8,  // 15: types.Pos33VoteSorts.vote_sorts:type_name -> types.Pos33Sorts
-	47, // 16: types.Pos33Online.Sig:type_name -> types.Signature
-	48, // 17: types.Pos33BlockMsg.b:type_name -> types.Block
-	48, // 18: types.Pos33BlockMsg2.b:type_name -> types.Block
-	13, // 19: types.Pos33BlockMsg2.vs:type_name -> types.Pos33VoteMsg
-	7,  // 20: types.Pos33VoteMsg.sort:type_name -> types.Pos33SortMsg
-	47, // 21: types.Pos33VoteMsg.sig:type_name -> types.Signature
-	7,  // 22: types.Pos33SortsVote.my_sorts:type_name -> types.Pos33SortMsg
-	47, // 23: types.Pos33SortsVote.sig:type_name -> types.Signature
-	46, // 24: types.Pos33SortMap.sort_map:type_name -> types.Pos33SortMap.SortMapEntry
-	13, // 25: types.Pos33Votes.vs:type_name -> types.Pos33VoteMsg
-	17, // 26: types.Pos33MakerVotes.mvs:type_name -> types.Pos33Votes
-	7,  // 27: types.Pos33TicketMiner.sort:type_name -> types.Pos33SortMsg
-	13, // 28: types.Pos33TicketMiner.vs:type_name -> types.Pos33VoteMsg
-	7,  // 29: types.Pos33MinerMsg.sort:type_name -> types.Pos33SortMsg
-	34, // 30: types.Pos33Consignor.consignees:type_name -> types.Consignee
-	35, // 31: types.Pos33Consignee.consignors:type_name -> types.Consignor
-	7,  // 32: types.Pos33SortMap.SortMapEntry.value:type_name -> types.Pos33SortMsg
-	38, // 33: types.pos33.SetPos33Entrust:input_type -> types.Pos33Entrust
-	44, // 34: types.pos33.SetPos33Entrust:output_type -> types.ReplyTxHex
-	34, // [34:35] is the sub-list for method output_type
-	33, // [33:34] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
+
+func (x *Pos33SortitionEvent) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
 }
 
-func init() { file_pos33_proto_init() }
-func file_pos33_proto_init() {
-	if File_pos33_proto != nil {
-		return
+func (x *Pos33SortitionEvent) GetDiff() float64 {
+	if x != nil {
+		return x.Diff
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_pos33_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33Ticket); i {
+	return 0
+}
+
+type ReqPos33SortitionEvents struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromSeq int64 `protobuf:"varint,1,opt,name=fromSeq,proto3" json:"fromSeq,omitempty"`
+	Count   int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// 只返回这些类型的事件, 为空时返回全部
+	Types []int32 `protobuf:"varint,3,rep,packed,name=types,proto3" json:"types,omitempty"`
+}
+
+func (x *ReqPos33SortitionEvents) Reset() {
+	*x = ReqPos33SortitionEvents{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33SortitionEvents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33SortitionEvents) ProtoMessage() {}
+
+func (x *ReqPos33SortitionEvents) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33SortitionEvents.ProtoReflect.Descriptor instead.
+func (*ReqPos33SortitionEvents) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ReqPos33SortitionEvents) GetFromSeq() int64 {
+	if x != nil {
+		return x.FromSeq
+	}
+	return 0
+}
+
+func (x *ReqPos33SortitionEvents) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ReqPos33SortitionEvents) GetTypes() []int32 {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type Pos33SortitionEvents struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events  []*Pos33SortitionEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextSeq int64                  `protobuf:"varint,2,opt,name=nextSeq,proto3" json:"nextSeq,omitempty"`
+	// fromSeq之后已经被覆盖的事件数
+	Dropped int64 `protobuf:"varint,3,opt,name=dropped,proto3" json:"dropped,omitempty"`
+}
+
+func (x *Pos33SortitionEvents) Reset() {
+	*x = Pos33SortitionEvents{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33SortitionEvents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33SortitionEvents) ProtoMessage() {}
+
+func (x *Pos33SortitionEvents) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33SortitionEvents.ProtoReflect.Descriptor instead.
+func (*Pos33SortitionEvents) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Pos33SortitionEvents) GetEvents() []*Pos33SortitionEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Pos33SortitionEvents) GetNextSeq() int64 {
+	if x != nil {
+		return x.NextSeq
+	}
+	return 0
+}
+
+func (x *Pos33SortitionEvents) GetDropped() int64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+// 全网抵押统计
+type Pos33StakingStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// 抵押的总金额
+	TotalDeposit int64 `protobuf:"varint,2,opt,name=totalDeposit,proto3" json:"totalDeposit,omitempty"`
+	TotalTickets int64 `protobuf:"varint,3,opt,name=totalTickets,proto3" json:"totalTickets,omitempty"`
+	Miners       int64 `protobuf:"varint,4,opt,name=miners,proto3" json:"miners,omitempty"`
+	// 不同的抵押人(委托人)数量
+	Depositors int64 `protobuf:"varint,5,opt,name=depositors,proto3" json:"depositors,omitempty"`
+	// 期望的委员会大小, 所有子委员会的和
+	ExpectedCommittee int64 `protobuf:"varint,6,opt,name=expectedCommittee,proto3" json:"expectedCommittee,omitempty"`
+	// 缓存的时间(毫秒)
+	CacheAge int64 `protobuf:"varint,7,opt,name=cacheAge,proto3" json:"cacheAge,omitempty"`
+}
+
+func (x *Pos33StakingStats) Reset() {
+	*x = Pos33StakingStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33StakingStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33StakingStats) ProtoMessage() {}
+
+func (x *Pos33StakingStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33StakingStats.ProtoReflect.Descriptor instead.
+func (*Pos33StakingStats) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Pos33StakingStats) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33StakingStats) GetTotalDeposit() int64 {
+	if x != nil {
+		return x.TotalDeposit
+	}
+	return 0
+}
+
+func (x *Pos33StakingStats) GetTotalTickets() int64 {
+	if x != nil {
+		return x.TotalTickets
+	}
+	return 0
+}
+
+func (x *Pos33StakingStats) GetMiners() int64 {
+	if x != nil {
+		return x.Miners
+	}
+	return 0
+}
+
+func (x *Pos33StakingStats) GetDepositors() int64 {
+	if x != nil {
+		return x.Depositors
+	}
+	return 0
+}
+
+func (x *Pos33StakingStats) GetExpectedCommittee() int64 {
+	if x != nil {
+		return x.ExpectedCommittee
+	}
+	return 0
+}
+
+func (x *Pos33StakingStats) GetCacheAge() int64 {
+	if x != nil {
+		return x.CacheAge
+	}
+	return 0
+}
+
+// 就绪检查的一项, ok为false时err说明原因
+type Pos33ReadyCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ok   bool   `protobuf:"varint,2,opt,name=ok,proto3" json:"ok,omitempty"`
+	Err  string `protobuf:"bytes,3,opt,name=err,proto3" json:"err,omitempty"`
+}
+
+func (x *Pos33ReadyCheck) Reset() {
+	*x = Pos33ReadyCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33ReadyCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33ReadyCheck) ProtoMessage() {}
+
+func (x *Pos33ReadyCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33ReadyCheck.ProtoReflect.Descriptor instead.
+func (*Pos33ReadyCheck) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Pos33ReadyCheck) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Pos33ReadyCheck) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *Pos33ReadyCheck) GetErr() string {
+	if x != nil {
+		return x.Err
+	}
+	return ""
+}
+
+// 节点是否可以参与共识
+type Pos33Readiness struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ready  bool               `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
+	Height int64              `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Addr   string             `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	Count  int64              `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Checks []*Pos33ReadyCheck `protobuf:"bytes,5,rep,name=checks,proto3" json:"checks,omitempty"`
+}
+
+func (x *Pos33Readiness) Reset() {
+	*x = Pos33Readiness{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Readiness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Readiness) ProtoMessage() {}
+
+func (x *Pos33Readiness) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Readiness.ProtoReflect.Descriptor instead.
+func (*Pos33Readiness) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Pos33Readiness) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *Pos33Readiness) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33Readiness) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Pos33Readiness) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Pos33Readiness) GetChecks() []*Pos33ReadyCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+type Pos33HeightCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Count  int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *Pos33HeightCount) Reset() {
+	*x = Pos33HeightCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33HeightCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33HeightCount) ProtoMessage() {}
+
+func (x *Pos33HeightCount) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33HeightCount.ProtoReflect.Descriptor instead.
+func (*Pos33HeightCount) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Pos33HeightCount) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33HeightCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type Pos33HeightTickets struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height     int64             `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Validators []*Pos33Validator `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (x *Pos33HeightTickets) Reset() {
+	*x = Pos33HeightTickets{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33HeightTickets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33HeightTickets) ProtoMessage() {}
+
+func (x *Pos33HeightTickets) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33HeightTickets.ProtoReflect.Descriptor instead.
+func (*Pos33HeightTickets) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Pos33HeightTickets) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33HeightTickets) GetValidators() []*Pos33Validator {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+// 共识模块缓存的快照, 新节点导入后不用重放很多区块
+type Pos33ConsensusSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height       int64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	AllCounts    []*Pos33HeightCount   `protobuf:"bytes,2,rep,name=allCounts,proto3" json:"allCounts,omitempty"`
+	Tickets      []*Pos33HeightTickets `protobuf:"bytes,3,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	DiffSchedule []*Pos33HeightCount   `protobuf:"bytes,4,rep,name=diffSchedule,proto3" json:"diffSchedule,omitempty"`
+	Committees   *Pos33CommitteeStore  `protobuf:"bytes,5,opt,name=committees,proto3" json:"committees,omitempty"`
+	Hash         []byte                `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *Pos33ConsensusSnapshot) Reset() {
+	*x = Pos33ConsensusSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33ConsensusSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33ConsensusSnapshot) ProtoMessage() {}
+
+func (x *Pos33ConsensusSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33ConsensusSnapshot.ProtoReflect.Descriptor instead.
+func (*Pos33ConsensusSnapshot) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Pos33ConsensusSnapshot) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33ConsensusSnapshot) GetAllCounts() []*Pos33HeightCount {
+	if x != nil {
+		return x.AllCounts
+	}
+	return nil
+}
+
+func (x *Pos33ConsensusSnapshot) GetTickets() []*Pos33HeightTickets {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+func (x *Pos33ConsensusSnapshot) GetDiffSchedule() []*Pos33HeightCount {
+	if x != nil {
+		return x.DiffSchedule
+	}
+	return nil
+}
+
+func (x *Pos33ConsensusSnapshot) GetCommittees() *Pos33CommitteeStore {
+	if x != nil {
+		return x.Committees
+	}
+	return nil
+}
+
+func (x *Pos33ConsensusSnapshot) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+// 同一个抽签在同一高度和轮次, 对不同的区块投票的证据. a和b按投票的Hash排序
+type Pos33Evidence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height   int64         `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round    int32         `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Offender string        `protobuf:"bytes,3,opt,name=offender,proto3" json:"offender,omitempty"`
+	A        *Pos33VoteMsg `protobuf:"bytes,4,opt,name=a,proto3" json:"a,omitempty"`
+	B        *Pos33VoteMsg `protobuf:"bytes,5,opt,name=b,proto3" json:"b,omitempty"`
+	Hash     []byte        `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
+	// a和b投票的两个出块抽签, 证明两个区块在同一个高度和轮次. 投票签名只包括hash, 没有出块抽签的证据不能上链处罚
+	MakerA *Pos33SortMsg `protobuf:"bytes,7,opt,name=makerA,proto3" json:"makerA,omitempty"`
+	MakerB *Pos33SortMsg `protobuf:"bytes,8,opt,name=makerB,proto3" json:"makerB,omitempty"`
+}
+
+func (x *Pos33Evidence) Reset() {
+	*x = Pos33Evidence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Evidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Evidence) ProtoMessage() {}
+
+func (x *Pos33Evidence) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Evidence.ProtoReflect.Descriptor instead.
+func (*Pos33Evidence) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Pos33Evidence) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33Evidence) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *Pos33Evidence) GetOffender() string {
+	if x != nil {
+		return x.Offender
+	}
+	return ""
+}
+
+func (x *Pos33Evidence) GetA() *Pos33VoteMsg {
+	if x != nil {
+		return x.A
+	}
+	return nil
+}
+
+func (x *Pos33Evidence) GetB() *Pos33VoteMsg {
+	if x != nil {
+		return x.B
+	}
+	return nil
+}
+
+func (x *Pos33Evidence) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *Pos33Evidence) GetMakerA() *Pos33SortMsg {
+	if x != nil {
+		return x.MakerA
+	}
+	return nil
+}
+
+func (x *Pos33Evidence) GetMakerB() *Pos33SortMsg {
+	if x != nil {
+		return x.MakerB
+	}
+	return nil
+}
+
+// 举报双重投票, 作恶地址的抵押按比例销毁, 一部分奖励给举报人
+type Pos33Slash struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Evidences []*Pos33Evidence `protobuf:"bytes,1,rep,name=evidences,proto3" json:"evidences,omitempty"`
+}
+
+func (x *Pos33Slash) Reset() {
+	*x = Pos33Slash{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Slash) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Slash) ProtoMessage() {}
+
+func (x *Pos33Slash) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Slash.ProtoReflect.Descriptor instead.
+func (*Pos33Slash) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Pos33Slash) GetEvidences() []*Pos33Evidence {
+	if x != nil {
+		return x.Evidences
+	}
+	return nil
+}
+
+type ReceiptPos33Slash struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offender string `protobuf:"bytes,1,opt,name=offender,proto3" json:"offender,omitempty"`
+	Reporter string `protobuf:"bytes,2,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	Height   int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Round    int32  `protobuf:"varint,4,opt,name=round,proto3" json:"round,omitempty"`
+	// 销毁的抵押
+	Burned int64 `protobuf:"varint,5,opt,name=burned,proto3" json:"burned,omitempty"`
+	// 给举报人的奖励
+	Bounty       int64  `protobuf:"varint,6,opt,name=bounty,proto3" json:"bounty,omitempty"`
+	EvidenceHash []byte `protobuf:"bytes,7,opt,name=evidenceHash,proto3" json:"evidenceHash,omitempty"`
+}
+
+func (x *ReceiptPos33Slash) Reset() {
+	*x = ReceiptPos33Slash{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptPos33Slash) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptPos33Slash) ProtoMessage() {}
+
+func (x *ReceiptPos33Slash) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptPos33Slash.ProtoReflect.Descriptor instead.
+func (*ReceiptPos33Slash) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ReceiptPos33Slash) GetOffender() string {
+	if x != nil {
+		return x.Offender
+	}
+	return ""
+}
+
+func (x *ReceiptPos33Slash) GetReporter() string {
+	if x != nil {
+		return x.Reporter
+	}
+	return ""
+}
+
+func (x *ReceiptPos33Slash) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ReceiptPos33Slash) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *ReceiptPos33Slash) GetBurned() int64 {
+	if x != nil {
+		return x.Burned
+	}
+	return 0
+}
+
+func (x *ReceiptPos33Slash) GetBounty() int64 {
+	if x != nil {
+		return x.Bounty
+	}
+	return 0
+}
+
+func (x *ReceiptPos33Slash) GetEvidenceHash() []byte {
+	if x != nil {
+		return x.EvidenceHash
+	}
+	return nil
+}
+
+// 链上可以治理的共识参数, 从height高度开始生效. 交易里为0的参数沿用之前的值
+type Pos33ChainParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// 委员会大小, 抽签难度是committeeSize/全网票数
+	CommitteeSize int32 `protobuf:"varint,2,opt,name=committeeSize,proto3" json:"committeeSize,omitempty"`
+	// 抽签难度的百分比
+	DiffPersent int32 `protobuf:"varint,3,opt,name=diffPersent,proto3" json:"diffPersent,omitempty"`
+	// 抽签使用多少个区块以前的seed和票数
+	SortBlocks int32 `protobuf:"varint,4,opt,name=sortBlocks,proto3" json:"sortBlocks,omitempty"`
+}
+
+func (x *Pos33ChainParam) Reset() {
+	*x = Pos33ChainParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33ChainParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33ChainParam) ProtoMessage() {}
+
+func (x *Pos33ChainParam) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33ChainParam.ProtoReflect.Descriptor instead.
+func (*Pos33ChainParam) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Pos33ChainParam) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33ChainParam) GetCommitteeSize() int32 {
+	if x != nil {
+		return x.CommitteeSize
+	}
+	return 0
+}
+
+func (x *Pos33ChainParam) GetDiffPersent() int32 {
+	if x != nil {
+		return x.DiffPersent
+	}
+	return 0
+}
+
+func (x *Pos33ChainParam) GetSortBlocks() int32 {
+	if x != nil {
+		return x.SortBlocks
+	}
+	return 0
+}
+
+// 按生效高度排列的参数, 每一项都是完整的参数
+type Pos33ChainParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Params []*Pos33ChainParam `protobuf:"bytes,1,rep,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *Pos33ChainParams) Reset() {
+	*x = Pos33ChainParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33ChainParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33ChainParams) ProtoMessage() {}
+
+func (x *Pos33ChainParams) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33ChainParams.ProtoReflect.Descriptor instead.
+func (*Pos33ChainParams) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *Pos33ChainParams) GetParams() []*Pos33ChainParam {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type Pos33Votes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vs []*Pos33VoteMsg `protobuf:"bytes,1,rep,name=vs,proto3" json:"vs,omitempty"`
+}
+
+func (x *Pos33Votes) Reset() {
+	*x = Pos33Votes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Votes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Votes) ProtoMessage() {}
+
+func (x *Pos33Votes) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Votes.ProtoReflect.Descriptor instead.
+func (*Pos33Votes) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Pos33Votes) GetVs() []*Pos33VoteMsg {
+	if x != nil {
+		return x.Vs
+	}
+	return nil
+}
+
+type Pos33MakerVotes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mvs []*Pos33Votes `protobuf:"bytes,1,rep,name=mvs,proto3" json:"mvs,omitempty"`
+}
+
+func (x *Pos33MakerVotes) Reset() {
+	*x = Pos33MakerVotes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33MakerVotes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33MakerVotes) ProtoMessage() {}
+
+func (x *Pos33MakerVotes) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33MakerVotes.ProtoReflect.Descriptor instead.
+func (*Pos33MakerVotes) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *Pos33MakerVotes) GetMvs() []*Pos33Votes {
+	if x != nil {
+		return x.Mvs
+	}
+	return nil
+}
+
+type Pos33TicketMiner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sort      *Pos33SortMsg   `protobuf:"bytes,1,opt,name=sort,proto3" json:"sort,omitempty"`
+	Vs        []*Pos33VoteMsg `protobuf:"bytes,2,rep,name=vs,proto3" json:"vs,omitempty"`
+	BlockTime int64           `protobuf:"varint,4,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
+}
+
+func (x *Pos33TicketMiner) Reset() {
+	*x = Pos33TicketMiner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33TicketMiner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33TicketMiner) ProtoMessage() {}
+
+func (x *Pos33TicketMiner) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33TicketMiner.ProtoReflect.Descriptor instead.
+func (*Pos33TicketMiner) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Pos33TicketMiner) GetSort() *Pos33SortMsg {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *Pos33TicketMiner) GetVs() []*Pos33VoteMsg {
+	if x != nil {
+		return x.Vs
+	}
+	return nil
+}
+
+func (x *Pos33TicketMiner) GetBlockTime() int64 {
+	if x != nil {
+		return x.BlockTime
+	}
+	return 0
+}
+
+type Pos33MinerMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlsPkList [][]byte      `protobuf:"bytes,1,rep,name=BlsPkList,proto3" json:"BlsPkList,omitempty"`
+	BlsSig    []byte        `protobuf:"bytes,2,opt,name=BlsSig,proto3" json:"BlsSig,omitempty"`
+	Hash      []byte        `protobuf:"bytes,3,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Sort      *Pos33SortMsg `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"`
+	BlockTime int64         `protobuf:"varint,4,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
+	// 达到ForkEvidence高度后, 打包的双重投票证据
+	Evidences []*Pos33Evidence `protobuf:"bytes,6,rep,name=evidences,proto3" json:"evidences,omitempty"`
+	// 达到ForkAggVote高度后, 投票用位图表示, BlsPkList和BlsSig为空
+	Agg *Pos33AggVote `protobuf:"bytes,7,opt,name=agg,proto3" json:"agg,omitempty"`
+	// 达到ForkRewardV2高度后, 出块人声明的这个区块的奖励, 执行和共识都会重新计算检查
+	Reward *Pos33MinerReward `protobuf:"bytes,8,opt,name=reward,proto3" json:"reward,omitempty"`
+	// 达到ForkLightProof高度后, 快照高度是上一个区块的那些高度(按高度排序)的票数承诺hash,
+	// 共识检查和本地计算的相同, 轻客户端从可信的区块头得到以后高度的票数承诺
+	TicketCommits [][]byte `protobuf:"bytes,9,rep,name=ticketCommits,proto3" json:"ticketCommits,omitempty"`
+}
+
+func (x *Pos33MinerMsg) Reset() {
+	*x = Pos33MinerMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33MinerMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33MinerMsg) ProtoMessage() {}
+
+func (x *Pos33MinerMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33MinerMsg.ProtoReflect.Descriptor instead.
+func (*Pos33MinerMsg) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Pos33MinerMsg) GetBlsPkList() [][]byte {
+	if x != nil {
+		return x.BlsPkList
+	}
+	return nil
+}
+
+func (x *Pos33MinerMsg) GetBlsSig() []byte {
+	if x != nil {
+		return x.BlsSig
+	}
+	return nil
+}
+
+func (x *Pos33MinerMsg) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *Pos33MinerMsg) GetSort() *Pos33SortMsg {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *Pos33MinerMsg) GetBlockTime() int64 {
+	if x != nil {
+		return x.BlockTime
+	}
+	return 0
+}
+
+func (x *Pos33MinerMsg) GetEvidences() []*Pos33Evidence {
+	if x != nil {
+		return x.Evidences
+	}
+	return nil
+}
+
+func (x *Pos33MinerMsg) GetAgg() *Pos33AggVote {
+	if x != nil {
+		return x.Agg
+	}
+	return nil
+}
+
+func (x *Pos33MinerMsg) GetReward() *Pos33MinerReward {
+	if x != nil {
+		return x.Reward
+	}
+	return nil
+}
+
+func (x *Pos33MinerMsg) GetTicketCommits() [][]byte {
+	if x != nil {
+		return x.TicketCommits
+	}
+	return nil
+}
+
+// Pos33MinerReward ForkRewardV2以后一个区块的奖励
+type Pos33MinerReward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 每个投票的奖励
+	VoteReward int64 `protobuf:"varint,1,opt,name=voteReward,proto3" json:"voteReward,omitempty"`
+	// 区块的投票数
+	Votes int64 `protobuf:"varint,2,opt,name=votes,proto3" json:"votes,omitempty"`
+	// 出块人的奖励, 包括前面超时的轮次累积的部分
+	MakerReward int64 `protobuf:"varint,3,opt,name=makerReward,proto3" json:"makerReward,omitempty"`
+	// 累积了多少个超时轮次的出块奖励
+	CarryRounds int64 `protobuf:"varint,4,opt,name=carryRounds,proto3" json:"carryRounds,omitempty"`
+	// 剩下给基金的奖励
+	FundReward int64 `protobuf:"varint,5,opt,name=fundReward,proto3" json:"fundReward,omitempty"`
+}
+
+func (x *Pos33MinerReward) Reset() {
+	*x = Pos33MinerReward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33MinerReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33MinerReward) ProtoMessage() {}
+
+func (x *Pos33MinerReward) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33MinerReward.ProtoReflect.Descriptor instead.
+func (*Pos33MinerReward) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *Pos33MinerReward) GetVoteReward() int64 {
+	if x != nil {
+		return x.VoteReward
+	}
+	return 0
+}
+
+func (x *Pos33MinerReward) GetVotes() int64 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *Pos33MinerReward) GetMakerReward() int64 {
+	if x != nil {
+		return x.MakerReward
+	}
+	return 0
+}
+
+func (x *Pos33MinerReward) GetCarryRounds() int64 {
+	if x != nil {
+		return x.CarryRounds
+	}
+	return 0
+}
+
+func (x *Pos33MinerReward) GetFundReward() int64 {
+	if x != nil {
+		return x.FundReward
+	}
+	return 0
+}
+
+// Pos33AggVote 聚合的委员会投票
+type Pos33AggVote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 按链上bls公钥编号的投票位图, 第i位表示编号i的公钥投了票
+	Bitmap []byte `protobuf:"bytes,1,opt,name=bitmap,proto3" json:"bitmap,omitempty"`
+	// 还没有编号的投票公钥, 执行以后按顺序编号
+	Pks [][]byte `protobuf:"bytes,2,rep,name=pks,proto3" json:"pks,omitempty"`
+	// 所有投票的聚合签名
+	Sig []byte `protobuf:"bytes,3,opt,name=sig,proto3" json:"sig,omitempty"`
+}
+
+func (x *Pos33AggVote) Reset() {
+	*x = Pos33AggVote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33AggVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33AggVote) ProtoMessage() {}
+
+func (x *Pos33AggVote) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33AggVote.ProtoReflect.Descriptor instead.
+func (*Pos33AggVote) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Pos33AggVote) GetBitmap() []byte {
+	if x != nil {
+		return x.Bitmap
+	}
+	return nil
+}
+
+func (x *Pos33AggVote) GetPks() [][]byte {
+	if x != nil {
+		return x.Pks
+	}
+	return nil
+}
+
+func (x *Pos33AggVote) GetSig() []byte {
+	if x != nil {
+		return x.Sig
+	}
+	return nil
+}
+
+type ReqPos33BlsPubkeys struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Indices []int64 `protobuf:"varint,1,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+}
+
+func (x *ReqPos33BlsPubkeys) Reset() {
+	*x = ReqPos33BlsPubkeys{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33BlsPubkeys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33BlsPubkeys) ProtoMessage() {}
+
+func (x *ReqPos33BlsPubkeys) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33BlsPubkeys.ProtoReflect.Descriptor instead.
+func (*ReqPos33BlsPubkeys) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ReqPos33BlsPubkeys) GetIndices() []int64 {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+type Pos33BlsPubkeys struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pks [][]byte `protobuf:"bytes,1,rep,name=pks,proto3" json:"pks,omitempty"`
+}
+
+func (x *Pos33BlsPubkeys) Reset() {
+	*x = Pos33BlsPubkeys{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33BlsPubkeys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33BlsPubkeys) ProtoMessage() {}
+
+func (x *Pos33BlsPubkeys) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33BlsPubkeys.ProtoReflect.Descriptor instead.
+func (*Pos33BlsPubkeys) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *Pos33BlsPubkeys) GetPks() [][]byte {
+	if x != nil {
+		return x.Pks
+	}
+	return nil
+}
+
+// Pos33BlsIndices 按查询的bls地址顺序返回的编号, 没有编号的是-1
+type Pos33BlsIndices struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Indices []int64 `protobuf:"varint,1,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+}
+
+func (x *Pos33BlsIndices) Reset() {
+	*x = Pos33BlsIndices{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33BlsIndices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33BlsIndices) ProtoMessage() {}
+
+func (x *Pos33BlsIndices) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33BlsIndices.ProtoReflect.Descriptor instead.
+func (*Pos33BlsIndices) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Pos33BlsIndices) GetIndices() []int64 {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+type Pos33MinerFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flag    int32 `protobuf:"varint,1,opt,name=flag,proto3" json:"flag,omitempty"`
+	Reserve int64 `protobuf:"varint,2,opt,name=reserve,proto3" json:"reserve,omitempty"`
+}
+
+func (x *Pos33MinerFlag) Reset() {
+	*x = Pos33MinerFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33MinerFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33MinerFlag) ProtoMessage() {}
+
+func (x *Pos33MinerFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33MinerFlag.ProtoReflect.Descriptor instead.
+func (*Pos33MinerFlag) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *Pos33MinerFlag) GetFlag() int32 {
+	if x != nil {
+		return x.Flag
+	}
+	return 0
+}
+
+func (x *Pos33MinerFlag) GetReserve() int64 {
+	if x != nil {
+		return x.Reserve
+	}
+	return 0
+}
+
+type Pos33PrivMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Priv []byte `protobuf:"bytes,1,opt,name=priv,proto3" json:"priv,omitempty"`
+}
+
+func (x *Pos33PrivMsg) Reset() {
+	*x = Pos33PrivMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33PrivMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33PrivMsg) ProtoMessage() {}
+
+func (x *Pos33PrivMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33PrivMsg.ProtoReflect.Descriptor instead.
+func (*Pos33PrivMsg) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *Pos33PrivMsg) GetPriv() []byte {
+	if x != nil {
+		return x.Priv
+	}
+	return nil
+}
+
+type Pos33TicketBind struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinerAddress  string `protobuf:"bytes,1,opt,name=minerAddress,proto3" json:"minerAddress,omitempty"`
+	ReturnAddress string `protobuf:"bytes,2,opt,name=returnAddress,proto3" json:"returnAddress,omitempty"`
+}
+
+func (x *Pos33TicketBind) Reset() {
+	*x = Pos33TicketBind{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33TicketBind) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33TicketBind) ProtoMessage() {}
+
+func (x *Pos33TicketBind) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33TicketBind.ProtoReflect.Descriptor instead.
+func (*Pos33TicketBind) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Pos33TicketBind) GetMinerAddress() string {
+	if x != nil {
+		return x.MinerAddress
+	}
+	return ""
+}
+
+func (x *Pos33TicketBind) GetReturnAddress() string {
+	if x != nil {
+		return x.ReturnAddress
+	}
+	return ""
+}
+
+type Pos33TicketOpen struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//用户挖矿的ticket 地址
+	MinerAddress string `protobuf:"bytes,1,opt,name=minerAddress,proto3" json:"minerAddress,omitempty"`
+	//币实际存储的地址
+	ReturnAddress string `protobuf:"bytes,3,opt,name=returnAddress,proto3" json:"returnAddress,omitempty"`
+	//聚合签名的地址
+	BlsAddress string `protobuf:"bytes,6,opt,name=blsAddress,proto3" json:"blsAddress,omitempty"`
+	//购买ticket的数目
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	//随机种子
+	RandSeed int64 `protobuf:"varint,4,opt,name=randSeed,proto3" json:"randSeed,omitempty"`
+}
+
+func (x *Pos33TicketOpen) Reset() {
+	*x = Pos33TicketOpen{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33TicketOpen) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33TicketOpen) ProtoMessage() {}
+
+func (x *Pos33TicketOpen) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33TicketOpen.ProtoReflect.Descriptor instead.
+func (*Pos33TicketOpen) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *Pos33TicketOpen) GetMinerAddress() string {
+	if x != nil {
+		return x.MinerAddress
+	}
+	return ""
+}
+
+func (x *Pos33TicketOpen) GetReturnAddress() string {
+	if x != nil {
+		return x.ReturnAddress
+	}
+	return ""
+}
+
+func (x *Pos33TicketOpen) GetBlsAddress() string {
+	if x != nil {
+		return x.BlsAddress
+	}
+	return ""
+}
+
+func (x *Pos33TicketOpen) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Pos33TicketOpen) GetRandSeed() int64 {
+	if x != nil {
+		return x.RandSeed
+	}
+	return 0
+}
+
+type Pos33TicketGenesis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinerAddress  string `protobuf:"bytes,1,opt,name=minerAddress,proto3" json:"minerAddress,omitempty"`
+	ReturnAddress string `protobuf:"bytes,2,opt,name=returnAddress,proto3" json:"returnAddress,omitempty"`
+	BlsAddress    string `protobuf:"bytes,6,opt,name=blsAddress,proto3" json:"blsAddress,omitempty"`
+	Count         int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *Pos33TicketGenesis) Reset() {
+	*x = Pos33TicketGenesis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33TicketGenesis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33TicketGenesis) ProtoMessage() {}
+
+func (x *Pos33TicketGenesis) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33TicketGenesis.ProtoReflect.Descriptor instead.
+func (*Pos33TicketGenesis) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *Pos33TicketGenesis) GetMinerAddress() string {
+	if x != nil {
+		return x.MinerAddress
+	}
+	return ""
+}
+
+func (x *Pos33TicketGenesis) GetReturnAddress() string {
+	if x != nil {
+		return x.ReturnAddress
+	}
+	return ""
+}
+
+func (x *Pos33TicketGenesis) GetBlsAddress() string {
+	if x != nil {
+		return x.BlsAddress
+	}
+	return ""
+}
+
+func (x *Pos33TicketGenesis) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type Pos33TicketClose struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// repeated string ticketId = 1;
+	MinerAddress string `protobuf:"bytes,2,opt,name=minerAddress,proto3" json:"minerAddress,omitempty"`
+	Count        int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *Pos33TicketClose) Reset() {
+	*x = Pos33TicketClose{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33TicketClose) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33TicketClose) ProtoMessage() {}
+
+func (x *Pos33TicketClose) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33TicketClose.ProtoReflect.Descriptor instead.
+func (*Pos33TicketClose) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *Pos33TicketClose) GetMinerAddress() string {
+	if x != nil {
+		return x.MinerAddress
+	}
+	return ""
+}
+
+func (x *Pos33TicketClose) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type Pos33TicketReward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr   string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Height int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *Pos33TicketReward) Reset() {
+	*x = Pos33TicketReward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33TicketReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33TicketReward) ProtoMessage() {}
+
+func (x *Pos33TicketReward) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33TicketReward.ProtoReflect.Descriptor instead.
+func (*Pos33TicketReward) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Pos33TicketReward) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Pos33TicketReward) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type Pos33TicketList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr   string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Status int32  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *Pos33TicketList) Reset() {
+	*x = Pos33TicketList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33TicketList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33TicketList) ProtoMessage() {}
+
+func (x *Pos33TicketList) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33TicketList.ProtoReflect.Descriptor instead.
+func (*Pos33TicketList) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *Pos33TicketList) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Pos33TicketList) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type ReplyPos33TicketReward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VoterReward int64 `protobuf:"varint,1,opt,name=voter_reward,json=voterReward,proto3" json:"voter_reward,omitempty"`
+	MinerReward int64 `protobuf:"varint,2,opt,name=miner_reward,json=minerReward,proto3" json:"miner_reward,omitempty"`
+}
+
+func (x *ReplyPos33TicketReward) Reset() {
+	*x = ReplyPos33TicketReward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplyPos33TicketReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyPos33TicketReward) ProtoMessage() {}
+
+func (x *ReplyPos33TicketReward) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyPos33TicketReward.ProtoReflect.Descriptor instead.
+func (*ReplyPos33TicketReward) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ReplyPos33TicketReward) GetVoterReward() int64 {
+	if x != nil {
+		return x.VoterReward
+	}
+	return 0
+}
+
+func (x *ReplyPos33TicketReward) GetMinerReward() int64 {
+	if x != nil {
+		return x.MinerReward
+	}
+	return 0
+}
+
+type ReplyWalletPos33Count struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Privkey []byte `protobuf:"bytes,2,opt,name=privkey,proto3" json:"privkey,omitempty"`
+	Count   int64  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ReplyWalletPos33Count) Reset() {
+	*x = ReplyWalletPos33Count{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplyWalletPos33Count) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyWalletPos33Count) ProtoMessage() {}
+
+func (x *ReplyWalletPos33Count) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyWalletPos33Count.ProtoReflect.Descriptor instead.
+func (*ReplyWalletPos33Count) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ReplyWalletPos33Count) GetPrivkey() []byte {
+	if x != nil {
+		return x.Privkey
+	}
+	return nil
+}
+
+func (x *ReplyWalletPos33Count) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ReceiptPos33Deposit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr  string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Count int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ReceiptPos33Deposit) Reset() {
+	*x = ReceiptPos33Deposit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptPos33Deposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptPos33Deposit) ProtoMessage() {}
+
+func (x *ReceiptPos33Deposit) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptPos33Deposit.ProtoReflect.Descriptor instead.
+func (*ReceiptPos33Deposit) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ReceiptPos33Deposit) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *ReceiptPos33Deposit) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ReceiptPos33Miner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr   string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Reward int64  `protobuf:"varint,3,opt,name=reward,proto3" json:"reward,omitempty"`
+}
+
+func (x *ReceiptPos33Miner) Reset() {
+	*x = ReceiptPos33Miner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptPos33Miner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptPos33Miner) ProtoMessage() {}
+
+func (x *ReceiptPos33Miner) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptPos33Miner.ProtoReflect.Descriptor instead.
+func (*ReceiptPos33Miner) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ReceiptPos33Miner) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *ReceiptPos33Miner) GetReward() int64 {
+	if x != nil {
+		return x.Reward
+	}
+	return 0
+}
+
+type ReceiptPos33TicketBind struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OldMinerAddress string `protobuf:"bytes,1,opt,name=oldMinerAddress,proto3" json:"oldMinerAddress,omitempty"`
+	NewMinerAddress string `protobuf:"bytes,2,opt,name=newMinerAddress,proto3" json:"newMinerAddress,omitempty"`
+	ReturnAddress   string `protobuf:"bytes,3,opt,name=returnAddress,proto3" json:"returnAddress,omitempty"`
+}
+
+func (x *ReceiptPos33TicketBind) Reset() {
+	*x = ReceiptPos33TicketBind{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptPos33TicketBind) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptPos33TicketBind) ProtoMessage() {}
+
+func (x *ReceiptPos33TicketBind) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptPos33TicketBind.ProtoReflect.Descriptor instead.
+func (*ReceiptPos33TicketBind) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ReceiptPos33TicketBind) GetOldMinerAddress() string {
+	if x != nil {
+		return x.OldMinerAddress
+	}
+	return ""
+}
+
+func (x *ReceiptPos33TicketBind) GetNewMinerAddress() string {
+	if x != nil {
+		return x.NewMinerAddress
+	}
+	return ""
+}
+
+func (x *ReceiptPos33TicketBind) GetReturnAddress() string {
+	if x != nil {
+		return x.ReturnAddress
+	}
+	return ""
+}
+
+type Consignee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Consignee) Reset() {
+	*x = Consignee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Consignee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Consignee) ProtoMessage() {}
+
+func (x *Consignee) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Consignee.ProtoReflect.Descriptor instead.
+func (*Consignee) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *Consignee) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Consignee) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type Consignor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address      string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount       int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`                                 // 委托数量
+	Reward       int64  `protobuf:"varint,3,opt,name=reward,proto3" json:"reward,omitempty"`                                 // 获取奖励的总额
+	RemainReward int64  `protobuf:"varint,4,opt,name=remain_reward,json=remainReward,proto3" json:"remain_reward,omitempty"` // 返给用户后的剩余奖励
+}
+
+func (x *Consignor) Reset() {
+	*x = Consignor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Consignor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Consignor) ProtoMessage() {}
+
+func (x *Consignor) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Consignor.ProtoReflect.Descriptor instead.
+func (*Consignor) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *Consignor) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Consignor) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Consignor) GetReward() int64 {
+	if x != nil {
+		return x.Reward
+	}
+	return 0
+}
+
+func (x *Consignor) GetRemainReward() int64 {
+	if x != nil {
+		return x.RemainReward
+	}
+	return 0
+}
+
+// 委托人
+type Pos33Consignor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address    string       `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Consignees []*Consignee `protobuf:"bytes,3,rep,name=consignees,proto3" json:"consignees,omitempty"` // 受托人列表
+}
+
+func (x *Pos33Consignor) Reset() {
+	*x = Pos33Consignor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Consignor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Consignor) ProtoMessage() {}
+
+func (x *Pos33Consignor) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Consignor.ProtoReflect.Descriptor instead.
+func (*Pos33Consignor) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *Pos33Consignor) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Pos33Consignor) GetConsignees() []*Consignee {
+	if x != nil {
+		return x.Consignees
+	}
+	return nil
+}
+
+// 受托人
+type Pos33Consignee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address         string       `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount          int64        `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	FeeReward       int64        `protobuf:"varint,3,opt,name=fee_reward,json=feeReward,proto3" json:"fee_reward,omitempty"`
+	RemainFeeReward int64        `protobuf:"varint,4,opt,name=remain_fee_reward,json=remainFeeReward,proto3" json:"remain_fee_reward,omitempty"` // 返给后的剩余奖励
+	FeePersent      int64        `protobuf:"varint,5,opt,name=fee_persent,json=feePersent,proto3" json:"fee_persent,omitempty"`
+	Consignors      []*Consignor `protobuf:"bytes,6,rep,name=consignors,proto3" json:"consignors,omitempty"` // 委托人列表
+}
+
+func (x *Pos33Consignee) Reset() {
+	*x = Pos33Consignee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Consignee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Consignee) ProtoMessage() {}
+
+func (x *Pos33Consignee) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Consignee.ProtoReflect.Descriptor instead.
+func (*Pos33Consignee) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *Pos33Consignee) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Pos33Consignee) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Pos33Consignee) GetFeeReward() int64 {
+	if x != nil {
+		return x.FeeReward
+	}
+	return 0
+}
+
+func (x *Pos33Consignee) GetRemainFeeReward() int64 {
+	if x != nil {
+		return x.RemainFeeReward
+	}
+	return 0
+}
+
+func (x *Pos33Consignee) GetFeePersent() int64 {
+	if x != nil {
+		return x.FeePersent
+	}
+	return 0
+}
+
+func (x *Pos33Consignee) GetConsignors() []*Consignor {
+	if x != nil {
+		return x.Consignors
+	}
+	return nil
+}
+
+type Pos33Entrust struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Consignee string `protobuf:"bytes,1,opt,name=consignee,proto3" json:"consignee,omitempty"`
+	Consignor string `protobuf:"bytes,2,opt,name=consignor,proto3" json:"consignor,omitempty"`
+	Amount    int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Pos33Entrust) Reset() {
+	*x = Pos33Entrust{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Entrust) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Entrust) ProtoMessage() {}
+
+func (x *Pos33Entrust) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Entrust.ProtoReflect.Descriptor instead.
+func (*Pos33Entrust) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Pos33Entrust) GetConsignee() string {
+	if x != nil {
+		return x.Consignee
+	}
+	return ""
+}
+
+func (x *Pos33Entrust) GetConsignor() string {
+	if x != nil {
+		return x.Consignor
+	}
+	return ""
+}
+
+func (x *Pos33Entrust) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// 把币委托给operator挖矿, 交易的发送者是委托人
+type Pos33Delegate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	Amount   int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Pos33Delegate) Reset() {
+	*x = Pos33Delegate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Delegate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Delegate) ProtoMessage() {}
+
+func (x *Pos33Delegate) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Delegate.ProtoReflect.Descriptor instead.
+func (*Pos33Delegate) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *Pos33Delegate) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *Pos33Delegate) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// 取回委托给operator的币, 同时结算还没有转出的奖励
+type Pos33Undelegate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	Amount   int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Pos33Undelegate) Reset() {
+	*x = Pos33Undelegate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Undelegate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Undelegate) ProtoMessage() {}
+
+func (x *Pos33Undelegate) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Undelegate.ProtoReflect.Descriptor instead.
+func (*Pos33Undelegate) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *Pos33Undelegate) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *Pos33Undelegate) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// 矿工的委托情况, count是共识使用的票数
+type Pos33OperatorInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount     int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`         // 包括自己的抵押和委托
+	SelfAmount int64  `protobuf:"varint,3,opt,name=selfAmount,proto3" json:"selfAmount,omitempty"` // 自己的抵押
+	Delegated  int64  `protobuf:"varint,4,opt,name=delegated,proto3" json:"delegated,omitempty"`   // 其他地址的委托
+	Count      int64  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Commission int64  `protobuf:"varint,6,opt,name=commission,proto3" json:"commission,omitempty"` // 抽成百分比
+	Delegators int32  `protobuf:"varint,7,opt,name=delegators,proto3" json:"delegators,omitempty"`
+}
+
+func (x *Pos33OperatorInfo) Reset() {
+	*x = Pos33OperatorInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33OperatorInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33OperatorInfo) ProtoMessage() {}
+
+func (x *Pos33OperatorInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33OperatorInfo.ProtoReflect.Descriptor instead.
+func (*Pos33OperatorInfo) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *Pos33OperatorInfo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Pos33OperatorInfo) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Pos33OperatorInfo) GetSelfAmount() int64 {
+	if x != nil {
+		return x.SelfAmount
+	}
+	return 0
+}
+
+func (x *Pos33OperatorInfo) GetDelegated() int64 {
+	if x != nil {
+		return x.Delegated
+	}
+	return 0
+}
+
+func (x *Pos33OperatorInfo) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Pos33OperatorInfo) GetCommission() int64 {
+	if x != nil {
+		return x.Commission
+	}
+	return 0
+}
+
+func (x *Pos33OperatorInfo) GetDelegators() int32 {
+	if x != nil {
+		return x.Delegators
+	}
+	return 0
+}
+
+// 一个矿工地址的参与记录, 出块或者投票时更新lastActive.
+// 连续jailBlocks个高度没有参与而且期望的投票数足够时可以被关押, 关押期间票数为0
+type Pos33Liveness struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	LastActive int64  `protobuf:"varint,2,opt,name=lastActive,proto3" json:"lastActive,omitempty"`
+	Votes      int64  `protobuf:"varint,3,opt,name=votes,proto3" json:"votes,omitempty"`
+	Jailed     bool   `protobuf:"varint,4,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	JailHeight int64  `protobuf:"varint,5,opt,name=jailHeight,proto3" json:"jailHeight,omitempty"`
+}
+
+func (x *Pos33Liveness) Reset() {
+	*x = Pos33Liveness{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Liveness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Liveness) ProtoMessage() {}
+
+func (x *Pos33Liveness) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Liveness.ProtoReflect.Descriptor instead.
+func (*Pos33Liveness) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *Pos33Liveness) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Pos33Liveness) GetLastActive() int64 {
+	if x != nil {
+		return x.LastActive
+	}
+	return 0
+}
+
+func (x *Pos33Liveness) GetVotes() int64 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *Pos33Liveness) GetJailed() bool {
+	if x != nil {
+		return x.Jailed
+	}
+	return false
+}
+
+func (x *Pos33Liveness) GetJailHeight() int64 {
+	if x != nil {
+		return x.JailHeight
+	}
+	return 0
+}
+
+// 关押不在线的矿工, 任何人都可以发送, 执行器按链上的参与记录检查
+type Pos33Jail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addrs []string `protobuf:"bytes,1,rep,name=addrs,proto3" json:"addrs,omitempty"`
+}
+
+func (x *Pos33Jail) Reset() {
+	*x = Pos33Jail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Jail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Jail) ProtoMessage() {}
+
+func (x *Pos33Jail) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Jail.ProtoReflect.Descriptor instead.
+func (*Pos33Jail) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *Pos33Jail) GetAddrs() []string {
+	if x != nil {
+		return x.Addrs
+	}
+	return nil
+}
+
+// 矿工解除自己的关押
+type Pos33Unjail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Pos33Unjail) Reset() {
+	*x = Pos33Unjail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Unjail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Unjail) ProtoMessage() {}
+
+func (x *Pos33Unjail) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Unjail.ProtoReflect.Descriptor instead.
+func (*Pos33Unjail) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{91}
+}
+
+type ReceiptPos33Jail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr       string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	LastActive int64  `protobuf:"varint,2,opt,name=lastActive,proto3" json:"lastActive,omitempty"`
+	Height     int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Jailed     bool   `protobuf:"varint,4,opt,name=jailed,proto3" json:"jailed,omitempty"`
+}
+
+func (x *ReceiptPos33Jail) Reset() {
+	*x = ReceiptPos33Jail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptPos33Jail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptPos33Jail) ProtoMessage() {}
+
+func (x *ReceiptPos33Jail) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptPos33Jail.ProtoReflect.Descriptor instead.
+func (*ReceiptPos33Jail) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ReceiptPos33Jail) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *ReceiptPos33Jail) GetLastActive() int64 {
+	if x != nil {
+		return x.LastActive
+	}
+	return 0
+}
+
+func (x *ReceiptPos33Jail) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ReceiptPos33Jail) GetJailed() bool {
+	if x != nil {
+		return x.Jailed
+	}
+	return false
+}
+
+// staker把抵押地址的共识公钥换成新的公钥, 从height高度开始生效.
+// sig是新公钥对{staker, height}的签名, 证明新公钥的私钥在staker手里
+type Pos33KeyRotate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Staker string           `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	Height int64            `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Sig    *types.Signature `protobuf:"bytes,3,opt,name=sig,proto3" json:"sig,omitempty"`
+}
+
+func (x *Pos33KeyRotate) Reset() {
+	*x = Pos33KeyRotate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33KeyRotate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33KeyRotate) ProtoMessage() {}
+
+func (x *Pos33KeyRotate) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33KeyRotate.ProtoReflect.Descriptor instead.
+func (*Pos33KeyRotate) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *Pos33KeyRotate) GetStaker() string {
+	if x != nil {
+		return x.Staker
+	}
+	return ""
+}
+
+func (x *Pos33KeyRotate) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33KeyRotate) GetSig() *types.Signature {
+	if x != nil {
+		return x.Sig
+	}
+	return nil
+}
+
+// 一个共识公钥绑定的staker, [height, revoke)高度有效, revoke为0表示没有被换掉
+type Pos33ConsensusKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Staker string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	Pubkey []byte `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Height int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Revoke int64  `protobuf:"varint,4,opt,name=revoke,proto3" json:"revoke,omitempty"`
+}
+
+func (x *Pos33ConsensusKey) Reset() {
+	*x = Pos33ConsensusKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33ConsensusKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33ConsensusKey) ProtoMessage() {}
+
+func (x *Pos33ConsensusKey) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33ConsensusKey.ProtoReflect.Descriptor instead.
+func (*Pos33ConsensusKey) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *Pos33ConsensusKey) GetStaker() string {
+	if x != nil {
+		return x.Staker
+	}
+	return ""
+}
+
+func (x *Pos33ConsensusKey) GetPubkey() []byte {
+	if x != nil {
+		return x.Pubkey
+	}
+	return nil
+}
+
+func (x *Pos33ConsensusKey) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33ConsensusKey) GetRevoke() int64 {
+	if x != nil {
+		return x.Revoke
+	}
+	return 0
+}
+
+// staker当前的共识公钥, selfRevoke是第一次换公钥生效的高度, 以后抵押地址自己的公钥不能再抽签
+type Pos33StakerKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Staker     string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	Pubkey     []byte `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Height     int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	SelfRevoke int64  `protobuf:"varint,4,opt,name=selfRevoke,proto3" json:"selfRevoke,omitempty"`
+}
+
+func (x *Pos33StakerKey) Reset() {
+	*x = Pos33StakerKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33StakerKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33StakerKey) ProtoMessage() {}
+
+func (x *Pos33StakerKey) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33StakerKey.ProtoReflect.Descriptor instead.
+func (*Pos33StakerKey) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *Pos33StakerKey) GetStaker() string {
+	if x != nil {
+		return x.Staker
+	}
+	return ""
+}
+
+func (x *Pos33StakerKey) GetPubkey() []byte {
+	if x != nil {
+		return x.Pubkey
+	}
+	return nil
+}
+
+func (x *Pos33StakerKey) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33StakerKey) GetSelfRevoke() int64 {
+	if x != nil {
+		return x.SelfRevoke
+	}
+	return 0
+}
+
+type ReqPos33KeyOwner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pubkey []byte `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// 公钥推导出的地址
+	Addr string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+}
+
+func (x *ReqPos33KeyOwner) Reset() {
+	*x = ReqPos33KeyOwner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33KeyOwner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33KeyOwner) ProtoMessage() {}
+
+func (x *ReqPos33KeyOwner) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33KeyOwner.ProtoReflect.Descriptor instead.
+func (*ReqPos33KeyOwner) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ReqPos33KeyOwner) GetPubkey() []byte {
+	if x != nil {
+		return x.Pubkey
+	}
+	return nil
+}
+
+func (x *ReqPos33KeyOwner) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+// 查询抽签使用的票数, 在抽签快照高度的状态上查询
+type ReqPos33SortCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// 抽签快照的高度, 用于分叉和票价
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *ReqPos33SortCount) Reset() {
+	*x = ReqPos33SortCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33SortCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33SortCount) ProtoMessage() {}
+
+func (x *ReqPos33SortCount) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33SortCount.ProtoReflect.Descriptor instead.
+func (*ReqPos33SortCount) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ReqPos33SortCount) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *ReqPos33SortCount) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type Pos33KeyOwner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    *Pos33ConsensusKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Staker *Pos33StakerKey    `protobuf:"bytes,2,opt,name=staker,proto3" json:"staker,omitempty"`
+}
+
+func (x *Pos33KeyOwner) Reset() {
+	*x = Pos33KeyOwner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33KeyOwner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33KeyOwner) ProtoMessage() {}
+
+func (x *Pos33KeyOwner) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33KeyOwner.ProtoReflect.Descriptor instead.
+func (*Pos33KeyOwner) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *Pos33KeyOwner) GetKey() *Pos33ConsensusKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *Pos33KeyOwner) GetStaker() *Pos33StakerKey {
+	if x != nil {
+		return x.Staker
+	}
+	return nil
+}
+
+type ReceiptPos33KeyRotate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Staker     string `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	Pubkey     []byte `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	PrevPubkey []byte `protobuf:"bytes,3,opt,name=prevPubkey,proto3" json:"prevPubkey,omitempty"`
+	Height     int64  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *ReceiptPos33KeyRotate) Reset() {
+	*x = ReceiptPos33KeyRotate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiptPos33KeyRotate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptPos33KeyRotate) ProtoMessage() {}
+
+func (x *ReceiptPos33KeyRotate) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptPos33KeyRotate.ProtoReflect.Descriptor instead.
+func (*ReceiptPos33KeyRotate) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ReceiptPos33KeyRotate) GetStaker() string {
+	if x != nil {
+		return x.Staker
+	}
+	return ""
+}
+
+func (x *ReceiptPos33KeyRotate) GetPubkey() []byte {
+	if x != nil {
+		return x.Pubkey
+	}
+	return nil
+}
+
+func (x *ReceiptPos33KeyRotate) GetPrevPubkey() []byte {
+	if x != nil {
+		return x.PrevPubkey
+	}
+	return nil
+}
+
+func (x *ReceiptPos33KeyRotate) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type Pos33Migrate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Miner string `protobuf:"bytes,1,opt,name=miner,proto3" json:"miner,omitempty"`
+}
+
+func (x *Pos33Migrate) Reset() {
+	*x = Pos33Migrate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Migrate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Migrate) ProtoMessage() {}
+
+func (x *Pos33Migrate) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Migrate.ProtoReflect.Descriptor instead.
+func (*Pos33Migrate) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *Pos33Migrate) GetMiner() string {
+	if x != nil {
+		return x.Miner
+	}
+	return ""
+}
+
+type Pos33BlsBind struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlsAddr   string `protobuf:"bytes,1,opt,name=BlsAddr,proto3" json:"BlsAddr,omitempty"`
+	MinerAddr string `protobuf:"bytes,2,opt,name=MinerAddr,proto3" json:"MinerAddr,omitempty"`
+}
+
+func (x *Pos33BlsBind) Reset() {
+	*x = Pos33BlsBind{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33BlsBind) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33BlsBind) ProtoMessage() {}
+
+func (x *Pos33BlsBind) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33BlsBind.ProtoReflect.Descriptor instead.
+func (*Pos33BlsBind) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *Pos33BlsBind) GetBlsAddr() string {
+	if x != nil {
+		return x.BlsAddr
+	}
+	return ""
+}
+
+func (x *Pos33BlsBind) GetMinerAddr() string {
+	if x != nil {
+		return x.MinerAddr
+	}
+	return ""
+}
+
+type ReqBindPos33Miner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BindAddr     string `protobuf:"bytes,1,opt,name=bindAddr,proto3" json:"bindAddr,omitempty"`
+	OriginAddr   string `protobuf:"bytes,2,opt,name=originAddr,proto3" json:"originAddr,omitempty"`
+	Amount       int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	CheckBalance bool   `protobuf:"varint,4,opt,name=checkBalance,proto3" json:"checkBalance,omitempty"`
+}
+
+func (x *ReqBindPos33Miner) Reset() {
+	*x = ReqBindPos33Miner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqBindPos33Miner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqBindPos33Miner) ProtoMessage() {}
+
+func (x *ReqBindPos33Miner) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqBindPos33Miner.ProtoReflect.Descriptor instead.
+func (*ReqBindPos33Miner) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ReqBindPos33Miner) GetBindAddr() string {
+	if x != nil {
+		return x.BindAddr
+	}
+	return ""
+}
+
+func (x *ReqBindPos33Miner) GetOriginAddr() string {
+	if x != nil {
+		return x.OriginAddr
+	}
+	return ""
+}
+
+func (x *ReqBindPos33Miner) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ReqBindPos33Miner) GetCheckBalance() bool {
+	if x != nil {
+		return x.CheckBalance
+	}
+	return false
+}
+
+type Pos33WithdrawReward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Consignee string `protobuf:"bytes,1,opt,name=consignee,proto3" json:"consignee,omitempty"`
+	Consignor string `protobuf:"bytes,2,opt,name=consignor,proto3" json:"consignor,omitempty"`
+	Amount    int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Pos33WithdrawReward) Reset() {
+	*x = Pos33WithdrawReward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33WithdrawReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33WithdrawReward) ProtoMessage() {}
+
+func (x *Pos33WithdrawReward) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33WithdrawReward.ProtoReflect.Descriptor instead.
+func (*Pos33WithdrawReward) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *Pos33WithdrawReward) GetConsignee() string {
+	if x != nil {
+		return x.Consignee
+	}
+	return ""
+}
+
+func (x *Pos33WithdrawReward) GetConsignor() string {
+	if x != nil {
+		return x.Consignor
+	}
+	return ""
+}
+
+func (x *Pos33WithdrawReward) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type Pos33MinerFeeRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinerAddr      string `protobuf:"bytes,1,opt,name=miner_addr,json=minerAddr,proto3" json:"miner_addr,omitempty"`
+	FeeRatePersent int32  `protobuf:"varint,2,opt,name=fee_rate_persent,json=feeRatePersent,proto3" json:"fee_rate_persent,omitempty"`
+}
+
+func (x *Pos33MinerFeeRate) Reset() {
+	*x = Pos33MinerFeeRate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33MinerFeeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33MinerFeeRate) ProtoMessage() {}
+
+func (x *Pos33MinerFeeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33MinerFeeRate.ProtoReflect.Descriptor instead.
+func (*Pos33MinerFeeRate) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *Pos33MinerFeeRate) GetMinerAddr() string {
+	if x != nil {
+		return x.MinerAddr
+	}
+	return ""
+}
+
+func (x *Pos33MinerFeeRate) GetFeeRatePersent() int32 {
+	if x != nil {
+		return x.FeeRatePersent
+	}
+	return 0
+}
+
+// message ReplyBindPos33Miner { string txHex = 1; }
+type ReplyTxHex struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxHex string `protobuf:"bytes,1,opt,name=txHex,proto3" json:"txHex,omitempty"`
+}
+
+func (x *ReplyTxHex) Reset() {
+	*x = ReplyTxHex{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplyTxHex) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyTxHex) ProtoMessage() {}
+
+func (x *ReplyTxHex) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyTxHex.ProtoReflect.Descriptor instead.
+func (*ReplyTxHex) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ReplyTxHex) GetTxHex() string {
+	if x != nil {
+		return x.TxHex
+	}
+	return ""
+}
+
+type ReplyPos33Info struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price    int64 `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
+	AllCount int64 `protobuf:"varint,2,opt,name=all_count,json=allCount,proto3" json:"all_count,omitempty"`
+}
+
+func (x *ReplyPos33Info) Reset() {
+	*x = ReplyPos33Info{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplyPos33Info) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyPos33Info) ProtoMessage() {}
+
+func (x *ReplyPos33Info) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyPos33Info.ProtoReflect.Descriptor instead.
+func (*ReplyPos33Info) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ReplyPos33Info) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ReplyPos33Info) GetAllCount() int64 {
+	if x != nil {
+		return x.AllCount
+	}
+	return 0
+}
+
+// 审计日志里一个收到的抽签和验证时的输入, err是verifySortCount的结果, 为空表示通过
+type Pos33AuditSort struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sort  *Pos33SortMsg `protobuf:"bytes,1,opt,name=sort,proto3" json:"sort,omitempty"`
+	Ty    int32         `protobuf:"varint,2,opt,name=ty,proto3" json:"ty,omitempty"`
+	Seed  []byte        `protobuf:"bytes,3,opt,name=seed,proto3" json:"seed,omitempty"`
+	Addr  string        `protobuf:"bytes,4,opt,name=addr,proto3" json:"addr,omitempty"`
+	Count int64         `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Diff  float64       `protobuf:"fixed64,6,opt,name=diff,proto3" json:"diff,omitempty"`
+	Err   string        `protobuf:"bytes,7,opt,name=err,proto3" json:"err,omitempty"`
+}
+
+func (x *Pos33AuditSort) Reset() {
+	*x = Pos33AuditSort{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33AuditSort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33AuditSort) ProtoMessage() {}
+
+func (x *Pos33AuditSort) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33AuditSort.ProtoReflect.Descriptor instead.
+func (*Pos33AuditSort) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *Pos33AuditSort) GetSort() *Pos33SortMsg {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *Pos33AuditSort) GetTy() int32 {
+	if x != nil {
+		return x.Ty
+	}
+	return 0
+}
+
+func (x *Pos33AuditSort) GetSeed() []byte {
+	if x != nil {
+		return x.Seed
+	}
+	return nil
+}
+
+func (x *Pos33AuditSort) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Pos33AuditSort) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Pos33AuditSort) GetDiff() float64 {
+	if x != nil {
+		return x.Diff
+	}
+	return 0
+}
+
+func (x *Pos33AuditSort) GetErr() string {
+	if x != nil {
+		return x.Err
+	}
+	return ""
+}
+
+// 共识审计日志的一条记录, 区块height加入链以后写入
+type Pos33AuditRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// 出块的轮次
+	Round int32  `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Seed  []byte `protobuf:"bytes,3,opt,name=seed,proto3" json:"seed,omitempty"`
+	// 第0轮的难度
+	Diff    float64           `protobuf:"fixed64,4,opt,name=diff,proto3" json:"diff,omitempty"`
+	MySorts []*Pos33SortMsg   `protobuf:"bytes,5,rep,name=mySorts,proto3" json:"mySorts,omitempty"`
+	Sorts   []*Pos33AuditSort `protobuf:"bytes,6,rep,name=sorts,proto3" json:"sorts,omitempty"`
+	// 最终委员会的抽签hash
+	Committee [][]byte `protobuf:"bytes,7,rep,name=committee,proto3" json:"committee,omitempty"`
+}
+
+func (x *Pos33AuditRecord) Reset() {
+	*x = Pos33AuditRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33AuditRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33AuditRecord) ProtoMessage() {}
+
+func (x *Pos33AuditRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33AuditRecord.ProtoReflect.Descriptor instead.
+func (*Pos33AuditRecord) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *Pos33AuditRecord) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33AuditRecord) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *Pos33AuditRecord) GetSeed() []byte {
+	if x != nil {
+		return x.Seed
+	}
+	return nil
+}
+
+func (x *Pos33AuditRecord) GetDiff() float64 {
+	if x != nil {
+		return x.Diff
+	}
+	return 0
+}
+
+func (x *Pos33AuditRecord) GetMySorts() []*Pos33SortMsg {
+	if x != nil {
+		return x.MySorts
+	}
+	return nil
+}
+
+func (x *Pos33AuditRecord) GetSorts() []*Pos33AuditSort {
+	if x != nil {
+		return x.Sorts
+	}
+	return nil
+}
+
+func (x *Pos33AuditRecord) GetCommittee() [][]byte {
+	if x != nil {
+		return x.Committee
+	}
+	return nil
+}
+
+// 重新验证的结果和记录的不同
+type Pos33AuditDivergence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SortHash []byte `protobuf:"bytes,1,opt,name=sortHash,proto3" json:"sortHash,omitempty"`
+	Ty       int32  `protobuf:"varint,2,opt,name=ty,proto3" json:"ty,omitempty"`
+	Round    int32  `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	Addr     string `protobuf:"bytes,4,opt,name=addr,proto3" json:"addr,omitempty"`
+	Recorded string `protobuf:"bytes,5,opt,name=recorded,proto3" json:"recorded,omitempty"`
+	Replayed string `protobuf:"bytes,6,opt,name=replayed,proto3" json:"replayed,omitempty"`
+}
+
+func (x *Pos33AuditDivergence) Reset() {
+	*x = Pos33AuditDivergence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33AuditDivergence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33AuditDivergence) ProtoMessage() {}
+
+func (x *Pos33AuditDivergence) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33AuditDivergence.ProtoReflect.Descriptor instead.
+func (*Pos33AuditDivergence) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *Pos33AuditDivergence) GetSortHash() []byte {
+	if x != nil {
+		return x.SortHash
+	}
+	return nil
+}
+
+func (x *Pos33AuditDivergence) GetTy() int32 {
+	if x != nil {
+		return x.Ty
+	}
+	return 0
+}
+
+func (x *Pos33AuditDivergence) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *Pos33AuditDivergence) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Pos33AuditDivergence) GetRecorded() string {
+	if x != nil {
+		return x.Recorded
+	}
+	return ""
+}
+
+func (x *Pos33AuditDivergence) GetReplayed() string {
+	if x != nil {
+		return x.Replayed
+	}
+	return ""
+}
+
+type Pos33AuditReplay struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height      int64                   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round       int32                   `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	MySorts     int32                   `protobuf:"varint,3,opt,name=mySorts,proto3" json:"mySorts,omitempty"`
+	Sorts       int32                   `protobuf:"varint,4,opt,name=sorts,proto3" json:"sorts,omitempty"`
+	Committee   int32                   `protobuf:"varint,5,opt,name=committee,proto3" json:"committee,omitempty"`
+	Divergences []*Pos33AuditDivergence `protobuf:"bytes,6,rep,name=divergences,proto3" json:"divergences,omitempty"`
+}
+
+func (x *Pos33AuditReplay) Reset() {
+	*x = Pos33AuditReplay{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33AuditReplay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33AuditReplay) ProtoMessage() {}
+
+func (x *Pos33AuditReplay) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33AuditReplay.ProtoReflect.Descriptor instead.
+func (*Pos33AuditReplay) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *Pos33AuditReplay) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33AuditReplay) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *Pos33AuditReplay) GetMySorts() int32 {
+	if x != nil {
+		return x.MySorts
+	}
+	return 0
+}
+
+func (x *Pos33AuditReplay) GetSorts() int32 {
+	if x != nil {
+		return x.Sorts
+	}
+	return 0
+}
+
+func (x *Pos33AuditReplay) GetCommittee() int32 {
+	if x != nil {
+		return x.Committee
+	}
+	return 0
+}
+
+func (x *Pos33AuditReplay) GetDivergences() []*Pos33AuditDivergence {
+	if x != nil {
+		return x.Divergences
+	}
+	return nil
+}
+
+// 快速同步的检查点, 检查点以下的区块不验证抽签和投票
+type Pos33Checkpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height    int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	BlockHash []byte `protobuf:"bytes,2,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	// 检查点区块的委员会摘要
+	Digest []byte `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
+	// sha256(height, blockHash, digest)
+	Hash []byte `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	// 验证者对hash的签名
+	Sigs []*types.Signature `protobuf:"bytes,5,rep,name=sigs,proto3" json:"sigs,omitempty"`
+}
+
+func (x *Pos33Checkpoint) Reset() {
+	*x = Pos33Checkpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33Checkpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33Checkpoint) ProtoMessage() {}
+
+func (x *Pos33Checkpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33Checkpoint.ProtoReflect.Descriptor instead.
+func (*Pos33Checkpoint) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *Pos33Checkpoint) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33Checkpoint) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *Pos33Checkpoint) GetDigest() []byte {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+func (x *Pos33Checkpoint) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *Pos33Checkpoint) GetSigs() []*types.Signature {
+	if x != nil {
+		return x.Sigs
+	}
+	return nil
+}
+
+type ReqPos33Checkpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// 用挖矿私钥签名
+	Sign bool `protobuf:"varint,2,opt,name=sign,proto3" json:"sign,omitempty"`
+}
+
+func (x *ReqPos33Checkpoint) Reset() {
+	*x = ReqPos33Checkpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33Checkpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33Checkpoint) ProtoMessage() {}
+
+func (x *ReqPos33Checkpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33Checkpoint.ProtoReflect.Descriptor instead.
+func (*ReqPos33Checkpoint) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ReqPos33Checkpoint) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ReqPos33Checkpoint) GetSign() bool {
+	if x != nil {
+		return x.Sign
+	}
+	return false
+}
+
+// 委员会的一个成员, 每个抽签一个
+type Pos33CommitteeAtMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr     string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Index    int64  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	SortHash []byte `protobuf:"bytes,3,opt,name=sortHash,proto3" json:"sortHash,omitempty"`
+	// 区块已经在链上时, 这个地址是否投票
+	Voted bool `protobuf:"varint,4,opt,name=voted,proto3" json:"voted,omitempty"`
+	// 是不是出块人的抽签
+	Maker bool `protobuf:"varint,5,opt,name=maker,proto3" json:"maker,omitempty"`
+}
+
+func (x *Pos33CommitteeAtMember) Reset() {
+	*x = Pos33CommitteeAtMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33CommitteeAtMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33CommitteeAtMember) ProtoMessage() {}
+
+func (x *Pos33CommitteeAtMember) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33CommitteeAtMember.ProtoReflect.Descriptor instead.
+func (*Pos33CommitteeAtMember) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *Pos33CommitteeAtMember) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Pos33CommitteeAtMember) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Pos33CommitteeAtMember) GetSortHash() []byte {
+	if x != nil {
+		return x.SortHash
+	}
+	return nil
+}
+
+func (x *Pos33CommitteeAtMember) GetVoted() bool {
+	if x != nil {
+		return x.Voted
+	}
+	return false
+}
+
+func (x *Pos33CommitteeAtMember) GetMaker() bool {
+	if x != nil {
+		return x.Maker
+	}
+	return false
+}
+
+// height高度的委员会: 抽签的seed, diff和成员, final表示区块已经在链上, 投票状态确定
+type Pos33CommitteeAt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height    int64                     `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round     int32                     `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Seed      []byte                    `protobuf:"bytes,3,opt,name=seed,proto3" json:"seed,omitempty"`
+	Diff      float64                   `protobuf:"fixed64,4,opt,name=diff,proto3" json:"diff,omitempty"`
+	Final     bool                      `protobuf:"varint,5,opt,name=final,proto3" json:"final,omitempty"`
+	BlockHash []byte                    `protobuf:"bytes,6,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Maker     string                    `protobuf:"bytes,7,opt,name=maker,proto3" json:"maker,omitempty"`
+	Members   []*Pos33CommitteeAtMember `protobuf:"bytes,8,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *Pos33CommitteeAt) Reset() {
+	*x = Pos33CommitteeAt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33CommitteeAt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33CommitteeAt) ProtoMessage() {}
+
+func (x *Pos33CommitteeAt) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33CommitteeAt.ProtoReflect.Descriptor instead.
+func (*Pos33CommitteeAt) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *Pos33CommitteeAt) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33CommitteeAt) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *Pos33CommitteeAt) GetSeed() []byte {
+	if x != nil {
+		return x.Seed
+	}
+	return nil
+}
+
+func (x *Pos33CommitteeAt) GetDiff() float64 {
+	if x != nil {
+		return x.Diff
+	}
+	return 0
+}
+
+func (x *Pos33CommitteeAt) GetFinal() bool {
+	if x != nil {
+		return x.Final
+	}
+	return false
+}
+
+func (x *Pos33CommitteeAt) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *Pos33CommitteeAt) GetMaker() string {
+	if x != nil {
+		return x.Maker
+	}
+	return ""
+}
+
+func (x *Pos33CommitteeAt) GetMembers() []*Pos33CommitteeAtMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// 共识状态日志的一条记录, 重启后重放. ty是记录的类型, myself表示是自己发出的
+type Pos33WalRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height    int64           `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round     int32           `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Ty        int32           `protobuf:"varint,3,opt,name=ty,proto3" json:"ty,omitempty"`
+	Myself    bool            `protobuf:"varint,4,opt,name=myself,proto3" json:"myself,omitempty"`
+	Sorts     []*Pos33SortMsg `protobuf:"bytes,5,rep,name=sorts,proto3" json:"sorts,omitempty"`
+	Votes     []*Pos33VoteMsg `protobuf:"bytes,6,rep,name=votes,proto3" json:"votes,omitempty"`
+	Block     *Pos33BlockMsg  `protobuf:"bytes,7,opt,name=block,proto3" json:"block,omitempty"`
+	Committee *Pos33SortsVote `protobuf:"bytes,8,opt,name=committee,proto3" json:"committee,omitempty"`
+}
+
+func (x *Pos33WalRecord) Reset() {
+	*x = Pos33WalRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33WalRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33WalRecord) ProtoMessage() {}
+
+func (x *Pos33WalRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33WalRecord.ProtoReflect.Descriptor instead.
+func (*Pos33WalRecord) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *Pos33WalRecord) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33WalRecord) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *Pos33WalRecord) GetTy() int32 {
+	if x != nil {
+		return x.Ty
+	}
+	return 0
+}
+
+func (x *Pos33WalRecord) GetMyself() bool {
+	if x != nil {
+		return x.Myself
+	}
+	return false
+}
+
+func (x *Pos33WalRecord) GetSorts() []*Pos33SortMsg {
+	if x != nil {
+		return x.Sorts
+	}
+	return nil
+}
+
+func (x *Pos33WalRecord) GetVotes() []*Pos33VoteMsg {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+func (x *Pos33WalRecord) GetBlock() *Pos33BlockMsg {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *Pos33WalRecord) GetCommittee() *Pos33SortsVote {
+	if x != nil {
+		return x.Committee
+	}
+	return nil
+}
+
+// 票数承诺里一个地址的票数和它到root的merkle路径
+type Pos33TicketBranch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Leaf   *Pos33Validator `protobuf:"bytes,1,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	Index  int32           `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Branch [][]byte        `protobuf:"bytes,3,rep,name=branch,proto3" json:"branch,omitempty"`
+}
+
+func (x *Pos33TicketBranch) Reset() {
+	*x = Pos33TicketBranch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33TicketBranch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33TicketBranch) ProtoMessage() {}
+
+func (x *Pos33TicketBranch) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33TicketBranch.ProtoReflect.Descriptor instead.
+func (*Pos33TicketBranch) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *Pos33TicketBranch) GetLeaf() *Pos33Validator {
+	if x != nil {
+		return x.Leaf
+	}
+	return nil
+}
+
+func (x *Pos33TicketBranch) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Pos33TicketBranch) GetBranch() [][]byte {
+	if x != nil {
+		return x.Branch
+	}
+	return nil
+}
+
+// height高度抽签使用的票数承诺. root是快照高度按地址排序的验证者的merkle根,
+// diffs[i]是第i轮委员会的难度, 更高的轮次使用最后一个. 轻客户端信任的是hash
+type Pos33TicketCommitment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height         int64     `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	SnapshotHeight int64     `protobuf:"varint,2,opt,name=snapshotHeight,proto3" json:"snapshotHeight,omitempty"`
+	AllCount       int64     `protobuf:"varint,3,opt,name=allCount,proto3" json:"allCount,omitempty"`
+	Seed           []byte    `protobuf:"bytes,4,opt,name=seed,proto3" json:"seed,omitempty"`
+	Diffs          []float64 `protobuf:"fixed64,5,rep,packed,name=diffs,proto3" json:"diffs,omitempty"`
+	SubCommittees  int32     `protobuf:"varint,6,opt,name=subCommittees,proto3" json:"subCommittees,omitempty"`
+	Root           []byte    `protobuf:"bytes,7,opt,name=root,proto3" json:"root,omitempty"`
+	Hash           []byte    `protobuf:"bytes,8,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *Pos33TicketCommitment) Reset() {
+	*x = Pos33TicketCommitment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33TicketCommitment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33TicketCommitment) ProtoMessage() {}
+
+func (x *Pos33TicketCommitment) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33TicketCommitment.ProtoReflect.Descriptor instead.
+func (*Pos33TicketCommitment) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *Pos33TicketCommitment) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33TicketCommitment) GetSnapshotHeight() int64 {
+	if x != nil {
+		return x.SnapshotHeight
+	}
+	return 0
+}
+
+func (x *Pos33TicketCommitment) GetAllCount() int64 {
+	if x != nil {
+		return x.AllCount
+	}
+	return 0
+}
+
+func (x *Pos33TicketCommitment) GetSeed() []byte {
+	if x != nil {
+		return x.Seed
+	}
+	return nil
+}
+
+func (x *Pos33TicketCommitment) GetDiffs() []float64 {
+	if x != nil {
+		return x.Diffs
+	}
+	return nil
+}
+
+func (x *Pos33TicketCommitment) GetSubCommittees() int32 {
+	if x != nil {
+		return x.SubCommittees
+	}
+	return 0
+}
+
+func (x *Pos33TicketCommitment) GetRoot() []byte {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *Pos33TicketCommitment) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+// 投票的bls公钥和它绑定的矿工地址
+type Pos33FinalityVoter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlsPk []byte `protobuf:"bytes,1,opt,name=blsPk,proto3" json:"blsPk,omitempty"`
+	Addr  string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+}
+
+func (x *Pos33FinalityVoter) Reset() {
+	*x = Pos33FinalityVoter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33FinalityVoter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33FinalityVoter) ProtoMessage() {}
+
+func (x *Pos33FinalityVoter) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33FinalityVoter.ProtoReflect.Descriptor instead.
+func (*Pos33FinalityVoter) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *Pos33FinalityVoter) GetBlsPk() []byte {
+	if x != nil {
+		return x.BlsPk
+	}
+	return nil
+}
+
+func (x *Pos33FinalityVoter) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+// 区块头和交易根下的miner交易
+type Pos33MinerTxProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header  *types.Header      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	MinerTx *types.Transaction `protobuf:"bytes,2,opt,name=minerTx,proto3" json:"minerTx,omitempty"`
+	// miner交易到交易根的merkle路径, fullHash表示叶子是FullHash(ForkRootHash以后).
+	// 有多条子链时childBranch是主链交易根到区块交易根的路径
+	TxBranch    [][]byte `protobuf:"bytes,3,rep,name=txBranch,proto3" json:"txBranch,omitempty"`
+	ChildBranch [][]byte `protobuf:"bytes,4,rep,name=childBranch,proto3" json:"childBranch,omitempty"`
+	FullHash    bool     `protobuf:"varint,5,opt,name=fullHash,proto3" json:"fullHash,omitempty"`
+}
+
+func (x *Pos33MinerTxProof) Reset() {
+	*x = Pos33MinerTxProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33MinerTxProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33MinerTxProof) ProtoMessage() {}
+
+func (x *Pos33MinerTxProof) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33MinerTxProof.ProtoReflect.Descriptor instead.
+func (*Pos33MinerTxProof) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *Pos33MinerTxProof) GetHeader() *types.Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *Pos33MinerTxProof) GetMinerTx() *types.Transaction {
+	if x != nil {
+		return x.MinerTx
+	}
+	return nil
+}
+
+func (x *Pos33MinerTxProof) GetTxBranch() [][]byte {
+	if x != nil {
+		return x.TxBranch
+	}
+	return nil
+}
+
+func (x *Pos33MinerTxProof) GetChildBranch() [][]byte {
+	if x != nil {
+		return x.ChildBranch
+	}
+	return nil
+}
+
+func (x *Pos33MinerTxProof) GetFullHash() bool {
+	if x != nil {
+		return x.FullHash
+	}
+	return false
+}
+
+// 区块的最终性证明: 出块人签名的区块头, 区块头交易根下的miner交易, 出块那一轮的委员会抽签,
+// 委员会地址的票数和投票的bls公钥. anchor是快照高度的下一个区块, 它的miner交易里有tickets的hash,
+// 只要信任anchor的区块hash就可以验证
+type Pos33FinalityProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header      *types.Header          `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	MinerTx     *types.Transaction     `protobuf:"bytes,2,opt,name=minerTx,proto3" json:"minerTx,omitempty"`
+	TxBranch    [][]byte               `protobuf:"bytes,3,rep,name=txBranch,proto3" json:"txBranch,omitempty"`
+	ChildBranch [][]byte               `protobuf:"bytes,4,rep,name=childBranch,proto3" json:"childBranch,omitempty"`
+	FullHash    bool                   `protobuf:"varint,5,opt,name=fullHash,proto3" json:"fullHash,omitempty"`
+	Tickets     *Pos33TicketCommitment `protobuf:"bytes,6,opt,name=tickets,proto3" json:"tickets,omitempty"`
+	Committee   []*Pos33SortMsg        `protobuf:"bytes,7,rep,name=committee,proto3" json:"committee,omitempty"`
+	Counts      []*Pos33TicketBranch   `protobuf:"bytes,8,rep,name=counts,proto3" json:"counts,omitempty"`
+	// 按miner交易展开以后的BlsPkList的顺序
+	Voters []*Pos33FinalityVoter `protobuf:"bytes,9,rep,name=voters,proto3" json:"voters,omitempty"`
+	Anchor *Pos33MinerTxProof    `protobuf:"bytes,10,opt,name=anchor,proto3" json:"anchor,omitempty"`
+}
+
+func (x *Pos33FinalityProof) Reset() {
+	*x = Pos33FinalityProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33FinalityProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33FinalityProof) ProtoMessage() {}
+
+func (x *Pos33FinalityProof) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33FinalityProof.ProtoReflect.Descriptor instead.
+func (*Pos33FinalityProof) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *Pos33FinalityProof) GetHeader() *types.Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *Pos33FinalityProof) GetMinerTx() *types.Transaction {
+	if x != nil {
+		return x.MinerTx
+	}
+	return nil
+}
+
+func (x *Pos33FinalityProof) GetTxBranch() [][]byte {
+	if x != nil {
+		return x.TxBranch
+	}
+	return nil
+}
+
+func (x *Pos33FinalityProof) GetChildBranch() [][]byte {
+	if x != nil {
+		return x.ChildBranch
+	}
+	return nil
+}
+
+func (x *Pos33FinalityProof) GetFullHash() bool {
+	if x != nil {
+		return x.FullHash
+	}
+	return false
+}
+
+func (x *Pos33FinalityProof) GetTickets() *Pos33TicketCommitment {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+func (x *Pos33FinalityProof) GetCommittee() []*Pos33SortMsg {
+	if x != nil {
+		return x.Committee
+	}
+	return nil
+}
+
+func (x *Pos33FinalityProof) GetCounts() []*Pos33TicketBranch {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *Pos33FinalityProof) GetVoters() []*Pos33FinalityVoter {
+	if x != nil {
+		return x.Voters
+	}
+	return nil
+}
+
+func (x *Pos33FinalityProof) GetAnchor() *Pos33MinerTxProof {
+	if x != nil {
+		return x.Anchor
+	}
+	return nil
+}
+
+// 转发共识消息的节点的计分, score随时间衰减, 超过阈值时暂时禁止
+type Pos33PeerScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Peer         string  `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	InvalidSorts int64   `protobuf:"varint,2,opt,name=invalidSorts,proto3" json:"invalidSorts,omitempty"`
+	InvalidVotes int64   `protobuf:"varint,3,opt,name=invalidVotes,proto3" json:"invalidVotes,omitempty"`
+	InvalidMsgs  int64   `protobuf:"varint,4,opt,name=invalidMsgs,proto3" json:"invalidMsgs,omitempty"`
+	Dropped      int64   `protobuf:"varint,5,opt,name=dropped,proto3" json:"dropped,omitempty"` // 超过每个高度的消息数限制丢弃的
+	Score        float64 `protobuf:"fixed64,6,opt,name=score,proto3" json:"score,omitempty"`
+	Banned       bool    `protobuf:"varint,7,opt,name=banned,proto3" json:"banned,omitempty"`
+	BannedUntil  int64   `protobuf:"varint,8,opt,name=bannedUntil,proto3" json:"bannedUntil,omitempty"` // unix秒
+	Bans         int64   `protobuf:"varint,9,opt,name=bans,proto3" json:"bans,omitempty"`
+}
+
+func (x *Pos33PeerScore) Reset() {
+	*x = Pos33PeerScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33PeerScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33PeerScore) ProtoMessage() {}
+
+func (x *Pos33PeerScore) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33PeerScore.ProtoReflect.Descriptor instead.
+func (*Pos33PeerScore) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *Pos33PeerScore) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *Pos33PeerScore) GetInvalidSorts() int64 {
+	if x != nil {
+		return x.InvalidSorts
+	}
+	return 0
+}
+
+func (x *Pos33PeerScore) GetInvalidVotes() int64 {
+	if x != nil {
+		return x.InvalidVotes
+	}
+	return 0
+}
+
+func (x *Pos33PeerScore) GetInvalidMsgs() int64 {
+	if x != nil {
+		return x.InvalidMsgs
+	}
+	return 0
+}
+
+func (x *Pos33PeerScore) GetDropped() int64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+func (x *Pos33PeerScore) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Pos33PeerScore) GetBanned() bool {
+	if x != nil {
+		return x.Banned
+	}
+	return false
+}
+
+func (x *Pos33PeerScore) GetBannedUntil() int64 {
+	if x != nil {
+		return x.BannedUntil
+	}
+	return 0
+}
+
+func (x *Pos33PeerScore) GetBans() int64 {
+	if x != nil {
+		return x.Bans
+	}
+	return 0
+}
+
+type Pos33PeerScores struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height        int64             `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	BanScore      int64             `protobuf:"varint,2,opt,name=banScore,proto3" json:"banScore,omitempty"`
+	MsgsPerHeight int64             `protobuf:"varint,3,opt,name=msgsPerHeight,proto3" json:"msgsPerHeight,omitempty"`
+	Peers         []*Pos33PeerScore `protobuf:"bytes,4,rep,name=peers,proto3" json:"peers,omitempty"`
+}
+
+func (x *Pos33PeerScores) Reset() {
+	*x = Pos33PeerScores{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33PeerScores) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33PeerScores) ProtoMessage() {}
+
+func (x *Pos33PeerScores) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33PeerScores.ProtoReflect.Descriptor instead.
+func (*Pos33PeerScores) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *Pos33PeerScores) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33PeerScores) GetBanScore() int64 {
+	if x != nil {
+		return x.BanScore
+	}
+	return 0
+}
+
+func (x *Pos33PeerScores) GetMsgsPerHeight() int64 {
+	if x != nil {
+		return x.MsgsPerHeight
+	}
+	return 0
+}
+
+func (x *Pos33PeerScores) GetPeers() []*Pos33PeerScore {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+// 抽签统计索引里一个地址在一个区块的投票数
+type Pos33SortWinner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr  string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *Pos33SortWinner) Reset() {
+	*x = Pos33SortWinner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33SortWinner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33SortWinner) ProtoMessage() {}
+
+func (x *Pos33SortWinner) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33SortWinner.ProtoReflect.Descriptor instead.
+func (*Pos33SortWinner) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *Pos33SortWinner) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Pos33SortWinner) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 抽签统计索引(exec.sub.pos33.enableSortIndex)记录的一个高度
+type Pos33SortRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height    int64              `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round     int32              `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"` // 出块的轮次, 0表示第一轮就出块
+	Maker     string             `protobuf:"bytes,3,opt,name=maker,proto3" json:"maker,omitempty"`
+	Votes     int64              `protobuf:"varint,4,opt,name=votes,proto3" json:"votes,omitempty"` // 区块的投票数, 也就是委员会的大小
+	Voters    []*Pos33SortWinner `protobuf:"bytes,5,rep,name=voters,proto3" json:"voters,omitempty"`
+	AllCount  int64              `protobuf:"varint,6,opt,name=allCount,proto3" json:"allCount,omitempty"` // 这个高度执行以后的全网票数
+	Diff      float64            `protobuf:"fixed64,7,opt,name=diff,proto3" json:"diff,omitempty"`        // 抽签快照高度的全网票数计算的基础难度, 不包括ForkDiffV2的调整
+	BlockTime int64              `protobuf:"varint,8,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
+	// 从开始索引到这个高度的累计, 用于区间统计
+	Blocks      int64   `protobuf:"varint,9,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	TotalVotes  int64   `protobuf:"varint,10,opt,name=totalVotes,proto3" json:"totalVotes,omitempty"`
+	TotalRounds int64   `protobuf:"varint,11,opt,name=totalRounds,proto3" json:"totalRounds,omitempty"`
+	RoundHist   []int64 `protobuf:"varint,12,rep,packed,name=roundHist,proto3" json:"roundHist,omitempty"`
+}
+
+func (x *Pos33SortRecord) Reset() {
+	*x = Pos33SortRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33SortRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33SortRecord) ProtoMessage() {}
+
+func (x *Pos33SortRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33SortRecord.ProtoReflect.Descriptor instead.
+func (*Pos33SortRecord) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *Pos33SortRecord) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33SortRecord) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *Pos33SortRecord) GetMaker() string {
+	if x != nil {
+		return x.Maker
+	}
+	return ""
+}
+
+func (x *Pos33SortRecord) GetVotes() int64 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *Pos33SortRecord) GetVoters() []*Pos33SortWinner {
+	if x != nil {
+		return x.Voters
+	}
+	return nil
+}
+
+func (x *Pos33SortRecord) GetAllCount() int64 {
+	if x != nil {
+		return x.AllCount
+	}
+	return 0
+}
+
+func (x *Pos33SortRecord) GetDiff() float64 {
+	if x != nil {
+		return x.Diff
+	}
+	return 0
+}
+
+func (x *Pos33SortRecord) GetBlockTime() int64 {
+	if x != nil {
+		return x.BlockTime
+	}
+	return 0
+}
+
+func (x *Pos33SortRecord) GetBlocks() int64 {
+	if x != nil {
+		return x.Blocks
+	}
+	return 0
+}
+
+func (x *Pos33SortRecord) GetTotalVotes() int64 {
+	if x != nil {
+		return x.TotalVotes
+	}
+	return 0
+}
+
+func (x *Pos33SortRecord) GetTotalRounds() int64 {
+	if x != nil {
+		return x.TotalRounds
+	}
+	return 0
+}
+
+func (x *Pos33SortRecord) GetRoundHist() []int64 {
+	if x != nil {
+		return x.RoundHist
+	}
+	return nil
+}
+
+type Pos33MakerBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round  int32 `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Votes  int64 `protobuf:"varint,3,opt,name=votes,proto3" json:"votes,omitempty"`
+}
+
+func (x *Pos33MakerBlock) Reset() {
+	*x = Pos33MakerBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33MakerBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33MakerBlock) ProtoMessage() {}
+
+func (x *Pos33MakerBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33MakerBlock.ProtoReflect.Descriptor instead.
+func (*Pos33MakerBlock) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *Pos33MakerBlock) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33MakerBlock) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *Pos33MakerBlock) GetVotes() int64 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+// 查询addr在[start, end]出的区块, 每次最多count个, 按高度从小到大
+type ReqPos33MakerBlocks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr  string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Start int64  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   int64  `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	Count int32  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ReqPos33MakerBlocks) Reset() {
+	*x = ReqPos33MakerBlocks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33MakerBlocks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33MakerBlocks) ProtoMessage() {}
+
+func (x *ReqPos33MakerBlocks) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33MakerBlocks.ProtoReflect.Descriptor instead.
+func (*ReqPos33MakerBlocks) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ReqPos33MakerBlocks) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *ReqPos33MakerBlocks) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *ReqPos33MakerBlocks) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *ReqPos33MakerBlocks) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type Pos33MakerBlocks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addr   string             `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Blocks []*Pos33MakerBlock `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	Next   int64              `protobuf:"varint,3,opt,name=next,proto3" json:"next,omitempty"` // 还有更多时下一次查询的start, 否则为0
+}
+
+func (x *Pos33MakerBlocks) Reset() {
+	*x = Pos33MakerBlocks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33MakerBlocks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33MakerBlocks) ProtoMessage() {}
+
+func (x *Pos33MakerBlocks) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33MakerBlocks.ProtoReflect.Descriptor instead.
+func (*Pos33MakerBlocks) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *Pos33MakerBlocks) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Pos33MakerBlocks) GetBlocks() []*Pos33MakerBlock {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+func (x *Pos33MakerBlocks) GetNext() int64 {
+	if x != nil {
+		return x.Next
+	}
+	return 0
+}
+
+// 查询[start, end]的抽签统计, last大于0时统计end(为0时使用最新的高度)之前的last个高度
+type ReqPos33SortStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   int64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Last  int64 `protobuf:"varint,3,opt,name=last,proto3" json:"last,omitempty"`
+}
+
+func (x *ReqPos33SortStats) Reset() {
+	*x = ReqPos33SortStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReqPos33SortStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReqPos33SortStats) ProtoMessage() {}
+
+func (x *ReqPos33SortStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReqPos33SortStats.ProtoReflect.Descriptor instead.
+func (*ReqPos33SortStats) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ReqPos33SortStats) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *ReqPos33SortStats) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *ReqPos33SortStats) GetLast() int64 {
+	if x != nil {
+		return x.Last
+	}
+	return 0
+}
+
+type Pos33SortStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start    int64   `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End      int64   `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Blocks   int64   `protobuf:"varint,3,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	AvgVotes float64 `protobuf:"fixed64,4,opt,name=avgVotes,proto3" json:"avgVotes,omitempty"`
+	AvgRound float64 `protobuf:"fixed64,5,opt,name=avgRound,proto3" json:"avgRound,omitempty"`
+	// 第i项是第i轮出块的区块数, 最后一项包括更多的轮次
+	RoundHist []int64 `protobuf:"varint,6,rep,packed,name=roundHist,proto3" json:"roundHist,omitempty"`
+}
+
+func (x *Pos33SortStats) Reset() {
+	*x = Pos33SortStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33SortStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33SortStats) ProtoMessage() {}
+
+func (x *Pos33SortStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33SortStats.ProtoReflect.Descriptor instead.
+func (*Pos33SortStats) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *Pos33SortStats) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Pos33SortStats) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *Pos33SortStats) GetBlocks() int64 {
+	if x != nil {
+		return x.Blocks
+	}
+	return 0
+}
+
+func (x *Pos33SortStats) GetAvgVotes() float64 {
+	if x != nil {
+		return x.AvgVotes
+	}
+	return 0
+}
+
+func (x *Pos33SortStats) GetAvgRound() float64 {
+	if x != nil {
+		return x.AvgRound
+	}
+	return 0
+}
+
+func (x *Pos33SortStats) GetRoundHist() []int64 {
+	if x != nil {
+		return x.RoundHist
+	}
+	return nil
+}
+
+// ForkTicketPrice之后每个周期开始时按抵押率调整的票价
+type Pos33TicketPrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price int64 `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
+	// 第几个周期, 从分叉高度开始
+	Epoch int64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// 调整价格的高度
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// 调整时的总发行量和总抵押
+	Supply int64 `protobuf:"varint,4,opt,name=supply,proto3" json:"supply,omitempty"`
+	Staked int64 `protobuf:"varint,5,opt,name=staked,proto3" json:"staked,omitempty"`
+	// 抵押率, 万分之几
+	BondRatio int64 `protobuf:"varint,6,opt,name=bondRatio,proto3" json:"bondRatio,omitempty"`
+}
+
+func (x *Pos33TicketPrice) Reset() {
+	*x = Pos33TicketPrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33TicketPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33TicketPrice) ProtoMessage() {}
+
+func (x *Pos33TicketPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33TicketPrice.ProtoReflect.Descriptor instead.
+func (*Pos33TicketPrice) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *Pos33TicketPrice) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Pos33TicketPrice) GetEpoch() int64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *Pos33TicketPrice) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pos33TicketPrice) GetSupply() int64 {
+	if x != nil {
+		return x.Supply
+	}
+	return 0
+}
+
+func (x *Pos33TicketPrice) GetStaked() int64 {
+	if x != nil {
+		return x.Staked
+	}
+	return 0
+}
+
+func (x *Pos33TicketPrice) GetBondRatio() int64 {
+	if x != nil {
+		return x.BondRatio
+	}
+	return 0
+}
+
+type Pos33TicketPriceInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Current *Pos33TicketPrice `protobuf:"bytes,1,opt,name=current,proto3" json:"current,omitempty"`
+	// 按现在的抵押计算的下一个周期的票价
+	Next *Pos33TicketPrice `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *Pos33TicketPriceInfo) Reset() {
+	*x = Pos33TicketPriceInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pos33_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pos33TicketPriceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pos33TicketPriceInfo) ProtoMessage() {}
+
+func (x *Pos33TicketPriceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pos33_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pos33TicketPriceInfo.ProtoReflect.Descriptor instead.
+func (*Pos33TicketPriceInfo) Descriptor() ([]byte, []int) {
+	return file_pos33_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *Pos33TicketPriceInfo) GetCurrent() *Pos33TicketPrice {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+func (x *Pos33TicketPriceInfo) GetNext() *Pos33TicketPrice {
+	if x != nil {
+		return x.Next
+	}
+	return nil
+}
+
+var File_pos33_proto protoreflect.FileDescriptor
+
+var file_pos33_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x33, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x1a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa1, 0x02, 0x0a, 0x0b, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x69, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x69, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6f,
+	0x70, 0x65, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x6f, 0x70, 0x65, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x87, 0x07,
+	0x0a, 0x11, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x48, 0x00, 0x52, 0x05, 0x74, 0x6f,
+	0x70, 0x65, 0x6e, 0x12, 0x35, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x48,
+	0x00, 0x52, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x74, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x2e, 0x0a,
+	0x05, 0x74, 0x62, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x42, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x05, 0x74, 0x62, 0x69, 0x6e, 0x64, 0x12, 0x2c, 0x0a,
+	0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x4d,
+	0x73, 0x67, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x45, 0x6e, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x07,
+	0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x67, 0x72, 0x61,
+	0x74, 0x65, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a,
+	0x07, 0x62, 0x6c, 0x73, 0x42, 0x69, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x42, 0x6c, 0x73, 0x42,
+	0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x07, 0x62, 0x6c, 0x73, 0x42, 0x69, 0x6e, 0x64, 0x12, 0x34,
+	0x0a, 0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e,
+	0x65, 0x72, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x07, 0x66, 0x65, 0x65,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x48, 0x00, 0x52, 0x08, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x29,
+	0x0a, 0x05, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x48, 0x00, 0x52, 0x05, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x12, 0x38, 0x0a, 0x0a, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x12, 0x32, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x08, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x12, 0x26, 0x0a, 0x04, 0x6a, 0x61, 0x69, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4a, 0x61, 0x69,
+	0x6c, 0x48, 0x00, 0x52, 0x04, 0x6a, 0x61, 0x69, 0x6c, 0x12, 0x2c, 0x0a, 0x06, 0x75, 0x6e, 0x6a,
+	0x61, 0x69, 0x6c, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x55, 0x6e, 0x6a, 0x61, 0x69, 0x6c, 0x48, 0x00, 0x52,
+	0x06, 0x75, 0x6e, 0x6a, 0x61, 0x69, 0x6c, 0x12, 0x35, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74,
+	0x65, 0x48, 0x00, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x42, 0x07,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x77, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33,
+	0x33, 0x4d, 0x73, 0x67, 0x2e, 0x54, 0x79, 0x52, 0x02, 0x74, 0x79, 0x22, 0x33, 0x0a, 0x02, 0x54,
+	0x79, 0x12, 0x06, 0x0a, 0x02, 0x4d, 0x56, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x42, 0x56, 0x10,
+	0x01, 0x12, 0x05, 0x0a, 0x01, 0x42, 0x10, 0x02, 0x12, 0x06, 0x0a, 0x02, 0x4d, 0x53, 0x10, 0x03,
+	0x12, 0x06, 0x0a, 0x02, 0x56, 0x53, 0x10, 0x04, 0x12, 0x06, 0x0a, 0x02, 0x43, 0x56, 0x10, 0x05,
+	0x22, 0x5a, 0x0a, 0x08, 0x53, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x5c, 0x0a, 0x08,
+	0x56, 0x72, 0x66, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x22, 0x9f, 0x01, 0x0a, 0x09, 0x48,
+	0x61, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x56, 0x72, 0x66, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x76, 0x72, 0x66, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x76, 0x72, 0x66, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x72,
+	0x66, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x76,
+	0x72, 0x66, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12,
+	0x1b, 0x0a, 0x09, 0x76, 0x72, 0x66, 0x5f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x76, 0x72, 0x66, 0x53, 0x75, 0x69, 0x74, 0x65, 0x22, 0x64, 0x0a, 0x0c,
+	0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x2c, 0x0a, 0x09,
+	0x73, 0x6f, 0x72, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68,
+	0x52, 0x08, 0x73, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x05, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x05, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x22, 0x37, 0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x73,
+	0x12, 0x29, 0x0a, 0x05, 0x73, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72,
+	0x74, 0x4d, 0x73, 0x67, 0x52, 0x05, 0x73, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x42, 0x0a, 0x0e, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x56, 0x6f, 0x74, 0x65, 0x53, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x30, 0x0a,
+	0x0a, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53,
+	0x6f, 0x72, 0x74, 0x73, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x53, 0x6f, 0x72, 0x74, 0x73, 0x22,
+	0x4b, 0x0a, 0x0b, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x03, 0x53, 0x69, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x03, 0x53, 0x69, 0x67, 0x22, 0x3d, 0x0a, 0x0d,
+	0x50, 0x6f, 0x73, 0x33, 0x33, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x73, 0x67, 0x12, 0x1a, 0x0a,
+	0x01, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x01, 0x62, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x0e, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x73, 0x67, 0x32, 0x12, 0x1a, 0x0a,
+	0x01, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x01, 0x62, 0x12, 0x23, 0x0a, 0x02, 0x76, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x56, 0x6f, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x02, 0x76, 0x73, 0x22, 0x85,
+	0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x6f, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x12,
+	0x27, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d,
+	0x73, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x22, 0x0a, 0x03, 0x73, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x52, 0x03, 0x73, 0x69, 0x67, 0x22, 0xab, 0x01, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x64, 0x64, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x72, 0x61, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x70, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x22, 0xb5, 0x01, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f,
+	0x72, 0x74, 0x73, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x6d, 0x79, 0x5f, 0x73, 0x6f,
+	0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x07,
+	0x6d, 0x79, 0x53, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x03, 0x73, 0x69, 0x67, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x03, 0x73, 0x69, 0x67, 0x22, 0x9c, 0x01, 0x0a,
+	0x0c, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x3b, 0x0a,
+	0x08, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72,
+	0x74, 0x4d, 0x61, 0x70, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x70, 0x1a, 0x4f, 0x0a, 0x0c, 0x53, 0x6f,
+	0x72, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x73, 0x67,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x70, 0x0a, 0x0c, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x4e, 0x6f, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x05, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x03, 0x73, 0x69, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x03, 0x73, 0x69, 0x67, 0x22, 0x3a, 0x0a,
+	0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61,
+	0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xba, 0x01, 0x0a, 0x11, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x6d, 0x0a, 0x14, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x27, 0x0a, 0x04,
+	0x63, 0x6f, 0x6d, 0x6d, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x73, 0x67, 0x52,
+	0x04, 0x63, 0x6f, 0x6d, 0x6d, 0x22, 0x4c, 0x0a, 0x13, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x35, 0x0a, 0x07,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x22, 0x3a, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22,
+	0x72, 0x0a, 0x13, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x69, 0x7a, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x05, 0x73, 0x69,
+	0x7a, 0x65, 0x73, 0x22, 0x6e, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x44, 0x69, 0x66, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x64,
+	0x69, 0x66, 0x66, 0x22, 0x39, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53,
+	0x65, 0x61, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69,
+	0x66, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x22, 0x83,
+	0x01, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x65, 0x61, 0x74, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x12, 0x0a, 0x04,
+	0x6d, 0x65, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x64, 0x64, 0x65, 0x76, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x73, 0x74,
+	0x64, 0x64, 0x65, 0x76, 0x22, 0x4d, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x65, 0x6e, 0x64, 0x22, 0xe2, 0x01, 0x0a, 0x12, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65,
+	0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x6f, 0x74, 0x65, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0x99, 0x02, 0x0a, 0x0c, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x65, 0x61,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x76, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x65, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x69, 0x6e,
+	0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x35, 0x0a,
+	0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0xab, 0x02, 0x0a, 0x13, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x52, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x77, 0x65,
+	0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x6f, 0x77, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72,
+	0x75, 0x6e, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x22, 0x3e, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x69,
+	0x67, 0x6e, 0x22, 0x3c, 0x0a, 0x12, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0xac, 0x01, 0x0a, 0x13, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
+	0x58, 0x0a, 0x14, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x65, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x65, 0x61, 0x74,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6c, 0x73, 0x50, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x06, 0x62, 0x6c, 0x73, 0x50, 0x6b, 0x73, 0x22, 0xd9, 0x01, 0x0a, 0x13, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6d, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x09, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x53, 0x6f,
+	0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x09, 0x6d,
+	0x61, 0x6b, 0x65, 0x72, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x12, 0x35,
+	0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x63, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65,
+	0x49, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74,
+	0x69, 0x6d, 0x65, 0x49, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x4c, 0x0a, 0x10, 0x52, 0x65,
+	0x71, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x47, 0x72, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x6c, 0x61, 0x67, 0x22, 0x94, 0x02, 0x0a, 0x12, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x47, 0x72, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61,
+	0x64, 0x64, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x57, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x57, 0x69, 0x6e, 0x73, 0x12,
+	0x22, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x57, 0x69, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x57,
+	0x69, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x5a, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x5a, 0x12, 0x20, 0x0a, 0x0b, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x65, 0x61, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x24, 0x0a,
+	0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65, 0x61, 0x74, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x65,
+	0x61, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x5a, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x73, 0x65, 0x61, 0x74, 0x5a, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22,
+	0x82, 0x01, 0x0a, 0x13, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x47, 0x72, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6c, 0x61,
+	0x67, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x47,
+	0x72, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x06, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x47, 0x0a, 0x0f, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70,
+	0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x73, 0x50, 0x75, 0x62, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x73, 0x50, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x22, 0x3d, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53,
+	0x69, 0x67, 0x6e, 0x56, 0x72, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x75, 0x69, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x22, 0x3d, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x53, 0x69, 0x67, 0x6e, 0x56, 0x72, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x22, 0x62, 0x0a, 0x0c, 0x52, 0x65, 0x71, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x69, 0x67,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x46, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x22, 0xc9, 0x01,
+	0x0a, 0x13, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x72,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x22, 0x5f, 0x0a, 0x17, 0x52, 0x65, 0x71,
+	0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x71, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x71, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x7e, 0x0a, 0x14, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x53, 0x6f, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x53, 0x65,
+	0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x53, 0x65, 0x71,
+	0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x22, 0xf5, 0x01, 0x0a, 0x11, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x22, 0x0a, 0x0c,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x63, 0x68, 0x65, 0x41,
+	0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x61, 0x63, 0x68, 0x65, 0x41,
+	0x67, 0x65, 0x22, 0x47, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x52, 0x65, 0x61, 0x64, 0x79,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x72, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x72, 0x72, 0x22, 0x98, 0x01, 0x0a, 0x0e,
+	0x50, 0x6f, 0x73, 0x33, 0x33, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72,
+	0x65, 0x61, 0x64, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x52, 0x65, 0x61, 0x64, 0x79, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x06,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x22, 0x40, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x63, 0x0a, 0x12, 0x50, 0x6f, 0x73, 0x33,
+	0x33, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0xa9, 0x02,
+	0x0a, 0x16, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x35, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33,
+	0x33, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x09, 0x61, 0x6c,
+	0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0c,
+	0x64, 0x69, 0x66, 0x66, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0c, 0x64, 0x69, 0x66,
+	0x66, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x8d, 0x02, 0x0a, 0x0d, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x66, 0x66,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x66, 0x66,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x01, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x6f,
+	0x74, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x01, 0x61, 0x12, 0x21, 0x0a, 0x01, 0x62, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33,
+	0x33, 0x56, 0x6f, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x01, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12,
+	0x2b, 0x0a, 0x06, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x41, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72,
+	0x74, 0x4d, 0x73, 0x67, 0x52, 0x06, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x41, 0x12, 0x2b, 0x0a, 0x06,
+	0x6d, 0x61, 0x6b, 0x65, 0x72, 0x42, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x73,
+	0x67, 0x52, 0x06, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x42, 0x22, 0x40, 0x0a, 0x0a, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x12, 0x32, 0x0a, 0x09, 0x65, 0x76, 0x69, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x09, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xcd, 0x01, 0x0a, 0x11,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6c, 0x61, 0x73,
+	0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x66, 0x66, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x66, 0x66, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x72, 0x6e, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x62, 0x6f, 0x75, 0x6e, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x62, 0x6f, 0x75, 0x6e, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x76, 0x69, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x65,
+	0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x61, 0x73, 0x68, 0x22, 0x91, 0x01, 0x0a, 0x0f,
+	0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x69, 0x66, 0x66, 0x50, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x64, 0x69, 0x66, 0x66, 0x50, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22,
+	0x42, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33,
+	0x33, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x06, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x22, 0x31, 0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x6f, 0x74, 0x65,
+	0x73, 0x12, 0x23, 0x0a, 0x02, 0x76, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x6f, 0x74, 0x65, 0x4d,
+	0x73, 0x67, 0x52, 0x02, 0x76, 0x73, 0x22, 0x36, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d,
+	0x61, 0x6b, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x03, 0x6d, 0x76, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x03, 0x6d, 0x76, 0x73, 0x22, 0x7e,
+	0x0a, 0x10, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x69, 0x6e,
+	0x65, 0x72, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f,
+	0x72, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x23, 0x0a, 0x02, 0x76,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x6f, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x02, 0x76, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xd2,
+	0x02, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x4d, 0x73, 0x67,
+	0x12, 0x1c, 0x0a, 0x09, 0x42, 0x6c, 0x73, 0x50, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x09, 0x42, 0x6c, 0x73, 0x50, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x42, 0x6c, 0x73, 0x53, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x42, 0x6c, 0x73, 0x53, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x6f,
+	0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x73,
+	0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x32, 0x0a, 0x09, 0x65, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x65, 0x76, 0x69, 0x64,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x03, 0x61, 0x67, 0x67, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x41, 0x67, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x03, 0x61, 0x67, 0x67, 0x12, 0x2f, 0x0a, 0x06,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x24, 0x0a,
+	0x0d, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e,
+	0x65, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x6f, 0x74, 0x65,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x76, 0x6f,
+	0x74, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x72, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x72, 0x79, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x22, 0x4a, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x41, 0x67, 0x67, 0x56, 0x6f,
+	0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6b,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x70, 0x6b, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x73, 0x69, 0x67, 0x22, 0x2e,
+	0x0a, 0x12, 0x52, 0x65, 0x71, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x42, 0x6c, 0x73, 0x50, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x22, 0x23,
+	0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x42, 0x6c, 0x73, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03,
+	0x70, 0x6b, 0x73, 0x22, 0x2b, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x42, 0x6c, 0x73, 0x49,
+	0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73,
+	0x22, 0x3e, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x6c,
+	0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x22, 0x22, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x50, 0x72, 0x69, 0x76, 0x4d, 0x73, 0x67,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x72, 0x69, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x70, 0x72, 0x69, 0x76, 0x22, 0x5b, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d,
+	0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0xad, 0x01, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69, 0x6e,
+	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x61, 0x6e, 0x64, 0x53, 0x65, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x61, 0x6e, 0x64, 0x53, 0x65, 0x65,
+	0x64, 0x22, 0x94, 0x01, 0x0a, 0x12, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x65,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4c, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x33,
+	0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3f, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x3d, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64,
+	0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x5e, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x65, 0x72,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0x47, 0x0a, 0x15, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x3f, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x3f, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x4d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x22, 0x92, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x0f,
+	0x6f, 0x6c, 0x64, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x6c, 0x64, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x4d, 0x69, 0x6e,
+	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x6e, 0x65, 0x77, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x3d, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7a, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e,
+	0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x22, 0x5c, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x67,
+	0x6e, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x65, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x73, 0x22,
+	0xe0, 0x01, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x65, 0x65, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65,
+	0x65, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x74,
+	0x12, 0x30, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e,
+	0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f,
+	0x72, 0x73, 0x22, 0x62, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x45, 0x6e, 0x74, 0x72, 0x75,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x43, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x44,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x45, 0x0a, 0x0f, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0xd9, 0x01, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65,
+	0x6c, 0x66, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x73, 0x65, 0x6c, 0x66, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x97,
+	0x01, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61,
+	0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f,
+	0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6a, 0x61, 0x69, 0x6c,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6a, 0x61,
+	0x69, 0x6c, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x21, 0x0a, 0x09, 0x50, 0x6f, 0x73, 0x33,
+	0x33, 0x4a, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x22, 0x0d, 0x0a, 0x0b, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x55, 0x6e, 0x6a, 0x61, 0x69, 0x6c, 0x22, 0x76, 0x0a, 0x10, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4a, 0x61, 0x69, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64,
+	0x64, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6a, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x22, 0x64, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4b, 0x65, 0x79, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x22, 0x0a, 0x03, 0x73, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x52, 0x03, 0x73, 0x69, 0x67, 0x22, 0x73, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x33,
+	0x33, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x22, 0x78, 0x0a,
+	0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x6c, 0x66, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x6c,
+	0x66, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x22, 0x3e, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x4b, 0x65, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62,
+	0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x22, 0x3f, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x6a, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x33,
+	0x33, 0x4b, 0x65, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x4b, 0x65, 0x79,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x72, 0x22, 0x7f, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x72, 0x65, 0x76, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x24, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69,
+	0x67, 0x72, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x22, 0x46, 0x0a, 0x0c, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x42, 0x6c, 0x73, 0x42, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x42,
+	0x6c, 0x73, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x42, 0x6c,
+	0x73, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64,
+	0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x22, 0x8b, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x42, 0x69, 0x6e, 0x64, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x6e,
+	0x64, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x69, 0x6e,
+	0x64, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x41,
+	0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a,
+	0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x22, 0x69, 0x0a, 0x13, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x67,
+	0x6e, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x69,
+	0x67, 0x6e, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5c, 0x0a, 0x11,
+	0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72,
+	0x73, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x65, 0x6e, 0x74, 0x22, 0x22, 0x0a, 0x0a, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x54, 0x78, 0x48, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x78, 0x48, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x78, 0x48, 0x65, 0x78, 0x22, 0x43,
+	0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x6c, 0x6c, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0xad, 0x01, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73,
+	0x65, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x65, 0x72, 0x72, 0x22, 0xe2, 0x01, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69,
+	0x66, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x2d,
+	0x0a, 0x07, 0x6d, 0x79, 0x53, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72,
+	0x74, 0x4d, 0x73, 0x67, 0x52, 0x07, 0x6d, 0x79, 0x53, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x2b, 0x0a,
+	0x05, 0x73, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x41, 0x75, 0x64, 0x69, 0x74, 0x53,
+	0x6f, 0x72, 0x74, 0x52, 0x05, 0x73, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x14, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x41, 0x75, 0x64, 0x69, 0x74, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x0e, 0x0a,
+	0x02, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x22,
+	0xcd, 0x01, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x79, 0x53, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x79, 0x53, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x6f, 0x72,
+	0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65,
+	0x12, 0x3d, 0x0a, 0x0b, 0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x41, 0x75, 0x64, 0x69, 0x74, 0x44, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x0b, 0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22,
+	0x99, 0x01, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x24, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x04, 0x73, 0x69, 0x67, 0x73, 0x22, 0x40, 0x0a, 0x12, 0x52,
+	0x65, 0x71, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x67,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x22, 0x8a, 0x01,
+	0x0a, 0x16, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65,
+	0x41, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76,
+	0x6f, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x22, 0xeb, 0x01, 0x0a, 0x10, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x41, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x65, 0x65,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6b,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x12,
+	0x37, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x41, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x9d, 0x02, 0x0a, 0x0e, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x57, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x79, 0x73,
+	0x65, 0x6c, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d, 0x79, 0x73, 0x65, 0x6c,
+	0x66, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f,
+	0x72, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x05, 0x73, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x05,
+	0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x6f, 0x74, 0x65, 0x4d, 0x73, 0x67,
+	0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x73, 0x67, 0x52, 0x05, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x33, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x73, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x22, 0x6c, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x33,
+	0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x29, 0x0a,
+	0x04, 0x6c, 0x65, 0x61, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x04, 0x6c, 0x65, 0x61, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16,
+	0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06,
+	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x22, 0xeb, 0x01, 0x0a, 0x15, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x65, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x69, 0x66, 0x66, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x01, 0x52,
+	0x05, 0x64, 0x69, 0x66, 0x66, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73,
+	0x75, 0x62, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x22, 0x3e, 0x0a, 0x12, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x46, 0x69, 0x6e,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c,
+	0x73, 0x50, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x6c, 0x73, 0x50, 0x6b,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x64, 0x64, 0x72, 0x22, 0xc2, 0x01, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69,
+	0x6e, 0x65, 0x72, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x25, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x2c, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x78, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x78, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x08, 0x74, 0x78, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c,
+	0x52, 0x0b, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x1a, 0x0a,
+	0x08, 0x66, 0x75, 0x6c, 0x6c, 0x48, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x66, 0x75, 0x6c, 0x6c, 0x48, 0x61, 0x73, 0x68, 0x22, 0xc5, 0x03, 0x0a, 0x12, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x12, 0x25, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x65, 0x72,
+	0x54, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6d, 0x69,
+	0x6e, 0x65, 0x72, 0x54, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x78, 0x42, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x74, 0x78, 0x42, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x42, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x48, 0x61, 0x73, 0x68, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x36, 0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x4d, 0x73, 0x67, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x06,
+	0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52, 0x06, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x30, 0x0a, 0x06, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x69, 0x6e,
+	0x65, 0x72, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x06, 0x61, 0x6e, 0x63, 0x68, 0x6f,
+	0x72, 0x22, 0x8c, 0x02, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x50, 0x65, 0x65, 0x72, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x53, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x53, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c,
+	0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x73,
+	0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x61,
+	0x6e, 0x6e, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04,
+	0x62, 0x61, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x62, 0x61, 0x6e, 0x73,
+	0x22, 0x98, 0x01, 0x0a, 0x0f, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x50, 0x65, 0x65, 0x72, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x62, 0x61, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x62, 0x61, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x73, 0x67, 0x73,
+	0x50, 0x65, 0x72, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x6d, 0x73, 0x67, 0x73, 0x50, 0x65, 0x72, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2b,
+	0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x50, 0x65, 0x65, 0x72, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x22, 0x3b, 0x0a, 0x0f, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64,
+	0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe1, 0x02, 0x0a, 0x0f, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61,
+	0x6b, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x06,
+	0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x22, 0x55, 0x0a, 0x0f,
+	0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x61, 0x6b, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x6f,
+	0x74, 0x65, 0x73, 0x22, 0x67, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d,
+	0x61, 0x6b, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64,
+	0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6a, 0x0a, 0x10,
+	0x50, 0x6f, 0x73, 0x33, 0x33, 0x4d, 0x61, 0x6b, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x61, 0x64, 0x64, 0x72, 0x12, 0x2e, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x4d, 0x61, 0x6b, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x22, 0x4f, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x50,
+	0x6f, 0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x22, 0xa6, 0x01, 0x0a, 0x0e, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x76, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x61, 0x76, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x76, 0x67, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x76, 0x67, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x48, 0x69, 0x73,
+	0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x48, 0x69,
+	0x73, 0x74, 0x22, 0xa4, 0x01, 0x0a, 0x10, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x75, 0x70,
+	0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x62,
+	0x6f, 0x6e, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x62, 0x6f, 0x6e, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x22, 0x76, 0x0a, 0x14, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x07, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x04, 0x6e, 0x65, 0x78,
+	0x74, 0x32, 0xdf, 0x01, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x33, 0x33, 0x12, 0x3b, 0x0a, 0x0f, 0x53,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x45, 0x6e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x12, 0x13,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x45, 0x6e, 0x74, 0x72,
+	0x75, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x54, 0x78, 0x48, 0x65, 0x78, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x15, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x1e, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x50, 0x6f, 0x73,
+	0x33, 0x33, 0x53, 0x6f, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x1a, 0x1a, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53,
+	0x6f, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x40, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x12, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x50, 0x6f,
+	0x73, 0x33, 0x33, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x13, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22,
+	0x00, 0x30, 0x01, 0x32, 0xc8, 0x01, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x33, 0x33, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71,
+	0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x1a,
+	0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x07, 0x53, 0x69, 0x67,
+	0x6e, 0x56, 0x72, 0x66, 0x12, 0x16, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71,
+	0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x69, 0x67, 0x6e, 0x56, 0x72, 0x66, 0x1a, 0x18, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53,
+	0x69, 0x67, 0x6e, 0x56, 0x72, 0x66, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x04, 0x53, 0x69, 0x67, 0x6e,
+	0x12, 0x13, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x50, 0x6f, 0x73, 0x33,
+	0x33, 0x53, 0x69, 0x67, 0x6e, 0x1a, 0x15, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x50, 0x6f, 0x73, 0x33, 0x33, 0x53, 0x69, 0x67, 0x6e, 0x22, 0x00, 0x42, 0x0a,
+	0x5a, 0x08, 0x2e, 0x2e, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_pos33_proto_rawDescOnce sync.Once
+	file_pos33_proto_rawDescData = file_pos33_proto_rawDesc
+)
+
+func file_pos33_proto_rawDescGZIP() []byte {
+	file_pos33_proto_rawDescOnce.Do(func() {
+		file_pos33_proto_rawDescData = protoimpl.X.CompressGZIP(file_pos33_proto_rawDescData)
+	})
+	return file_pos33_proto_rawDescData
+}
+
+var file_pos33_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_pos33_proto_msgTypes = make([]protoimpl.MessageInfo, 133)
+var file_pos33_proto_goTypes = []interface{}{
+	(Pos33Msg_Ty)(0),                // 0: types.Pos33Msg.Ty
+	(*Pos33Ticket)(nil),             // 1: types.Pos33Ticket
+	(*Pos33TicketAction)(nil),       // 2: types.Pos33TicketAction
+	(*Pos33Msg)(nil),                // 3: types.Pos33Msg
+	(*SortHash)(nil),                // 4: types.SortHash
+	(*VrfInput)(nil),                // 5: types.VrfInput
+	(*HashProof)(nil),               // 6: types.HashProof
+	(*Pos33SortMsg)(nil),            // 7: types.Pos33SortMsg
+	(*Pos33Sorts)(nil),              // 8: types.Pos33Sorts
+	(*Pos33VoteSorts)(nil),          // 9: types.Pos33VoteSorts
+	(*Pos33Online)(nil),             // 10: types.Pos33Online
+	(*Pos33BlockMsg)(nil),           // 11: types.Pos33BlockMsg
+	(*Pos33BlockMsg2)(nil),          // 12: types.Pos33BlockMsg2
+	(*Pos33VoteMsg)(nil),            // 13: types.Pos33VoteMsg
+	(*Pos33DepositMsg)(nil),         // 14: types.Pos33DepositMsg
+	(*Pos33SortsVote)(nil),          // 15: types.Pos33SortsVote
+	(*Pos33SortMap)(nil),            // 16: types.Pos33SortMap
+	(*Pos33NoSeats)(nil),            // 17: types.Pos33NoSeats
+	(*Pos33Validator)(nil),          // 18: types.Pos33Validator
+	(*Pos33ValidatorSet)(nil),       // 19: types.Pos33ValidatorSet
+	(*Pos33CommitteeRecord)(nil),    // 20: types.Pos33CommitteeRecord
+	(*Pos33CommitteeStore)(nil),     // 21: types.Pos33CommitteeStore
+	(*Pos33RoundSize)(nil),          // 22: types.Pos33RoundSize
+	(*Pos33CommitteeSizes)(nil),     // 23: types.Pos33CommitteeSizes
+	(*Pos33BlockDiff)(nil),          // 24: types.Pos33BlockDiff
+	(*ReqPos33Seats)(nil),           // 25: types.ReqPos33Seats
+	(*Pos33SeatsStats)(nil),         // 26: types.Pos33SeatsStats
+	(*ReqPos33Rewards)(nil),         // 27: types.ReqPos33Rewards
+	(*Pos33RewardSegment)(nil),      // 28: types.Pos33RewardSegment
+	(*Pos33Rewards)(nil),            // 29: types.Pos33Rewards
+	(*Pos33RetentionStats)(nil),     // 30: types.Pos33RetentionStats
+	(*ReqPos33Manifest)(nil),        // 31: types.ReqPos33Manifest
+	(*Pos33ManifestEntry)(nil),      // 32: types.Pos33ManifestEntry
+	(*Pos33ConfigManifest)(nil),     // 33: types.Pos33ConfigManifest
+	(*Pos33CommitteeMember)(nil),    // 34: types.Pos33CommitteeMember
+	(*Pos33BlockCommittee)(nil),     // 35: types.Pos33BlockCommittee
+	(*Pos33CurrentRound)(nil),       // 36: types.Pos33CurrentRound
+	(*ReqPos33Grinding)(nil),        // 37: types.ReqPos33Grinding
+	(*Pos33GrindingScore)(nil),      // 38: types.Pos33GrindingScore
+	(*Pos33GrindingReport)(nil),     // 39: types.Pos33GrindingReport
+	(*ReqPos33SignerInfo)(nil),      // 40: types.ReqPos33SignerInfo
+	(*Pos33SignerInfo)(nil),         // 41: types.Pos33SignerInfo
+	(*ReqPos33SignVrf)(nil),         // 42: types.ReqPos33SignVrf
+	(*ReplyPos33SignVrf)(nil),       // 43: types.ReplyPos33SignVrf
+	(*ReqPos33Sign)(nil),            // 44: types.ReqPos33Sign
+	(*ReplyPos33Sign)(nil),          // 45: types.ReplyPos33Sign
+	(*Pos33SortitionEvent)(nil),     // 46: types.Pos33SortitionEvent
+	(*ReqPos33SortitionEvents)(nil), // 47: types.ReqPos33SortitionEvents
+	(*Pos33SortitionEvents)(nil),    // 48: types.Pos33SortitionEvents
+	(*Pos33StakingStats)(nil),       // 49: types.Pos33StakingStats
+	(*Pos33ReadyCheck)(nil),         // 50: types.Pos33ReadyCheck
+	(*Pos33Readiness)(nil),          // 51: types.Pos33Readiness
+	(*Pos33HeightCount)(nil),        // 52: types.Pos33HeightCount
+	(*Pos33HeightTickets)(nil),      // 53: types.Pos33HeightTickets
+	(*Pos33ConsensusSnapshot)(nil),  // 54: types.Pos33ConsensusSnapshot
+	(*Pos33Evidence)(nil),           // 55: types.Pos33Evidence
+	(*Pos33Slash)(nil),              // 56: types.Pos33Slash
+	(*ReceiptPos33Slash)(nil),       // 57: types.ReceiptPos33Slash
+	(*Pos33ChainParam)(nil),         // 58: types.Pos33ChainParam
+	(*Pos33ChainParams)(nil),        // 59: types.Pos33ChainParams
+	(*Pos33Votes)(nil),              // 60: types.Pos33Votes
+	(*Pos33MakerVotes)(nil),         // 61: types.Pos33MakerVotes
+	(*Pos33TicketMiner)(nil),        // 62: types.Pos33TicketMiner
+	(*Pos33MinerMsg)(nil),           // 63: types.Pos33MinerMsg
+	(*Pos33MinerReward)(nil),        // 64: types.Pos33MinerReward
+	(*Pos33AggVote)(nil),            // 65: types.Pos33AggVote
+	(*ReqPos33BlsPubkeys)(nil),      // 66: types.ReqPos33BlsPubkeys
+	(*Pos33BlsPubkeys)(nil),         // 67: types.Pos33BlsPubkeys
+	(*Pos33BlsIndices)(nil),         // 68: types.Pos33BlsIndices
+	(*Pos33MinerFlag)(nil),          // 69: types.Pos33MinerFlag
+	(*Pos33PrivMsg)(nil),            // 70: types.Pos33PrivMsg
+	(*Pos33TicketBind)(nil),         // 71: types.Pos33TicketBind
+	(*Pos33TicketOpen)(nil),         // 72: types.Pos33TicketOpen
+	(*Pos33TicketGenesis)(nil),      // 73: types.Pos33TicketGenesis
+	(*Pos33TicketClose)(nil),        // 74: types.Pos33TicketClose
+	(*Pos33TicketReward)(nil),       // 75: types.Pos33TicketReward
+	(*Pos33TicketList)(nil),         // 76: types.Pos33TicketList
+	(*ReplyPos33TicketReward)(nil),  // 77: types.ReplyPos33TicketReward
+	(*ReplyWalletPos33Count)(nil),   // 78: types.ReplyWalletPos33Count
+	(*ReceiptPos33Deposit)(nil),     // 79: types.ReceiptPos33Deposit
+	(*ReceiptPos33Miner)(nil),       // 80: types.ReceiptPos33Miner
+	(*ReceiptPos33TicketBind)(nil),  // 81: types.ReceiptPos33TicketBind
+	(*Consignee)(nil),               // 82: types.Consignee
+	(*Consignor)(nil),               // 83: types.Consignor
+	(*Pos33Consignor)(nil),          // 84: types.Pos33Consignor
+	(*Pos33Consignee)(nil),          // 85: types.Pos33Consignee
+	(*Pos33Entrust)(nil),            // 86: types.Pos33Entrust
+	(*Pos33Delegate)(nil),           // 87: types.Pos33Delegate
+	(*Pos33Undelegate)(nil),         // 88: types.Pos33Undelegate
+	(*Pos33OperatorInfo)(nil),       // 89: types.Pos33OperatorInfo
+	(*Pos33Liveness)(nil),           // 90: types.Pos33Liveness
+	(*Pos33Jail)(nil),               // 91: types.Pos33Jail
+	(*Pos33Unjail)(nil),             // 92: types.Pos33Unjail
+	(*ReceiptPos33Jail)(nil),        // 93: types.ReceiptPos33Jail
+	(*Pos33KeyRotate)(nil),          // 94: types.Pos33KeyRotate
+	(*Pos33ConsensusKey)(nil),       // 95: types.Pos33ConsensusKey
+	(*Pos33StakerKey)(nil),          // 96: types.Pos33StakerKey
+	(*ReqPos33KeyOwner)(nil),        // 97: types.ReqPos33KeyOwner
+	(*ReqPos33SortCount)(nil),       // 98: types.ReqPos33SortCount
+	(*Pos33KeyOwner)(nil),           // 99: types.Pos33KeyOwner
+	(*ReceiptPos33KeyRotate)(nil),   // 100: types.ReceiptPos33KeyRotate
+	(*Pos33Migrate)(nil),            // 101: types.Pos33Migrate
+	(*Pos33BlsBind)(nil),            // 102: types.Pos33BlsBind
+	(*ReqBindPos33Miner)(nil),       // 103: types.ReqBindPos33Miner
+	(*Pos33WithdrawReward)(nil),     // 104: types.Pos33WithdrawReward
+	(*Pos33MinerFeeRate)(nil),       // 105: types.Pos33MinerFeeRate
+	(*ReplyTxHex)(nil),              // 106: types.ReplyTxHex
+	(*ReplyPos33Info)(nil),          // 107: types.ReplyPos33Info
+	(*Pos33AuditSort)(nil),          // 108: types.Pos33AuditSort
+	(*Pos33AuditRecord)(nil),        // 109: types.Pos33AuditRecord
+	(*Pos33AuditDivergence)(nil),    // 110: types.Pos33AuditDivergence
+	(*Pos33AuditReplay)(nil),        // 111: types.Pos33AuditReplay
+	(*Pos33Checkpoint)(nil),         // 112: types.Pos33Checkpoint
+	(*ReqPos33Checkpoint)(nil),      // 113: types.ReqPos33Checkpoint
+	(*Pos33CommitteeAtMember)(nil),  // 114: types.Pos33CommitteeAtMember
+	(*Pos33CommitteeAt)(nil),        // 115: types.Pos33CommitteeAt
+	(*Pos33WalRecord)(nil),          // 116: types.Pos33WalRecord
+	(*Pos33TicketBranch)(nil),       // 117: types.Pos33TicketBranch
+	(*Pos33TicketCommitment)(nil),   // 118: types.Pos33TicketCommitment
+	(*Pos33FinalityVoter)(nil),      // 119: types.Pos33FinalityVoter
+	(*Pos33MinerTxProof)(nil),       // 120: types.Pos33MinerTxProof
+	(*Pos33FinalityProof)(nil),      // 121: types.Pos33FinalityProof
+	(*Pos33PeerScore)(nil),          // 122: types.Pos33PeerScore
+	(*Pos33PeerScores)(nil),         // 123: types.Pos33PeerScores
+	(*Pos33SortWinner)(nil),         // 124: types.Pos33SortWinner
+	(*Pos33SortRecord)(nil),         // 125: types.Pos33SortRecord
+	(*Pos33MakerBlock)(nil),         // 126: types.Pos33MakerBlock
+	(*ReqPos33MakerBlocks)(nil),     // 127: types.ReqPos33MakerBlocks
+	(*Pos33MakerBlocks)(nil),        // 128: types.Pos33MakerBlocks
+	(*ReqPos33SortStats)(nil),       // 129: types.ReqPos33SortStats
+	(*Pos33SortStats)(nil),          // 130: types.Pos33SortStats
+	(*Pos33TicketPrice)(nil),        // 131: types.Pos33TicketPrice
+	(*Pos33TicketPriceInfo)(nil),    // 132: types.Pos33TicketPriceInfo
+	nil,                             // 133: types.Pos33SortMap.SortMapEntry
+	(*types.Signature)(nil),         // 134: types.Signature
+	(*types.Block)(nil),             // 135: types.Block
+	(*types.Header)(nil),            // 136: types.Header
+	(*types.Transaction)(nil),       // 137: types.Transaction
+}
+var file_pos33_proto_depIdxs = []int32{
+	72,  // 0: types.Pos33TicketAction.topen:type_name -> types.Pos33TicketOpen
+	73,  // 1: types.Pos33TicketAction.genesis:type_name -> types.Pos33TicketGenesis
+	74,  // 2: types.Pos33TicketAction.tclose:type_name -> types.Pos33TicketClose
+	71,  // 3: types.Pos33TicketAction.tbind:type_name -> types.Pos33TicketBind
+	63,  // 4: types.Pos33TicketAction.miner:type_name -> types.Pos33MinerMsg
+	86,  // 5: types.Pos33TicketAction.entrust:type_name -> types.Pos33Entrust
+	101, // 6: types.Pos33TicketAction.migrate:type_name -> types.Pos33Migrate
+	102, // 7: types.Pos33TicketAction.blsBind:type_name -> types.Pos33BlsBind
+	105, // 8: types.Pos33TicketAction.feeRate:type_name -> types.Pos33MinerFeeRate
+	104, // 9: types.Pos33TicketAction.withdraw:type_name -> types.Pos33WithdrawReward
+	56,  // 10: types.Pos33TicketAction.slash:type_name -> types.Pos33Slash
+	58,  // 11: types.Pos33TicketAction.chainParam:type_name -> types.Pos33ChainParam
+	87,  // 12: types.Pos33TicketAction.delegate:type_name -> types.Pos33Delegate
+	88,  // 13: types.Pos33TicketAction.undelegate:type_name -> types.Pos33Undelegate
+	91,  // 14: types.Pos33TicketAction.jail:type_name -> types.Pos33Jail
+	92,  // 15: types.Pos33TicketAction.unjail:type_name -> types.Pos33Unjail
+	94,  // 16: types.Pos33TicketAction.keyRotate:type_name -> types.Pos33KeyRotate
+	0,   // 17: types.Pos33Msg.ty:type_name -> types.Pos33Msg.Ty
+	5,   // 18: types.HashProof.input:type_name -> types.VrfInput
+	4,   // 19: types.Pos33SortMsg.sort_hash:type_name -> types.SortHash
+	6,   // 20: types.Pos33SortMsg.proof:type_name -> types.HashProof
+	7,   // 21: types.Pos33Sorts.sorts:type_name -> types.Pos33SortMsg
+	8,   // 22: types.Pos33VoteSorts.vote_sorts:type_name -> types.Pos33Sorts
+	134, // 23: types.Pos33Online.Sig:type_name -> types.Signature
+	135, // 24: types.Pos33BlockMsg.b:type_name -> types.Block
+	135, // 25: types.Pos33BlockMsg2.b:type_name -> types.Block
+	13,  // 26: types.Pos33BlockMsg2.vs:type_name -> types.Pos33VoteMsg
+	7,   // 27: types.Pos33VoteMsg.sort:type_name -> types.Pos33SortMsg
+	134, // 28: types.Pos33VoteMsg.sig:type_name -> types.Signature
+	7,   // 29: types.Pos33SortsVote.my_sorts:type_name -> types.Pos33SortMsg
+	134, // 30: types.Pos33SortsVote.sig:type_name -> types.Signature
+	133, // 31: types.Pos33SortMap.sort_map:type_name -> types.Pos33SortMap.SortMapEntry
+	6,   // 32: types.Pos33NoSeats.proof:type_name -> types.HashProof
+	134, // 33: types.Pos33NoSeats.sig:type_name -> types.Signature
+	18,  // 34: types.Pos33ValidatorSet.validators:type_name -> types.Pos33Validator
+	7,   // 35: types.Pos33CommitteeRecord.comm:type_name -> types.Pos33SortMsg
+	20,  // 36: types.Pos33CommitteeStore.records:type_name -> types.Pos33CommitteeRecord
+	22,  // 37: types.Pos33CommitteeSizes.sizes:type_name -> types.Pos33RoundSize
+	28,  // 38: types.Pos33Rewards.segments:type_name -> types.Pos33RewardSegment
+	32,  // 39: types.Pos33ConfigManifest.entries:type_name -> types.Pos33ManifestEntry
+	7,   // 40: types.Pos33BlockCommittee.makerSort:type_name -> types.Pos33SortMsg
+	34,  // 41: types.Pos33BlockCommittee.members:type_name -> types.Pos33CommitteeMember
+	38,  // 42: types.Pos33GrindingReport.scores:type_name -> types.Pos33GrindingScore
+	46,  // 43: types.Pos33SortitionEvents.events:type_name -> types.Pos33SortitionEvent
+	50,  // 44: types.Pos33Readiness.checks:type_name -> types.Pos33ReadyCheck
+	18,  // 45: types.Pos33HeightTickets.validators:type_name -> types.Pos33Validator
+	52,  // 46: types.Pos33ConsensusSnapshot.allCounts:type_name -> types.Pos33HeightCount
+	53,  // 47: types.Pos33ConsensusSnapshot.tickets:type_name -> types.Pos33HeightTickets
+	52,  // 48: types.Pos33ConsensusSnapshot.diffSchedule:type_name -> types.Pos33HeightCount
+	21,  // 49: types.Pos33ConsensusSnapshot.committees:type_name -> types.Pos33CommitteeStore
+	13,  // 50: types.Pos33Evidence.a:type_name -> types.Pos33VoteMsg
+	13,  // 51: types.Pos33Evidence.b:type_name -> types.Pos33VoteMsg
+	7,   // 52: types.Pos33Evidence.makerA:type_name -> types.Pos33SortMsg
+	7,   // 53: types.Pos33Evidence.makerB:type_name -> types.Pos33SortMsg
+	55,  // 54: types.Pos33Slash.evidences:type_name -> types.Pos33Evidence
+	58,  // 55: types.Pos33ChainParams.params:type_name -> types.Pos33ChainParam
+	13,  // 56: types.Pos33Votes.vs:type_name -> types.Pos33VoteMsg
+	60,  // 57: types.Pos33MakerVotes.mvs:type_name -> types.Pos33Votes
+	7,   // 58: types.Pos33TicketMiner.sort:type_name -> types.Pos33SortMsg
+	13,  // 59: types.Pos33TicketMiner.vs:type_name -> types.Pos33VoteMsg
+	7,   // 60: types.Pos33MinerMsg.sort:type_name -> types.Pos33SortMsg
+	55,  // 61: types.Pos33MinerMsg.evidences:type_name -> types.Pos33Evidence
+	65,  // 62: types.Pos33MinerMsg.agg:type_name -> types.Pos33AggVote
+	64,  // 63: types.Pos33MinerMsg.reward:type_name -> types.Pos33MinerReward
+	82,  // 64: types.Pos33Consignor.consignees:type_name -> types.Consignee
+	83,  // 65: types.Pos33Consignee.consignors:type_name -> types.Consignor
+	134, // 66: types.Pos33KeyRotate.sig:type_name -> types.Signature
+	95,  // 67: types.Pos33KeyOwner.key:type_name -> types.Pos33ConsensusKey
+	96,  // 68: types.Pos33KeyOwner.staker:type_name -> types.Pos33StakerKey
+	7,   // 69: types.Pos33AuditSort.sort:type_name -> types.Pos33SortMsg
+	7,   // 70: types.Pos33AuditRecord.mySorts:type_name -> types.Pos33SortMsg
+	108, // 71: types.Pos33AuditRecord.sorts:type_name -> types.Pos33AuditSort
+	110, // 72: types.Pos33AuditReplay.divergences:type_name -> types.Pos33AuditDivergence
+	134, // 73: types.Pos33Checkpoint.sigs:type_name -> types.Signature
+	114, // 74: types.Pos33CommitteeAt.members:type_name -> types.Pos33CommitteeAtMember
+	7,   // 75: types.Pos33WalRecord.sorts:type_name -> types.Pos33SortMsg
+	13,  // 76: types.Pos33WalRecord.votes:type_name -> types.Pos33VoteMsg
+	11,  // 77: types.Pos33WalRecord.block:type_name -> types.Pos33BlockMsg
+	15,  // 78: types.Pos33WalRecord.committee:type_name -> types.Pos33SortsVote
+	18,  // 79: types.Pos33TicketBranch.leaf:type_name -> types.Pos33Validator
+	136, // 80: types.Pos33MinerTxProof.header:type_name -> types.Header
+	137, // 81: types.Pos33MinerTxProof.minerTx:type_name -> types.Transaction
+	136, // 82: types.Pos33FinalityProof.header:type_name -> types.Header
+	137, // 83: types.Pos33FinalityProof.minerTx:type_name -> types.Transaction
+	118, // 84: types.Pos33FinalityProof.tickets:type_name -> types.Pos33TicketCommitment
+	7,   // 85: types.Pos33FinalityProof.committee:type_name -> types.Pos33SortMsg
+	117, // 86: types.Pos33FinalityProof.counts:type_name -> types.Pos33TicketBranch
+	119, // 87: types.Pos33FinalityProof.voters:type_name -> types.Pos33FinalityVoter
+	120, // 88: types.Pos33FinalityProof.anchor:type_name -> types.Pos33MinerTxProof
+	122, // 89: types.Pos33PeerScores.peers:type_name -> types.Pos33PeerScore
+	124, // 90: types.Pos33SortRecord.voters:type_name -> types.Pos33SortWinner
+	126, // 91: types.Pos33MakerBlocks.blocks:type_name -> types.Pos33MakerBlock
+	131, // 92: types.Pos33TicketPriceInfo.current:type_name -> types.Pos33TicketPrice
+	131, // 93: types.Pos33TicketPriceInfo.next:type_name -> types.Pos33TicketPrice
+	7,   // 94: types.Pos33SortMap.SortMapEntry.value:type_name -> types.Pos33SortMsg
+	86,  // 95: types.pos33.SetPos33Entrust:input_type -> types.Pos33Entrust
+	47,  // 96: types.pos33.StreamSortitionEvents:input_type -> types.ReqPos33SortitionEvents
+	27,  // 97: types.pos33.StreamRewards:input_type -> types.ReqPos33Rewards
+	40,  // 98: types.pos33signer.GetSignerInfo:input_type -> types.ReqPos33SignerInfo
+	42,  // 99: types.pos33signer.SignVrf:input_type -> types.ReqPos33SignVrf
+	44,  // 100: types.pos33signer.Sign:input_type -> types.ReqPos33Sign
+	106, // 101: types.pos33.SetPos33Entrust:output_type -> types.ReplyTxHex
+	46,  // 102: types.pos33.StreamSortitionEvents:output_type -> types.Pos33SortitionEvent
+	29,  // 103: types.pos33.StreamRewards:output_type -> types.Pos33Rewards
+	41,  // 104: types.pos33signer.GetSignerInfo:output_type -> types.Pos33SignerInfo
+	43,  // 105: types.pos33signer.SignVrf:output_type -> types.ReplyPos33SignVrf
+	45,  // 106: types.pos33signer.Sign:output_type -> types.ReplyPos33Sign
+	101, // [101:107] is the sub-list for method output_type
+	95,  // [95:101] is the sub-list for method input_type
+	95,  // [95:95] is the sub-list for extension type_name
+	95,  // [95:95] is the sub-list for extension extendee
+	0,   // [0:95] is the sub-list for field type_name
+}
+
+func init() { file_pos33_proto_init() }
+func file_pos33_proto_init() {
+	if File_pos33_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pos33_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Ticket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TicketAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Msg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SortHash); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VrfInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HashProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33SortMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Sorts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33VoteSorts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Online); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33BlockMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33BlockMsg2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33VoteMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33DepositMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33SortsVote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33SortMap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33NoSeats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Validator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33ValidatorSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33CommitteeRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33CommitteeStore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33RoundSize); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33CommitteeSizes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33BlockDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33Seats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33SeatsStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33Rewards); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33RewardSegment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Rewards); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33RetentionStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33Manifest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33ManifestEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33ConfigManifest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33CommitteeMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33BlockCommittee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33CurrentRound); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33Grinding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33GrindingScore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33GrindingReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33SignerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33SignerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33SignVrf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyPos33SignVrf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33Sign); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyPos33Sign); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33SortitionEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33SortitionEvents); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33SortitionEvents); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33StakingStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33ReadyCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Readiness); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33HeightCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33HeightTickets); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33ConsensusSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Evidence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Slash); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptPos33Slash); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33ChainParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33ChainParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Votes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33MakerVotes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TicketMiner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33MinerMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33MinerReward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33AggVote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33BlsPubkeys); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33BlsPubkeys); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33BlsIndices); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33MinerFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33PrivMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TicketBind); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TicketOpen); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TicketGenesis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TicketClose); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TicketReward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TicketList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyPos33TicketReward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyWalletPos33Count); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptPos33Deposit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptPos33Miner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptPos33TicketBind); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Consignee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Consignor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Consignor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Consignee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Entrust); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Delegate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3455,8 +11973,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33TicketAction); i {
+		file_pos33_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Undelegate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3467,8 +11985,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33Msg); i {
+		file_pos33_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33OperatorInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3479,8 +11997,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SortHash); i {
+		file_pos33_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Liveness); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3491,8 +12009,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VrfInput); i {
+		file_pos33_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Jail); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3503,8 +12021,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HashProof); i {
+		file_pos33_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Unjail); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3515,8 +12033,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33SortMsg); i {
+		file_pos33_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptPos33Jail); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3527,8 +12045,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33Sorts); i {
+		file_pos33_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33KeyRotate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3539,8 +12057,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33VoteSorts); i {
+		file_pos33_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33ConsensusKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3551,8 +12069,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33Online); i {
+		file_pos33_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33StakerKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3563,8 +12081,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33BlockMsg); i {
+		file_pos33_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33KeyOwner); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3575,8 +12093,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33BlockMsg2); i {
+		file_pos33_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33SortCount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3587,8 +12105,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33VoteMsg); i {
+		file_pos33_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33KeyOwner); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3599,8 +12117,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33DepositMsg); i {
+		file_pos33_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptPos33KeyRotate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3611,8 +12129,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33SortsVote); i {
+		file_pos33_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Migrate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3623,8 +12141,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33SortMap); i {
+		file_pos33_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33BlsBind); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3635,8 +12153,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33Votes); i {
+		file_pos33_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqBindPos33Miner); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3647,8 +12165,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33MakerVotes); i {
+		file_pos33_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33WithdrawReward); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3659,8 +12177,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33TicketMiner); i {
+		file_pos33_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33MinerFeeRate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3671,8 +12189,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33MinerMsg); i {
+		file_pos33_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyTxHex); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3683,8 +12201,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33MinerFlag); i {
+		file_pos33_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyPos33Info); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3695,8 +12213,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33PrivMsg); i {
+		file_pos33_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33AuditSort); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3707,8 +12225,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33TicketBind); i {
+		file_pos33_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33AuditRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3719,8 +12237,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33TicketOpen); i {
+		file_pos33_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33AuditDivergence); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3731,8 +12249,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33TicketGenesis); i {
+		file_pos33_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33AuditReplay); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3743,8 +12261,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33TicketClose); i {
+		file_pos33_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33Checkpoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3755,8 +12273,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33TicketReward); i {
+		file_pos33_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33Checkpoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3767,8 +12285,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33TicketList); i {
+		file_pos33_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33CommitteeAtMember); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3779,8 +12297,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyPos33TicketReward); i {
+		file_pos33_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33CommitteeAt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3791,8 +12309,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyWalletPos33Count); i {
+		file_pos33_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33WalRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3803,8 +12321,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptPos33Deposit); i {
+		file_pos33_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TicketBranch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3815,8 +12333,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptPos33Miner); i {
+		file_pos33_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TicketCommitment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3827,8 +12345,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReceiptPos33TicketBind); i {
+		file_pos33_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33FinalityVoter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3839,8 +12357,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Consignee); i {
+		file_pos33_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33MinerTxProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3851,8 +12369,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Consignor); i {
+		file_pos33_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33FinalityProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3863,8 +12381,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33Consignor); i {
+		file_pos33_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33PeerScore); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3875,8 +12393,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33Consignee); i {
+		file_pos33_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33PeerScores); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3887,8 +12405,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33Entrust); i {
+		file_pos33_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33SortWinner); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3899,8 +12417,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33Migrate); i {
+		file_pos33_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33SortRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3911,8 +12429,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33BlsBind); i {
+		file_pos33_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33MakerBlock); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3923,8 +12441,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReqBindPos33Miner); i {
+		file_pos33_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33MakerBlocks); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3935,8 +12453,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33WithdrawReward); i {
+		file_pos33_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33MakerBlocks); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3947,8 +12465,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pos33MinerFeeRate); i {
+		file_pos33_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReqPos33SortStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3959,8 +12477,8 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyTxHex); i {
+		file_pos33_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33SortStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3971,8 +12489,20 @@ func file_pos33_proto_init() {
 				return nil
 			}
 		}
-		file_pos33_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReplyPos33Info); i {
+		file_pos33_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TicketPrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pos33_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pos33TicketPriceInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3995,6 +12525,13 @@ func file_pos33_proto_init() {
 		(*Pos33TicketAction_BlsBind)(nil),
 		(*Pos33TicketAction_FeeRate)(nil),
 		(*Pos33TicketAction_Withdraw)(nil),
+		(*Pos33TicketAction_Slash)(nil),
+		(*Pos33TicketAction_ChainParam)(nil),
+		(*Pos33TicketAction_Delegate)(nil),
+		(*Pos33TicketAction_Undelegate)(nil),
+		(*Pos33TicketAction_Jail)(nil),
+		(*Pos33TicketAction_Unjail)(nil),
+		(*Pos33TicketAction_KeyRotate)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4002,9 +12539,9 @@ func file_pos33_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pos33_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   46,
+			NumMessages:   133,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_pos33_proto_goTypes,
 		DependencyIndexes: file_pos33_proto_depIdxs,
@@ -4031,6 +12568,10 @@ const _ = grpc.SupportPackageIsVersion6
 type Pos33Client interface {
 	// 创建entrust
 	SetPos33Entrust(ctx context.Context, in *Pos33Entrust, opts ...grpc.CallOption) (*ReplyTxHex, error)
+	// 推送抽签和委员会事件
+	StreamSortitionEvents(ctx context.Context, in *ReqPos33SortitionEvents, opts ...grpc.CallOption) (Pos33_StreamSortitionEventsClient, error)
+	// 分段推送一个高度区间的奖励
+	StreamRewards(ctx context.Context, in *ReqPos33Rewards, opts ...grpc.CallOption) (Pos33_StreamRewardsClient, error)
 }
 
 type pos33Client struct {
@@ -4050,10 +12591,78 @@ func (c *pos33Client) SetPos33Entrust(ctx context.Context, in *Pos33Entrust, opt
 	return out, nil
 }
 
+func (c *pos33Client) StreamSortitionEvents(ctx context.Context, in *ReqPos33SortitionEvents, opts ...grpc.CallOption) (Pos33_StreamSortitionEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Pos33_serviceDesc.Streams[0], "/types.pos33/StreamSortitionEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pos33StreamSortitionEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Pos33_StreamSortitionEventsClient interface {
+	Recv() (*Pos33SortitionEvent, error)
+	grpc.ClientStream
+}
+
+type pos33StreamSortitionEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *pos33StreamSortitionEventsClient) Recv() (*Pos33SortitionEvent, error) {
+	m := new(Pos33SortitionEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pos33Client) StreamRewards(ctx context.Context, in *ReqPos33Rewards, opts ...grpc.CallOption) (Pos33_StreamRewardsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Pos33_serviceDesc.Streams[1], "/types.pos33/StreamRewards", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pos33StreamRewardsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Pos33_StreamRewardsClient interface {
+	Recv() (*Pos33Rewards, error)
+	grpc.ClientStream
+}
+
+type pos33StreamRewardsClient struct {
+	grpc.ClientStream
+}
+
+func (x *pos33StreamRewardsClient) Recv() (*Pos33Rewards, error) {
+	m := new(Pos33Rewards)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Pos33Server is the server API for Pos33 service.
 type Pos33Server interface {
 	// 创建entrust
 	SetPos33Entrust(context.Context, *Pos33Entrust) (*ReplyTxHex, error)
+	// 推送抽签和委员会事件
+	StreamSortitionEvents(*ReqPos33SortitionEvents, Pos33_StreamSortitionEventsServer) error
+	// 分段推送一个高度区间的奖励
+	StreamRewards(*ReqPos33Rewards, Pos33_StreamRewardsServer) error
 }
 
 // UnimplementedPos33Server can be embedded to have forward compatible implementations.
@@ -4063,6 +12672,12 @@ type UnimplementedPos33Server struct {
 func (*UnimplementedPos33Server) SetPos33Entrust(context.Context, *Pos33Entrust) (*ReplyTxHex, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetPos33Entrust not implemented")
 }
+func (*UnimplementedPos33Server) StreamSortitionEvents(*ReqPos33SortitionEvents, Pos33_StreamSortitionEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSortitionEvents not implemented")
+}
+func (*UnimplementedPos33Server) StreamRewards(*ReqPos33Rewards, Pos33_StreamRewardsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamRewards not implemented")
+}
 
 func RegisterPos33Server(s *grpc.Server, srv Pos33Server) {
 	s.RegisterService(&_Pos33_serviceDesc, srv)
@@ -4086,6 +12701,48 @@ func _Pos33_SetPos33Entrust_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Pos33_StreamSortitionEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReqPos33SortitionEvents)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(Pos33Server).StreamSortitionEvents(m, &pos33StreamSortitionEventsServer{stream})
+}
+
+type Pos33_StreamSortitionEventsServer interface {
+	Send(*Pos33SortitionEvent) error
+	grpc.ServerStream
+}
+
+type pos33StreamSortitionEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *pos33StreamSortitionEventsServer) Send(m *Pos33SortitionEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Pos33_StreamRewards_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReqPos33Rewards)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(Pos33Server).StreamRewards(m, &pos33StreamRewardsServer{stream})
+}
+
+type Pos33_StreamRewardsServer interface {
+	Send(*Pos33Rewards) error
+	grpc.ServerStream
+}
+
+type pos33StreamRewardsServer struct {
+	grpc.ServerStream
+}
+
+func (x *pos33StreamRewardsServer) Send(m *Pos33Rewards) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Pos33_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "types.pos33",
 	HandlerType: (*Pos33Server)(nil),
@@ -4095,6 +12752,161 @@ var _Pos33_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Pos33_SetPos33Entrust_Handler,
 		},
 	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamSortitionEvents",
+			Handler:       _Pos33_StreamSortitionEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamRewards",
+			Handler:       _Pos33_StreamRewards_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pos33.proto",
+}
+
+// Pos33SignerClient is the client API for Pos33Signer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type Pos33SignerClient interface {
+	GetSignerInfo(ctx context.Context, in *ReqPos33SignerInfo, opts ...grpc.CallOption) (*Pos33SignerInfo, error)
+	SignVrf(ctx context.Context, in *ReqPos33SignVrf, opts ...grpc.CallOption) (*ReplyPos33SignVrf, error)
+	Sign(ctx context.Context, in *ReqPos33Sign, opts ...grpc.CallOption) (*ReplyPos33Sign, error)
+}
+
+type pos33SignerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPos33SignerClient(cc grpc.ClientConnInterface) Pos33SignerClient {
+	return &pos33SignerClient{cc}
+}
+
+func (c *pos33SignerClient) GetSignerInfo(ctx context.Context, in *ReqPos33SignerInfo, opts ...grpc.CallOption) (*Pos33SignerInfo, error) {
+	out := new(Pos33SignerInfo)
+	err := c.cc.Invoke(ctx, "/types.pos33signer/GetSignerInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pos33SignerClient) SignVrf(ctx context.Context, in *ReqPos33SignVrf, opts ...grpc.CallOption) (*ReplyPos33SignVrf, error) {
+	out := new(ReplyPos33SignVrf)
+	err := c.cc.Invoke(ctx, "/types.pos33signer/SignVrf", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pos33SignerClient) Sign(ctx context.Context, in *ReqPos33Sign, opts ...grpc.CallOption) (*ReplyPos33Sign, error) {
+	out := new(ReplyPos33Sign)
+	err := c.cc.Invoke(ctx, "/types.pos33signer/Sign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Pos33SignerServer is the server API for Pos33Signer service.
+type Pos33SignerServer interface {
+	GetSignerInfo(context.Context, *ReqPos33SignerInfo) (*Pos33SignerInfo, error)
+	SignVrf(context.Context, *ReqPos33SignVrf) (*ReplyPos33SignVrf, error)
+	Sign(context.Context, *ReqPos33Sign) (*ReplyPos33Sign, error)
+}
+
+// UnimplementedPos33SignerServer can be embedded to have forward compatible implementations.
+type UnimplementedPos33SignerServer struct {
+}
+
+func (*UnimplementedPos33SignerServer) GetSignerInfo(context.Context, *ReqPos33SignerInfo) (*Pos33SignerInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSignerInfo not implemented")
+}
+func (*UnimplementedPos33SignerServer) SignVrf(context.Context, *ReqPos33SignVrf) (*ReplyPos33SignVrf, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignVrf not implemented")
+}
+func (*UnimplementedPos33SignerServer) Sign(context.Context, *ReqPos33Sign) (*ReplyPos33Sign, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sign not implemented")
+}
+
+func RegisterPos33SignerServer(s *grpc.Server, srv Pos33SignerServer) {
+	s.RegisterService(&_Pos33Signer_serviceDesc, srv)
+}
+
+func _Pos33Signer_GetSignerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqPos33SignerInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Pos33SignerServer).GetSignerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.pos33signer/GetSignerInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Pos33SignerServer).GetSignerInfo(ctx, req.(*ReqPos33SignerInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pos33Signer_SignVrf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqPos33SignVrf)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Pos33SignerServer).SignVrf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.pos33signer/SignVrf",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Pos33SignerServer).SignVrf(ctx, req.(*ReqPos33SignVrf))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pos33Signer_Sign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqPos33Sign)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Pos33SignerServer).Sign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.pos33signer/Sign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Pos33SignerServer).Sign(ctx, req.(*ReqPos33Sign))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Pos33Signer_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "types.pos33signer",
+	HandlerType: (*Pos33SignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSignerInfo",
+			Handler:    _Pos33Signer_GetSignerInfo_Handler,
+		},
+		{
+			MethodName: "SignVrf",
+			Handler:    _Pos33Signer_SignVrf_Handler,
+		},
+		{
+			MethodName: "Sign",
+			Handler:    _Pos33Signer_Sign_Handler,
+		},
+	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pos33.proto",
 }
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Pos33BondRatioBase 抵押率的单位, 万分之一
+const Pos33BondRatioBase = 10000
+
+// InitTicketPrice ForkTicketPrice高度的票价, 第一次调整以前使用配置的票价
+func (mp *Pos33MineParam) InitTicketPrice() *Pos33TicketPrice {
+	return &Pos33TicketPrice{
+		Price:  mp.GetTicketPrice(),
+		Height: mp.cfg.GetDappFork(Pos33TicketX, "ForkTicketPrice"),
+		Supply: mp.ForkSupply,
+	}
+}
+
+// PriceEpochStart height是否是调整票价的高度, 分叉以后每PriceEpoch个区块调整一次
+func (mp *Pos33MineParam) PriceEpochStart(height int64) bool {
+	fork := mp.cfg.GetDappFork(Pos33TicketX, "ForkTicketPrice")
+	if mp.PriceEpoch <= 0 || height <= fork || !mp.cfg.IsDappFork(height, Pos33TicketX, "ForkTicketPrice") {
+		return false
+	}
+	return (height-fork)%mp.PriceEpoch == 0
+}
+
+// PriceEpochHeight height使用的票价最后一次调整的高度, 还没有调整时是分叉高度
+func (mp *Pos33MineParam) PriceEpochHeight(height int64) int64 {
+	fork := mp.cfg.GetDappFork(Pos33TicketX, "ForkTicketPrice")
+	if mp.PriceEpoch <= 0 || height <= fork {
+		return fork
+	}
+	return fork + ((height-fork)/mp.PriceEpoch)*mp.PriceEpoch
+}
+
+// NextPriceHeight height以后下一个调整票价的高度, 不调整时返回0
+func (mp *Pos33MineParam) NextPriceHeight(height int64) int64 {
+	fork := mp.cfg.GetDappFork(Pos33TicketX, "ForkTicketPrice")
+	if mp.PriceEpoch <= 0 || !mp.cfg.IsDappFork(height, Pos33TicketX, "ForkTicketPrice") {
+		return 0
+	}
+	return fork + ((height-fork)/mp.PriceEpoch+1)*mp.PriceEpoch
+}
+
+// NextTicketPrice 在height高度按总抵押staked调整prev以后的票价.
+// 总发行量是prev的发行量加上中间每个区块的奖励, 抵押率高于目标时涨价, 低于目标时降价,
+// 每次最多调整MaxPriceStep%, 调整的比例和偏离目标的比例成正比
+func (mp *Pos33MineParam) NextTicketPrice(prev *Pos33TicketPrice, staked, height int64) (*Pos33TicketPrice, error) {
+	if prev == nil || prev.Price <= 0 || height <= prev.Height || staked < 0 {
+		return nil, fmt.Errorf("%w: prev %v, staked %d, height %d", ErrTicketPrice, prev, staked, height)
+	}
+	if mp.TargetBondRatio <= 0 || mp.TargetBondRatio > 100 || mp.MaxPriceStep < 0 || mp.MaxPriceStep >= 100 {
+		return nil, fmt.Errorf("%w: targetBondRatio %d, maxPriceStep %d", ErrTicketPrice, mp.TargetBondRatio, mp.MaxPriceStep)
+	}
+	p := &Pos33TicketPrice{
+		Price:  prev.Price,
+		Epoch:  prev.Epoch + 1,
+		Height: height,
+		Staked: staked,
+	}
+	// 没有配置分叉高度的发行量时不调整
+	if prev.Supply <= 0 {
+		return p, nil
+	}
+	p.Supply = prev.Supply + mp.BlockReward*(height-prev.Height)
+	// 抵押和发行量相乘会溢出int64
+	ratio := new(big.Int).Mul(big.NewInt(staked), big.NewInt(Pos33BondRatioBase))
+	ratio.Quo(ratio, big.NewInt(p.Supply))
+	// 配置的发行量太小时抵押可能超过发行量, 按100%算
+	p.BondRatio = Pos33BondRatioBase
+	if ratio.IsInt64() && ratio.Int64() < Pos33BondRatioBase {
+		p.BondRatio = ratio.Int64()
+	}
+
+	target := mp.TargetBondRatio * Pos33BondRatioBase / 100
+	maxStep := mp.MaxPriceStep * Pos33BondRatioBase / 100
+	step := maxStep * (p.BondRatio - target) / target
+	if step > maxStep {
+		step = maxStep
+	}
+	if step < -maxStep {
+		step = -maxStep
+	}
+	price := new(big.Int).Mul(big.NewInt(prev.Price), big.NewInt(Pos33BondRatioBase+step))
+	p.Price = price.Quo(price, big.NewInt(Pos33BondRatioBase)).Int64()
+	if mp.MinTicketPrice > 0 && p.Price < mp.MinTicketPrice {
+		p.Price = mp.MinTicketPrice
+	}
+	if mp.MaxTicketPrice > 0 && p.Price > mp.MaxTicketPrice {
+		p.Price = mp.MaxTicketPrice
+	}
+	return p, nil
+}
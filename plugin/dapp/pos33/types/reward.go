@@ -0,0 +1,42 @@
+package types
+
+import "fmt"
+
+// MinerRewardV2 ForkRewardV2以后round轮有votes个投票的区块的奖励.
+// 每个投票得到PerVoteReward, 出块人得到MakerBonus, 前面round个轮次超时没有出块,
+// 它们的MakerBonus也给这个出块人, 最多累积MaxCarryRounds轮. 出块人的奖励不超过投票以后剩下的, 最后剩下的给基金
+func (mp *Pos33MineParam) MinerRewardV2(votes, round int) (*Pos33MinerReward, error) {
+	if votes < 0 || round < 0 {
+		return nil, fmt.Errorf("%w: votes %d, round %d", ErrMinerReward, votes, round)
+	}
+	r := &Pos33MinerReward{VoteReward: mp.PerVoteReward, Votes: int64(votes)}
+	remain := mp.BlockReward - r.VoteReward*r.Votes
+	if remain < 0 {
+		return nil, fmt.Errorf("%w: vote reward %d*%d > block reward %d", ErrMinerReward, r.VoteReward, votes, mp.BlockReward)
+	}
+	r.CarryRounds = int64(round)
+	if r.CarryRounds > mp.MaxCarryRounds {
+		r.CarryRounds = mp.MaxCarryRounds
+	}
+	if r.CarryRounds < 0 {
+		r.CarryRounds = 0
+	}
+	r.MakerReward = mp.MakerBonus * (r.CarryRounds + 1)
+	if r.MakerReward > remain {
+		r.MakerReward = remain
+	}
+	r.FundReward = remain - r.MakerReward
+	return r, nil
+}
+
+// Check 检查出块人声明的奖励和按参数计算的expect每一项都相同
+func (r *Pos33MinerReward) Check(expect *Pos33MinerReward) error {
+	if r == nil {
+		return fmt.Errorf("%w: miner reward is nil", ErrMinerReward)
+	}
+	if r.VoteReward != expect.VoteReward || r.Votes != expect.Votes || r.MakerReward != expect.MakerReward ||
+		r.CarryRounds != expect.CarryRounds || r.FundReward != expect.FundReward {
+		return fmt.Errorf("%w: %v NOT match %v", ErrMinerReward, r, expect)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package types
+
+import "fmt"
+
+// Pos33SortRoundBuckets 抽签统计的轮次分布的项数, 最后一项包括更多的轮次
+const Pos33SortRoundBuckets = 8
+
+// Accumulate 在prev的累计上加上这个高度, prev为nil时从这个高度开始累计
+func (r *Pos33SortRecord) Accumulate(prev *Pos33SortRecord) {
+	r.RoundHist = make([]int64, Pos33SortRoundBuckets)
+	if prev != nil {
+		r.Blocks = prev.Blocks
+		r.TotalVotes = prev.TotalVotes
+		r.TotalRounds = prev.TotalRounds
+		copy(r.RoundHist, prev.RoundHist)
+	}
+	r.Blocks++
+	r.TotalVotes += r.Votes
+	r.TotalRounds += int64(r.Round)
+	i := int(r.Round)
+	if i < 0 {
+		i = 0
+	}
+	if i >= Pos33SortRoundBuckets {
+		i = Pos33SortRoundBuckets - 1
+	}
+	r.RoundHist[i]++
+}
+
+// SortStatsBetween from之后到to(包括to)的抽签统计, from为nil时从开始索引的高度统计.
+// start是统计的第一个高度, 索引有间断时按实际记录的区块计算
+func SortStatsBetween(start int64, from, to *Pos33SortRecord) (*Pos33SortStats, error) {
+	if to == nil {
+		return nil, fmt.Errorf("%w: sort record NOT found", ErrSortIndex)
+	}
+	if from == nil {
+		from = &Pos33SortRecord{}
+	}
+	if from.Height > to.Height || from.Blocks > to.Blocks {
+		return nil, fmt.Errorf("%w: sort record %d after %d", ErrSortIndex, from.Height, to.Height)
+	}
+	s := &Pos33SortStats{
+		Start:     start,
+		End:       to.Height,
+		Blocks:    to.Blocks - from.Blocks,
+		RoundHist: make([]int64, Pos33SortRoundBuckets),
+	}
+	for i := range s.RoundHist {
+		var a, b int64
+		if i < len(from.RoundHist) {
+			a = from.RoundHist[i]
+		}
+		if i < len(to.RoundHist) {
+			b = to.RoundHist[i]
+		}
+		s.RoundHist[i] = b - a
+	}
+	if s.Blocks > 0 {
+		s.AvgVotes = float64(to.TotalVotes-from.TotalVotes) / float64(s.Blocks)
+		s.AvgRound = float64(to.TotalRounds-from.TotalRounds) / float64(s.Blocks)
+	}
+	return s, nil
+}
@@ -5,8 +5,11 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"reflect"
+	"sort"
 
 	"github.com/33cn/chain33/common/crypto"
 	"github.com/33cn/chain33/common/difficulty"
@@ -30,6 +33,16 @@ const (
 	TyLogVoterPos33Ticket = 335
 	// TyLogPos33TicketBind bind ticket log type
 	TyLogPos33TicketBind = 334
+	// TyLogPos33Slash slash log type
+	TyLogPos33Slash = 336
+	// TyLogPos33ChainParam chain param log type
+	TyLogPos33ChainParam = 337
+	// TyLogPos33Jail jail and unjail log type
+	TyLogPos33Jail = 338
+	// TyLogPos33KeyRotate key rotate log type
+	TyLogPos33KeyRotate = 339
+	// TyLogPos33TicketPrice ticket price log type
+	TyLogPos33TicketPrice = 340
 )
 
 // ticket
@@ -59,6 +72,20 @@ const (
 	Pos33ActionMinerFeeRate = 21
 	// Pos33ActionWithdrawReward action withdraw reward
 	Pos33ActionWithdrawReward = 22
+	// Pos33ActionSlash action slash
+	Pos33ActionSlash = 23
+	// Pos33ActionChainParam action set chain param
+	Pos33ActionChainParam = 24
+	// Pos33ActionDelegate action delegate
+	Pos33ActionDelegate = 25
+	// Pos33ActionUndelegate action undelegate
+	Pos33ActionUndelegate = 26
+	// Pos33ActionJail action jail
+	Pos33ActionJail = 27
+	// Pos33ActionUnjail action unjail
+	Pos33ActionUnjail = 28
+	// Pos33ActionKeyRotate action key rotate
+	Pos33ActionKeyRotate = 29
 )
 
 // Pos33TicketX dapp name
@@ -76,6 +103,24 @@ func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(Pos33TicketX, "ForkReward15", 725000)
 	cfg.RegisterDappFork(Pos33TicketX, "ForkFixReward", 5000000)
 	cfg.RegisterDappFork(Pos33TicketX, "UseEntrust", 7000000)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkSubCommittee", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkRoundEvidence", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkAddressFormat", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkEvidence", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkVrfSuite", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkParticipants", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkQuorumRule", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkSlash", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkChainParam", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkAggVote", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkDelegate", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkDiffV2", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkJail", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkKeyRotate", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkRewardV2", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkTicketPrice", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkLightProof", types.MaxHeight)
+	cfg.RegisterDappFork(Pos33TicketX, "ForkBlockCheck", types.MaxHeight)
 }
 
 func InitExecutor(cfg *types.Chain33Config) {
@@ -108,6 +153,11 @@ func (ticket *Pos33TicketType) GetLogMap() map[int64]*types.LogInfo {
 		TyLogMinerPos33Ticket: {Ty: reflect.TypeOf(ReceiptPos33Miner{}), Name: "LogMinerPos33Ticket"},
 		TyLogVoterPos33Ticket: {Ty: reflect.TypeOf(ReceiptPos33Miner{}), Name: "LogVoterPos33Ticket"},
 		TyLogPos33TicketBind:  {Ty: reflect.TypeOf(ReceiptPos33TicketBind{}), Name: "LogPos33TicketBind"},
+		TyLogPos33Slash:       {Ty: reflect.TypeOf(ReceiptPos33Slash{}), Name: "LogPos33Slash"},
+		TyLogPos33ChainParam:  {Ty: reflect.TypeOf(Pos33ChainParam{}), Name: "LogPos33ChainParam"},
+		TyLogPos33Jail:        {Ty: reflect.TypeOf(ReceiptPos33Jail{}), Name: "LogPos33Jail"},
+		TyLogPos33KeyRotate:   {Ty: reflect.TypeOf(ReceiptPos33KeyRotate{}), Name: "LogPos33KeyRotate"},
+		TyLogPos33TicketPrice: {Ty: reflect.TypeOf(Pos33TicketPrice{}), Name: "LogPos33TicketPrice"},
 	}
 }
 
@@ -126,7 +176,7 @@ func (ticket Pos33TicketType) Amount(tx *types.Transaction) (int64, error) {
 		if ticketMiner == nil {
 			return 0, nil
 		}
-		nvs := len(ticketMiner.BlsPkList)
+		nvs := ticketMiner.VoterCount()
 		bpr := reward * int64(nvs)
 		return bpr, nil
 	}
@@ -145,10 +195,17 @@ func (ticket *Pos33TicketType) GetTypeMap() map[string]int32 {
 		// "Topen":   Pos33TicketActionOpen,
 		// "Tbind":   Pos33TicketActionBind,
 		// "Tclose":  Pos33TicketActionClose,
-		"Miner":   Pos33TicketActionMiner,
-		"Entrust": Pos33ActionEntrust,
-		"BlsBind": Pos33ActionBlsBind,
-		// "FeeRate": Pos33ActionMinerFeeRate,
+		"Miner":      Pos33TicketActionMiner,
+		"Entrust":    Pos33ActionEntrust,
+		"BlsBind":    Pos33ActionBlsBind,
+		"Slash":      Pos33ActionSlash,
+		"ChainParam": Pos33ActionChainParam,
+		"Delegate":   Pos33ActionDelegate,
+		"Undelegate": Pos33ActionUndelegate,
+		"FeeRate":    Pos33ActionMinerFeeRate,
+		"Jail":       Pos33ActionJail,
+		"Unjail":     Pos33ActionUnjail,
+		"KeyRotate":  Pos33ActionKeyRotate,
 		// "Withdraw": Pos33ActionWithdrawReward,
 		// "Migrate":  Pos33ActionMigrate,
 	}
@@ -163,6 +220,23 @@ type Pos33MineParam struct {
 	BlockReward     int64
 	VoteReward      int64
 	MineReward      int64
+	// 双重投票销毁抵押的百分比, 和其中奖励给举报人的百分比
+	SlashPersent       int64
+	SlashBountyPersent int64
+	// ForkJail之后连续多少个高度没有参与可以被关押, 0表示不关押
+	JailBlocks int64
+	// ForkRewardV2之后每个投票的奖励, 出块人的奖励, 和最多累积多少个超时轮次的出块奖励
+	PerVoteReward  int64
+	MakerBonus     int64
+	MaxCarryRounds int64
+	// ForkTicketPrice之后每PriceEpoch个区块按抵押率调整一次票价, 目标抵押率(百分比),
+	// 每次最多调整的百分比, 票价的范围, 和分叉高度的总发行量
+	PriceEpoch      int64
+	TargetBondRatio int64
+	MaxPriceStep    int64
+	MinTicketPrice  int64
+	MaxTicketPrice  int64
+	ForkSupply      int64
 
 	cfg    *types.Chain33Config
 	height int64
@@ -179,11 +253,111 @@ func GetPos33MineParam(cfg *types.Chain33Config, height int64) *Pos33MineParam {
 	c.BlockReward = conf.MGInt("blockReward", height) * cfg.GetCoinPrecision()
 	c.VoteReward = conf.MGInt("voteRewardPersent", height) * cfg.GetCoinPrecision() / 100
 	c.MineReward = conf.MGInt("mineRewardPersent", height) * cfg.GetCoinPrecision() / 100
+	c.SlashPersent = conf.MGInt("slashPersent", height)
+	c.SlashBountyPersent = conf.MGInt("slashBountyPersent", height)
+	c.JailBlocks = conf.MGInt("jailBlocks", height)
+	c.PerVoteReward = conf.MGInt("perVoteReward", height) * cfg.GetCoinPrecision() / 100
+	c.MakerBonus = conf.MGInt("makerBonus", height) * cfg.GetCoinPrecision() / 100
+	c.MaxCarryRounds = conf.MGInt("maxCarryRounds", height)
+	c.PriceEpoch = conf.MGInt("priceEpoch", height)
+	c.TargetBondRatio = conf.MGInt("targetBondRatio", height)
+	c.MaxPriceStep = conf.MGInt("maxPriceStep", height)
+	c.MinTicketPrice = conf.MGInt("minTicketPrice", height) * cfg.GetCoinPrecision()
+	c.MaxTicketPrice = conf.MGInt("maxTicketPrice", height) * cfg.GetCoinPrecision()
+	c.ForkSupply = conf.MGInt("forkSupply", height) * cfg.GetCoinPrecision()
 	c.cfg = cfg
 	c.height = height
 	return c
 }
 
+// GetPos33QuorumRule 获取height高度区块验证使用的投票数量规则, 在mver.consensus.pos33的quorumRule中配置.
+// ForkQuorumRule之前返回空, 使用默认的规则
+func GetPos33QuorumRule(cfg *types.Chain33Config, height int64) string {
+	if !cfg.IsDappFork(height, Pos33TicketX, "ForkQuorumRule") {
+		return ""
+	}
+	return types.Conf(cfg, "mver.consensus.pos33").MGStr("quorumRule", height)
+}
+
+// GetPos33SubCommittees 获取height高度的子委员会数量, 在mver.consensus.pos33的subCommittees中配置.
+// ForkSubCommittee之前和没有配置时是1, 最多Pos33MaxSubCommittees个
+func GetPos33SubCommittees(cfg *types.Chain33Config, height int64) int {
+	if !cfg.IsDappFork(height, Pos33TicketX, "ForkSubCommittee") {
+		return 1
+	}
+	num := int(types.Conf(cfg, "mver.consensus.pos33").MGInt("subCommittees", height))
+	if num <= 1 {
+		return 1
+	}
+	if num > Pos33MaxSubCommittees {
+		num = Pos33MaxSubCommittees
+	}
+	return num
+}
+
+// GetPos33AddressFormat 获取height高度抽签时从公钥推导矿工地址的格式, 在mver.consensus.pos33的addressFormat中配置.
+// ForkAddressFormat之前和没有配置时是eth
+func GetPos33AddressFormat(cfg *types.Chain33Config, height int64) string {
+	if !cfg.IsDappFork(height, Pos33TicketX, "ForkAddressFormat") {
+		return "eth"
+	}
+	format := types.Conf(cfg, "mver.consensus.pos33").MGStr("addressFormat", height)
+	if format == "" {
+		return "eth"
+	}
+	return format
+}
+
+// Pos33Participants 允许和禁止参与共识的地址名单
+type Pos33Participants struct {
+	allow map[string]bool
+	deny  map[string]bool
+}
+
+// GetPos33Participants 获取height高度的参与共识的地址名单, ForkParticipants之前返回nil, 不限制.
+// 名单在mver.consensus.pos33的allowList和denyList中配置, 可以按分叉高度修改
+func GetPos33Participants(cfg *types.Chain33Config, height int64) *Pos33Participants {
+	if !cfg.IsDappFork(height, Pos33TicketX, "ForkParticipants") {
+		return nil
+	}
+	conf := types.Conf(cfg, "mver.consensus.pos33")
+	p := &Pos33Participants{allow: make(map[string]bool), deny: make(map[string]bool)}
+	for _, addr := range conf.MGStrList("allowList", height) {
+		p.allow[addr] = true
+	}
+	for _, addr := range conf.MGStrList("denyList", height) {
+		p.deny[addr] = true
+	}
+	return p
+}
+
+// Lists 返回排序后的允许和禁止名单
+func (p *Pos33Participants) Lists() (allow, deny []string) {
+	if p == nil {
+		return nil, nil
+	}
+	for addr := range p.allow {
+		allow = append(allow, addr)
+	}
+	for addr := range p.deny {
+		deny = append(deny, addr)
+	}
+	sort.Strings(allow)
+	sort.Strings(deny)
+	return allow, deny
+}
+
+// Permit addr是否可以参与共识. 在denyList中的不可以, allowList不为空时只有名单中的可以
+func (p *Pos33Participants) Permit(addr string) bool {
+	if p == nil {
+		return true
+	}
+	if p.deny[addr] {
+		return false
+	}
+	return len(p.allow) == 0 || p.allow[addr]
+}
+
 func (mp *Pos33MineParam) ChangeTicketPrice() bool {
 	return mp.cfg.GetDappFork("pos33", "UseEntrust") == mp.height
 }
@@ -206,6 +380,28 @@ const (
 	Pos33CommitteeSize = 75
 	// Pos33MustVotes 必须达到的票数
 	Pos33MustVotes = 17
+	// Pos33MaxSubCommittees 子委员会的最大数量
+	Pos33MaxSubCommittees = 3
+)
+
+// Pos33SortitionEvent的类型
+const (
+	// Pos33EventWonSeats 自己中签, Count是中签的票数
+	Pos33EventWonSeats = iota + 1
+	// Pos33EventCommittee 委员会确定, Count是委员会的大小
+	Pos33EventCommittee
+	// Pos33EventVerifyFailed 抽签或者区块验证失败
+	Pos33EventVerifyFailed
+	// Pos33EventDropped 消费太慢, Count个事件被丢弃
+	Pos33EventDropped
+	// Pos33EventRound 超时进入新的一轮, Round是新的轮次
+	Pos33EventRound
+	// Pos33EventQuorum 区块的投票过半, Count是投票数
+	Pos33EventQuorum
+	// Pos33EventBlock 区块上链, Addr是出块人, Count是投票数
+	Pos33EventBlock
+	// Pos33EventDiff 下一个高度的抽签难度变化, Diff是新的难度
+	Pos33EventDiff
 )
 
 // Verify is verify msg
@@ -225,6 +421,88 @@ func (v *Pos33SortsVote) Sign(priv crypto.PrivKey) {
 	v.Sig = &types.Signature{Ty: types.SECP256K1, Pubkey: priv.PubKey().Bytes(), Signature: sig.Bytes()}
 }
 
+// Verify is verify no seats msg
+func (m *Pos33NoSeats) Verify() bool {
+	s := m.Sig
+	if s == nil || m.Proof == nil || string(s.Pubkey) != string(m.Proof.Pubkey) {
+		return false
+	}
+	m.Sig = nil
+	b := crypto.Sha256(types.Encode(m))
+	m.Sig = s
+	return types.CheckSign(b, "", s, m.Proof.Input.GetHeight())
+}
+
+// Sign is sign no seats msg
+func (m *Pos33NoSeats) Sign(priv crypto.PrivKey) {
+	m.Sig = nil
+	b := crypto.Sha256(types.Encode(m))
+	sig := priv.Sign(b)
+	m.Sig = &types.Signature{Ty: types.SECP256K1, Pubkey: priv.PubKey().Bytes(), Signature: sig.Bytes()}
+}
+
+// NewPos33ValidatorSet 生成规范的验证者集合: 去掉票数为0的地址, 按地址排序, 计算hash
+func NewPos33ValidatorSet(height, snapshotHeight, allCount int64, counts map[string]int64) *Pos33ValidatorSet {
+	vs := &Pos33ValidatorSet{Height: height, SnapshotHeight: snapshotHeight, AllCount: allCount}
+	for addr, count := range counts {
+		if count <= 0 {
+			continue
+		}
+		vs.Validators = append(vs.Validators, &Pos33Validator{Addr: addr, Count: count})
+	}
+	sort.Slice(vs.Validators, func(i, j int) bool { return vs.Validators[i].Addr < vs.Validators[j].Addr })
+	vs.Hash = vs.CalcHash()
+	return vs
+}
+
+// CalcHash 计算验证者集合的hash, 不包括Hash字段本身
+func (m *Pos33ValidatorSet) CalcHash() []byte {
+	h := m.Hash
+	m.Hash = nil
+	b := crypto.Sha256(types.Encode(m))
+	m.Hash = h
+	return b
+}
+
+// CalcHash 计算快照的hash, 不包括Hash字段本身
+func (m *Pos33ConsensusSnapshot) CalcHash() []byte {
+	h := m.Hash
+	m.Hash = nil
+	b := crypto.Sha256(types.Encode(m))
+	m.Hash = h
+	return b
+}
+
+// DiffPos33ValidatorSet 比较两个验证者集合, 返回不同之处
+func DiffPos33ValidatorSet(a, b *Pos33ValidatorSet) []string {
+	var ds []string
+	if a.SnapshotHeight != b.SnapshotHeight {
+		ds = append(ds, fmt.Sprintf("snapshotHeight: %d != %d", a.SnapshotHeight, b.SnapshotHeight))
+	}
+	if a.AllCount != b.AllCount {
+		ds = append(ds, fmt.Sprintf("allCount: %d != %d", a.AllCount, b.AllCount))
+	}
+	ma := make(map[string]int64)
+	for _, v := range a.Validators {
+		ma[v.Addr] = v.Count
+	}
+	mb := make(map[string]int64)
+	for _, v := range b.Validators {
+		mb[v.Addr] = v.Count
+	}
+	for _, v := range a.Validators {
+		if ma[v.Addr] != mb[v.Addr] {
+			ds = append(ds, fmt.Sprintf("%s: %d != %d", v.Addr, ma[v.Addr], mb[v.Addr]))
+		}
+	}
+	for _, v := range b.Validators {
+		if _, ok := ma[v.Addr]; !ok {
+			ds = append(ds, fmt.Sprintf("%s: %d != %d", v.Addr, 0, v.Count))
+		}
+	}
+	return ds
+}
+
 // Verify is verify vote msg
 func (v *Pos33VoteMsg) Verify() bool {
 	return types.CheckSign(v.Hash, Pos33TicketX, v.Sig, v.Sort.Proof.Input.Height)
@@ -248,31 +526,77 @@ func (act *Pos33TicketMiner) ToString() string {
 	return string(b)
 }
 
+// CompareSort 抽签的规范顺序: HashToBig(SortHash.Hash)小的在前,
+// hash相同时依次比较Pubkey, Num(子委员会), Index(第几张票). 所有节点的排序结果一致
+func CompareSort(a, b *Pos33SortMsg) int {
+	h1 := make([]byte, 32)
+	copy(h1, a.SortHash.Hash)
+	h2 := make([]byte, 32)
+	copy(h2, b.SortHash.Hash)
+	if c := difficulty.HashToBig(h1).Cmp(difficulty.HashToBig(h2)); c != 0 {
+		return c
+	}
+	if c := bytes.Compare(a.GetProof().GetPubkey(), b.GetProof().GetPubkey()); c != 0 {
+		return c
+	}
+	if a.SortHash.Num != b.SortHash.Num {
+		if a.SortHash.Num < b.SortHash.Num {
+			return -1
+		}
+		return 1
+	}
+	if a.SortHash.Index != b.SortHash.Index {
+		if a.SortHash.Index < b.SortHash.Index {
+			return -1
+		}
+		return 1
+	}
+	return 0
+}
+
 // Sorts is for sort []*Pos33SortMsg
 type Sorts []*Pos33SortMsg
 
-func (m Sorts) Len() int { return len(m) }
-func (m Sorts) Less(i, j int) bool {
-	h1 := make([]byte, 32)
-	copy(h1, m[i].SortHash.Hash)
-	h2 := make([]byte, 32)
-	copy(h2, m[j].SortHash.Hash)
-	return difficulty.HashToBig(h1).Cmp(difficulty.HashToBig(h2)) < 0
+func (m Sorts) Len() int           { return len(m) }
+func (m Sorts) Less(i, j int) bool { return CompareSort(m[i], m[j]) < 0 }
+func (m Sorts) Swap(i, j int)      { m[i], m[j] = m[j], m[i] }
+
+// DedupSorts 去掉同一个公钥, 子委员会和票重复的抽签, 保留第一个, 不改变顺序
+func DedupSorts(ss []*Pos33SortMsg) []*Pos33SortMsg {
+	type ticket struct {
+		pub   string
+		num   int32
+		index int64
+	}
+	seen := make(map[ticket]bool)
+	var r []*Pos33SortMsg
+	for _, s := range ss {
+		k := ticket{string(s.GetProof().GetPubkey()), s.SortHash.Num, s.SortHash.Index}
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		r = append(r, s)
+	}
+	return r
+}
+
+// CanonicalSorts 去重以后按CompareSort排序, k > 0时只返回前k个. 不修改ss
+func CanonicalSorts(ss []*Pos33SortMsg, k int) []*Pos33SortMsg {
+	r := DedupSorts(ss)
+	sort.Sort(Sorts(r))
+	if k > 0 && len(r) > k {
+		r = r[:k]
+	}
+	return r
 }
-func (m Sorts) Swap(i, j int) { m[i], m[j] = m[j], m[i] }
 
 // Votes is for sort []*Pos33SortMsg
 type Votes []*Pos33VoteMsg
 
-func (m Votes) Len() int { return len(m) }
-func (m Votes) Less(i, j int) bool {
-	h1 := make([]byte, 32)
-	copy(h1, m[i].Sort.SortHash.Hash)
-	h2 := make([]byte, 32)
-	copy(h2, m[j].Sort.SortHash.Hash)
-	return difficulty.HashToBig(h1).Cmp(difficulty.HashToBig(h2)) < 0
-}
-func (m Votes) Swap(i, j int) { m[i], m[j] = m[j], m[i] }
+func (m Votes) Len() int           { return len(m) }
+func (m Votes) Less(i, j int) bool { return CompareSort(m[i].Sort, m[j].Sort) < 0 }
+func (m Votes) Swap(i, j int)      { m[i], m[j] = m[j], m[i] }
 
 func Hash2BlsSk(hash []byte) crypto.PrivKey {
 	var h [32]byte
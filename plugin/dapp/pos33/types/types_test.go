@@ -5,17 +5,27 @@
 package types
 
 import (
+	"crypto/ecdsa"
 	"encoding/hex"
+	"errors"
+	"math/rand"
+	"strings"
 	"testing"
 
 	"github.com/33cn/chain33/common/crypto"
+	vrf "github.com/33cn/chain33/common/vrf/secp256k1"
 	rpctypes "github.com/33cn/chain33/rpc/types"
 	"github.com/33cn/chain33/types"
+	secp256k1 "github.com/btcsuite/btcd/btcec"
 	"github.com/stretchr/testify/assert"
+	"github.com/yccproject/ycc/plugin/dapp/pos33/verifier"
 )
 
+var testCfg *types.Chain33Config
+
 func init() {
-	InitExecutor(nil)
+	// 创建配置时注册执行器类型和分叉, local的分叉总是0, 不能设置
+	testCfg = types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), `Title="local"`, `Title="pos33test"`+"\nDisableForkCheck=true", 1))
 }
 
 func TestDecodeLogNewPos33Ticket(t *testing.T) {
@@ -79,3 +89,364 @@ func TestHash2BlsSk(t *testing.T) {
 		t.Error("diffrent hash must diffrent sk")
 	}
 }
+
+func TestCanonicalSorts(t *testing.T) {
+	newSort := func(h byte, index int64) *Pos33SortMsg {
+		hash := make([]byte, 32)
+		hash[31] = h
+		return &Pos33SortMsg{SortHash: &SortHash{Hash: hash, Index: index}, Proof: &HashProof{Pubkey: []byte("pub")}}
+	}
+	// hash相同的按index排序
+	want := []*Pos33SortMsg{newSort(1, 5), newSort(2, 3), newSort(2, 4), newSort(3, 0), newSort(9, 1)}
+	ss := append([]*Pos33SortMsg{}, want...)
+	for i := 0; i < 10; i++ {
+		rand.Shuffle(len(ss), func(i, j int) { ss[i], ss[j] = ss[j], ss[i] })
+		assert.Equal(t, want, CanonicalSorts(ss, 0))
+		assert.Equal(t, want[:3], CanonicalSorts(ss, 3))
+		assert.Equal(t, want, CanonicalSorts(ss, 10))
+	}
+
+	// 重复的票只保留第一个
+	dup := newSort(2, 3)
+	r := DedupSorts([]*Pos33SortMsg{want[1], want[0], dup, want[1]})
+	assert.Equal(t, 2, len(r))
+	assert.True(t, r[0] == want[1])
+	assert.Equal(t, want[:2], CanonicalSorts(append(ss, dup), 2))
+	assert.Equal(t, 5, len(CanonicalSorts(append(ss, dup), 0)))
+}
+
+func newTestKey(t *testing.T) crypto.PrivKey {
+	c, err := crypto.Load(types.GetSignName("", types.SECP256K1), -1)
+	assert.Nil(t, err)
+	priv, err := c.GenKey()
+	assert.Nil(t, err)
+	return priv
+}
+
+// newTestMaker priv在height高度round轮的出块抽签
+func newTestMaker(priv crypto.PrivKey, height int64, round int32) *Pos33SortMsg {
+	input := &VrfInput{Seed: []byte("seed"), Height: height, Round: round}
+	privKey, _ := secp256k1.PrivKeyFromBytes(secp256k1.S256(), priv.Bytes())
+	vrfHash, vrfProof := (&vrf.PrivateKey{PrivateKey: (*ecdsa.PrivateKey)(privKey)}).Evaluate(types.Encode(input))
+	proof := &HashProof{Input: input, VrfHash: vrfHash[:], VrfProof: vrfProof, Pubkey: priv.PubKey().Bytes()}
+	return &Pos33SortMsg{SortHash: &SortHash{Hash: verifier.SortHash(vrfHash[:], 0, 0)}, Proof: proof}
+}
+
+func TestEvidenceCheck(t *testing.T) {
+	cfg := testCfg
+	voter := newTestKey(t)
+	height := int64(100)
+	ma, mb := newTestMaker(newTestKey(t), height, 0), newTestMaker(newTestKey(t), height, 0)
+	makers := map[string]*Pos33SortMsg{string(ma.SortHash.Hash): ma, string(mb.SortHash.Hash): mb}
+
+	sort := &Pos33SortMsg{SortHash: &SortHash{Hash: []byte("seat")}, Proof: &HashProof{Input: &VrfInput{Height: height}}}
+	newEvidence := func(a, b *Pos33SortMsg, signer crypto.PrivKey) *Pos33Evidence {
+		va := &Pos33VoteMsg{Sort: sort, Hash: a.SortHash.Hash}
+		va.Sign(voter)
+		vb := &Pos33VoteMsg{Sort: sort, Hash: b.SortHash.Hash}
+		vb.Sign(signer)
+		e, err := NewPos33Evidence("offender", va, vb, makers)
+		assert.Nil(t, err)
+		return e
+	}
+	e := newEvidence(ma, mb, voter)
+	assert.Nil(t, e.Check(cfg))
+
+	// 两个投票不是同一个bls私钥签的
+	assert.Equal(t, ErrSlashEvidence, newEvidence(ma, mb, newTestKey(t)).Check(cfg))
+
+	// 没有出块抽签, 或者出块抽签不是这一轮的
+	mc := newTestMaker(newTestKey(t), height, 1)
+	makers[string(mc.SortHash.Hash)] = mc
+	assert.Equal(t, ErrSlashEvidence, newEvidence(ma, mc, voter).Check(cfg))
+	delete(makers, string(mb.SortHash.Hash))
+	assert.Equal(t, ErrSlashEvidence, newEvidence(ma, mb, voter).Check(cfg))
+
+	// 修改过的证据
+	e.Offender = "other"
+	assert.Equal(t, ErrSlashEvidence, e.Check(cfg))
+	e.Offender = "offender"
+	e.MakerA.SortHash.Index = 1
+	assert.NotNil(t, e.Check(cfg))
+	e.MakerA.SortHash.Index = 0
+	assert.Nil(t, e.Check(cfg))
+
+	// ForkVrfSuite以前的proof不能写明算法, 以后必须写明
+	e.MakerA.Proof.VrfSuite = verifier.SuiteSecp256k1SHA256
+	e.Hash = e.CalcHash()
+	assert.Equal(t, ErrSlashEvidence, e.Check(cfg))
+	cfg.SetDappFork(Pos33TicketX, "ForkVrfSuite", height)
+	assert.Equal(t, ErrSlashEvidence, e.Check(cfg))
+	e.MakerB.Proof.VrfSuite = verifier.SuiteSecp256k1SHA256
+	e.Hash = e.CalcHash()
+	assert.Nil(t, e.Check(cfg))
+}
+
+func TestChainParams(t *testing.T) {
+	assert.NotNil(t, (&Pos33ChainParam{Height: 100}).Check())
+	assert.NotNil(t, (&Pos33ChainParam{Height: 100, SortBlocks: Pos33SortBlocks + 1}).Check())
+	assert.NotNil(t, (&Pos33ChainParam{Height: 100, SortBlocks: Pos33MinSortBlocks - 1}).Check())
+	assert.NotNil(t, (&Pos33ChainParam{Height: 100, CommitteeSize: -1}).Check())
+	assert.Nil(t, (&Pos33ChainParam{Height: 100, CommitteeSize: 150}).Check())
+
+	ps := &Pos33ChainParams{}
+	assert.Equal(t, DefaultPos33ChainParam(), ps.At(1000))
+
+	// 为0的参数沿用之前的值
+	_, err := ps.Add(&Pos33ChainParam{Height: 100, CommitteeSize: 150})
+	assert.Nil(t, err)
+	np, err := ps.Add(&Pos33ChainParam{Height: 200, SortBlocks: Pos33MinSortBlocks})
+	assert.Nil(t, err)
+	assert.Equal(t, &Pos33ChainParam{Height: 200, CommitteeSize: 150, DiffPersent: 100, SortBlocks: Pos33MinSortBlocks}, np)
+	// 生效高度必须比已有的高
+	_, err = ps.Add(&Pos33ChainParam{Height: 150, DiffPersent: 50})
+	assert.NotNil(t, err)
+
+	assert.Equal(t, DefaultPos33ChainParam(), ps.At(99))
+	assert.Equal(t, int32(150), ps.At(100).CommitteeSize)
+	assert.Equal(t, int32(Pos33SortBlocks), ps.At(199).SortBlocks)
+	assert.Equal(t, int32(Pos33MinSortBlocks), ps.At(200).SortBlocks)
+	assert.Equal(t, float64(150)/300, ps.At(100).Diff(300))
+	assert.Equal(t, float64(Pos33CommitteeSize)/300/2, (&Pos33ChainParam{CommitteeSize: Pos33CommitteeSize, DiffPersent: 50}).Diff(300))
+	// 默认参数的难度和以前的计算完全一样
+	for _, all := range []int{1, 45, 300, 199999} {
+		assert.Equal(t, float64(Pos33CommitteeSize)/float64(all), DefaultPos33ChainParam().Diff(all))
+	}
+}
+
+func TestAggVoteBitmap(t *testing.T) {
+	a := NewPos33AggVote([]int64{9, 0, 3}, [][]byte{[]byte("pk")}, []byte("sig"))
+	assert.Equal(t, []byte{0x09, 0x02}, a.Bitmap)
+	assert.Equal(t, []int64{0, 3, 9}, a.Indices())
+	assert.Nil(t, NewPos33AggVote(nil, nil, nil).Indices())
+
+	m := &Pos33MinerMsg{Agg: a}
+	assert.Equal(t, 4, m.VoterCount())
+	reg := [][]byte{[]byte("pk0"), nil, nil, []byte("pk3"), nil, nil, nil, nil, nil, []byte("pk9")}
+	lookup := func(is []int64) ([][]byte, error) {
+		var pks [][]byte
+		for _, i := range is {
+			pks = append(pks, reg[i])
+		}
+		return pks, nil
+	}
+	assert.Nil(t, m.Expand(lookup))
+	assert.Equal(t, [][]byte{[]byte("pk0"), []byte("pk3"), []byte("pk9"), []byte("pk")}, m.BlsPkList)
+	assert.Equal(t, []byte("sig"), m.BlsSig)
+
+	// 不能同时有公钥列表和聚合投票
+	assert.NotNil(t, m.Expand(lookup))
+	// 查到的公钥数量不对
+	m = &Pos33MinerMsg{Agg: a}
+	assert.NotNil(t, m.Expand(func(is []int64) ([][]byte, error) { return nil, nil }))
+}
+
+func TestDelegate(t *testing.T) {
+	assert.Nil(t, (&Pos33Delegate{Operator: "op", Amount: 1}).Check())
+	assert.NotNil(t, (&Pos33Delegate{Amount: 1}).Check())
+	assert.NotNil(t, (&Pos33Delegate{Operator: "op"}).Check())
+	assert.NotNil(t, (&Pos33Undelegate{Operator: "op", Amount: -1}).Check())
+	var d *Pos33Delegate
+	assert.NotNil(t, d.Check())
+
+	// 不足一张票的委托也按数量分到奖励
+	price := int64(10000 * 1e8)
+	total := 3*price + price/10
+	reward := int64(3 * 1e8)
+	assert.Equal(t, reward*100/3100, DelegatorShare(reward, price/10, total))
+	assert.Equal(t, reward*3000/3100, DelegatorShare(reward, 3*price, total))
+	assert.Equal(t, reward, DelegatorShare(reward, total, total))
+	assert.Equal(t, int64(0), DelegatorShare(reward, 0, total))
+	assert.Equal(t, int64(0), DelegatorShare(reward, price, 0))
+}
+
+func TestLivenessJailable(t *testing.T) {
+	l := &Pos33Liveness{Address: "miner", LastActive: 100}
+	assert.Equal(t, int64(0), l.Missed(50))
+	assert.Equal(t, int64(900), l.Missed(1000))
+
+	all := int64(1000)
+	assert.NotNil(t, l.Jailable(1000, 0, 100, all))
+	// 没有参与的高度不够
+	assert.NotNil(t, l.Jailable(1000, 1000, 100, all))
+	// 期望的投票数 900*25*100/1000
+	assert.Nil(t, l.Jailable(1000, 900, 100, all))
+	// 抵押太少的可能只是没有中签
+	assert.NotNil(t, l.Jailable(1000, 900, 0, all))
+	assert.NotNil(t, l.Jailable(1000, 900, 100, all*1000))
+	assert.NotNil(t, (&Pos33Liveness{LastActive: 100, Jailed: true}).Jailable(1000, 900, 100, all))
+}
+
+func TestMinerRewardV2(t *testing.T) {
+	coin := int64(1e8)
+	mp := &Pos33MineParam{BlockReward: 15 * coin, PerVoteReward: coin * 36 / 100, MakerBonus: coin, MaxCarryRounds: 3}
+
+	r, err := mp.MinerRewardV2(Pos33VoterSize, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, &Pos33MinerReward{VoteReward: mp.PerVoteReward, Votes: Pos33VoterSize, MakerReward: coin, FundReward: 15*coin - 9*coin - coin}, r)
+	assert.Nil(t, r.Check(r))
+
+	// 超时2轮, 出块奖励累积3份, 最多累积MaxCarryRounds轮
+	r, err = mp.MinerRewardV2(Pos33VoterSize, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), r.CarryRounds)
+	assert.Equal(t, 3*coin, r.MakerReward)
+	r, err = mp.MinerRewardV2(Pos33VoterSize, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), r.CarryRounds)
+	assert.Equal(t, 4*coin, r.MakerReward)
+	assert.Equal(t, mp.BlockReward, r.VoteReward*r.Votes+r.MakerReward+r.FundReward)
+
+	// 出块奖励不超过投票以后剩下的
+	mp.MaxCarryRounds = 10
+	r, err = mp.MinerRewardV2(Pos33VoterSize, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 6*coin, r.MakerReward)
+	assert.Equal(t, int64(0), r.FundReward)
+
+	_, err = mp.MinerRewardV2(100, 0)
+	assert.True(t, errors.Is(err, ErrMinerReward))
+	_, err = mp.MinerRewardV2(Pos33VoterSize, -1)
+	assert.NotNil(t, err)
+
+	// 声明的奖励任何一项不同都不对
+	expect, _ := mp.MinerRewardV2(Pos33VoterSize, 1)
+	claim := types.Clone(expect).(*Pos33MinerReward)
+	claim.FundReward++
+	assert.True(t, errors.Is(claim.Check(expect), ErrMinerReward))
+	claim = types.Clone(expect).(*Pos33MinerReward)
+	claim.CarryRounds = 0
+	assert.NotNil(t, claim.Check(expect))
+	var none *Pos33MinerReward
+	assert.NotNil(t, none.Check(expect))
+}
+
+func TestSortStats(t *testing.T) {
+	var rs []*Pos33SortRecord
+	var prev *Pos33SortRecord
+	for h, round := range []int32{0, 0, 2, 0, 1, 20} {
+		r := &Pos33SortRecord{Height: int64(h + 10), Round: round, Votes: int64(10 + h)}
+		r.Accumulate(prev)
+		rs = append(rs, r)
+		prev = r
+	}
+	last := rs[len(rs)-1]
+	assert.Equal(t, int64(6), last.Blocks)
+	assert.Equal(t, int64(10+11+12+13+14+15), last.TotalVotes)
+	assert.Equal(t, []int64{3, 1, 1, 0, 0, 0, 0, 1}, last.RoundHist)
+
+	// 从开始索引到最后
+	s, err := SortStatsBetween(10, nil, last)
+	assert.Nil(t, err)
+	assert.Equal(t, &Pos33SortStats{Start: 10, End: 15, Blocks: 6, AvgVotes: 12.5, AvgRound: 23.0 / 6, RoundHist: last.RoundHist}, s)
+
+	// 高度12到14
+	s, err = SortStatsBetween(12, rs[1], rs[4])
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), s.Blocks)
+	assert.Equal(t, float64(13), s.AvgVotes)
+	assert.Equal(t, float64(1), s.AvgRound)
+	assert.Equal(t, []int64{1, 1, 1, 0, 0, 0, 0, 0}, s.RoundHist)
+
+	_, err = SortStatsBetween(12, rs[4], rs[1])
+	assert.True(t, errors.Is(err, ErrSortIndex))
+	_, err = SortStatsBetween(12, nil, nil)
+	assert.True(t, errors.Is(err, ErrSortIndex))
+}
+
+func TestNextTicketPrice(t *testing.T) {
+	coin := int64(1e8)
+	mp := &Pos33MineParam{BlockReward: 15 * coin, PriceEpoch: 100, TargetBondRatio: 50, MaxPriceStep: 10, MinTicketPrice: 5000 * coin, MaxTicketPrice: 150000 * coin}
+	prev := &Pos33TicketPrice{Price: 100000 * coin, Height: 1000, Supply: 1e9*coin - 1500*coin}
+
+	// 发行量加上中间的区块奖励, 抵押率等于目标时不变
+	p, err := mp.NextTicketPrice(prev, 5e8*coin, 1100)
+	assert.Nil(t, err)
+	assert.Equal(t, &Pos33TicketPrice{Price: prev.Price, Epoch: 1, Height: 1100, Supply: 1e9 * coin, Staked: 5e8 * coin, BondRatio: 5000}, p)
+
+	// 抵押率60%涨价2%, 抵押率25%降价5%
+	p, err = mp.NextTicketPrice(prev, 6e8*coin, 1100)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(6000), p.BondRatio)
+	assert.Equal(t, 102000*coin, p.Price)
+	p, err = mp.NextTicketPrice(prev, 2.5e8*coin, 1100)
+	assert.Nil(t, err)
+	assert.Equal(t, 95000*coin, p.Price)
+
+	// 每次最多调整MaxPriceStep%, 不超出票价的范围
+	p, err = mp.NextTicketPrice(prev, 0, 1100)
+	assert.Nil(t, err)
+	assert.Equal(t, 90000*coin, p.Price)
+	p, err = mp.NextTicketPrice(prev, 1e9*coin, 1100)
+	assert.Nil(t, err)
+	assert.Equal(t, 110000*coin, p.Price)
+	p, err = mp.NextTicketPrice(&Pos33TicketPrice{Price: 140000 * coin, Height: 1000, Supply: 1e9 * coin}, 1e9*coin, 1100)
+	assert.Nil(t, err)
+	assert.Equal(t, mp.MaxTicketPrice, p.Price)
+	p, err = mp.NextTicketPrice(&Pos33TicketPrice{Price: 5100 * coin, Height: 1000, Supply: 1e9 * coin}, 0, 1100)
+	assert.Nil(t, err)
+	assert.Equal(t, mp.MinTicketPrice, p.Price)
+
+	// 抵押超过发行量按100%算, 没有发行量时不调整
+	p, err = mp.NextTicketPrice(&Pos33TicketPrice{Price: prev.Price, Height: 1000, Supply: 1}, 1e9*coin, 1001)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(Pos33BondRatioBase), p.BondRatio)
+	p, err = mp.NextTicketPrice(&Pos33TicketPrice{Price: prev.Price, Height: 1000}, 1e9*coin, 1001)
+	assert.Nil(t, err)
+	assert.Equal(t, prev.Price, p.Price)
+
+	_, err = mp.NextTicketPrice(prev, 0, 1000)
+	assert.True(t, errors.Is(err, ErrTicketPrice))
+	_, err = mp.NextTicketPrice(nil, 0, 1100)
+	assert.NotNil(t, err)
+	mp.TargetBondRatio = 0
+	_, err = mp.NextTicketPrice(prev, 0, 1100)
+	assert.True(t, errors.Is(err, ErrTicketPrice))
+}
+
+func TestKeyRotate(t *testing.T) {
+	priv := newTestKey(t)
+	k := &Pos33KeyRotate{Staker: "staker", Height: 100}
+	assert.NotNil(t, k.Check())
+	k.Sign(priv)
+	assert.Nil(t, k.Check())
+	// 签名的是staker和height, 别人不能拿去绑定自己的地址
+	k.Staker = "other"
+	assert.NotNil(t, k.Check())
+	assert.NotNil(t, (*Pos33KeyRotate)(nil).Check())
+
+	ck := &Pos33ConsensusKey{Staker: "staker", Height: 100, Revoke: 200}
+	o := &Pos33KeyOwner{Key: ck}
+	assert.Equal(t, "self", o.Owner("self", 99))
+	assert.Equal(t, "staker", o.Owner("self", 100))
+	assert.Equal(t, "self", o.Owner("self", 200))
+	assert.Equal(t, "self", (*Pos33KeyOwner)(nil).Owner("self", 100))
+
+	// 抵押地址换了公钥以后, 自己的公钥不能再抽签
+	o = &Pos33KeyOwner{Staker: &Pos33StakerKey{Staker: "self", SelfRevoke: 100}}
+	assert.Equal(t, "self", o.Owner("self", 99))
+	assert.Equal(t, "", o.Owner("self", 100))
+}
+
+func TestTicketCommitment(t *testing.T) {
+	counts := map[string]int64{"a": 1, "b": 2, "c": 3, "zero": 0}
+	vs := NewPos33ValidatorSet(100, 90, 6, counts)
+	for _, v := range vs.Validators {
+		b, err := vs.TicketBranch(v.Addr)
+		assert.Nil(t, err)
+		assert.Equal(t, vs.TicketRoot(), b.Root())
+	}
+	_, err := vs.TicketBranch("zero")
+	assert.NotNil(t, err)
+
+	c := NewPos33TicketCommitment(vs, []byte("seed"), []float64{0.1, 0.15}, 1)
+	assert.Equal(t, 0.1, c.Diff(0))
+	assert.Equal(t, 0.15, c.Diff(1))
+	// 更高的轮次使用最后一个
+	assert.Equal(t, 0.15, c.Diff(5))
+	assert.Nil(t, c.Check(c.Hash))
+	assert.NotNil(t, c.Check([]byte("other")))
+	c.AllCount++
+	assert.NotNil(t, c.Check(c.Hash))
+	assert.NotNil(t, (*Pos33TicketCommitment)(nil).Check(nil))
+}
@@ -0,0 +1,216 @@
+// Package verifier 无状态的抽签验证: vrf验证, 抽签hash, 难度比较.
+// 不依赖chain33节点和状态, 可以编译到 GOOS=js GOARCH=wasm, 用于轻客户端验证
+package verifier
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/33cn/chain33/common/difficulty"
+	vrf "github.com/33cn/chain33/common/vrf/secp256k1"
+	secp256k1 "github.com/btcsuite/btcd/btcec"
+)
+
+var big1 = big.NewInt(1)
+var max = big1.Lsh(big1, 256)
+var fmax = big.NewFloat(0).SetInt(max) // 2^^256
+
+// SuiteSecp256k1SHA256 当前支持的vrf算法: secp256k1曲线, SHA256
+const SuiteSecp256k1SHA256 = "secp256k1-SHA256"
+
+// ProofSize vrf proof的长度: s(32) + t(32) + 非压缩的点(65)
+const ProofSize = 64 + 65
+
+var (
+	// ErrProofSize vrf proof或者vrf hash的长度/格式不对
+	ErrProofSize = errors.New("invalid VRF proof size")
+	// ErrVrfHash vrf hash和proof不一致
+	ErrVrfHash = errors.New("invalid VRF hash")
+	// ErrSortHash 抽签hash和vrf hash不一致
+	ErrSortHash = errors.New("sort hash NOT match")
+	// ErrNotWin 抽签hash超过了难度的阈值
+	ErrNotWin = errors.New("sort hash NOT win")
+	// ErrSuite 不支持的vrf算法
+	ErrSuite = errors.New("unsupported VRF suite")
+)
+
+// CheckSuite 检查proof的vrf算法. tagged表示ForkVrfSuite以后的proof, 必须写明算法;
+// 以前的proof不能写, 都是SuiteSecp256k1SHA256. 这里只能验证SuiteSecp256k1SHA256
+func CheckSuite(suite string, tagged bool) error {
+	if !tagged {
+		if suite != "" {
+			return fmt.Errorf("%w: %s before ForkVrfSuite", ErrSuite, suite)
+		}
+		return nil
+	}
+	if suite != SuiteSecp256k1SHA256 {
+		return fmt.Errorf("%w: %q", ErrSuite, suite)
+	}
+	return nil
+}
+
+// CheckPubKey 不依赖解析器, 显式检查公钥不是无穷远点, 并且在secp256k1曲线上
+func CheckPubKey(pk *ecdsa.PublicKey) error {
+	if pk == nil || pk.X == nil || pk.Y == nil {
+		return errors.New("vrf pubkey is nil")
+	}
+	if pk.X.Sign() == 0 && pk.Y.Sign() == 0 {
+		return errors.New("vrf pubkey is the point at infinity")
+	}
+	if !secp256k1.S256().IsOnCurve(pk.X, pk.Y) {
+		return errors.New("vrf pubkey is NOT on curve")
+	}
+	return nil
+}
+
+// CheckProof 只检查proof和hash的长度和格式, 很快, 在ProofToHash之前拒绝畸形的消息
+func CheckProof(proof, hash []byte) error {
+	if len(proof) != ProofSize || proof[64] != 4 || len(hash) != sha256.Size {
+		return ErrProofSize
+	}
+	return nil
+}
+
+// VerifyVrf 验证input的vrf proof和hash.
+// ProofToHash中固定基点的[t]G使用btcec的ScalarBaseMult, btcec在S256()初始化时已经加载了G的预计算表,
+// vrf库在包初始化时调用S256(), 所以不需要也没有办法再配置. 其余三次是公钥, H1(m)和vrf点的
+// 变基点乘法, 每次的点都不同, vrf库也没有替换曲线运算的接口. 见BenchmarkScalarBaseMult
+// vrf库没有批量验证: 验证是比较s和H2(..., [t+ks]G, [t+ks]H), 重新计算的点要先计算hash,
+// 不能像签名一样用随机线性组合合并多个验证方程, 所以同一个input的多个proof也只能逐个验证.
+// 重复的验证由node的vrfMemo缓存
+func VerifyVrf(pub, input, proof, hash []byte) error {
+	err := CheckProof(proof, hash)
+	if err != nil {
+		return err
+	}
+	vrfHash, err := ProofToHash(pub, input, proof)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(vrfHash, hash) {
+		return ErrVrfHash
+	}
+	return nil
+}
+
+// ProofToHash 验证input的vrf proof, 返回proof对应的vrf hash
+func ProofToHash(pub, input, proof []byte) ([]byte, error) {
+	pubKey, err := secp256k1.ParsePubKey(pub, secp256k1.S256())
+	if err != nil {
+		return nil, err
+	}
+	err = CheckPubKey((*ecdsa.PublicKey)(pubKey))
+	if err != nil {
+		return nil, err
+	}
+	vrfPub := &vrf.PublicKey{PublicKey: (*ecdsa.PublicKey)(pubKey)}
+	vrfHash, err := vrfPub.ProofToHash(input, proof)
+	if err != nil {
+		return nil, err
+	}
+	return vrfHash[:], nil
+}
+
+func hash2(data []byte) []byte {
+	h := sha256.Sum256(data)
+	h = sha256.Sum256(h[:])
+	return h[:]
+}
+
+// SortHash 第index张票在num子委员会的抽签hash
+func SortHash(vrfHash []byte, index, num int) []byte {
+	var buf [128]byte
+	return hash2(sortHashInput(buf[:0], vrfHash, index, num))
+}
+
+// sortHashInput 把抽签hash的输入追加到dst, 和fmt.Sprintf("%x+%d+%d", vrfHash, index, num)完全相同.
+// 每张票都要计算, 不使用fmt减少分配
+func sortHashInput(dst, vrfHash []byte, index, num int) []byte {
+	n := len(dst)
+	dst = append(dst, make([]byte, hex.EncodedLen(len(vrfHash)))...)
+	hex.Encode(dst[n:], vrfHash)
+	dst = append(dst, '+')
+	dst = strconv.AppendInt(dst, int64(index), 10)
+	dst = append(dst, '+')
+	return strconv.AppendInt(dst, int64(num), 10)
+}
+
+// Win 抽签hash在diff下是否中签
+func Win(hash []byte, diff float64) bool {
+	tmpHash := make([]byte, len(hash))
+	copy(tmpHash, hash)
+
+	// 转为big.Float计算，比较难度diff
+	y := difficulty.HashToBig(tmpHash)
+	z := new(big.Float).SetInt(y)
+	return new(big.Float).Quo(z, fmax).Cmp(big.NewFloat(diff)) <= 0
+}
+
+// WinProbability 一张票在diff下中签的概率. 抽签hash/max在[0, 1]上均匀分布, hash/max <= diff时中签
+func WinProbability(diff float64) float64 {
+	if diff <= 0 {
+		return 0
+	}
+	if diff >= 1 {
+		return 1
+	}
+	return diff
+}
+
+// ExpectedWinners count张票在diff下中签数量的期望, 每张票独立抽签, 中签数量是Binomial(count, diff)
+func ExpectedWinners(count int, diff float64) float64 {
+	if count <= 0 {
+		return 0
+	}
+	return float64(count) * WinProbability(diff)
+}
+
+// Threshold diff对应的阈值整数, HashToBig(hash) <= 阈值时中签, 和Win一致
+func Threshold(diff float64) *big.Int {
+	if diff <= 0 {
+		return new(big.Int)
+	}
+	thr, _ := new(big.Float).Mul(big.NewFloat(diff), fmax).Int(nil)
+	return thr
+}
+
+// Margin 返回hash在diff下是否中签, 以及hash和阈值的比值.
+// 比值<=1中签, 例如1.0001表示比阈值大了万分之一
+func Margin(hash []byte, diff float64) (bool, float64) {
+	tmpHash := make([]byte, len(hash))
+	copy(tmpHash, hash)
+	y := difficulty.HashToBig(tmpHash)
+	thr := Threshold(diff)
+	win := y.Cmp(thr) <= 0
+	if thr.Sign() == 0 {
+		if y.Sign() == 0 {
+			return win, 0
+		}
+		return win, math.Inf(1)
+	}
+	ratio, _ := new(big.Float).Quo(new(big.Float).SetInt(y), new(big.Float).SetInt(thr)).Float64()
+	return win, ratio
+}
+
+// VerifySort 无状态地验证一个抽签: vrf, 抽签hash, 难度.
+// input是编码后的VrfInput, diff由调用者根据票数计算
+func VerifySort(pub, input, proof, vrfHash, sortHash []byte, index, num int, diff float64) error {
+	err := VerifyVrf(pub, input, proof, vrfHash)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(SortHash(vrfHash, index, num), sortHash) {
+		return ErrSortHash
+	}
+	if !Win(sortHash, diff) {
+		return ErrNotWin
+	}
+	return nil
+}
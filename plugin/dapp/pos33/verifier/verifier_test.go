@@ -0,0 +1,232 @@
+package verifier
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	vrf "github.com/33cn/chain33/common/vrf/secp256k1"
+	secp256k1 "github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKey(t testing.TB) *vrf.PrivateKey {
+	priv, err := secp256k1.NewPrivateKey(secp256k1.S256())
+	require.Nil(t, err)
+	return &vrf.PrivateKey{PrivateKey: (*ecdsa.PrivateKey)(priv)}
+}
+
+func pubBytes(k *vrf.PrivateKey) []byte {
+	return (*secp256k1.PublicKey)(&k.PublicKey).SerializeCompressed()
+}
+
+// bigToHash HashToBig的逆运算
+func bigToHash(y *big.Int) []byte {
+	b := y.FillBytes(make([]byte, 32))
+	for i := 0; i < len(b)/2; i++ {
+		b[i], b[len(b)-1-i] = b[len(b)-1-i], b[i]
+	}
+	return b
+}
+
+func TestCheckPubKey(t *testing.T) {
+	require.NotNil(t, CheckPubKey(nil))
+	require.NotNil(t, CheckPubKey(&ecdsa.PublicKey{X: new(big.Int), Y: new(big.Int)}))
+	require.NotNil(t, CheckPubKey(&ecdsa.PublicKey{X: big.NewInt(1), Y: big.NewInt(1)}))
+	k := newTestKey(t)
+	require.Nil(t, CheckPubKey(&k.PublicKey))
+}
+
+func TestCheckProof(t *testing.T) {
+	k := newTestKey(t)
+	vrfHash, proof := k.Evaluate([]byte("input"))
+	require.Nil(t, CheckProof(proof, vrfHash[:]))
+	require.Equal(t, ProofSize, len(proof))
+
+	require.Equal(t, ErrProofSize, CheckProof(proof[:ProofSize-1], vrfHash[:]))
+	require.Equal(t, ErrProofSize, CheckProof(append(proof, 0), vrfHash[:]))
+	require.Equal(t, ErrProofSize, CheckProof(proof, vrfHash[:31]))
+	bad := append([]byte{}, proof...)
+	bad[64] = 2 // 非压缩的点以04开头
+	require.Equal(t, ErrProofSize, CheckProof(bad, vrfHash[:]))
+	require.Equal(t, ErrProofSize, VerifyVrf(pubBytes(k), []byte("input"), bad, vrfHash[:]))
+}
+
+func TestVerifySort(t *testing.T) {
+	k := newTestKey(t)
+	input := []byte("encoded vrf input")
+	vrfHash, proof := k.Evaluate(input)
+	pub := pubBytes(k)
+
+	var hash []byte
+	index := -1
+	for i := 0; i < 100; i++ {
+		hash = SortHash(vrfHash[:], i, 0)
+		if Win(hash, 0.5) {
+			index = i
+			break
+		}
+	}
+	require.NotEqual(t, -1, index)
+	require.Nil(t, VerifySort(pub, input, proof, vrfHash[:], hash, index, 0, 0.5))
+
+	require.Equal(t, ErrSortHash, VerifySort(pub, input, proof, vrfHash[:], hash, index, 1, 0.5))
+	require.Equal(t, ErrNotWin, VerifySort(pub, input, proof, vrfHash[:], hash, index, 0, 0))
+	require.NotNil(t, VerifySort(pub, []byte("other input"), proof, vrfHash[:], hash, index, 0, 0.5))
+	require.NotNil(t, VerifySort(pubBytes(newTestKey(t)), input, proof, vrfHash[:], hash, index, 0, 0.5))
+	require.NotNil(t, VerifySort(append([]byte{0x04}, make([]byte, 64)...), input, proof, vrfHash[:], hash, index, 0, 0.5))
+}
+
+func TestMargin(t *testing.T) {
+	diff := 0.01
+	thr := Threshold(diff)
+
+	win, ratio := Margin(bigToHash(thr), diff)
+	require.True(t, win)
+	require.Equal(t, 1., ratio)
+
+	below := new(big.Int).Sub(thr, big.NewInt(1))
+	win, ratio = Margin(bigToHash(below), diff)
+	require.True(t, win)
+	require.True(t, ratio <= 1)
+
+	above := new(big.Int).Add(thr, big.NewInt(1))
+	win, ratio = Margin(bigToHash(above), diff)
+	require.False(t, win)
+	require.True(t, ratio >= 1)
+
+	// 比阈值大万分之一
+	over := new(big.Int).Div(new(big.Int).Mul(thr, big.NewInt(10001)), big.NewInt(10000))
+	win, ratio = Margin(bigToHash(over), diff)
+	require.False(t, win)
+	require.InDelta(t, 1.0001, ratio, 1e-9)
+
+	win, ratio = Margin(bigToHash(big.NewInt(0)), 0)
+	require.True(t, win)
+	require.Equal(t, 0., ratio)
+	win, _ = Margin(bigToHash(big.NewInt(1)), 0)
+	require.False(t, win)
+
+	// 和Win的结果一致
+	for _, h := range [][]byte{bigToHash(thr), bigToHash(below), bigToHash(above)} {
+		win, _ := Margin(h, diff)
+		require.Equal(t, win, Win(h, diff))
+	}
+	for i := 0; i < 1000; i++ {
+		h := SortHash([]byte(fmt.Sprintf("vrf hash %d", i)), i, 0)
+		win, _ := Margin(h, 0.5)
+		require.Equal(t, win, Win(h, 0.5))
+	}
+}
+
+// TestScalarBaseMultTable 使用预计算表的ScalarBaseMult和通用的ScalarMult(G)结果相同
+func TestScalarBaseMultTable(t *testing.T) {
+	c := secp256k1.S256()
+	for i := 0; i < 16; i++ {
+		k := newTestKey(t).D.Bytes()
+		x1, y1 := c.ScalarBaseMult(k)
+		x2, y2 := c.ScalarMult(c.Gx, c.Gy, k)
+		require.Equal(t, 0, x1.Cmp(x2))
+		require.Equal(t, 0, y1.Cmp(y2))
+	}
+}
+
+// BenchmarkScalarBaseMult 比较ProofToHash中的点乘: 预计算表的固定基点, 没有表的G, 以及整个VerifyVrf.
+// 预计算表是btcec初始化时加载的, 内存大约32*256*3*40字节(约1MB).
+// 本机结果: table约60us, notable约170us, verify约710us, 变基点乘法是VerifyVrf的主要开销
+func BenchmarkScalarBaseMult(b *testing.B) {
+	c := secp256k1.S256()
+	k := newTestKey(b)
+	scalar := k.D.Bytes()
+	b.Run("table", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			c.ScalarBaseMult(scalar)
+		}
+	})
+	b.Run("notable", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			c.ScalarMult(c.Gx, c.Gy, scalar)
+		}
+	})
+	input := []byte("bench vrf input")
+	vrfHash, proof := k.Evaluate(input)
+	pub := pubBytes(k)
+	b.Run("verify", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			VerifyVrf(pub, input, proof, vrfHash[:])
+		}
+	})
+}
+
+// TestSortHashInput 抽签hash的输入和以前的fmt.Sprintf完全相同, 否则是共识的改变
+func TestSortHashInput(t *testing.T) {
+	hashes := [][]byte{nil, {}, {0}, {0x0f, 0xf0}}
+	for i := 0; i < 64; i++ {
+		h := sha256.Sum256([]byte{byte(i)})
+		hashes = append(hashes, h[:], h[:i%32])
+	}
+	ints := []int{0, 1, 9, 10, 99, 100, 1234567, -1, -10, math.MaxInt32, math.MinInt32, math.MaxInt64, math.MinInt64}
+	for _, h := range hashes {
+		for _, index := range ints {
+			for _, num := range ints {
+				want := fmt.Sprintf("%x+%d+%d", h, index, num)
+				require.Equal(t, want, string(sortHashInput(nil, h, index, num)))
+				require.Equal(t, hash2([]byte(want)), SortHash(h, index, num))
+			}
+		}
+	}
+	// 追加到已有的内容后面
+	require.Equal(t, "ab0102+3+4", string(sortHashInput([]byte("ab"), []byte{1, 2}, 3, 4)))
+}
+
+// BenchmarkSortHash 比较抽签hash的输入使用fmt.Sprintf和直接拼接.
+// 本机结果: sprintf约580ns 4次分配, append约350ns 1次分配(返回的hash)
+func BenchmarkSortHash(b *testing.B) {
+	h := sha256.Sum256([]byte("bench sort hash"))
+	vrfHash := h[:]
+	b.Run("sprintf", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			hash2([]byte(fmt.Sprintf("%x+%d+%d", vrfHash, i%75, 0)))
+		}
+	})
+	b.Run("append", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			SortHash(vrfHash, i%75, 0)
+		}
+	})
+}
+
+func TestExpectedWinners(t *testing.T) {
+	require.Equal(t, float64(0), WinProbability(-0.1))
+	require.Equal(t, float64(0), WinProbability(0))
+	require.Equal(t, 0.25, WinProbability(0.25))
+	require.Equal(t, float64(1), WinProbability(1.5))
+
+	require.Equal(t, float64(10), ExpectedWinners(100, 0.1))
+	require.Equal(t, float64(25), ExpectedWinners(1000, 0.025))
+	// 全网75000张票选75个委员会成员时, 10张票期望0.01个座位
+	require.InDelta(t, 0.01, ExpectedWinners(10, 75.0/75000), 1e-12)
+	require.Equal(t, float64(10), ExpectedWinners(10, 2))
+	require.Equal(t, float64(0), ExpectedWinners(0, 0.5))
+	require.Equal(t, float64(0), ExpectedWinners(-1, 0.5))
+
+	// 和逐票抽签的平均数一致
+	diff := 0.2
+	wins := 0
+	count := 20000
+	for i := 0; i < count; i++ {
+		h := sha256.Sum256([]byte(fmt.Sprintf("expected winners %d", i)))
+		if Win(SortHash(h[:], i, 0), diff) {
+			wins++
+		}
+	}
+	require.InDelta(t, ExpectedWinners(count, diff), float64(wins), 4*math.Sqrt(float64(count)*diff*(1-diff)))
+}
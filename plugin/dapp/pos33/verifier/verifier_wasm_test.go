@@ -0,0 +1,22 @@
+//go:build js && wasm
+// +build js,wasm
+
+package verifier
+
+import "testing"
+
+// TestWasmSmoke 在 GOOS=js GOARCH=wasm 下运行:
+// GOOS=js GOARCH=wasm go test -exec="$(go env GOROOT)/lib/wasm/go_js_wasm_exec" .
+func TestWasmSmoke(t *testing.T) {
+	k := newTestKey(t)
+	input := []byte("wasm smoke")
+	vrfHash, proof := k.Evaluate(input)
+	hash := SortHash(vrfHash[:], 0, 0)
+	err := VerifySort(pubBytes(k), input, proof, vrfHash[:], hash, 0, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if VerifySort(pubBytes(k), input, proof, vrfHash[:], hash, 0, 0, 0) != ErrNotWin {
+		t.Fatal("must not win at diff 0")
+	}
+}
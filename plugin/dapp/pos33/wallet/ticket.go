@@ -161,6 +161,17 @@ func (policy *ticketPolicy) onAddOrDeleteBlockTx(block *types.BlockDetail, tx *t
 			return nil
 		}
 		mact := pact.GetMiner()
+		err = mact.Expand(func(is []int64) ([][]byte, error) {
+			msg, err := policy.getAPI().Query(ty.Pos33TicketX, "Pos33BlsPubkeys", &ty.ReqPos33BlsPubkeys{Indices: is})
+			if err != nil {
+				return nil, err
+			}
+			return msg.(*ty.Pos33BlsPubkeys).Pks, nil
+		})
+		if err != nil {
+			bizlog.Error("pos33 agg vote expand error", "err", err)
+			return nil
+		}
 		n := int64(0)
 		for _, pk := range mact.BlsPkList {
 			addr := address.PubKeyToAddr(ethID, pk)
@@ -3,4 +3,5 @@ package init
 import (
 	_ "github.com/33cn/plugin/plugin/mempool/price" //auto gen
 	_ "github.com/33cn/plugin/plugin/mempool/score" //auto gen
+	_ "github.com/yccproject/ycc/plugin/mempool/pos33"
 )
@@ -0,0 +1,51 @@
+// Package pos33 按手续费排序的mempool, 另外有一个给pos33共识交易的优先通道.
+// 出块, 举报双重投票和解除关押的交易不和用户交易按手续费排队, 也不占用poolCacheSize,
+// 每次取区块交易时最多优先打包priorityQuota个, 超过的排在所有交易后面.
+// 最低手续费和每个地址的交易数仍然由mempool检查. 默认的mempool仍然是price, 配置name = "pos33"才使用
+package pos33
+
+import (
+	"github.com/33cn/chain33/queue"
+	drivers "github.com/33cn/chain33/system/mempool"
+	"github.com/33cn/chain33/types"
+)
+
+// 优先通道的默认大小和每个区块的配额
+const (
+	defaultPriorityCacheSize = 10000
+	defaultPriorityQuota     = 100
+)
+
+type subConfig struct {
+	PoolCacheSize int64 `json:"poolCacheSize"`
+	ProperFee     int64 `json:"properFee"`
+	// PriorityCacheSize 优先通道最多缓存的交易数
+	PriorityCacheSize int64 `json:"priorityCacheSize"`
+	// PriorityQuota 每次取区块交易时最多优先打包的交易数, 小于0时不优先
+	PriorityQuota int `json:"priorityQuota"`
+}
+
+func init() {
+	drivers.Reg("pos33", New)
+}
+
+// New 创建有pos33优先通道的mempool
+func New(cfg *types.Mempool, sub []byte) queue.Module {
+	c := drivers.NewMempool(cfg)
+	var subcfg subConfig
+	types.MustDecode(sub, &subcfg)
+	if subcfg.PoolCacheSize == 0 {
+		subcfg.PoolCacheSize = cfg.PoolCacheSize
+	}
+	if subcfg.ProperFee == 0 {
+		subcfg.ProperFee = cfg.MinTxFeeRate
+	}
+	if subcfg.PriorityCacheSize == 0 {
+		subcfg.PriorityCacheSize = defaultPriorityCacheSize
+	}
+	if subcfg.PriorityQuota == 0 {
+		subcfg.PriorityQuota = defaultPriorityQuota
+	}
+	c.SetQueueCache(NewQueue(subcfg))
+	return c
+}
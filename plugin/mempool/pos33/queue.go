@@ -0,0 +1,231 @@
+package pos33
+
+import (
+	"sync"
+
+	"github.com/33cn/chain33/common/listmap"
+	"github.com/33cn/chain33/common/skiplist"
+	drivers "github.com/33cn/chain33/system/mempool"
+	"github.com/33cn/chain33/types"
+	"github.com/golang/protobuf/proto"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+// Classifier 判断交易是否走优先通道
+type Classifier func(tx *types.Transaction) bool
+
+var (
+	classifierMu sync.RWMutex
+	classifiers  []Classifier
+)
+
+func init() {
+	RegisterClassifier(IsConsensusTx)
+}
+
+// RegisterClassifier 注册优先通道的判断, 任何一个返回true的交易走优先通道
+func RegisterClassifier(c Classifier) {
+	classifierMu.Lock()
+	defer classifierMu.Unlock()
+	classifiers = append(classifiers, c)
+}
+
+func isPriority(tx *types.Transaction) bool {
+	classifierMu.RLock()
+	defer classifierMu.RUnlock()
+	for _, c := range classifiers {
+		if c(tx) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsConsensusTx pos33共识需要的交易: 出块, 举报双重投票, 解除关押. 交易组不算
+func IsConsensusTx(tx *types.Transaction) bool {
+	if string(tx.Execer) != pt.Pos33TicketX || tx.GetGroupCount() > 0 {
+		return false
+	}
+	var act pt.Pos33TicketAction
+	if types.Decode(tx.Payload, &act) != nil {
+		return false
+	}
+	switch act.Ty {
+	case pt.Pos33TicketActionMiner, pt.Pos33ActionSlash, pt.Pos33ActionUnjail:
+		return true
+	}
+	return false
+}
+
+type priceScore struct {
+	*drivers.Item
+}
+
+func (item *priceScore) GetScore() int64 {
+	txSize := proto.Size(item.Value)
+	return item.Value.Fee / int64(txSize)
+}
+
+func (item *priceScore) Hash() []byte {
+	return item.Value.Hash()
+}
+
+func (item *priceScore) Compare(cmp skiplist.Scorer) int {
+	it := cmp.(*priceScore)
+	//时间越小，权重越高
+	if item.EnterTime < it.EnterTime {
+		return skiplist.Big
+	}
+	if item.EnterTime == it.EnterTime {
+		return skiplist.Equal
+	}
+	return skiplist.Small
+}
+
+func (item *priceScore) ByteSize() int64 {
+	return int64(proto.Size(item.Value))
+}
+
+// Queue 用户交易按手续费排序, 优先通道的交易按进入的顺序排在前面
+type Queue struct {
+	*skiplist.Queue
+	lane      *listmap.ListMap
+	laneBytes int64
+	subConfig subConfig
+}
+
+// NewQueue 创建队列
+func NewQueue(subcfg subConfig) *Queue {
+	return &Queue{
+		Queue:     skiplist.NewQueue(subcfg.PoolCacheSize),
+		lane:      listmap.New(),
+		subConfig: subcfg,
+	}
+}
+
+// Exist 是否存在
+func (cache *Queue) Exist(hash string) bool {
+	return cache.lane.Exist(hash) || cache.Queue.Exist(hash)
+}
+
+// GetItem 获取交易
+func (cache *Queue) GetItem(hash string) (*drivers.Item, error) {
+	if v, err := cache.lane.GetItem(hash); err == nil {
+		return v.(*drivers.Item), nil
+	}
+	item, err := cache.Queue.GetItem(hash)
+	if err != nil {
+		return nil, err
+	}
+	return item.(*priceScore).Item, nil
+}
+
+// Push 共识交易放进优先通道, 满了返回ErrMemFull, 不和用户交易竞争
+func (cache *Queue) Push(item *drivers.Item) error {
+	if !isPriority(item.Value) {
+		return cache.Queue.Push(&priceScore{Item: item})
+	}
+	hash := string(item.Value.Hash())
+	if cache.Exist(hash) {
+		return types.ErrTxExist
+	}
+	if int64(cache.lane.Size()) >= cache.subConfig.PriorityCacheSize {
+		return types.ErrMemFull
+	}
+	cache.lane.Push(hash, item)
+	cache.laneBytes += int64(proto.Size(item.Value))
+	return nil
+}
+
+// Remove 删除交易
+func (cache *Queue) Remove(hash string) error {
+	if v, err := cache.lane.GetItem(hash); err == nil {
+		cache.lane.Remove(hash)
+		cache.laneBytes -= int64(proto.Size(v.(*drivers.Item).Value))
+		return nil
+	}
+	return cache.Queue.Remove(hash)
+}
+
+// Size 所有交易的数量
+func (cache *Queue) Size() int {
+	return cache.lane.Size() + cache.Queue.Size()
+}
+
+// PrioritySize 优先通道的交易数量
+func (cache *Queue) PrioritySize() int {
+	return cache.lane.Size()
+}
+
+// GetCacheBytes 所有交易的字节数
+func (cache *Queue) GetCacheBytes() int64 {
+	return cache.laneBytes + cache.Queue.GetCacheBytes()
+}
+
+// Walk 先是优先通道最早的priorityQuota个交易, 然后是按手续费排序的交易, 最后是优先通道剩下的.
+// mempool每次取区块交易都从头遍历, 所以一个区块最多优先打包priorityQuota个
+func (cache *Queue) Walk(count int, cb func(tx *drivers.Item) bool) {
+	i := 0
+	visit := func(item *drivers.Item) bool {
+		i++
+		return cb(item) && (count <= 0 || i < count)
+	}
+	quota := cache.subConfig.PriorityQuota
+	var rest []*drivers.Item
+	next := true
+	cache.lane.Walk(func(v interface{}) bool {
+		item := v.(*drivers.Item)
+		if quota <= 0 {
+			rest = append(rest, item)
+			return true
+		}
+		quota--
+		next = visit(item)
+		return next
+	})
+	if !next {
+		return
+	}
+	cache.Queue.Walk(0, func(item skiplist.Scorer) bool {
+		next = visit(item.(*priceScore).Item)
+		return next
+	})
+	for _, item := range rest {
+		if !next {
+			return
+		}
+		next = visit(item)
+	}
+}
+
+// GetProperFee 按手续费排序的交易的平均费率, 不包括优先通道
+func (cache *Queue) GetProperFee() int64 {
+	var sumFeeRate int64
+	var properFeeRate int64
+	if cache.Queue.Size() < 100 {
+		return cache.subConfig.ProperFee
+	}
+	i := 0
+	var feeRate int64
+	cache.Queue.Walk(100, func(item skiplist.Scorer) bool {
+		tx := item.(*priceScore).Value
+		//总单元费率的个数, 单个交易根据txsize/1000 + 1计算
+		unitFeeNum := proto.Size(tx)/1000 + 1
+		//交易组计算
+		if count := tx.GetGroupCount(); count > 0 {
+			unitFeeNum = int(count)
+			txs, err := tx.GetTxGroup()
+			if err == nil {
+				for _, tx := range txs.GetTxs() {
+					unitFeeNum += proto.Size(tx) / 1000
+				}
+			}
+		}
+		feeRate = tx.Fee / int64(unitFeeNum)
+		sumFeeRate += feeRate
+		i++
+		return true
+	})
+	properFeeRate = sumFeeRate / int64(i)
+	return properFeeRate
+}
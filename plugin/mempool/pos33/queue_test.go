@@ -0,0 +1,98 @@
+package pos33
+
+import (
+	"testing"
+
+	drivers "github.com/33cn/chain33/system/mempool"
+	"github.com/33cn/chain33/types"
+	"github.com/stretchr/testify/require"
+	pt "github.com/yccproject/ycc/plugin/dapp/pos33/types"
+)
+
+func newPos33Item(ty int32, nonce int64) *drivers.Item {
+	act := &pt.Pos33TicketAction{Ty: ty}
+	tx := &types.Transaction{Execer: []byte(pt.Pos33TicketX), Payload: types.Encode(act), Fee: 100000, Nonce: nonce}
+	return &drivers.Item{Value: tx, Priority: tx.Fee, EnterTime: nonce}
+}
+
+func newUserItem(fee, nonce int64) *drivers.Item {
+	tx := &types.Transaction{Execer: []byte("coins"), Payload: []byte("transfer"), Fee: fee, Nonce: nonce}
+	return &drivers.Item{Value: tx, Priority: tx.Fee, EnterTime: nonce}
+}
+
+func walkAll(cache *Queue, count int) []*drivers.Item {
+	var items []*drivers.Item
+	cache.Walk(count, func(item *drivers.Item) bool {
+		items = append(items, item)
+		return true
+	})
+	return items
+}
+
+func TestIsConsensusTx(t *testing.T) {
+	for _, ty := range []int32{pt.Pos33TicketActionMiner, pt.Pos33ActionSlash, pt.Pos33ActionUnjail} {
+		require.True(t, IsConsensusTx(newPos33Item(ty, 1).Value), ty)
+	}
+	require.False(t, IsConsensusTx(newPos33Item(pt.Pos33ActionEntrust, 1).Value))
+	require.False(t, IsConsensusTx(newUserItem(100000, 1).Value))
+
+	tx := newPos33Item(pt.Pos33ActionSlash, 1).Value
+	tx.GroupCount = 2
+	require.False(t, IsConsensusTx(tx))
+	tx = newPos33Item(pt.Pos33ActionSlash, 1).Value
+	tx.Payload = []byte("bad payload")
+	require.False(t, IsConsensusTx(tx))
+}
+
+func TestPriorityLane(t *testing.T) {
+	cache := NewQueue(subConfig{PoolCacheSize: 2, PriorityCacheSize: 3, PriorityQuota: 2})
+	u1, u2, u3 := newUserItem(1000000, 1), newUserItem(2000000, 2), newUserItem(100000, 3)
+	require.Nil(t, cache.Push(u1))
+	require.Nil(t, cache.Push(u2))
+	// 用户交易满了, 手续费低的进不来
+	require.Equal(t, types.ErrMemFull, cache.Push(u3))
+
+	// 共识交易不占用poolCacheSize, 手续费低也可以进来
+	p1, p2, p3 := newPos33Item(pt.Pos33TicketActionMiner, 4), newPos33Item(pt.Pos33ActionSlash, 5), newPos33Item(pt.Pos33ActionUnjail, 6)
+	for _, p := range []*drivers.Item{p1, p2, p3} {
+		require.Nil(t, cache.Push(p))
+	}
+	require.Equal(t, types.ErrTxExist, cache.Push(p1))
+	require.Equal(t, types.ErrMemFull, cache.Push(newPos33Item(pt.Pos33ActionUnjail, 7)))
+	require.Equal(t, 5, cache.Size())
+	require.Equal(t, 3, cache.PrioritySize())
+	require.True(t, cache.Exist(string(p2.Value.Hash())))
+	it, err := cache.GetItem(string(p2.Value.Hash()))
+	require.Nil(t, err)
+	require.Equal(t, p2, it)
+
+	// 最多priorityQuota个排在前面, 剩下的在用户交易后面
+	require.Equal(t, []*drivers.Item{p1, p2, u2, u1, p3}, walkAll(cache, 0))
+	require.Equal(t, []*drivers.Item{p1, p2, u2}, walkAll(cache, 3))
+	require.Equal(t, []*drivers.Item{p1}, walkAll(cache, 1))
+
+	bytes := cache.GetCacheBytes()
+	require.Nil(t, cache.Remove(string(p1.Value.Hash())))
+	require.Equal(t, bytes-int64(types.Size(p1.Value)), cache.GetCacheBytes())
+	require.Nil(t, cache.Remove(string(u2.Value.Hash())))
+	require.Equal(t, []*drivers.Item{p2, p3, u1}, walkAll(cache, 0))
+	_, err = cache.GetItem(string(p1.Value.Hash()))
+	require.Equal(t, types.ErrNotFound, err)
+
+	// 配额小于0时共识交易都排在后面
+	cache = NewQueue(subConfig{PoolCacheSize: 2, PriorityCacheSize: 3, PriorityQuota: -1})
+	require.Nil(t, cache.Push(p1))
+	require.Nil(t, cache.Push(u1))
+	require.Equal(t, []*drivers.Item{u1, p1}, walkAll(cache, 0))
+}
+
+func TestRegisterClassifier(t *testing.T) {
+	cache := NewQueue(subConfig{PoolCacheSize: 10, PriorityCacheSize: 10, PriorityQuota: 10})
+	u1, u2 := newUserItem(2000000, 1), newUserItem(1000000, 2)
+	u2.Value.Execer = []byte("user.priority")
+	RegisterClassifier(func(tx *types.Transaction) bool { return string(tx.Execer) == "user.priority" })
+	require.Nil(t, cache.Push(u1))
+	require.Nil(t, cache.Push(u2))
+	require.Equal(t, 1, cache.PrioritySize())
+	require.Equal(t, []*drivers.Item{u2, u1}, walkAll(cache, 0))
+}
@@ -89,6 +89,14 @@ pricePower=1     #常量比例
 [mempool.sub.price]
 poolCacheSize = 1024000
 
+# 可选的pos33 mempool, 需要把上面的name改成"pos33"才启用, 默认仍然是price.
+# pos33的出块, 举报和解除关押的交易走优先通道, 不按手续费排队, 不占用poolCacheSize,
+# 优先通道最多priorityCacheSize个交易, 每个区块最多优先打包priorityQuota个
+[mempool.sub.pos33]
+poolCacheSize = 1024000
+priorityCacheSize = 10000
+priorityQuota = 100
+
 [consensus]
 name="pos33"
 minerstart=true
@@ -119,6 +127,34 @@ rewardTransfer=1
 blockReward=15
 voteRewardPersent=25
 mineRewardPersent=11
+# ForkParticipants之后, 只有allowList中的地址可以参与共识(为空不限制), denyList中的地址不能参与
+allowList=[]
+denyList=[]
+# ForkQuorumRule之后区块投票数量的规则: seats(过半), twothirds(超过2/3), stake:<percent>(投票人的抵押超过全网的percent%)
+quorumRule="seats"
+# ForkSlash之后, 双重投票的地址每个委托人的抵押扣除slashPersent%, 其中slashBountyPersent%奖励给举报人, 剩下的销毁
+slashPersent=10
+slashBountyPersent=20
+# ForkJail之后, 连续jailBlocks个高度没有出块和投票, 而且期望的投票数足够的矿工可以被关押, 关押期间票数为0, 0表示不关押
+jailBlocks=17280
+# ForkRewardV2之后, 每个投票奖励perVoteReward/100个币, 出块人再奖励makerBonus/100个币,
+# 前面超时的轮次没有出块, 它们的出块奖励累积给这一轮的出块人, 最多累积maxCarryRounds轮, 剩下的给基金
+perVoteReward=36
+makerBonus=100
+maxCarryRounds=3
+# ForkTicketPrice之后, 每priceEpoch个区块按总抵押/总发行量调整一次票价, 目标抵押率targetBondRatio%,
+# 抵押率高于目标时涨价, 低于目标时降价, 每次最多调整maxPriceStep%, 票价在minTicketPrice和maxTicketPrice之间.
+# forkSupply是分叉高度的总发行量, 以后按区块奖励累加, 为0时不调整
+priceEpoch=17280
+targetBondRatio=50
+maxPriceStep=5
+minTicketPrice=10000
+maxTicketPrice=1000000
+forkSupply=0
+# ForkAddressFormat之后抽签时从公钥推导矿工地址的格式(eth, btc), 必须和链上记录票数的地址格式一致
+addressFormat="eth"
+# ForkSubCommittee之后子委员会的数量, 每个子委员会独立抽签, 最多3个
+subCommittees=1
 
 [store]
 dbCache = 256
@@ -204,6 +240,10 @@ paraConsensusStopBlocks=30000
 total="0x6950e4d7a94947b1f36265828de26c13ba3dee69"
 useBalance=false
 
+[exec.sub.pos33]
+#在localdb记录每个高度的抽签统计, 供浏览器查询出块记录, 委员会大小和轮次分布
+enableSortIndex=false
+
 [mver.autonomy]
 #最小委员会数量
 minBoards=20
@@ -305,6 +345,24 @@ Enable=0
 ForkReward15=0 
 ForkFixReward=0
 UseEntrust=0
+ForkSubCommittee=-1
+ForkRoundEvidence=-1
+ForkAddressFormat=-1
+ForkEvidence=-1
+ForkVrfSuite=-1
+ForkParticipants=-1
+ForkQuorumRule=-1
+ForkSlash=-1
+ForkChainParam=-1
+ForkAggVote=-1
+ForkDelegate=-1
+ForkDiffV2=-1
+ForkJail=-1
+ForkKeyRotate=-1
+ForkRewardV2=-1
+ForkTicketPrice=-1
+ForkLightProof=-1
+ForkBlockCheck=-1
 
 [fork.sub.none]
 ForkUseTimeDelay=0